@@ -22,7 +22,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a list of administrations",
+                "description": "API for getting a list of administrations with totalsum, what locked payroll runs paid them",
                 "consumes": [
                     "application/json"
                 ],
@@ -77,14 +77,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/CreateAdministration": {
+        "/ApproveCashShift/{id}": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for creating administration",
+                "description": "API for a manager to approve the Z-report of a closed shift. Shifts with a mismatch need a note.",
                 "consumes": [
                     "application/json"
                 ],
@@ -92,17 +92,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "administration"
+                    "cash_shift"
                 ],
-                "summary": "Create administration",
+                "summary": "Approve a cash shift",
                 "parameters": [
                     {
-                        "description": "administration",
-                        "name": "administration",
+                        "type": "string",
+                        "description": "Cash Shift ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Approval",
+                        "name": "shift",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/user_service.CreateAdministration"
+                            "$ref": "#/definitions/schedule_service.ApproveCashShift"
                         }
                     }
                 ],
@@ -110,7 +117,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.CreateAdministration"
+                            "$ref": "#/definitions/schedule_service.CashShift"
                         }
                     },
                     "404": {
@@ -128,14 +135,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/CreateBranch": {
+        "/AssignSubstitute": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for creating branch",
+                "description": "API for assigning a substitute teacher to a single lesson. An empty teacherId removes the substitute.",
                 "consumes": [
                     "application/json"
                 ],
@@ -143,17 +150,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "branch"
+                    "schedule"
                 ],
-                "summary": "Create branch",
+                "summary": "Assign substitute teacher",
                 "parameters": [
                     {
-                        "description": "Branch",
-                        "name": "branch",
+                        "description": "Substitute",
+                        "name": "substitute",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/user_service.CreateBranch"
+                            "$ref": "#/definitions/schedule_service.AssignSubstituteRequest"
                         }
                     }
                 ],
@@ -161,7 +168,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.CreateBranch"
+                            "$ref": "#/definitions/schedule_service.GetSchedule"
                         }
                     },
                     "404": {
@@ -179,14 +186,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/CreateEvent": {
+        "/BookLeadTrial/{id}": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for creating event",
+                "description": "API for booking a lead into an upcoming lesson (schedule) of a group in their branch. Booking again moves the trial.",
                 "consumes": [
                     "application/json"
                 ],
@@ -194,17 +201,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "event"
+                    "lead"
                 ],
-                "summary": "Create event",
+                "summary": "Book trial lesson",
                 "parameters": [
                     {
-                        "description": "Event",
-                        "name": "event",
+                        "type": "string",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Trial",
+                        "name": "trial",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.CreateEvent"
+                            "$ref": "#/definitions/user_service.BookLeadTrial"
                         }
                     }
                 ],
@@ -212,7 +226,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.CreateEvent"
+                            "$ref": "#/definitions/user_service.Lead"
                         }
                     },
                     "404": {
@@ -230,14 +244,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/CreateEventStudent": {
+        "/CancelEventStudent/{id}": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for creating event student",
+                "description": "API for cancelling an event registration before the event's cutoff. Students can only cancel their own registrations; the freed seat goes to the first waitlisted student.",
                 "consumes": [
                     "application/json"
                 ],
@@ -247,23 +261,21 @@ const docTemplate = `{
                 "tags": [
                     "event_student"
                 ],
-                "summary": "Create event student",
+                "summary": "Cancel an event registration",
                 "parameters": [
                     {
-                        "description": "Event Student",
-                        "name": "event_student",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schedule_service.CreateEventStudent"
-                        }
+                        "type": "string",
+                        "description": "Event Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.CreateEventStudent"
+                            "$ref": "#/definitions/schedule_service.GetEventStudent"
                         }
                     },
                     "404": {
@@ -281,14 +293,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/CreateGroup": {
+        "/CheckInEventStudent": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for creating group",
+                "description": "API for marking a registered student as attended, either by the scanned QR payload or by event and student ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -296,17 +308,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "event_student"
                 ],
-                "summary": "Create group",
+                "summary": "Check a student in to an event",
                 "parameters": [
                     {
-                        "description": "Group",
-                        "name": "group",
+                        "description": "Check In",
+                        "name": "check_in",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.CreateGroup"
+                            "$ref": "#/definitions/schedule_service.CheckInEventStudent"
                         }
                     }
                 ],
@@ -314,7 +326,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.CreateGroup"
+                            "$ref": "#/definitions/schedule_service.GetEventStudent"
                         }
                     },
                     "404": {
@@ -332,14 +344,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/CreateJournal": {
+        "/ClearStudentHold/{id}": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for creating journal",
+                "description": "API for lifting the hold of a student. A student who is still overdue is not put back on hold for the same overdue payments.",
                 "consumes": [
                     "application/json"
                 ],
@@ -347,17 +359,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "journal"
+                    "overdue"
                 ],
-                "summary": "Create journal",
+                "summary": "Clear the hold of a student",
                 "parameters": [
                     {
-                        "description": "Journal",
-                        "name": "journal",
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Note",
+                        "name": "hold",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.CreateJournal"
+                            "$ref": "#/definitions/schedule_service.ClearStudentHold"
                         }
                     }
                 ],
@@ -365,7 +384,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetJournal"
+                            "$ref": "#/definitions/schedule_service.StudentHold"
                         }
                     },
                     "404": {
@@ -383,14 +402,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/CreateManager": {
+        "/CloseCashShift/{id}": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for creating manager",
+                "description": "API for closing a cash shift with the amounts counted per payment method (cash, card, transfer). Returns the Z-report: expected against counted per method, with mismatches flagged. Administrators can only close their own shift.",
                 "consumes": [
                     "application/json"
                 ],
@@ -398,17 +417,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "manager"
+                    "cash_shift"
                 ],
-                "summary": "Create manager",
+                "summary": "Close a cash shift",
                 "parameters": [
                     {
-                        "description": "Manager",
-                        "name": "manager",
+                        "type": "string",
+                        "description": "Cash Shift ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Counted amounts",
+                        "name": "shift",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/user_service.CreateManager"
+                            "$ref": "#/definitions/schedule_service.CloseCashShift"
                         }
                     }
                 ],
@@ -416,7 +442,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.CreateManager"
+                            "$ref": "#/definitions/schedule_service.CashShift"
                         }
                     },
                     "404": {
@@ -434,14 +460,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/CreateSchedule": {
+        "/CommitTimetable/{id}": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for creating schedule",
+                "description": "API for saving a proposal as recurring lessons and creating the future lessons of open journals in the room each group already uses. The commit fails when a room is taken at a new lesson time.",
                 "consumes": [
                     "application/json"
                 ],
@@ -449,25 +475,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schedule"
+                    "timetable"
                 ],
-                "summary": "Create schedule",
+                "summary": "Commit a timetable proposal",
                 "parameters": [
                     {
-                        "description": "Schedule",
-                        "name": "schedule",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schedule_service.CreateSchedule"
-                        }
+                        "type": "string",
+                        "description": "Proposal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetSchedule"
+                            "$ref": "#/definitions/schedule_service.CommitTimetableResponse"
                         }
                     },
                     "404": {
@@ -485,14 +509,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/CreateStudent": {
+        "/ConvertLead/{id}": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for creating student",
+                "description": "API for turning an open lead into a student with the lead's name, phone and branch and a generated login (returned as studentLogin). A groupName must name a running group of the lead's branch with a free seat; the student is enrolled in it in the same step, so a full or unknown group leaves the lead open.",
                 "consumes": [
                     "application/json"
                 ],
@@ -500,17 +524,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "student"
+                    "lead"
                 ],
-                "summary": "Create student",
+                "summary": "Convert lead",
                 "parameters": [
                     {
-                        "description": "Student",
+                        "type": "string",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Student password and group",
                         "name": "student",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/user_service.CreateStudent"
+                            "$ref": "#/definitions/user_service.ConvertLead"
                         }
                     }
                 ],
@@ -518,7 +549,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.CreateStudent"
+                            "$ref": "#/definitions/user_service.Lead"
                         }
                     },
                     "404": {
@@ -536,14 +567,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/CreateStudentPayment": {
+        "/CreateAdministration": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for creating student payment",
+                "description": "API for creating administration. ieltsScore is derived from the IELTS results and ignored here.",
                 "consumes": [
                     "application/json"
                 ],
@@ -551,17 +582,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "student_payment"
+                    "administration"
                 ],
-                "summary": "Create student payment",
+                "summary": "Create administration",
                 "parameters": [
                     {
-                        "description": "Student Payment",
-                        "name": "student_payment",
+                        "description": "administration",
+                        "name": "administration",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.CreateStudentPayment"
+                            "$ref": "#/definitions/user_service.CreateAdministration"
                         }
                     }
                 ],
@@ -569,7 +600,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetStudentPayment"
+                            "$ref": "#/definitions/user_service.CreateAdministration"
                         }
                     },
                     "404": {
@@ -587,14 +618,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/CreateStudentTask": {
+        "/CreateAvailability": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for creating student task",
+                "description": "API for adding a weekly availability window of a teacher or a support teacher. Teachers and support teachers can only add their own windows.",
                 "consumes": [
                     "application/json"
                 ],
@@ -602,17 +633,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "student_task"
+                    "timetable"
                 ],
-                "summary": "Create student task",
+                "summary": "Create availability window",
                 "parameters": [
                     {
-                        "description": "Student Task",
-                        "name": "student_task",
+                        "description": "Availability",
+                        "name": "availability",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.CreateStudentTask"
+                            "$ref": "#/definitions/schedule_service.CreateTeacherAvailability"
                         }
                     }
                 ],
@@ -620,7 +651,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetStudentTask"
+                            "$ref": "#/definitions/schedule_service.TeacherAvailability"
                         }
                     },
                     "404": {
@@ -638,14 +669,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/CreateSupportTeacher": {
+        "/CreateBranch": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for creating support teacher",
+                "description": "API for creating branch",
                 "consumes": [
                     "application/json"
                 ],
@@ -653,17 +684,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "support_teacher"
+                    "branch"
                 ],
-                "summary": "Create support teacher",
+                "summary": "Create branch",
                 "parameters": [
                     {
-                        "description": "Support Teacher",
-                        "name": "support_teacher",
+                        "description": "Branch",
+                        "name": "branch",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/user_service.CreateSupportTeacher"
+                            "$ref": "#/definitions/user_service.CreateBranch"
                         }
                     }
                 ],
@@ -671,7 +702,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.CreateSupportTeacher"
+                            "$ref": "#/definitions/user_service.CreateBranch"
                         }
                     },
                     "404": {
@@ -689,14 +720,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/CreateTask": {
+        "/CreateBranchSetting": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for creating task",
+                "description": "API for creating the rules of a branch. Event weekdays are ISO (1 = Monday, 7 = Sunday); fields left empty get the defaults.",
                 "consumes": [
                     "application/json"
                 ],
@@ -704,17 +735,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "task"
+                    "branch_setting"
                 ],
-                "summary": "Create task",
+                "summary": "Create branch settings",
                 "parameters": [
                     {
-                        "description": "Task",
-                        "name": "task",
+                        "description": "Branch Setting",
+                        "name": "branch_setting",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.CreateTask"
+                            "$ref": "#/definitions/user_service.CreateBranchSetting"
                         }
                     }
                 ],
@@ -722,7 +753,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetTask"
+                            "$ref": "#/definitions/user_service.BranchSetting"
                         }
                     },
                     "404": {
@@ -740,14 +771,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/CreateTeacher": {
+        "/CreateCommissionRule": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for creating teacher",
+                "description": "API for setting the percentage (rate, such as \"5.00\") of collected tuition a teacher or support_teacher earns from the month of validFrom on. A rule is for one staff member, one group type (beginner, elementary, intermediate, ielts) or both; the most specific rule wins. Refunds and reversals claw the commission back.",
                 "consumes": [
                     "application/json"
                 ],
@@ -755,17 +786,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "teacher"
+                    "commission"
                 ],
-                "summary": "Create teacher",
+                "summary": "Create commission rule",
                 "parameters": [
                     {
-                        "description": "Teacher",
-                        "name": "teacher",
+                        "description": "Commission Rule",
+                        "name": "rule",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/user_service.CreateTeacher"
+                            "$ref": "#/definitions/schedule_service.CreateCommissionRule"
                         }
                     }
                 ],
@@ -773,7 +804,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.CreateTeacher"
+                            "$ref": "#/definitions/schedule_service.CommissionRule"
                         }
                     },
                     "404": {
@@ -791,14 +822,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/DeleteAdministration/{id}": {
-            "delete": {
+        "/CreateContractTemplate": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for deleting a administration by ID",
+                "description": "API for creating an enrollment contract template. The body may use placeholders such as {{studentName}}; GetListContractTemplate lists them. Templates without a branch are shared.",
                 "consumes": [
                     "application/json"
                 ],
@@ -806,23 +837,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "administration"
+                    "document"
                 ],
-                "summary": "Delete a administration by ID",
+                "summary": "Create contract template",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "administration ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Contract Template",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateContractTemplate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.EmptyAdmin"
+                            "$ref": "#/definitions/schedule_service.ContractTemplate"
                         }
                     },
                     "404": {
@@ -840,14 +873,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/DeleteBranch/{id}": {
-            "delete": {
+        "/CreateDiscount": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for deleting a branch by ID",
+                "description": "API for giving a student a sibling, scholarship or other discount, optionally for one group only and between validFrom and validTo (YYYY-MM-DD). kind is percent or fixed and value a decimal string. Discounts that are not stackable are never combined with others.",
                 "consumes": [
                     "application/json"
                 ],
@@ -855,23 +888,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "branch"
+                    "discount"
                 ],
-                "summary": "Delete a branch by ID",
+                "summary": "Create discount",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Branch ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Discount",
+                        "name": "discount",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateDiscount"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.EmptyBranch"
+                            "$ref": "#/definitions/schedule_service.Discount"
                         }
                     },
                     "404": {
@@ -889,14 +924,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/DeleteEvent/{id}": {
-            "delete": {
+        "/CreateEvent": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for deleting an event by ID",
+                "description": "API for creating event. An event given a roomId needs an endTime, must fit in the room and cannot overlap its other bookings; without a capacity it takes the room's.",
                 "consumes": [
                     "application/json"
                 ],
@@ -906,21 +941,23 @@ const docTemplate = `{
                 "tags": [
                     "event"
                 ],
-                "summary": "Delete an event by ID",
+                "summary": "Create event",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Event",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateEvent"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.EmptyEvent"
+                            "$ref": "#/definitions/schedule_service.CreateEvent"
                         }
                     },
                     "404": {
@@ -938,14 +975,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/DeleteEventStudent/{id}": {
-            "delete": {
+        "/CreateEventFeedback": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for deleting an event student by ID",
+                "description": "API for rating an attended event from 1 to 5 with an optional comment. A registration can be rated once, after the event starts.",
                 "consumes": [
                     "application/json"
                 ],
@@ -953,23 +990,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "event_student"
+                    "event_feedback"
                 ],
-                "summary": "Delete an event student by ID",
+                "summary": "Leave feedback on an event",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event Student ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Feedback",
+                        "name": "feedback",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateEventFeedback"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.EmptyEventStudent"
+                            "$ref": "#/definitions/schedule_service.EventFeedback"
                         }
                     },
                     "404": {
@@ -987,14 +1026,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/DeleteGroup/{id}": {
-            "delete": {
+        "/CreateEventStudent": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for deleting a group by ID",
+                "description": "API for registering the current student for an event. Registration closes cutoffHours before the event starts; when the event is full the student is waitlisted.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1002,23 +1041,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "event_student"
                 ],
-                "summary": "Delete a group by ID",
+                "summary": "Create event student",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
+                        "description": "Event Student",
+                        "name": "event_student",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateEventStudent"
+                        }
+                    }
+                ],
+                "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.EmptyGroup"
+                            "$ref": "#/definitions/schedule_service.GetEventStudent"
                         }
                     },
                     "404": {
@@ -1036,14 +1077,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/DeleteJournal/{id}": {
-            "delete": {
+        "/CreateExpense": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for deleting a journal by ID",
+                "description": "API for recording a branch expense. It stays pending until a manager approves it. Administrators record expenses of their own branch; others must give branchId. The amount is a decimal string such as \"1500000.00\" and spentOn (YYYY-MM-DD) defaults to today.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1051,23 +1092,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "journal"
+                    "expense"
                 ],
-                "summary": "Delete a journal by ID",
+                "summary": "Create expense",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Journal ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Expense",
+                        "name": "expense",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateExpense"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.EmptyJournal"
+                            "$ref": "#/definitions/schedule_service.Expense"
                         }
                     },
                     "404": {
@@ -1085,14 +1128,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/DeleteManager/{id}": {
-            "delete": {
+        "/CreateExpenseCategory": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for deleting a manager by ID",
+                "description": "API for adding an expense category such as rent or utilities",
                 "consumes": [
                     "application/json"
                 ],
@@ -1100,23 +1143,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "manager"
+                    "expense"
                 ],
-                "summary": "Delete a manager by ID",
+                "summary": "Create expense category",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Manager ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Expense Category",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateExpenseCategory"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.EmptyManager"
+                            "$ref": "#/definitions/schedule_service.ExpenseCategory"
                         }
                     },
                     "404": {
@@ -1134,14 +1179,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/DeleteSchedule/{id}": {
-            "delete": {
+        "/CreateGroup": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for deleting a schedule by ID",
+                "description": "API for creating group",
                 "consumes": [
                     "application/json"
                 ],
@@ -1149,23 +1194,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schedule"
+                    "group"
                 ],
-                "summary": "Delete a schedule by ID",
+                "summary": "Create group",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Schedule ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Group",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateGroup"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.EmptySchedule"
+                            "$ref": "#/definitions/schedule_service.CreateGroup"
                         }
                     },
                     "404": {
@@ -1183,14 +1230,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/DeleteStudent/{id}": {
-            "delete": {
+        "/CreateGroupStudent": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for deleting a student by ID",
+                "description": "API for adding a student to a group",
                 "consumes": [
                     "application/json"
                 ],
@@ -1198,23 +1245,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "student"
+                    "group_student"
                 ],
-                "summary": "Delete a student by ID",
+                "summary": "Add student to group",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Student ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Group Student",
+                        "name": "group_student",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateGroupStudent"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.StudentEmpty"
+                            "$ref": "#/definitions/schedule_service.GetGroupStudent"
                         }
                     },
                     "404": {
@@ -1232,14 +1281,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/DeleteStudentPayment/{id}": {
-            "delete": {
+        "/CreateGuardian": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for deleting a student payment by ID",
+                "description": "API for creating a parent or guardian. The login (P00001, P00002, ...) is generated; link students with /LinkGuardianStudent.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1247,23 +1296,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "student_payment"
+                    "guardian"
                 ],
-                "summary": "Delete a student payment by ID",
+                "summary": "Create guardian",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Student Payment ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Guardian",
+                        "name": "guardian",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.CreateGuardian"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.EmptyStudentPayment"
+                            "$ref": "#/definitions/user_service.Guardian"
                         }
                     },
                     "404": {
@@ -1281,14 +1332,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/DeleteStudentTask/{id}": {
-            "delete": {
+        "/CreateIeltsResult": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for deleting a student task by ID",
+                "description": "API for recording an IELTS test of a teacher, support_teacher or administration. Bands go from 0 to 9 in half bands; overall is worked out from the four sub-scores when left empty. The certificate expires two years after testDate (YYYY-MM-DD). The staff member's ieltsScore becomes the overall band of their latest test and ieltsAttemptCount the number of tests.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1296,23 +1347,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "student_task"
+                    "ielts_result"
                 ],
-                "summary": "Delete a student task by ID",
+                "summary": "Create IELTS result",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Student Task ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "IELTS Result",
+                        "name": "ielts_result",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.CreateIeltsResult"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.EmptyStudentTask"
+                            "$ref": "#/definitions/user_service.IeltsResult"
                         }
                     },
                     "404": {
@@ -1330,14 +1383,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/DeleteSupportTeacher/{id}": {
-            "delete": {
+        "/CreateJournal": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for deleting a support teacher by ID",
+                "description": "API for creating journal",
                 "consumes": [
                     "application/json"
                 ],
@@ -1345,23 +1398,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "support_teacher"
+                    "journal"
                 ],
-                "summary": "Delete a support teacher by ID",
+                "summary": "Create journal",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Support Teacher ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Journal",
+                        "name": "journal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateJournal"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.EmptySTeacher"
+                            "$ref": "#/definitions/schedule_service.GetJournal"
                         }
                     },
                     "404": {
@@ -1379,14 +1434,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/DeleteTask/{id}": {
-            "delete": {
+        "/CreateLead": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for deleting a task by ID",
+                "description": "API for recording a prospective student. source is walk_in, call, referral, instagram, telegram, facebook, website or other; desiredLevel is a group type and may be left empty. The lead starts as new.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1394,23 +1449,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "task"
+                    "lead"
                 ],
-                "summary": "Delete a task by ID",
+                "summary": "Create lead",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Task ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Lead",
+                        "name": "lead",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.CreateLead"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.EmptyTask"
+                            "$ref": "#/definitions/user_service.Lead"
                         }
                     },
                     "404": {
@@ -1428,14 +1485,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/DeleteTeacher/{id}": {
-            "delete": {
+        "/CreateManager": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for deleting a teacher by ID",
+                "description": "API for creating manager",
                 "consumes": [
                     "application/json"
                 ],
@@ -1443,23 +1500,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "teacher"
+                    "manager"
                 ],
-                "summary": "Delete a teacher by ID",
+                "summary": "Create manager",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Teacher ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Manager",
+                        "name": "manager",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.CreateManager"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.EmptyTeacher"
+                            "$ref": "#/definitions/user_service.CreateManager"
                         }
                     },
                     "404": {
@@ -1477,14 +1536,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/EventStudent/{id}": {
-            "get": {
+        "/CreateMockTest": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a student with their events by student ID",
+                "description": "API for scheduling a mock IELTS test for an ielts group on testDate (YYYY-MM-DD, today when empty). readingType is academic (default) or general and picks the reading conversion table.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1492,33 +1551,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "event_student"
+                    "mock_test"
                 ],
-                "summary": "Get student with events by student ID",
+                "summary": "Create mock test",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Student ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Mock Test",
+                        "name": "mock_test",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateMockTest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetStudentWithEventsResponse"
+                            "$ref": "#/definitions/schedule_service.MockTest"
                         }
                     },
                     "404": {
-                        "description": "Student not found",
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
@@ -1526,14 +1587,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetByIdAdministration/{id}": {
-            "get": {
+        "/CreateOnlinePayment": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a single administration by ID",
+                "description": "API for paying tuition online. Returns the checkout URL of the provider (\"click\", \"payme\", or \"mock\" for offline testing). Students can only pay for themselves.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1541,23 +1602,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "administration"
+                    "online_payment"
                 ],
-                "summary": "Get a single administration by ID",
+                "summary": "Start an online payment",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "administration ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Online Payment",
+                        "name": "payment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateOnlinePayment"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.Administration"
+                            "$ref": "#/definitions/schedule_service.OnlinePayment"
                         }
                     },
                     "404": {
@@ -1575,14 +1638,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetByIdBranch/{id}": {
-            "get": {
+        "/CreatePayRule": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a single branch by ID",
+                "description": "API for setting how a staff member (teacher, support_teacher, administration or manager) is paid from the month of validFrom on: a monthly fee, a rate per lesson delivered, a rate per student present at a lesson, or any mix. Amounts are decimal strings such as \"50000.00\".",
                 "consumes": [
                     "application/json"
                 ],
@@ -1590,23 +1653,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "branch"
+                    "payroll"
                 ],
-                "summary": "Get a single branch by ID",
+                "summary": "Create pay rule",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Branch ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Pay Rule",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreatePayRule"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.Branch"
+                            "$ref": "#/definitions/schedule_service.PayRule"
                         }
                     },
                     "404": {
@@ -1624,14 +1689,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetByIdEvent/{id}": {
-            "get": {
+        "/CreatePayrollAdjustment": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a single event by ID",
+                "description": "API for giving a staff member a bonus or a penalty for a month (YYYY-MM, current month when empty). The month's payroll must not be locked.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1639,23 +1704,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "event"
+                    "payroll"
                 ],
-                "summary": "Get a single event by ID",
+                "summary": "Create payroll adjustment",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Payroll Adjustment",
+                        "name": "adjustment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreatePayrollAdjustment"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetEvent"
+                            "$ref": "#/definitions/schedule_service.PayrollAdjustment"
                         }
                     },
                     "404": {
@@ -1673,14 +1740,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetByIdEventStudent/{id}": {
-            "get": {
+        "/CreatePayrollRun": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a single event student by ID",
+                "description": "API for computing the payroll of a month (YYYY-MM, current month when empty) for every staff member from their pay rule, the lessons delivered so far, their bonuses and penalties, and their commissions on the tuition collected in the month. Staff without a pay rule get their salary. There is one run per month.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1688,23 +1755,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "event_student"
+                    "payroll"
                 ],
-                "summary": "Get a single event student by ID",
+                "summary": "Create payroll run",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Event Student ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                        "description": "Payroll Run",
+                        "name": "run",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreatePayrollRun"
+                        }
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetEventStudent"
+                            "$ref": "#/definitions/schedule_service.PayrollRun"
                         }
                     },
                     "404": {
@@ -1722,14 +1791,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetByIdGroup/{id}": {
-            "get": {
+        "/CreatePromoCode": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a single group by ID",
+                "description": "API for creating a promo code, optionally for one branch. It can be redeemed between validFrom and validTo, at most maxUses times (0 is unlimited), and the discount lasts for months billing months.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1737,23 +1806,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "discount"
                 ],
-                "summary": "Get a single group by ID",
+                "summary": "Create promo code",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Promo Code",
+                        "name": "promo_code",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreatePromoCode"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetGroup"
+                            "$ref": "#/definitions/schedule_service.PromoCode"
                         }
                     },
                     "404": {
@@ -1771,14 +1842,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetByIdManager/{id}": {
-            "get": {
+        "/CreateRoom": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a single manager by ID",
+                "description": "API for adding a classroom to a branch. capacity is the number of seats; openTime and closeTime (\"15:04\") default to 08:00 and 21:00. Lessons and events given the room must fit in its seats and hours and cannot overlap.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1786,23 +1857,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "manager"
+                    "room"
                 ],
-                "summary": "Get a single manager by ID",
+                "summary": "Create room",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Manager ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Room",
+                        "name": "room",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateRoom"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.Manager"
+                            "$ref": "#/definitions/schedule_service.Room"
                         }
                     },
                     "404": {
@@ -1820,14 +1893,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetByIdStudent/{id}": {
-            "get": {
+        "/CreateSchedule": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a single student by ID",
+                "description": "API for creating schedule. A lesson given a roomId must fit in the room's seats and hours and cannot overlap its other bookings.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1835,23 +1908,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "student"
+                    "schedule"
                 ],
-                "summary": "Get a single student by ID",
+                "summary": "Create schedule",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Student ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Schedule",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateSchedule"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.Student"
+                            "$ref": "#/definitions/schedule_service.GetSchedule"
                         }
                     },
                     "404": {
@@ -1869,14 +1944,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetByIdSupportTeacher/{id}": {
-            "get": {
+        "/CreateStudent": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a single support teacher by ID",
+                "description": "API for creating student. A groupName must name a running group of the branch with a free seat; the student is created and enrolled in it together.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1884,23 +1959,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "support_teacher"
+                    "student"
                 ],
-                "summary": "Get a single support teacher by ID",
+                "summary": "Create student",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Support Teacher ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Student",
+                        "name": "student",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.CreateStudent"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.SupportTeacher"
+                            "$ref": "#/definitions/user_service.CreateStudent"
                         }
                     },
                     "404": {
@@ -1918,14 +1995,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetByIdTeacher/{id}": {
-            "get": {
+        "/CreateStudentHold": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a single teacher by ID",
+                "description": "API for putting a student on hold. A student on hold cannot register for events or get new tasks until a manager clears the hold.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1933,23 +2010,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "teacher"
+                    "overdue"
                 ],
-                "summary": "Get a single teacher by ID",
+                "summary": "Put a student on hold",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Teacher ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Hold",
+                        "name": "hold",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateStudentHold"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.Teacher"
+                            "$ref": "#/definitions/schedule_service.StudentHold"
                         }
                     },
                     "404": {
@@ -1967,14 +2046,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetJournal/{id}": {
-            "get": {
+        "/CreateStudentPayment": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a journal by ID",
+                "description": "API for recording a student payment. The amount is a decimal string such as \"450000.00\". Payments cannot be changed afterwards, only reversed or refunded. paymentMethod is cash (default), card or transfer; administrators need an open cash shift.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1982,23 +2061,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "journal"
+                    "student_payment"
                 ],
-                "summary": "Get a journal by ID",
+                "summary": "Create student payment",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Journal ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Student Payment",
+                        "name": "student_payment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateStudentPayment"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetJournal"
+                            "$ref": "#/definitions/schedule_service.GetStudentPayment"
                         }
                     },
                     "404": {
@@ -2016,45 +2097,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetJurnalsStudent/{id}": {
-            "get": {
+        "/CreateStudentTask": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a Jurnal entry by Student Group ID",
+                "description": "API for creating student task",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "journal"
+                    "student_task"
                 ],
-                "summary": "Get a Jurnal by Student Group ID",
+                "summary": "Create student task",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Student Group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Student Task",
+                        "name": "student_task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateStudentTask"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetJournal"
+                            "$ref": "#/definitions/schedule_service.GetStudentTask"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
@@ -2062,14 +2148,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetListAdministration": {
-            "get": {
+        "/CreateSupportTeacher": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting list administration",
+                "description": "API for creating support teacher. ieltsScore and ieltsAttemptCount are derived from the IELTS results and ignored here.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2077,34 +2163,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "administration"
+                    "support_teacher"
                 ],
-                "summary": "Get List administration",
+                "summary": "Create support teacher",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "administration",
-                        "name": "seller",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "limit",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Support Teacher",
+                        "name": "support_teacher",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.CreateSupportTeacher"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.GetListAdministrationResponse"
+                            "$ref": "#/definitions/user_service.CreateSupportTeacher"
                         }
                     },
                     "404": {
@@ -2122,14 +2199,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetListBranch": {
-            "get": {
+        "/CreateTask": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting list of branches",
+                "description": "API for creating task",
                 "consumes": [
                     "application/json"
                 ],
@@ -2137,34 +2214,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "branch"
+                    "task"
                 ],
-                "summary": "Get list of branches",
+                "summary": "Create task",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Task",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateTask"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.GetListBranchResponse"
+                            "$ref": "#/definitions/schedule_service.GetTask"
                         }
                     },
                     "404": {
@@ -2182,14 +2250,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetListEvent": {
-            "get": {
+        "/CreateTeacher": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting list of events",
+                "description": "API for creating teacher. ieltsScore and ieltsAttemptCount are derived from the IELTS results and ignored here.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2197,35 +2265,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "event"
+                    "teacher"
                 ],
-                "summary": "Get list of events",
+                "summary": "Create teacher",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/schedule_service.GetListEventResponse"
-                        }
+                        "description": "Teacher",
+                        "name": "teacher",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.CreateTeacher"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.CreateTeacher"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -2242,14 +2301,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetListEventStudent": {
-            "get": {
+        "/CreateTuitionPlan": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting list of event students",
+                "description": "API for setting the monthly fee of a group type in a branch from validFrom on. The fee is a decimal string such as \"450000.00\".",
                 "consumes": [
                     "application/json"
                 ],
@@ -2257,34 +2316,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "event_student"
+                    "tuition"
                 ],
-                "summary": "Get list of event students",
+                "summary": "Create tuition plan",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Tuition Plan",
+                        "name": "plan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateTuitionPlan"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetListEventStudentResponse"
+                            "$ref": "#/definitions/schedule_service.TuitionPlan"
                         }
                     },
                     "404": {
@@ -2302,14 +2352,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetListGroup": {
-            "get": {
+        "/DeleteAdministration/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting list of groups",
+                "description": "API for deleting a administration by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2317,34 +2367,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "administration"
                 ],
-                "summary": "Get list of groups",
+                "summary": "Delete a administration by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "administration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetListGroupResponse"
+                            "$ref": "#/definitions/user_service.EmptyAdmin"
                         }
                     },
                     "404": {
@@ -2362,14 +2401,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetListJournal": {
-            "get": {
+        "/DeleteAvailability/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting list of journals",
+                "description": "API for deleting an availability window by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2377,34 +2416,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "journal"
+                    "timetable"
                 ],
-                "summary": "Get list of journals",
+                "summary": "Delete an availability window by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Availability ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetListJournalResponse"
+                            "$ref": "#/definitions/schedule_service.EmptyTimetable"
                         }
                     },
                     "404": {
@@ -2422,14 +2450,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetListManager": {
-            "get": {
+        "/DeleteBranch/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting list of managers",
+                "description": "API for deleting a branch by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2437,34 +2465,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "manager"
+                    "branch"
                 ],
-                "summary": "Get list of managers",
+                "summary": "Delete a branch by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Branch ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.GetListManagerResponse"
+                            "$ref": "#/definitions/user_service.EmptyBranch"
                         }
                     },
                     "404": {
@@ -2482,14 +2499,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetListSchedule": {
-            "get": {
+        "/DeleteBranchSetting/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting list of schedules",
+                "description": "API for deleting the settings of a branch, which then falls back to the defaults",
                 "consumes": [
                     "application/json"
                 ],
@@ -2497,34 +2514,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schedule"
+                    "branch_setting"
                 ],
-                "summary": "Get list of schedules",
+                "summary": "Delete settings of a branch",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Branch ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetListScheduleResponse"
+                            "$ref": "#/definitions/user_service.EmptyBranchSetting"
                         }
                     },
                     "404": {
@@ -2542,14 +2548,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetListStudent": {
-            "get": {
+        "/DeleteCommissionRule/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting list of students",
+                "description": "API for deleting a commission rule. Locked payroll runs keep the commissions they paid.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2557,34 +2563,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "student"
+                    "commission"
                 ],
-                "summary": "Get list of students",
+                "summary": "Delete a commission rule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Commission Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.GetListStudentResponse"
+                            "$ref": "#/definitions/schedule_service.EmptyCommission"
                         }
                     },
                     "404": {
@@ -2602,14 +2597,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetListStudentPayment": {
-            "get": {
+        "/DeleteContractTemplate/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting list of student payments",
+                "description": "API for deleting a contract template",
                 "consumes": [
                     "application/json"
                 ],
@@ -2617,34 +2612,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "student_payment"
+                    "document"
                 ],
-                "summary": "Get list of student payments",
+                "summary": "Delete a contract template",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Contract Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetListStudentPaymentResponse"
+                            "$ref": "#/definitions/schedule_service.EmptyDocument"
                         }
                     },
                     "404": {
@@ -2662,14 +2646,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetListStudentTask": {
-            "get": {
+        "/DeleteDiscount/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting list of student tasks",
+                "description": "API for ending a discount. Invoices already issued keep it.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2677,34 +2661,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "student_task"
+                    "discount"
                 ],
-                "summary": "Get list of student tasks",
+                "summary": "Delete a discount",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Discount ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetListStudentTaskResponse"
+                            "$ref": "#/definitions/schedule_service.EmptyDiscount"
                         }
                     },
                     "404": {
@@ -2722,14 +2695,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetListSupportTeacher": {
-            "get": {
+        "/DeleteEvent/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting list of support teachers",
+                "description": "API for deleting an event by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2737,35 +2710,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "support_teacher"
+                    "event"
                 ],
-                "summary": "Get list of support teachers",
+                "summary": "Delete an event by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/user_service.GetListSupportTeacherResponse"
-                        }
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.EmptyEvent"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -2782,14 +2744,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetListTask": {
-            "get": {
+        "/DeleteEventStudent/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting list of tasks",
+                "description": "API for deleting an event student by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2797,34 +2759,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "task"
+                    "event_student"
                 ],
-                "summary": "Get list of tasks",
+                "summary": "Delete an event student by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Event Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetListTaskResponse"
+                            "$ref": "#/definitions/schedule_service.EmptyEventStudent"
                         }
                     },
                     "404": {
@@ -2842,14 +2793,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetListTeacher": {
-            "get": {
+        "/DeleteExpense/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting list of teachers",
+                "description": "API for deleting a pending expense. Administrators can only delete expenses they recorded.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2857,34 +2808,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "teacher"
+                    "expense"
                 ],
-                "summary": "Get list of teachers",
+                "summary": "Delete an expense",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Expense ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.GetListTeacherResponse"
+                            "$ref": "#/definitions/schedule_service.EmptyExpense"
                         }
                     },
                     "404": {
@@ -2902,14 +2842,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetSchedule/{id}": {
-            "get": {
+        "/DeleteExpenseCategory/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a schedule by ID",
+                "description": "API for deleting an expense category. Expenses already recorded keep it.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2917,13 +2857,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schedule"
+                    "expense"
                 ],
-                "summary": "Get a schedule by ID",
+                "summary": "Delete an expense category",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Schedule ID",
+                        "description": "Expense Category ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2933,7 +2873,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetSchedule"
+                            "$ref": "#/definitions/schedule_service.EmptyExpense"
                         }
                     },
                     "404": {
@@ -2951,14 +2891,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetScheduleForMonth": {
-            "get": {
+        "/DeleteGroup/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting schedules for a specific month",
+                "description": "API for deleting a group by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2966,22 +2906,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schedule"
+                    "group"
                 ],
-                "summary": "Get schedules for a specific month",
+                "summary": "Delete a group by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Month Start Date",
-                        "name": "monthStartDate",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Month End Date",
-                        "name": "monthEndDate",
-                        "in": "query",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -2989,7 +2922,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetListScheduleResponse"
+                            "$ref": "#/definitions/schedule_service.EmptyGroup"
                         }
                     },
                     "404": {
@@ -3007,14 +2940,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetScheduleForWeek": {
-            "get": {
+        "/DeleteGroupStudent/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting schedules for a specific week",
+                "description": "API for removing a student from a group by membership ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3022,22 +2955,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "schedule"
+                    "group_student"
                 ],
-                "summary": "Get schedules for a specific week",
+                "summary": "Remove a student from a group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Week Start Date",
-                        "name": "weekStartDate",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Week End Date",
-                        "name": "weekEndDate",
-                        "in": "query",
+                        "description": "Group Student ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -3045,7 +2971,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetListScheduleResponse"
+                            "$ref": "#/definitions/schedule_service.EmptyGroupStudent"
                         }
                     },
                     "404": {
@@ -3063,14 +2989,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetStudentPayment/{id}": {
-            "get": {
+        "/DeleteGuardian/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a student payment by ID",
+                "description": "API for deleting a guardian by ID. They can no longer log in.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3078,13 +3004,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "student_payment"
+                    "guardian"
                 ],
-                "summary": "Get a student payment by ID",
+                "summary": "Delete guardian",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Student Payment ID",
+                        "description": "Guardian ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3094,7 +3020,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetStudentPayment"
+                            "$ref": "#/definitions/user_service.EmptyGuardian"
                         }
                     },
                     "404": {
@@ -3112,14 +3038,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetStudentTask/{id}": {
-            "get": {
+        "/DeleteIeltsResult/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a student task by ID",
+                "description": "API for deleting an IELTS result by ID. The staff member's current score and attempt count follow.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3127,13 +3053,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "student_task"
+                    "ielts_result"
                 ],
-                "summary": "Get a student task by ID",
+                "summary": "Delete IELTS result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Student Task ID",
+                        "description": "IELTS Result ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3143,7 +3069,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetStudentTask"
+                            "$ref": "#/definitions/user_service.EmptyIeltsResult"
                         }
                     },
                     "404": {
@@ -3161,14 +3087,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/GetTask/{id}": {
-            "get": {
+        "/DeleteJournal/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a task by ID",
+                "description": "API for deleting a journal by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3176,13 +3102,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "task"
+                    "journal"
                 ],
-                "summary": "Get a task by ID",
+                "summary": "Delete a journal by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Task ID",
+                        "description": "Journal ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3192,7 +3118,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetTask"
+                            "$ref": "#/definitions/schedule_service.EmptyJournal"
                         }
                     },
                     "404": {
@@ -3210,25 +3136,28 @@ const docTemplate = `{
                 }
             }
         },
-        "/GroupTeacher/{id}": {
-            "get": {
+        "/DeleteLead/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get Groups associated with a Teacher by Teacher ID",
+                "description": "API for deleting a lead by ID",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "lead"
                 ],
-                "summary": "Get Groups by Teacher ID",
+                "summary": "Delete lead",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Teacher ID",
+                        "description": "Lead ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3238,17 +3167,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetListGroupResponse"
+                            "$ref": "#/definitions/user_service.EmptyLead"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
@@ -3256,14 +3185,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/LoginAdmin": {
-            "post": {
+        "/DeleteManager/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for admin login",
+                "description": "API for deleting a manager by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3271,35 +3200,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "login"
+                    "manager"
                 ],
-                "summary": "Admin Login",
+                "summary": "Delete a manager by ID",
                 "parameters": [
                     {
-                        "description": "Admin credentials",
-                        "name": "login",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user_service.LoginPasswors"
-                        }
+                        "type": "string",
+                        "description": "Manager ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.Token"
+                            "$ref": "#/definitions/user_service.EmptyManager"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
@@ -3307,14 +3234,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/LoginManager": {
-            "post": {
+        "/DeleteMockTest/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for manager login",
+                "description": "API for deleting a mock test. Its results no longer count towards progress and averages.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3322,35 +3249,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "login"
+                    "mock_test"
                 ],
-                "summary": "Manager Login",
+                "summary": "Delete mock test",
                 "parameters": [
                     {
-                        "description": "Manager credentials",
-                        "name": "login",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user_service.LoginPasswors"
-                        }
+                        "type": "string",
+                        "description": "Mock Test ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.Token"
+                            "$ref": "#/definitions/schedule_service.EmptyMockTest"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
@@ -3358,14 +3283,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/LoginStudent": {
-            "post": {
+        "/DeleteMockTestResult/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for student login",
+                "description": "API for deleting a student's mock test result",
                 "consumes": [
                     "application/json"
                 ],
@@ -3373,35 +3298,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "login"
+                    "mock_test"
                 ],
-                "summary": "Student Login",
+                "summary": "Delete mock test result",
                 "parameters": [
                     {
-                        "description": "Student credentials",
-                        "name": "login",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user_service.LoginPasswors"
-                        }
+                        "type": "string",
+                        "description": "Mock Test Result ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.Token"
+                            "$ref": "#/definitions/schedule_service.EmptyMockTest"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
@@ -3409,14 +3332,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/LoginSuperAdmin": {
-            "post": {
+        "/DeletePayRule/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for super admin login",
+                "description": "API for deleting a pay rule. Payroll runs already computed keep their amounts.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3424,35 +3347,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "login"
+                    "payroll"
                 ],
-                "summary": "Super Admin Login",
+                "summary": "Delete a pay rule",
                 "parameters": [
                     {
-                        "description": "Super Admin credentials",
-                        "name": "login",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user_service.LoginPasswors"
-                        }
+                        "type": "string",
+                        "description": "Pay Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.Token"
+                            "$ref": "#/definitions/schedule_service.EmptyPayroll"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
@@ -3460,14 +3381,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/LoginSupportTeacher": {
-            "post": {
+        "/DeletePayrollAdjustment/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for support teacher login",
+                "description": "API for deleting a bonus or a penalty. The month's payroll must not be locked.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3475,35 +3396,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "login"
+                    "payroll"
                 ],
-                "summary": "Support Teacher Login",
+                "summary": "Delete a payroll adjustment",
                 "parameters": [
                     {
-                        "description": "Support Teacher credentials",
-                        "name": "login",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user_service.LoginPasswors"
-                        }
+                        "type": "string",
+                        "description": "Payroll Adjustment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.Token"
+                            "$ref": "#/definitions/schedule_service.EmptyPayroll"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
@@ -3511,14 +3430,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/LoginTeacher": {
-            "post": {
+        "/DeletePromoCode/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for teacher login",
+                "description": "API for withdrawing a promo code. Discounts already redeemed with it stay in force.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3526,35 +3445,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "login"
+                    "discount"
                 ],
-                "summary": "Teacher Login",
+                "summary": "Delete a promo code",
                 "parameters": [
                     {
-                        "description": "Teacher credentials",
-                        "name": "login",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user_service.LoginPasswors"
-                        }
+                        "type": "string",
+                        "description": "Promo Code ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.Token"
+                            "$ref": "#/definitions/schedule_service.EmptyDiscount"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
@@ -3562,14 +3479,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/StudentReportList": {
-            "get": {
+        "/DeleteRoom/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a list of students",
+                "description": "API for deleting a room by ID. Rooms with upcoming lessons or events cannot be deleted.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3577,46 +3494,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "report"
+                    "room"
                 ],
-                "summary": "Get List of Students",
+                "summary": "Delete room",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query",
+                        "description": "Room ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search term",
-                        "name": "search",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.GetListStudentResponse"
+                            "$ref": "#/definitions/schedule_service.EmptyRoom"
                         }
                     },
-                    "400": {
-                        "description": "Invalid query parameters",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
@@ -3624,14 +3528,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/SupportTeacherReportList": {
-            "get": {
+        "/DeleteSchedule/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a list of support teachers",
+                "description": "API for deleting a schedule by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3639,46 +3543,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "report"
+                    "schedule"
                 ],
-                "summary": "Get List of Support Teachers",
+                "summary": "Delete a schedule by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.GetListSupportTeacherResponse"
+                            "$ref": "#/definitions/schedule_service.EmptySchedule"
                         }
                     },
-                    "400": {
-                        "description": "Invalid query parameters",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
@@ -3686,14 +3577,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/TeacherReportList": {
-            "get": {
+        "/DeleteStudent/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for getting a list of teachers",
+                "description": "API for deleting a student by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3701,46 +3592,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "report"
+                    "student"
                 ],
-                "summary": "Get List of Teachers",
+                "summary": "Delete a student by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query",
+                        "description": "Student ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search term",
-                        "name": "search",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.GetReportListTeacherResponse"
+                            "$ref": "#/definitions/user_service.StudentEmpty"
                         }
                     },
-                    "400": {
-                        "description": "Invalid query parameters",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
@@ -3748,14 +3626,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/UpdateAdministration/{id}": {
-            "put": {
+        "/DeleteStudentTask/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for updating a administration by ID",
+                "description": "API for deleting a student task by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3763,32 +3641,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "administration"
+                    "student_task"
                 ],
-                "summary": "Update a administration by ID",
+                "summary": "Delete a student task by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "administration ID",
+                        "description": "Student Task ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Administration",
-                        "name": "seller",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user_service.UpdateAdministration"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.UpdateAdministration"
+                            "$ref": "#/definitions/schedule_service.EmptyStudentTask"
                         }
                     },
                     "404": {
@@ -3806,14 +3675,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/UpdateBranch/{id}": {
-            "put": {
+        "/DeleteSupportTeacher/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for updating a branch by ID",
+                "description": "API for deleting a support teacher by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3821,32 +3690,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "branch"
+                    "support_teacher"
                 ],
-                "summary": "Update a branch by ID",
+                "summary": "Delete a support teacher by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Branch ID",
+                        "description": "Support Teacher ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Branch",
-                        "name": "branch",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user_service.UpdateBranch"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.UpdateBranch"
+                            "$ref": "#/definitions/user_service.EmptySTeacher"
                         }
                     },
                     "404": {
@@ -3864,14 +3724,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/UpdateEvent/{id}": {
-            "put": {
+        "/DeleteTask/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for updating an event by ID",
+                "description": "API for deleting a task by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3879,32 +3739,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "event"
+                    "task"
                 ],
-                "summary": "Update an event by ID",
+                "summary": "Delete a task by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID",
+                        "description": "Task ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Event",
-                        "name": "event",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schedule_service.UpdateEvent"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.UpdateEvent"
+                            "$ref": "#/definitions/schedule_service.EmptyTask"
                         }
                     },
                     "404": {
@@ -3922,14 +3773,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/UpdateEventStudent/{id}": {
-            "put": {
+        "/DeleteTeacher/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for updating an event student by ID",
+                "description": "API for deleting a teacher by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3937,32 +3788,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "event_student"
+                    "teacher"
                 ],
-                "summary": "Update an event student by ID",
+                "summary": "Delete a teacher by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event Student ID",
+                        "description": "Teacher ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Event Student",
-                        "name": "event_student",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schedule_service.UpdateEventStudent"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.UpdateEventStudent"
+                            "$ref": "#/definitions/user_service.EmptyTeacher"
                         }
                     },
                     "404": {
@@ -3980,14 +3822,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/UpdateGroup/{id}": {
-            "put": {
+        "/DeleteTuitionPlan/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for updating a group by ID",
+                "description": "API for deleting a tuition plan. Invoices already issued are kept.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3995,32 +3837,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "group"
+                    "tuition"
                 ],
-                "summary": "Update a group by ID",
+                "summary": "Delete a tuition plan",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group ID",
+                        "description": "Tuition Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Group",
-                        "name": "group",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schedule_service.UpdateGroup"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.UpdateGroup"
+                            "$ref": "#/definitions/schedule_service.EmptyTuition"
                         }
                     },
                     "404": {
@@ -4038,14 +3871,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/UpdateJournal/{id}": {
-            "put": {
+        "/EventStudent/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for updating a journal by ID",
+                "description": "API for getting a student with their events by student ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -4053,42 +3886,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "journal"
+                    "event_student"
                 ],
-                "summary": "Update a journal by ID",
+                "summary": "Get student with events by student ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Journal ID",
+                        "description": "Student ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Journal",
-                        "name": "journal",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schedule_service.UpdateJournal"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetJournal"
+                            "$ref": "#/definitions/schedule_service.GetStudentWithEventsResponse"
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "Student not found",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/models.ResponseError"
                         }
@@ -4096,47 +3920,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/UpdateManager/{id}": {
-            "put": {
+        "/Expense/{id}/attachment": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for updating a manager by ID",
+                "description": "API for attaching a receipt or an invoice, up to 2 MB, to a pending expense",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "manager"
+                    "expense"
                 ],
-                "summary": "Update a manager by ID",
+                "summary": "Attach a file to an expense",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Manager ID",
+                        "description": "Expense ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Manager",
-                        "name": "manager",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user_service.UpdateManager"
-                        }
+                        "type": "file",
+                        "description": "File",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.UpdateManager"
+                            "$ref": "#/definitions/schedule_service.ExpenseAttachment"
                         }
                     },
                     "404": {
@@ -4154,47 +3976,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/UpdateSchedule/{id}": {
-            "put": {
+        "/ExpenseAttachment/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for updating a schedule by ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "API for downloading a file attached to an expense",
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "schedule"
+                    "expense"
                 ],
-                "summary": "Update a schedule by ID",
+                "summary": "Download an expense attachment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Schedule ID",
+                        "description": "Expense Attachment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Schedule",
-                        "name": "schedule",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schedule_service.UpdateSchedule"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetSchedule"
+                            "type": "file"
                         }
                     },
                     "404": {
@@ -4210,16 +4020,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/UpdateStudent/{id}": {
-            "put": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for updating a student by ID",
+                "description": "API for removing a file from a pending expense",
                 "consumes": [
                     "application/json"
                 ],
@@ -4227,24 +4035,66 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "student"
+                    "expense"
                 ],
-                "summary": "Update a student by ID",
+                "summary": "Delete an expense attachment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Student ID",
+                        "description": "Expense Attachment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.EmptyExpense"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GenerateInvoices": {
+            "post": {
+                "security": [
                     {
-                        "description": "Student",
-                        "name": "student",
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for issuing the invoices of a month (YYYY-MM, current month when empty) to every student of a group. Running it again only adds the missing invoices. Groups whose type has no plan are returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tuition"
+                ],
+                "summary": "Generate monthly invoices",
+                "parameters": [
+                    {
+                        "description": "Period",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/user_service.UpdateStudent"
+                            "$ref": "#/definitions/schedule_service.GenerateInvoicesRequest"
                         }
                     }
                 ],
@@ -4252,7 +4102,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.UpdateStudent"
+                            "$ref": "#/definitions/schedule_service.GenerateInvoicesResponse"
                         }
                     },
                     "404": {
@@ -4270,14 +4120,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/UpdateStudentPayment/{id}": {
-            "put": {
+        "/GetByIdAdministration/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for updating a student payment by ID",
+                "description": "API for getting a single administration by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -4285,32 +4135,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "student_payment"
+                    "administration"
                 ],
-                "summary": "Update a student payment by ID",
+                "summary": "Get a single administration by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Student Payment ID",
+                        "description": "administration ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Student Payment",
-                        "name": "student_payment",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schedule_service.UpdateStudentPayment"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetStudentPayment"
+                            "$ref": "#/definitions/user_service.Administration"
                         }
                     },
                     "404": {
@@ -4328,14 +4169,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/UpdateStudentTask/{id}": {
-            "put": {
+        "/GetByIdBranch/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for updating a student task by ID",
+                "description": "API for getting a single branch by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -4343,32 +4184,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "student_task"
+                    "branch"
                 ],
-                "summary": "Update a student task by ID",
+                "summary": "Get a single branch by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Student Task ID",
+                        "description": "Branch ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Student Task",
-                        "name": "student_task",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schedule_service.UpdateStudentTask"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetStudentTask"
+                            "$ref": "#/definitions/user_service.Branch"
                         }
                     },
                     "404": {
@@ -4386,14 +4218,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/UpdateSupportTeacher/{id}": {
-            "put": {
+        "/GetByIdBranchSetting/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for updating a support teacher by ID",
+                "description": "API for getting the rules of a branch. Branches without settings get the defaults with isDefault set.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4401,32 +4233,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "support_teacher"
+                    "branch_setting"
                 ],
-                "summary": "Update a support teacher by ID",
+                "summary": "Get settings of a branch",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Support Teacher ID",
+                        "description": "Branch ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Support Teacher",
-                        "name": "support_teacher",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user_service.UpdateSupportTeacher"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.UpdateSupportTeacher"
+                            "$ref": "#/definitions/user_service.BranchSetting"
                         }
                     },
                     "404": {
@@ -4444,14 +4267,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/UpdateTask/{id}": {
-            "put": {
+        "/GetByIdCashShift/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for updating a task by ID",
+                "description": "API for getting a cash shift with its totals per payment method. Open shifts show what they are expected to hold so far. Administrators can only get their own shifts.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4459,32 +4282,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "task"
+                    "cash_shift"
                 ],
-                "summary": "Update a task by ID",
+                "summary": "Get a cash shift",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Task ID",
+                        "description": "Cash Shift ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Task",
-                        "name": "task",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schedule_service.UpdateTask"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schedule_service.GetTask"
+                            "$ref": "#/definitions/schedule_service.CashShift"
                         }
                     },
                     "404": {
@@ -4502,14 +4316,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/UpdateTeacher/{id}": {
-            "put": {
+        "/GetByIdContractTemplate/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "API for updating a teacher by ID",
+                "description": "API for getting a contract template by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -4517,32 +4331,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "teacher"
+                    "document"
                 ],
-                "summary": "Update a teacher by ID",
+                "summary": "Get a contract template",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Teacher ID",
+                        "description": "Contract Template ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Teacher",
-                        "name": "teacher",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user_service.UpdateTeacher"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user_service.UpdateTeacher"
+                            "$ref": "#/definitions/schedule_service.ContractTemplate"
                         }
                     },
                     "404": {
@@ -4559,197 +4364,11805 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.ResponseError": {
-            "type": "object",
-            "properties": {
-                "error": {}
-            }
-        },
-        "schedule_service.CreateEvent": {
-            "type": "object",
-            "properties": {
-                "assignStudent": {
-                    "type": "string"
-                },
-                "branchId": {
-                    "type": "string"
-                },
-                "date": {
-                    "type": "string"
-                },
-                "startTime": {
-                    "type": "string"
-                },
-                "topic": {
-                    "type": "string"
-                }
-            }
         },
-        "schedule_service.CreateEventStudent": {
-            "type": "object",
-            "properties": {
-                "eventId": {
-                    "type": "string"
+        "/GetByIdEvent/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a single event by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "Get a single event by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetEvent"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetByIdEventStudent/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a single event student by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event_student"
+                ],
+                "summary": "Get a single event student by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetEventStudent"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetByIdExpense/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting an expense with its attachments. Administrators can only get expenses they recorded.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense"
+                ],
+                "summary": "Get an expense",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.Expense"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetByIdGroup/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a single group by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "Get a single group by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetGroup"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetByIdGroupStudent/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a single group student by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group_student"
+                ],
+                "summary": "Get a single group student by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetGroupStudent"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetByIdGuardian/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a guardian with their children by ID. Parents always get themselves.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "guardian"
+                ],
+                "summary": "Get guardian",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Guardian ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Guardian"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetByIdIeltsResult/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting an IELTS result by ID. Teachers, support teachers and administrations only get their own.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ielts_result"
+                ],
+                "summary": "Get IELTS result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "IELTS Result ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.IeltsResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetByIdLead/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a lead by ID with their trial lesson and, once converted, their student",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lead"
+                ],
+                "summary": "Get lead",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Lead"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetByIdManager/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a single manager by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manager"
+                ],
+                "summary": "Get a single manager by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Manager ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Manager"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetByIdMockTest/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a mock test with the result of every student and the average bands",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mock_test"
+                ],
+                "summary": "Get mock test",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Mock Test ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.MockTest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetByIdOnlinePayment/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting an online payment by ID. Students can only get their own payments.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "online_payment"
+                ],
+                "summary": "Get an online payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Online Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.OnlinePayment"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetByIdPayrollRun/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a payroll run with a line per staff member",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Get a payroll run",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll Run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Staff type",
+                        "name": "staffType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Staff ID",
+                        "name": "staffId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.PayrollRun"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetByIdRoom/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a room by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "room"
+                ],
+                "summary": "Get room",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.Room"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetByIdStudent/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a single student by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "student"
+                ],
+                "summary": "Get a single student by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Student"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetByIdSupportTeacher/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a single support teacher by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "support_teacher"
+                ],
+                "summary": "Get a single support teacher by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Support Teacher ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.SupportTeacher"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetByIdTeacher/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a single teacher by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teacher"
+                ],
+                "summary": "Get a single teacher by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Teacher ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Teacher"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetChildEvents/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for a parent to get the events their child registered for and attended",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "guardian"
+                ],
+                "summary": "Get child event registrations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetStudentWithEventsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetChildPayments/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for a parent to get the payments made for their child",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "guardian"
+                ],
+                "summary": "Get child payments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListStudentPaymentResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetChildSchedule/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for a parent to get the lessons of the groups their child studies in",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "guardian"
+                ],
+                "summary": "Get child schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListScheduleResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetChildTasks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for a parent to get the tasks of their child with their scores",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "guardian"
+                ],
+                "summary": "Get child task scores",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListStudentTaskResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetCollectionsReport": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting what each administrator collected per payment method. Dates are YYYY-MM-DD and default to the last twelve months.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "finance_report"
+                ],
+                "summary": "Get collections report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "fromDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date",
+                        "name": "toDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CollectionsReport"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetCommissionReport": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting the commissions earned on the tuition collected in a month (YYYY-MM, current month when empty), a row per staff member and group, with the amounts clawed back by refunds and reversals",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "commission"
+                ],
+                "summary": "Get commission report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Month (YYYY-MM)",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Staff type",
+                        "name": "staffType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Staff ID",
+                        "name": "staffId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CommissionReport"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetCurrentCashShift": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for an administrator to get their open cash shift",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cash_shift"
+                ],
+                "summary": "Get the open cash shift",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CashShift"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetDebtAgingReport": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting what students owe today, bucketed by days past the due date: current, 1-30, 31-60, 61-90 and 90+",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "finance_report"
+                ],
+                "summary": "Get debt aging report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.DebtAgingReport"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetDiscountReport": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting discount totals per branch, month and category between fromPeriod and toPeriod (YYYY-MM)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "discount"
+                ],
+                "summary": "Get discount report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "From month",
+                        "name": "fromPeriod",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To month",
+                        "name": "toPeriod",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.DiscountReport"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetEventAttendance/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting registered, waitlisted, cancelled, attended and no-show counts of an event together with its registered students",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event_student"
+                ],
+                "summary": "Get attendance summary of an event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.EventAttendance"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetEventCheckInQR/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting the signed check-in code of a registration as a PNG image. Students can only get codes of their own registrations.",
+                "produces": [
+                    "image/png"
+                ],
+                "tags": [
+                    "event_student"
+                ],
+                "summary": "Get the check-in QR code of an event registration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetEventRating/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting the average rating, number of responses and the 1 to 5 distribution of an event",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event_feedback"
+                ],
+                "summary": "Get rating of an event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.EventRating"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetGroupMockAverages/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting the average bands of a group on each mock test, oldest first, and over the whole date range",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mock_test"
+                ],
+                "summary": "Get group mock test averages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "fromDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date",
+                        "name": "toDate",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GroupMockAverages"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetJournal/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a journal by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "journal"
+                ],
+                "summary": "Get a journal by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Journal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetJournal"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetJournalRolloverReport": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting groups whose journal could not be rolled over to the next month",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "journal"
+                ],
+                "summary": "Get journal rollover report",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetRolloverReportResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetJurnalsStudent/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a Jurnal entry by Student Group ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "journal"
+                ],
+                "summary": "Get a Jurnal by Student Group ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetJournal"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetLeadConversionReport": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting, per source and branch, the leads that came in between fromDate and toDate (YYYY-MM-DD), how many got to a trial, attended it, converted or were lost, and the conversion rate in percent",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lead"
+                ],
+                "summary": "Get lead conversion report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "fromDate",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date",
+                        "name": "toDate",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.LeadConversionReport"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListAdministration": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting list administration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "administration"
+                ],
+                "summary": "Get List administration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "administration",
+                        "name": "seller",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.GetListAdministrationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListAvailability": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting availability windows by teacher, support teacher or branch",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timetable"
+                ],
+                "summary": "Get list of availability windows",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Teacher ID",
+                        "name": "teacherId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Support Teacher ID",
+                        "name": "supportTeacherId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListAvailabilityResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListBranch": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting list of branches",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "branch"
+                ],
+                "summary": "Get list of branches",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.GetListBranchResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListBranchSetting": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting the branches that have their own settings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "branch_setting"
+                ],
+                "summary": "Get list of branch settings",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.GetListBranchSettingResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListCashShift": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting cash shifts, newest first. Administrators only see their own.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cash_shift"
+                ],
+                "summary": "Get list of cash shifts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Administration ID",
+                        "name": "administrationId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "open, closed or approved",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only shifts with a mismatch",
+                        "name": "mismatchOnly",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListCashShiftResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListCommissionRule": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting commission rules by staff type, staff member and group type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "commission"
+                ],
+                "summary": "Get list of commission rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Staff type",
+                        "name": "staffType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Staff ID",
+                        "name": "staffId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group type",
+                        "name": "groupType",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListCommissionRuleResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListContractTemplate": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting contract templates of a branch, including the shared ones, and the placeholders templates can use",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "document"
+                ],
+                "summary": "Get list of contract templates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListContractTemplateResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListDiscount": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting discounts, optionally only those in force on activeOn (YYYY-MM-DD). Students only see their own.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "discount"
+                ],
+                "summary": "Get list of discounts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "sibling, scholarship, promo or other",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date",
+                        "name": "activeOn",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListDiscountResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListEvent": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting list of events",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "Get list of events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListEventResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListEventFeedback": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting the feedback left on an event, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event_feedback"
+                ],
+                "summary": "Get feedback of an event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "eventId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListEventFeedbackResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListEventStudent": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting list of event students",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event_student"
+                ],
+                "summary": "Get list of event students",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListEventStudentResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListExpense": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting expenses by branch, category, status (pending, approved, rejected) and spending dates (YYYY-MM-DD), newest first. Administrators only get expenses they recorded.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense"
+                ],
+                "summary": "Get list of expenses",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expense Category ID",
+                        "name": "categoryId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "fromDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date",
+                        "name": "toDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListExpenseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListExpenseCategory": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting the expense categories",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense"
+                ],
+                "summary": "Get list of expense categories",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListExpenseCategoryResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListExpiringIelts": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting the staff whose latest IELTS certificate expires within the given number of days (60 when empty) or has already expired, soonest first. daysLeft is negative once expired.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ielts_result"
+                ],
+                "summary": "Get expiring IELTS certificates",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Days ahead",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Staff type",
+                        "name": "staffType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.GetListExpiringIeltsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListGroup": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting list of groups",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "Get list of groups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Exact group name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListGroupResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListGroupStudent": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting list of group students",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group_student"
+                ],
+                "summary": "Get list of group students",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListGroupStudentResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListGuardian": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting guardians, optionally only those of one student",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "guardian"
+                ],
+                "summary": "Get list of guardians",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.GetListGuardianResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListIeltsResult": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting IELTS results, newest test first. Teachers, support teachers and administrations only get their own.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ielts_result"
+                ],
+                "summary": "Get list of IELTS results",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Staff type",
+                        "name": "staffType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Staff ID",
+                        "name": "staffId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.GetListIeltsResultResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListInvoice": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting invoices by student, group or month (YYYY-MM)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tuition"
+                ],
+                "summary": "Get list of invoices",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListInvoiceResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListJournal": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting list of journals",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "journal"
+                ],
+                "summary": "Get list of journals",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListJournalResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListLead": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting leads, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lead"
+                ],
+                "summary": "Get list of leads",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by name or phone",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Source",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.GetListLeadResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListLessonRequirement": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting lesson requirements of the active groups of a branch",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timetable"
+                ],
+                "summary": "Get lesson requirements of a branch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListLessonRequirementResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListManager": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting list of managers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manager"
+                ],
+                "summary": "Get list of managers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.GetListManagerResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListMockTest": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting mock tests by group and test date range (YYYY-MM-DD), newest first, with their average bands",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mock_test"
+                ],
+                "summary": "Get list of mock tests",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "fromDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date",
+                        "name": "toDate",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListMockTestResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListOnlinePayment": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting online payments, newest first. Students only see their own.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "online_payment"
+                ],
+                "summary": "Get list of online payments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Provider",
+                        "name": "provider",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "pending, paid or cancelled",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListOnlinePaymentResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListOverdue": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting invoices past their due date that are not fully paid, most days late first. Payments cover the oldest invoices first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "overdue"
+                ],
+                "summary": "Get list of overdue payments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Min days late",
+                        "name": "minDaysLate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include paid ones",
+                        "name": "includeResolved",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListOverdueResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListPayRule": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting pay rules by staff type and staff member, newest validFrom first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Get list of pay rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Staff type",
+                        "name": "staffType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Staff ID",
+                        "name": "staffId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListPayRuleResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListPayrollAdjustment": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting bonuses and penalties by month (YYYY-MM), staff type and staff member",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Get list of payroll adjustments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Month",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Staff type",
+                        "name": "staffType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Staff ID",
+                        "name": "staffId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListPayrollAdjustmentResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListPayrollRun": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting payroll runs, newest month first, without their lines",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Get list of payroll runs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "draft or locked",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListPayrollRunResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListPromoCode": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting promo codes with how many times they were used",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "discount"
+                ],
+                "summary": "Get list of promo codes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Code search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListPromoCodeResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListRoom": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting rooms by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "room"
+                ],
+                "summary": "Get list of rooms",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by name",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListRoomResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListSchedule": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting list of schedules",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedule"
+                ],
+                "summary": "Get list of schedules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListScheduleResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListStudent": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting list of students",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "student"
+                ],
+                "summary": "Get list of students",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.GetListStudentResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListStudentHold": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting current and past holds, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "overdue"
+                ],
+                "summary": "Get list of student holds",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only holds not cleared yet",
+                        "name": "activeOnly",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListStudentHoldResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListStudentPayment": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting the payment ledger in the order entries were recorded, each with the running balance of its student",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "student_payment"
+                ],
+                "summary": "Get list of student payments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "payment, reversal or refund",
+                        "name": "entryType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cash Shift ID",
+                        "name": "shiftId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListStudentPaymentResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListStudentTask": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting list of student tasks",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "student_task"
+                ],
+                "summary": "Get list of student tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListStudentTaskResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListSupportTeacher": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting list of support teachers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "support_teacher"
+                ],
+                "summary": "Get list of support teachers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.GetListSupportTeacherResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListTask": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting list of tasks",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "Get list of tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListTaskResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListTeacher": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting list of teachers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teacher"
+                ],
+                "summary": "Get list of teachers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.GetListTeacherResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetListTuitionPlan": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting tuition plans by branch and group type, newest validFrom first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tuition"
+                ],
+                "summary": "Get list of tuition plans",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group type",
+                        "name": "groupType",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListTuitionPlanResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetLowRatedEvents": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for listing events of a branch whose average rating is at most maxRating (3 by default), lowest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event_feedback"
+                ],
+                "summary": "Get low rated events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Max average rating",
+                        "name": "maxRating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Min number of responses",
+                        "name": "minResponses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.LowRatedEventsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetMonthComparisonReport": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for comparing each month of the range with the month before: invoiced, discounts, collected and the collection rate. Dates are YYYY-MM-DD and default to the last twelve months.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "finance_report"
+                ],
+                "summary": "Get month comparison report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "fromDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date",
+                        "name": "toDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.MonthComparisonReport"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetProfitAndLossReport": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for comparing branches by income (net payments), payroll cost (locked payroll runs of the months the range touches) and approved expenses by category, with the profit and margin of each. Dates are YYYY-MM-DD and default to the last twelve months.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "finance_report"
+                ],
+                "summary": "Get profit and loss report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "fromDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date",
+                        "name": "toDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.ProfitAndLossReport"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetPromotion/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a group promotion with promoted and repeating students",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "Get a group promotion by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Promotion ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.PromoteGroupResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetRevenueReport": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting payments, corrections and net revenue per branch, month and group type. Dates are YYYY-MM-DD and default to the last twelve months.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "finance_report"
+                ],
+                "summary": "Get revenue report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "fromDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date",
+                        "name": "toDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.RevenueReport"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetRoomUtilization": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for the share of each room's opening hours that was booked by lessons and events in a week, and how full those bookings were. weekStart may be any day of the week and defaults to the current week.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "room"
+                ],
+                "summary": "Room utilization report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Day of the week (2006-01-02)",
+                        "name": "weekStart",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.RoomUtilizationReport"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetSchedule/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a schedule by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedule"
+                ],
+                "summary": "Get a schedule by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetSchedule"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetScheduleForMonth": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting schedules for a specific month",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedule"
+                ],
+                "summary": "Get schedules for a specific month",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Teacher ID, ignored for teachers who see their own schedule",
+                        "name": "teacherId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month Start Date",
+                        "name": "monthStartDate",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month End Date",
+                        "name": "monthEndDate",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListScheduleResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetScheduleForWeek": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting schedules for a specific week",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedule"
+                ],
+                "summary": "Get schedules for a specific week",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Teacher ID, ignored for teachers who see their own schedule",
+                        "name": "teacherId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Week Start Date",
+                        "name": "weekStartDate",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Week End Date",
+                        "name": "weekEndDate",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListScheduleResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetStudentBalance/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting what a student was invoiced, what they paid and what they still owe. Students can only see their own balance.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tuition"
+                ],
+                "summary": "Get balance of a student",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.StudentBalance"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetStudentHold/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for checking whether a student is on hold. Students can only check themselves.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "overdue"
+                ],
+                "summary": "Get the hold of a student",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.StudentHoldStatus"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetStudentMockProgress/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a student's mock test results over time, oldest first, with the latest and best bands and the change in overall band. Students only get their own.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mock_test"
+                ],
+                "summary": "Get student mock test progress",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "fromDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date",
+                        "name": "toDate",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.StudentMockProgress"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetStudentPayment/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a student payment by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "student_payment"
+                ],
+                "summary": "Get a student payment by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetStudentPayment"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetStudentTask/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a student task by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "student_task"
+                ],
+                "summary": "Get a student task by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetStudentTask"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetTask/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a task by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "Get a task by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetTask"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetTeacherLessonCount": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for counting lessons a teacher actually taught in a period, including lessons covered as a substitute",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedule"
+                ],
+                "summary": "Get delivered lesson count of a teacher",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Teacher ID",
+                        "name": "teacherId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "From Date",
+                        "name": "fromDate",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "To Date",
+                        "name": "toDate",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.TeacherLessonCount"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetTimetableProposal/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a timetable proposal with its lessons and unscheduled groups",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timetable"
+                ],
+                "summary": "Get a timetable proposal by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Proposal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.TimetableProposal"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetTopicRatings": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting average ratings of event topics, optionally within a branch",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event_feedback"
+                ],
+                "summary": "Get ratings per topic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "branchId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Topic search",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.TopicRatingResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GetWaitlist": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting the students waiting for a seat in a group, first come first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group_student"
+                ],
+                "summary": "Get group waitlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetWaitlistResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/GroupTeacher/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get Groups associated with a Teacher by Teacher ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "Get Groups by Teacher ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Teacher ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetListGroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/JoinWaitlist": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for putting a student on the waitlist of a full group. The student is moved into the group automatically when a seat frees up.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group_student"
+                ],
+                "summary": "Add student to group waitlist",
+                "parameters": [
+                    {
+                        "description": "Waitlist",
+                        "name": "waitlist",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CreateGroupStudent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.WaitlistEntry"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/LeaveWaitlist/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for removing a waitlist entry by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group_student"
+                ],
+                "summary": "Remove a student from a group waitlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Waitlist Entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.EmptyGroupStudent"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/LinkGuardianStudent": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for linking a student to a guardian. relation is mother, father, guardian (default) or other; linking again changes it. A student can have several guardians and a guardian several students.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "guardian"
+                ],
+                "summary": "Link student to guardian",
+                "parameters": [
+                    {
+                        "description": "Link",
+                        "name": "link",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.GuardianStudent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Guardian"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/LockPayrollRun/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for locking a payroll run once it is approved. A locked run, and the adjustments of its month, no longer change.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Lock a payroll run",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll Run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.PayrollRun"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/LoginAdmin": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for admin login",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "login"
+                ],
+                "summary": "Admin Login",
+                "parameters": [
+                    {
+                        "description": "Admin credentials",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.LoginPasswors"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Token"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/LoginManager": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for manager login",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "login"
+                ],
+                "summary": "Manager Login",
+                "parameters": [
+                    {
+                        "description": "Manager credentials",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.LoginPasswors"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Token"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/LoginParent": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for parent login. Parents can only read the schedule, task scores, payments and event registrations of their own children.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "login"
+                ],
+                "summary": "Parent Login",
+                "parameters": [
+                    {
+                        "description": "Parent credentials",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.LoginPasswors"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Token"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/LoginStudent": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for student login",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "login"
+                ],
+                "summary": "Student Login",
+                "parameters": [
+                    {
+                        "description": "Student credentials",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.LoginPasswors"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Token"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/LoginSuperAdmin": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for super admin login",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "login"
+                ],
+                "summary": "Super Admin Login",
+                "parameters": [
+                    {
+                        "description": "Super Admin credentials",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.LoginPasswors"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Token"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/LoginSupportTeacher": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for support teacher login",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "login"
+                ],
+                "summary": "Support Teacher Login",
+                "parameters": [
+                    {
+                        "description": "Support Teacher credentials",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.LoginPasswors"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Token"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/LoginTeacher": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for teacher login",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "login"
+                ],
+                "summary": "Teacher Login",
+                "parameters": [
+                    {
+                        "description": "Teacher credentials",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.LoginPasswors"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Token"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/MarkAttendance": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for marking whether a student attended a lesson",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedule"
+                ],
+                "summary": "Mark lesson attendance",
+                "parameters": [
+                    {
+                        "description": "Attendance",
+                        "name": "attendance",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.MarkAttendanceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.LessonAttendance"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/OpenCashShift": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for an administrator to open their cash shift with the opening float in the drawer. Payments they record go to this shift until it is closed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cash_shift"
+                ],
+                "summary": "Open a cash shift",
+                "parameters": [
+                    {
+                        "description": "Cash Shift",
+                        "name": "shift",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.OpenCashShift"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.CashShift"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/PaymentCallback/{provider}": {
+            "post": {
+                "description": "Webhook the payment providers call. Needs no token: the callback signature (or, for Payme, the Authorization header) is verified instead, and the reply is in the provider's own format. Accepts form and JSON bodies; nested JSON keys are joined with dots.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "online_payment"
+                ],
+                "summary": "Payment provider callback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/PayrollRun/{id}/export.csv": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for downloading a payroll run as CSV, a row per staff member, for accounting or the bank",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Export a payroll run",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll Run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Staff type",
+                        "name": "staffType",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/PromoteGroup": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for closing a group's journal and promoting students who meet the attendance and score thresholds. Mode \"move\" keeps the group and raises its level, mode \"new\" finishes it and creates a new group for promoted students. Repeating students move to repeatGroupId, a running group of the same branch and level with enough seats; mode \"move\" requires it when anyone repeats. Seats freed in a moved group go to its waitlist.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "Promote group to the next level",
+                "parameters": [
+                    {
+                        "description": "Promotion",
+                        "name": "promotion",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.PromoteGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.PromoteGroupResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/ProposeTimetable": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for proposing a conflict-free weekly timetable for a branch. No more lessons overlap than the branch has rooms. The proposal is saved as a draft.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timetable"
+                ],
+                "summary": "Propose weekly timetable",
+                "parameters": [
+                    {
+                        "description": "Proposal",
+                        "name": "proposal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.ProposeTimetableRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.TimetableProposal"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/RecalculatePayrollRun/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for computing a draft payroll run again from the current lessons, pay rules and adjustments",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Recalculate a payroll run",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll Run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.PayrollRun"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/ReconcileOnlinePayment": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for comparing the statement of a provider (dates YYYY-MM-DD, today when empty) with the recorded online payments. Reports missing_locally, missing_at_provider, amount_mismatch and stale_pending issues.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "online_payment"
+                ],
+                "summary": "Reconcile online payments",
+                "parameters": [
+                    {
+                        "description": "Statement",
+                        "name": "statement",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.ReconcileOnlinePaymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.OnlinePaymentReconciliation"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/RedeemPromoCode": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for turning a promo code into a discount for a student. Students redeem codes for themselves; staff can redeem them for any student.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "discount"
+                ],
+                "summary": "Redeem a promo code",
+                "parameters": [
+                    {
+                        "description": "Promo Code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.RedeemPromoCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.Discount"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/RefundStudentPayment/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for paying back all or part of a payment. The refund is a negative entry linked to the payment, approved by the manager or super admin making the request. A reason is required.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "student_payment"
+                ],
+                "summary": "Refund a student payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Refund",
+                        "name": "refund",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.RefundStudentPayment"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetStudentPayment"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/ReverseStudentPayment/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for cancelling a payment recorded by mistake. Payments are never changed; a negative reversal entry linked to the payment is added instead. A reason is required.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "student_payment"
+                ],
+                "summary": "Reverse a student payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reversal",
+                        "name": "reversal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.ReverseStudentPayment"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetStudentPayment"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/ReviewExpense/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for a manager to approve or reject a pending expense (status approved or rejected). Rejections need a note. Only approved expenses count as costs.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense"
+                ],
+                "summary": "Approve or reject an expense",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Review",
+                        "name": "review",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.ReviewExpense"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.Expense"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/SaveMockTestResult": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for recording a student's mock test scores, replacing any earlier ones. listeningRaw and readingRaw are correct answers out of 40 and are converted to bands on the official tables. The four Writing and the four Speaking criterion scores are whole bands from 0 to 9; their mean rounded down to the half band is the paper band. The overall band follows IELTS rounding.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "mock_test"
+                ],
+                "summary": "Save mock test result",
+                "parameters": [
+                    {
+                        "description": "Mock Test Result",
+                        "name": "result",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.SaveMockTestResult"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.MockTestResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/SetLessonRequirement": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for setting how many lessons per week and how long a group needs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timetable"
+                ],
+                "summary": "Set group lesson requirement",
+                "parameters": [
+                    {
+                        "description": "Lesson Requirement",
+                        "name": "requirement",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.LessonRequirement"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.LessonRequirement"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/SimulateOnlinePayment/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for playing the signed callback of the mock provider for a mock payment: \"complete\" (default), \"prepare\" or \"cancel\". Only works when the mock provider is enabled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "online_payment"
+                ],
+                "summary": "Simulate the mock payment provider",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Online Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Stage",
+                        "name": "simulation",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.SimulateOnlinePayment"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.OnlinePayment"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/Student/{id}/contract.pdf": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for rendering an enrollment contract of a student as a PDF. Without a template the newest one of the student's branch is used, then the newest shared one. Fields without a value are left blank.",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "document"
+                ],
+                "summary": "Download an enrollment contract",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Contract Template ID",
+                        "name": "templateId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/StudentPayment/{id}/receipt.pdf": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting the receipt of a student payment as a PDF, numbered per branch. Students can only get receipts of their own payments.",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "document"
+                ],
+                "summary": "Download a payment receipt",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/StudentReportList": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a list of students",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "report"
+                ],
+                "summary": "Get List of Students",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search term",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.GetListStudentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/SupportTeacherReportList": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a list of support teachers with totalsum, what locked payroll runs paid them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "report"
+                ],
+                "summary": "Get List of Support Teachers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.GetListSupportTeacherResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/TeacherReportList": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for getting a list of teachers with totalsum, what locked payroll runs paid them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "report"
+                ],
+                "summary": "Get List of Teachers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search term",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.GetReportListTeacherResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UnlinkGuardianStudent/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for removing a student from a guardian",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "guardian"
+                ],
+                "summary": "Unlink student from guardian",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Guardian ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.EmptyGuardian"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateAdministration/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for updating a administration by ID. ieltsScore is derived from the IELTS results and ignored here.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "administration"
+                ],
+                "summary": "Update a administration by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "administration ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Administration",
+                        "name": "seller",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateAdministration"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateAdministration"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateBranch/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for updating a branch by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "branch"
+                ],
+                "summary": "Update a branch by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Branch",
+                        "name": "branch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateBranch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateBranch"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateBranchSetting/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for replacing the rules of a branch. Fields left empty get the defaults.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "branch_setting"
+                ],
+                "summary": "Update settings of a branch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Branch ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Branch Setting",
+                        "name": "branch_setting",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateBranchSetting"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.BranchSetting"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateContractTemplate/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for changing the name and body of a contract template",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "document"
+                ],
+                "summary": "Update a contract template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Contract Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Contract Template",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.UpdateContractTemplate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.ContractTemplate"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateEvent/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for updating an event by ID. An event given a roomId needs an endTime, must fit in the room and cannot overlap its other bookings.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "Update an event by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Event",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.UpdateEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.UpdateEvent"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateExpense/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for correcting a pending expense. Administrators can only change expenses they recorded.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense"
+                ],
+                "summary": "Update an expense",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Expense",
+                        "name": "expense",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.UpdateExpense"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.Expense"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateGroup/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for updating a group by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "group"
+                ],
+                "summary": "Update a group by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Group",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.UpdateGroup"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.UpdateGroup"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateGuardian/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for updating a guardian by ID. An empty password keeps the current one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "guardian"
+                ],
+                "summary": "Update guardian",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Guardian ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Guardian",
+                        "name": "guardian",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateGuardian"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Guardian"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateIeltsResult/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for correcting an IELTS result by ID. The staff member's current score and attempt count follow.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ielts_result"
+                ],
+                "summary": "Update IELTS result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "IELTS Result ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "IELTS Result",
+                        "name": "ielts_result",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateIeltsResult"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.IeltsResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateJournal/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for updating a journal by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "journal"
+                ],
+                "summary": "Update a journal by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Journal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Journal",
+                        "name": "journal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.UpdateJournal"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetJournal"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateLead/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for updating the details of a lead by ID. The status changes through the status, trial and convert APIs; converted leads cannot be edited.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lead"
+                ],
+                "summary": "Update lead",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Lead",
+                        "name": "lead",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateLead"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Lead"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateLeadStatus/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for moving a lead along the pipeline. status is contacted (from new, trial_booked or lost), trial_attended (from trial_booked) or lost (from any open status, with an optional lostReason).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lead"
+                ],
+                "summary": "Move lead",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateLeadStatus"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.Lead"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateManager/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for updating a manager by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manager"
+                ],
+                "summary": "Update a manager by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Manager ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Manager",
+                        "name": "manager",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateManager"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateManager"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateRoom/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for updating a room by ID. Empty openTime or closeTime keep the current hours; the change is rejected while upcoming lessons or events would no longer fit.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "room"
+                ],
+                "summary": "Update room",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Room",
+                        "name": "room",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.UpdateRoom"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.Room"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateSchedule/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for updating a schedule by ID. A lesson given a roomId must fit in the room's seats and hours and cannot overlap its other bookings.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedule"
+                ],
+                "summary": "Update a schedule by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Schedule",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.UpdateSchedule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetSchedule"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateStudent/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for updating a student by ID. groupName follows group membership and is not changed here; use the group student APIs.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "student"
+                ],
+                "summary": "Update a student by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Student",
+                        "name": "student",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateStudent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateStudent"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateStudentTask/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for updating a student task by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "student_task"
+                ],
+                "summary": "Update a student task by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Student Task",
+                        "name": "student_task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.UpdateStudentTask"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetStudentTask"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateSupportTeacher/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for updating a support teacher by ID. ieltsScore and ieltsAttemptCount are derived from the IELTS results and ignored here.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "support_teacher"
+                ],
+                "summary": "Update a support teacher by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Support Teacher ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Support Teacher",
+                        "name": "support_teacher",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateSupportTeacher"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateSupportTeacher"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateTask/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for updating a task by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "Update a task by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.UpdateTask"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.GetTask"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateTeacher/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for updating a teacher by ID. ieltsScore and ieltsAttemptCount are derived from the IELTS results and ignored here.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teacher"
+                ],
+                "summary": "Update a teacher by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Teacher ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Teacher",
+                        "name": "teacher",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateTeacher"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user_service.UpdateTeacher"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/UpdateTuitionPlan/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "API for changing the fee or the start of a tuition plan. Invoices already issued keep their amount.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tuition"
+                ],
+                "summary": "Update a tuition plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tuition Plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tuition Plan",
+                        "name": "plan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.UpdateTuitionPlan"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule_service.TuitionPlan"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.ResponseError": {
+            "type": "object",
+            "properties": {
+                "error": {}
+            }
+        },
+        "schedule_service.ApproveCashShift": {
+            "type": "object",
+            "properties": {
+                "approvedBy": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.AssignSubstituteRequest": {
+            "type": "object",
+            "properties": {
+                "scheduleId": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.BranchProfit": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                },
+                "branchName": {
+                    "type": "string"
+                },
+                "expenses": {
+                    "type": "string"
+                },
+                "expensesByCategory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.CategoryAmount"
+                    }
+                },
+                "income": {
+                    "type": "string"
+                },
+                "margin": {
+                    "type": "string"
+                },
+                "payroll": {
+                    "type": "string"
+                },
+                "profit": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CashCount": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "paymentMethod": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CashShift": {
+            "type": "object",
+            "properties": {
+                "administrationId": {
+                    "type": "string"
+                },
+                "administrationName": {
+                    "type": "string"
+                },
+                "approvalNote": {
+                    "type": "string"
+                },
+                "approvedAt": {
+                    "type": "string"
+                },
+                "approvedBy": {
+                    "type": "string"
+                },
+                "branchId": {
+                    "type": "string"
+                },
+                "closeNote": {
+                    "type": "string"
+                },
+                "closedAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "mismatch": {
+                    "type": "boolean"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "openedAt": {
+                    "type": "string"
+                },
+                "openingFloat": {
+                    "type": "string"
+                },
+                "paymentCount": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "totals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.CashShiftTotal"
+                    }
+                }
+            }
+        },
+        "schedule_service.CashShiftTotal": {
+            "type": "object",
+            "properties": {
+                "counted": {
+                    "type": "string"
+                },
+                "difference": {
+                    "type": "string"
+                },
+                "expected": {
+                    "type": "string"
+                },
+                "mismatch": {
+                    "type": "boolean"
+                },
+                "paymentMethod": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CategoryAmount": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "categoryId": {
+                    "type": "string"
+                },
+                "categoryName": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CheckInEventStudent": {
+            "type": "object",
+            "properties": {
+                "checkedInBy": {
+                    "type": "string"
+                },
+                "eventId": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.ClearStudentHold": {
+            "type": "object",
+            "properties": {
+                "clearedBy": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CloseCashShift": {
+            "type": "object",
+            "properties": {
+                "administrationId": {
+                    "type": "string"
+                },
+                "counted": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.CashCount"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CollectionRow": {
+            "type": "object",
+            "properties": {
+                "administrationId": {
+                    "type": "string"
+                },
+                "administrationName": {
+                    "type": "string"
+                },
+                "corrections": {
+                    "type": "string"
+                },
+                "net": {
+                    "type": "string"
+                },
+                "paymentCount": {
+                    "type": "integer"
+                },
+                "paymentMethod": {
+                    "type": "string"
+                },
+                "payments": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CollectionsReport": {
+            "type": "object",
+            "properties": {
+                "fromDate": {
+                    "type": "string"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.CollectionRow"
+                    }
+                },
+                "toDate": {
+                    "type": "string"
+                },
+                "totalNet": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CommissionReport": {
+            "type": "object",
+            "properties": {
+                "period": {
+                    "type": "string"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.CommissionRow"
+                    }
+                },
+                "totalClawback": {
+                    "type": "string"
+                },
+                "totalCommission": {
+                    "type": "string"
+                },
+                "totalNet": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CommissionRow": {
+            "type": "object",
+            "properties": {
+                "clawback": {
+                    "type": "string"
+                },
+                "collected": {
+                    "type": "string"
+                },
+                "commission": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "net": {
+                    "type": "string"
+                },
+                "paymentCount": {
+                    "type": "integer"
+                },
+                "refunded": {
+                    "type": "string"
+                },
+                "staffId": {
+                    "type": "string"
+                },
+                "staffName": {
+                    "type": "string"
+                },
+                "staffType": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CommissionRule": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "groupType": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "string"
+                },
+                "staffId": {
+                    "type": "string"
+                },
+                "staffName": {
+                    "type": "string"
+                },
+                "staffType": {
+                    "type": "string"
+                },
+                "validFrom": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CommitTimetableResponse": {
+            "type": "object",
+            "properties": {
+                "proposalId": {
+                    "type": "string"
+                },
+                "recurringLessons": {
+                    "type": "integer"
+                },
+                "scheduledLessons": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.ContractTemplate": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "branchId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateCommissionRule": {
+            "type": "object",
+            "properties": {
+                "groupType": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "string"
+                },
+                "staffId": {
+                    "type": "string"
+                },
+                "staffType": {
+                    "type": "string"
+                },
+                "validFrom": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateContractTemplate": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "branchId": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateDiscount": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "stackable": {
+                    "type": "boolean"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "validFrom": {
+                    "type": "string"
+                },
+                "validTo": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateEvent": {
+            "type": "object",
+            "properties": {
+                "assignStudent": {
+                    "type": "string"
+                },
+                "branchId": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "cutoffHours": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateEventFeedback": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "eventStudentId": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "integer"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateEventStudent": {
+            "type": "object",
+            "properties": {
+                "eventId": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateExpense": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "branchId": {
+                    "type": "string"
+                },
+                "categoryId": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "spentOn": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateExpenseCategory": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateGroup": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "suppportTeacherId": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateGroupStudent": {
+            "type": "object",
+            "properties": {
+                "groupId": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateJournal": {
+            "type": "object",
+            "properties": {
+                "fromDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "studentsCount": {
+                    "type": "integer"
+                },
+                "toDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateMockTest": {
+            "type": "object",
+            "properties": {
+                "createdBy": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "readingType": {
+                    "type": "string"
+                },
+                "testDate": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateOnlinePayment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "returnUrl": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreatePayRule": {
+            "type": "object",
+            "properties": {
+                "monthlyFee": {
+                    "type": "string"
+                },
+                "perLesson": {
+                    "type": "string"
+                },
+                "perStudent": {
+                    "type": "string"
+                },
+                "staffId": {
+                    "type": "string"
+                },
+                "staffType": {
+                    "type": "string"
+                },
+                "validFrom": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreatePayrollAdjustment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "staffId": {
+                    "type": "string"
+                },
+                "staffType": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreatePayrollRun": {
+            "type": "object",
+            "properties": {
+                "createdBy": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreatePromoCode": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "maxUses": {
+                    "type": "integer"
+                },
+                "months": {
+                    "type": "integer"
+                },
+                "stackable": {
+                    "type": "boolean"
+                },
+                "validFrom": {
+                    "type": "string"
+                },
+                "validTo": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateRoom": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "closeTime": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "openTime": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateSchedule": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "journalId": {
+                    "type": "string"
+                },
+                "lesson": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateStudentHold": {
+            "type": "object",
+            "properties": {
+                "createdBy": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateStudentPayment": {
+            "type": "object",
+            "properties": {
+                "administration_id": {
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "paidSum": {
+                    "type": "string"
+                },
+                "paymentMethod": {
+                    "type": "string"
+                },
+                "student_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateStudentTask": {
+            "type": "object",
+            "properties": {
+                "studentId": {
+                    "type": "string"
+                },
+                "taskId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.CreateTask": {
+            "type": "object",
+            "properties": {
+                "deadlineDate": {
+                    "type": "string"
+                },
+                "deadlineTime": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "scheduleId": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.CreateTeacherAvailability": {
+            "type": "object",
+            "properties": {
+                "endTime": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "supportTeacherId": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "weekday": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.CreateTuitionPlan": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                },
+                "groupType": {
+                    "type": "string"
+                },
+                "monthlyFee": {
+                    "type": "string"
+                },
+                "validFrom": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.DebtAgingBucket": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "bucket": {
+                    "type": "string"
+                },
+                "invoiceCount": {
+                    "type": "integer"
+                },
+                "studentCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.DebtAgingReport": {
+            "type": "object",
+            "properties": {
+                "asOf": {
+                    "type": "string"
+                },
+                "buckets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.DebtAgingBucket"
+                    }
+                },
+                "total": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.Discount": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "promoCodeId": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "stackable": {
+                    "type": "boolean"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "validFrom": {
+                    "type": "string"
+                },
+                "validTo": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.DiscountReport": {
+            "type": "object",
+            "properties": {
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.DiscountReportRow"
+                    }
+                },
+                "total": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.DiscountReportRow": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "invoices": {
+                    "type": "integer"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "students": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.EmptyCommission": {
+            "type": "object"
+        },
+        "schedule_service.EmptyDiscount": {
+            "type": "object"
+        },
+        "schedule_service.EmptyDocument": {
+            "type": "object"
+        },
+        "schedule_service.EmptyEvent": {
+            "type": "object"
+        },
+        "schedule_service.EmptyEventStudent": {
+            "type": "object"
+        },
+        "schedule_service.EmptyExpense": {
+            "type": "object"
+        },
+        "schedule_service.EmptyGroup": {
+            "type": "object"
+        },
+        "schedule_service.EmptyGroupStudent": {
+            "type": "object"
+        },
+        "schedule_service.EmptyJournal": {
+            "type": "object"
+        },
+        "schedule_service.EmptyMockTest": {
+            "type": "object"
+        },
+        "schedule_service.EmptyPayroll": {
+            "type": "object"
+        },
+        "schedule_service.EmptyRoom": {
+            "type": "object"
+        },
+        "schedule_service.EmptySchedule": {
+            "type": "object"
+        },
+        "schedule_service.EmptyStudentTask": {
+            "type": "object"
+        },
+        "schedule_service.EmptyTask": {
+            "type": "object"
+        },
+        "schedule_service.EmptyTimetable": {
+            "type": "object"
+        },
+        "schedule_service.EmptyTuition": {
+            "type": "object"
+        },
+        "schedule_service.Event": {
+            "type": "object",
+            "properties": {
+                "assignStudent": {
+                    "type": "string"
+                },
+                "branchId": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "cutoffHours": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "integer"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "registeredCount": {
+                    "type": "integer"
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "waitlistedCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.EventAttendance": {
+            "type": "object",
+            "properties": {
+                "attended": {
+                    "type": "integer"
+                },
+                "cancelled": {
+                    "type": "integer"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "eventId": {
+                    "type": "string"
+                },
+                "eventStudents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.EventStudent"
+                    }
+                },
+                "noShow": {
+                    "type": "integer"
+                },
+                "registered": {
+                    "type": "integer"
+                },
+                "waitlisted": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.EventFeedback": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "eventId": {
+                    "type": "string"
+                },
+                "eventStudentId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "integer"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.EventRating": {
+            "type": "object",
+            "properties": {
+                "averageRating": {
+                    "type": "number"
+                },
+                "branchId": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "distribution": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "eventId": {
+                    "type": "string"
+                },
+                "responses": {
+                    "type": "integer"
+                },
+                "topic": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.EventStudent": {
+            "type": "object",
+            "properties": {
+                "checkedInAt": {
+                    "type": "string"
+                },
+                "checkedInBy": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "integer"
+                },
+                "eventId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.EventStudentResponse": {
+            "type": "object",
+            "properties": {
+                "assignStudent": {
+                    "type": "string"
+                },
+                "branchId": {
+                    "type": "string"
+                },
+                "checkedInAt": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "eventId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.Expense": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.ExpenseAttachment"
+                    }
+                },
+                "branchId": {
+                    "type": "string"
+                },
+                "branchName": {
+                    "type": "string"
+                },
+                "categoryId": {
+                    "type": "string"
+                },
+                "categoryName": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reviewNote": {
+                    "type": "string"
+                },
+                "reviewedAt": {
+                    "type": "string"
+                },
+                "reviewedBy": {
+                    "type": "string"
+                },
+                "spentOn": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.ExpenseAttachment": {
+            "type": "object",
+            "properties": {
+                "contentType": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "expenseId": {
+                    "type": "string"
+                },
+                "fileName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.ExpenseCategory": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.GenerateInvoicesRequest": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.GenerateInvoicesResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "groupsWithoutPlan": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.GroupWithoutPlan"
+                    }
+                },
+                "period": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.GetEvent": {
+            "type": "object",
+            "properties": {
+                "assignStudent": {
+                    "type": "string"
+                },
+                "branchId": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "cutoffHours": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "integer"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "registeredCount": {
+                    "type": "integer"
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "waitlistedCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.GetEventStudent": {
+            "type": "object",
+            "properties": {
+                "checkedInAt": {
+                    "type": "string"
+                },
+                "checkedInBy": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "integer"
+                },
+                "eventId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.GetGroup": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "studentsCount": {
+                    "type": "integer"
+                },
+                "suppportTeacherId": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.GetGroupStudent": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "integer"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.GetJournal": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "integer"
+                },
+                "fromDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "studentsCount": {
+                    "type": "integer"
+                },
+                "toDate": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.GetListAvailabilityResponse": {
+            "type": "object",
+            "properties": {
+                "availabilities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.TeacherAvailability"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.GetListCashShiftResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "shifts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.CashShift"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListCommissionRuleResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.CommissionRule"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListContractTemplateResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "templates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.ContractTemplate"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListDiscountResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.Discount"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListEventFeedbackResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "feedbacks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.EventFeedback"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListEventResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.Event"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListEventStudentResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "eventStudents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.EventStudent"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListExpenseCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.ExpenseCategory"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.GetListExpenseResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.Expense"
+                    }
+                },
+                "total": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.GetListGroupResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.Group"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListGroupStudentResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "groupStudents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.GroupStudent"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListInvoiceResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "invoices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.Invoice"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListJournalResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "journals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.Journal"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListLessonRequirementResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "requirements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.LessonRequirement"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListMockTestResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "mockTests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.MockTest"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListOnlinePaymentResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "onlinePayments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.OnlinePayment"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListOverdueResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "overdues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.Overdue"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListPayRuleResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.PayRule"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListPayrollAdjustmentResponse": {
+            "type": "object",
+            "properties": {
+                "adjustments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.PayrollAdjustment"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.GetListPayrollRunResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "runs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.PayrollRun"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListPromoCodeResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "promoCodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.PromoCode"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListRoomResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.Room"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "schedules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.Schedule"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListStudentHoldResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "holds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.StudentHold"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListStudentPaymentResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "student_payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.StudentPayment"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListStudentTaskResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "studentTasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.StudentTask"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListTaskResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.Task"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetListTuitionPlanResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "plans": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.TuitionPlan"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetRolloverReportResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "failures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.RolloverFailure"
+                    }
+                }
+            }
+        },
+        "schedule_service.GetSchedule": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "integer"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "journalId": {
+                    "type": "string"
+                },
+                "lesson": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "substituteTeacherId": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.GetStudentPayment": {
+            "type": "object",
+            "properties": {
+                "administration_id": {
+                    "type": "string"
+                },
+                "approvedBy": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "entryType": {
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "paidSum": {
+                    "type": "string"
+                },
+                "paymentMethod": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "refundable": {
+                    "type": "string"
+                },
+                "reversalOf": {
+                    "type": "string"
+                },
+                "shiftId": {
+                    "type": "string"
+                },
+                "student_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.GetStudentTask": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "taskId": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.GetStudentWithEventsResponse": {
+            "type": "object",
+            "properties": {
+                "attendedCount": {
+                    "type": "integer"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.EventStudentResponse"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "registeredCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.GetTask": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deadlineDate": {
+                    "type": "string"
+                },
+                "deadlineTime": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "scheduleId": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.GetWaitlistResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.WaitlistEntry"
+                    }
+                }
+            }
+        },
+        "schedule_service.Group": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "studentsCount": {
+                    "type": "integer"
+                },
+                "suppportTeacherId": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.GroupMockAverages": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "$ref": "#/definitions/schedule_service.MockBands"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "tests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.MockTest"
+                    }
+                }
+            }
+        },
+        "schedule_service.GroupStudent": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "integer"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.GroupWithoutPlan": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupType": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.Invoice": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "baseAmount": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "discountAmount": {
+                    "type": "string"
+                },
+                "dueDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "tuitionPlanId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.Journal": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "integer"
+                },
+                "fromDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "studentsCount": {
+                    "type": "integer"
+                },
+                "toDate": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.LessonAttendance": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isPresent": {
+                    "type": "boolean"
+                },
+                "scheduleId": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.LessonRequirement": {
+            "type": "object",
+            "properties": {
+                "durationMinutes": {
+                    "type": "integer"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "lessonsPerWeek": {
+                    "type": "integer"
+                },
+                "supportTeacherId": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.LowRatedEventsResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.EventRating"
+                    }
+                }
+            }
+        },
+        "schedule_service.MarkAttendanceRequest": {
+            "type": "object",
+            "properties": {
+                "isPresent": {
+                    "type": "boolean"
+                },
+                "scheduleId": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.MockBands": {
+            "type": "object",
+            "properties": {
+                "listening": {
+                    "type": "number"
+                },
+                "overall": {
+                    "type": "number"
+                },
+                "reading": {
+                    "type": "number"
+                },
+                "speaking": {
+                    "type": "number"
+                },
+                "writing": {
+                    "type": "number"
+                }
+            }
+        },
+        "schedule_service.MockTest": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "$ref": "#/definitions/schedule_service.MockBands"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "readingType": {
+                    "type": "string"
+                },
+                "resultCount": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.MockTestResult"
+                    }
+                },
+                "testDate": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.MockTestResult": {
+            "type": "object",
+            "properties": {
+                "bands": {
+                    "$ref": "#/definitions/schedule_service.MockBands"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "listeningRaw": {
+                    "type": "integer"
+                },
+                "mockTestId": {
+                    "type": "string"
+                },
+                "readingRaw": {
+                    "type": "integer"
+                },
+                "speakingFluency": {
+                    "type": "integer"
+                },
+                "speakingGrammar": {
+                    "type": "integer"
+                },
+                "speakingLexical": {
+                    "type": "integer"
+                },
+                "speakingPronunciation": {
+                    "type": "integer"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                },
+                "testDate": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "writingCoherence": {
+                    "type": "integer"
+                },
+                "writingGrammar": {
+                    "type": "integer"
+                },
+                "writingLexical": {
+                    "type": "integer"
+                },
+                "writingTaskResponse": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.MonthComparisonReport": {
+            "type": "object",
+            "properties": {
+                "fromDate": {
+                    "type": "string"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.MonthComparisonRow"
+                    }
+                },
+                "toDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.MonthComparisonRow": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "type": "string"
+                },
+                "changePercent": {
+                    "type": "string"
+                },
+                "collected": {
+                    "type": "string"
+                },
+                "collectionRate": {
+                    "type": "string"
+                },
+                "discounts": {
+                    "type": "string"
+                },
+                "invoiced": {
+                    "type": "string"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "previousCollected": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.OnlinePayment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "checkoutUrl": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "paidAt": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "returnUrl": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentPaymentId": {
+                    "type": "string"
+                },
+                "transactionId": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.OnlinePaymentReconciliation": {
+            "type": "object",
+            "properties": {
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.ReconciliationIssue"
+                    }
+                },
+                "matched": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.OpenCashShift": {
+            "type": "object",
+            "properties": {
+                "administrationId": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "openingFloat": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.Overdue": {
+            "type": "object",
+            "properties": {
+                "amountDue": {
+                    "type": "string"
+                },
+                "branchId": {
+                    "type": "string"
+                },
+                "daysLate": {
+                    "type": "integer"
+                },
+                "dueDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "onHold": {
+                    "type": "boolean"
+                },
+                "outstanding": {
+                    "type": "string"
+                },
+                "paid": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "remindedAt": {
+                    "type": "string"
+                },
+                "resolvedAt": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.PayRule": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "monthlyFee": {
+                    "type": "string"
+                },
+                "perLesson": {
+                    "type": "string"
+                },
+                "perStudent": {
+                    "type": "string"
+                },
+                "staffId": {
+                    "type": "string"
+                },
+                "staffName": {
+                    "type": "string"
+                },
+                "staffType": {
+                    "type": "string"
+                },
+                "validFrom": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.PayrollAdjustment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "staffId": {
+                    "type": "string"
+                },
+                "staffName": {
+                    "type": "string"
+                },
+                "staffType": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.PayrollLine": {
+            "type": "object",
+            "properties": {
+                "bonuses": {
+                    "type": "string"
+                },
+                "branchId": {
+                    "type": "string"
+                },
+                "commission": {
+                    "type": "string"
+                },
+                "lessonAmount": {
+                    "type": "string"
+                },
+                "lessonCount": {
+                    "type": "integer"
+                },
+                "monthlyFee": {
+                    "type": "string"
+                },
+                "penalties": {
+                    "type": "string"
+                },
+                "perLesson": {
+                    "type": "string"
+                },
+                "perStudent": {
+                    "type": "string"
+                },
+                "ruleId": {
+                    "type": "string"
+                },
+                "staffId": {
+                    "type": "string"
+                },
+                "staffName": {
+                    "type": "string"
+                },
+                "staffType": {
+                    "type": "string"
+                },
+                "studentAmount": {
+                    "type": "string"
+                },
+                "studentCount": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.PayrollRun": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.PayrollLine"
+                    }
+                },
+                "lockedAt": {
+                    "type": "string"
+                },
+                "lockedBy": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "staffCount": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.ProfitAndLossReport": {
+            "type": "object",
+            "properties": {
+                "branches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.BranchProfit"
+                    }
+                },
+                "fromDate": {
+                    "type": "string"
+                },
+                "toDate": {
+                    "type": "string"
+                },
+                "totalExpenses": {
+                    "type": "string"
+                },
+                "totalIncome": {
+                    "type": "string"
+                },
+                "totalPayroll": {
+                    "type": "string"
+                },
+                "totalProfit": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.PromoCode": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "maxUses": {
+                    "type": "integer"
+                },
+                "months": {
+                    "type": "integer"
+                },
+                "stackable": {
+                    "type": "boolean"
+                },
+                "usedCount": {
+                    "type": "integer"
+                },
+                "validFrom": {
+                    "type": "string"
+                },
+                "validTo": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.PromoteGroupRequest": {
+            "type": "object",
+            "properties": {
+                "groupId": {
+                    "type": "string"
+                },
+                "minAttendance": {
+                    "type": "number"
+                },
+                "minAverageScore": {
+                    "type": "number"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "repeatGroupId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.PromoteGroupResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "fromGroupId": {
+                    "type": "string"
+                },
+                "fromLevel": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "journalId": {
+                    "type": "string"
+                },
+                "minAttendance": {
+                    "type": "number"
+                },
+                "minAverageScore": {
+                    "type": "number"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "promoted": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.PromotionStudent"
+                    }
+                },
+                "repeat": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.PromotionStudent"
+                    }
+                },
+                "repeatGroupId": {
+                    "type": "string"
+                },
+                "toGroupId": {
+                    "type": "string"
+                },
+                "toLevel": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.PromotionStudent": {
+            "type": "object",
+            "properties": {
+                "attendance": {
+                    "type": "number"
+                },
+                "averageScore": {
+                    "type": "number"
+                },
+                "outcome": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.ProposeTimetableRequest": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.ProposedLesson": {
+            "type": "object",
+            "properties": {
+                "endTime": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "supportTeacherId": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "weekday": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.ProviderTransaction": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "orderId": {
+                    "type": "string"
+                },
+                "transactionId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.ReconcileOnlinePaymentRequest": {
+            "type": "object",
+            "properties": {
+                "fromDate": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "toDate": {
+                    "type": "string"
+                },
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.ProviderTransaction"
+                    }
+                }
+            }
+        },
+        "schedule_service.ReconciliationIssue": {
+            "type": "object",
+            "properties": {
+                "actual": {
+                    "type": "string"
+                },
+                "expected": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "onlinePaymentId": {
+                    "type": "string"
+                },
+                "transactionId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.RedeemPromoCodeRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
                 },
                 "studentId": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.CreateGroup": {
+        "schedule_service.RefundStudentPayment": {
             "type": "object",
             "properties": {
-                "branchId": {
+                "administration_id": {
                     "type": "string"
                 },
-                "suppportTeacherId": {
+                "approvedBy": {
                     "type": "string"
                 },
-                "teacherId": {
+                "id": {
                     "type": "string"
                 },
-                "type": {
+                "paidSum": {
+                    "type": "string"
+                },
+                "reason": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.CreateJournal": {
+        "schedule_service.RevenueReport": {
             "type": "object",
             "properties": {
                 "fromDate": {
                     "type": "string"
                 },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.RevenueRow"
+                    }
+                },
+                "toDate": {
+                    "type": "string"
+                },
+                "totalCorrections": {
+                    "type": "string"
+                },
+                "totalNet": {
+                    "type": "string"
+                },
+                "totalPayments": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.RevenueRow": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                },
+                "branchName": {
+                    "type": "string"
+                },
+                "corrections": {
+                    "type": "string"
+                },
+                "groupType": {
+                    "type": "string"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "net": {
+                    "type": "string"
+                },
+                "paymentCount": {
+                    "type": "integer"
+                },
+                "payments": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.ReverseStudentPayment": {
+            "type": "object",
+            "properties": {
+                "administration_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.ReviewExpense": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "reviewedBy": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.RolloverFailure": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
                 "groupId": {
                     "type": "string"
                 },
-                "studentsCount": {
-                    "type": "integer"
+                "groupName": {
+                    "type": "string"
+                },
+                "journalId": {
+                    "type": "string"
+                },
+                "toDate": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.Room": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "closeTime": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "openTime": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.RoomUtilization": {
+            "type": "object",
+            "properties": {
+                "bookedMinutes": {
+                    "type": "integer"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "events": {
+                    "type": "integer"
+                },
+                "lessons": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "openMinutes": {
+                    "type": "integer"
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "seatFill": {
+                    "type": "number"
+                },
+                "utilization": {
+                    "type": "number"
+                }
+            }
+        },
+        "schedule_service.RoomUtilizationReport": {
+            "type": "object",
+            "properties": {
+                "bookedMinutes": {
+                    "type": "integer"
+                },
+                "branchId": {
+                    "type": "string"
+                },
+                "openMinutes": {
+                    "type": "integer"
+                },
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.RoomUtilization"
+                    }
+                },
+                "utilization": {
+                    "type": "number"
+                },
+                "weekEnd": {
+                    "type": "string"
+                },
+                "weekStart": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.SaveMockTestResult": {
+            "type": "object",
+            "properties": {
+                "listeningRaw": {
+                    "type": "integer"
+                },
+                "mockTestId": {
+                    "type": "string"
+                },
+                "readingRaw": {
+                    "type": "integer"
+                },
+                "speakingFluency": {
+                    "type": "integer"
+                },
+                "speakingGrammar": {
+                    "type": "integer"
+                },
+                "speakingLexical": {
+                    "type": "integer"
+                },
+                "speakingPronunciation": {
+                    "type": "integer"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "writingCoherence": {
+                    "type": "integer"
+                },
+                "writingGrammar": {
+                    "type": "integer"
+                },
+                "writingLexical": {
+                    "type": "integer"
+                },
+                "writingTaskResponse": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule_service.Schedule": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "integer"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "journalId": {
+                    "type": "string"
+                },
+                "lesson": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "substituteTeacherId": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.SimulateOnlinePayment": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "stage": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.StudentBalance": {
+            "type": "object",
+            "properties": {
+                "invoices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.Invoice"
+                    }
+                },
+                "outstanding": {
+                    "type": "string"
+                },
+                "payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.StudentPayment"
+                    }
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "totalInvoiced": {
+                    "type": "string"
                 },
-                "toDate": {
+                "totalPaid": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.CreateSchedule": {
+        "schedule_service.StudentHold": {
             "type": "object",
             "properties": {
-                "date": {
+                "clearNote": {
                     "type": "string"
                 },
-                "endTime": {
+                "clearedAt": {
                     "type": "string"
                 },
-                "journalId": {
+                "clearedBy": {
                     "type": "string"
                 },
-                "lesson": {
+                "createdBy": {
                     "type": "string"
                 },
-                "startTime": {
-                    "type": "string"
-                }
-            }
-        },
-        "schedule_service.CreateStudentPayment": {
-            "type": "object",
-            "properties": {
-                "administration_id": {
+                "created_at": {
                     "type": "string"
                 },
-                "group_id": {
+                "id": {
                     "type": "string"
                 },
-                "paidsum": {
-                    "type": "integer"
+                "reason": {
+                    "type": "string"
                 },
-                "student_id": {
+                "studentId": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.CreateStudentTask": {
+        "schedule_service.StudentHoldStatus": {
             "type": "object",
             "properties": {
-                "studentId": {
-                    "type": "string"
+                "hold": {
+                    "$ref": "#/definitions/schedule_service.StudentHold"
                 },
-                "taskId": {
-                    "type": "string"
+                "onHold": {
+                    "type": "boolean"
                 }
             }
         },
-        "schedule_service.CreateTask": {
+        "schedule_service.StudentMockProgress": {
             "type": "object",
             "properties": {
-                "deadlineDate": {
-                    "type": "string"
+                "best": {
+                    "$ref": "#/definitions/schedule_service.MockBands"
                 },
-                "deadlineTime": {
-                    "type": "string"
+                "latest": {
+                    "$ref": "#/definitions/schedule_service.MockBands"
                 },
-                "label": {
-                    "type": "string"
+                "overallChange": {
+                    "type": "number"
                 },
-                "scheduleId": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.MockTestResult"
+                    }
+                },
+                "studentId": {
                     "type": "string"
                 },
-                "score": {
-                    "type": "integer"
+                "studentName": {
+                    "type": "string"
                 }
             }
         },
-        "schedule_service.EmptyEvent": {
-            "type": "object"
-        },
-        "schedule_service.EmptyEventStudent": {
-            "type": "object"
-        },
-        "schedule_service.EmptyGroup": {
-            "type": "object"
-        },
-        "schedule_service.EmptyJournal": {
-            "type": "object"
-        },
-        "schedule_service.EmptySchedule": {
-            "type": "object"
-        },
-        "schedule_service.EmptyStudentPayment": {
-            "type": "object"
-        },
-        "schedule_service.EmptyStudentTask": {
-            "type": "object"
-        },
-        "schedule_service.EmptyTask": {
-            "type": "object"
-        },
-        "schedule_service.Event": {
+        "schedule_service.StudentPayment": {
             "type": "object",
             "properties": {
-                "assignStudent": {
+                "administration_id": {
                     "type": "string"
                 },
-                "branchId": {
+                "approvedBy": {
+                    "type": "string"
+                },
+                "balance": {
                     "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "date": {
+                "entryType": {
                     "type": "string"
                 },
-                "deleted_at": {
-                    "type": "integer"
+                "group_id": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "startTime": {
+                "paidSum": {
                     "type": "string"
                 },
-                "topic": {
+                "paymentMethod": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "reversalOf": {
+                    "type": "string"
+                },
+                "shiftId": {
+                    "type": "string"
+                },
+                "student_id": {
                     "type": "string"
                 },
                 "updated_at": {
@@ -4757,7 +16170,7 @@ const docTemplate = `{
                 }
             }
         },
-        "schedule_service.EventStudent": {
+        "schedule_service.StudentTask": {
             "type": "object",
             "properties": {
                 "created_at": {
@@ -4766,33 +16179,33 @@ const docTemplate = `{
                 "deleted_at": {
                     "type": "integer"
                 },
-                "eventId": {
-                    "type": "string"
-                },
                 "id": {
                     "type": "string"
                 },
+                "score": {
+                    "type": "integer"
+                },
                 "studentId": {
                     "type": "string"
                 },
+                "taskId": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.GetEvent": {
+        "schedule_service.Task": {
             "type": "object",
             "properties": {
-                "assignStudent": {
-                    "type": "string"
-                },
-                "branchId": {
+                "created_at": {
                     "type": "string"
                 },
-                "created_at": {
+                "deadlineDate": {
                     "type": "string"
                 },
-                "date": {
+                "deadlineTime": {
                     "type": "string"
                 },
                 "deleted_at": {
@@ -4801,222 +16214,301 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "startTime": {
+                "label": {
                     "type": "string"
                 },
-                "topic": {
+                "scheduleId": {
                     "type": "string"
                 },
+                "score": {
+                    "type": "integer"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.GetEventStudent": {
+        "schedule_service.TeacherAvailability": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "deleted_at": {
-                    "type": "integer"
-                },
-                "eventId": {
+                "endTime": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "studentId": {
+                "startTime": {
+                    "type": "string"
+                },
+                "supportTeacherId": {
+                    "type": "string"
+                },
+                "teacherId": {
                     "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "weekday": {
+                    "type": "integer"
                 }
             }
         },
-        "schedule_service.GetGroup": {
+        "schedule_service.TeacherLessonCount": {
+            "type": "object",
+            "properties": {
+                "lessons": {
+                    "type": "integer"
+                },
+                "substitutedLessons": {
+                    "type": "integer"
+                },
+                "teacherId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.TimetableProposal": {
             "type": "object",
             "properties": {
                 "branchId": {
                     "type": "string"
                 },
-                "created_at": {
+                "committed_at": {
                     "type": "string"
                 },
-                "deleted_at": {
-                    "type": "integer"
+                "created_at": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "suppportTeacherId": {
-                    "type": "string"
+                "lessons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.ProposedLesson"
+                    }
                 },
-                "teacherId": {
-                    "type": "string"
+                "roomCount": {
+                    "type": "integer"
                 },
-                "type": {
+                "status": {
                     "type": "string"
                 },
-                "updated_at": {
-                    "type": "string"
+                "unscheduled": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.UnscheduledGroup"
+                    }
                 }
             }
         },
-        "schedule_service.GetJournal": {
+        "schedule_service.TopicRating": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
+                "averageRating": {
+                    "type": "number"
                 },
-                "deleted_at": {
+                "events": {
                     "type": "integer"
                 },
-                "fromDate": {
+                "responses": {
+                    "type": "integer"
+                },
+                "topic": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.TopicRatingResponse": {
+            "type": "object",
+            "properties": {
+                "topics": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule_service.TopicRating"
+                    }
+                }
+            }
+        },
+        "schedule_service.TuitionPlan": {
+            "type": "object",
+            "properties": {
+                "branchId": {
                     "type": "string"
                 },
-                "groupId": {
+                "created_at": {
                     "type": "string"
                 },
-                "id": {
+                "groupType": {
                     "type": "string"
                 },
-                "studentsCount": {
-                    "type": "integer"
+                "id": {
+                    "type": "string"
                 },
-                "toDate": {
+                "monthlyFee": {
                     "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "validFrom": {
+                    "type": "string"
                 }
             }
         },
-        "schedule_service.GetListEventResponse": {
+        "schedule_service.UnscheduledGroup": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "groupId": {
+                    "type": "string"
                 },
-                "events": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schedule_service.Event"
-                    }
+                "reason": {
+                    "type": "string"
                 }
             }
         },
-        "schedule_service.GetListEventStudentResponse": {
+        "schedule_service.UpdateContractTemplate": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "body": {
+                    "type": "string"
                 },
-                "eventStudents": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schedule_service.EventStudent"
-                    }
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "schedule_service.GetListGroupResponse": {
+        "schedule_service.UpdateEvent": {
             "type": "object",
             "properties": {
-                "count": {
+                "assignStudent": {
+                    "type": "string"
+                },
+                "branchId": {
+                    "type": "string"
+                },
+                "capacity": {
                     "type": "integer"
                 },
-                "groups": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schedule_service.Group"
-                    }
-                }
-            }
-        },
-        "schedule_service.GetListJournalResponse": {
-            "type": "object",
-            "properties": {
-                "count": {
+                "cutoffHours": {
                     "type": "integer"
                 },
-                "journals": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schedule_service.Journal"
-                    }
+                "date": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string"
                 }
             }
         },
-        "schedule_service.GetListScheduleResponse": {
+        "schedule_service.UpdateExpense": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "amount": {
+                    "type": "string"
                 },
-                "schedules": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schedule_service.Schedule"
-                    }
+                "categoryId": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "spentOn": {
+                    "type": "string"
                 }
             }
         },
-        "schedule_service.GetListStudentPaymentResponse": {
+        "schedule_service.UpdateGroup": {
             "type": "object",
             "properties": {
-                "count": {
+                "branchId": {
+                    "type": "string"
+                },
+                "capacity": {
                     "type": "integer"
                 },
-                "student_payments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schedule_service.StudentPayment"
-                    }
+                "id": {
+                    "type": "string"
+                },
+                "suppportTeacherId": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "schedule_service.GetListStudentTaskResponse": {
+        "schedule_service.UpdateJournal": {
             "type": "object",
             "properties": {
-                "count": {
+                "fromDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "studentsCount": {
                     "type": "integer"
                 },
-                "studentTasks": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schedule_service.StudentTask"
-                    }
+                "toDate": {
+                    "type": "string"
                 }
             }
         },
-        "schedule_service.GetListTaskResponse": {
+        "schedule_service.UpdateRoom": {
             "type": "object",
             "properties": {
-                "count": {
+                "capacity": {
                     "type": "integer"
                 },
-                "tasks": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schedule_service.Task"
-                    }
+                "closeTime": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "openTime": {
+                    "type": "string"
                 }
             }
         },
-        "schedule_service.GetSchedule": {
+        "schedule_service.UpdateSchedule": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
                 "date": {
                     "type": "string"
                 },
-                "deleted_at": {
-                    "type": "integer"
-                },
                 "endTime": {
                     "type": "string"
                 },
@@ -5029,96 +16521,150 @@ const docTemplate = `{
                 "lesson": {
                     "type": "string"
                 },
-                "startTime": {
+                "roomId": {
                     "type": "string"
                 },
-                "updated_at": {
+                "startTime": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.GetStudentPayment": {
+        "schedule_service.UpdateStudentTask": {
             "type": "object",
             "properties": {
-                "administration_id": {
+                "id": {
                     "type": "string"
                 },
-                "created_at": {
+                "studentId": {
                     "type": "string"
                 },
-                "deleted_at": {
-                    "type": "integer"
+                "taskId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule_service.UpdateTask": {
+            "type": "object",
+            "properties": {
+                "deadlineDate": {
+                    "type": "string"
                 },
-                "group_id": {
+                "deadlineTime": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "paidsum": {
+                "label": {
+                    "type": "string"
+                },
+                "scheduleId": {
+                    "type": "string"
+                },
+                "score": {
                     "type": "integer"
+                }
+            }
+        },
+        "schedule_service.UpdateTuitionPlan": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
                 },
-                "student_id": {
+                "monthlyFee": {
                     "type": "string"
                 },
-                "updated_at": {
+                "validFrom": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.GetStudentTask": {
+        "schedule_service.WaitlistEntry": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "deleted_at": {
-                    "type": "integer"
+                "groupId": {
+                    "type": "string"
+                },
+                "groupStudentId": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "studentId": {
+                "position": {
+                    "type": "integer"
+                },
+                "promoted_at": {
                     "type": "string"
                 },
-                "taskId": {
+                "status": {
                     "type": "string"
                 },
-                "updated_at": {
+                "studentId": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.GetStudentWithEventsResponse": {
+        "user_service.Administration": {
             "type": "object",
             "properties": {
-                "events": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schedule_service.GetEventStudent"
-                    }
+                "branchId": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "integer"
+                },
+                "fullname": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "ieltsScore": {
+                    "type": "number"
+                },
+                "login": {
+                    "type": "string"
+                },
+                "password": {
                     "type": "string"
                 },
                 "phone": {
                     "type": "string"
+                },
+                "salary": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "schedule_service.GetTask": {
+        "user_service.BookLeadTrial": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "id": {
                     "type": "string"
                 },
-                "deadlineDate": {
+                "scheduleId": {
+                    "type": "string"
+                }
+            }
+        },
+        "user_service.Branch": {
+            "type": "object",
+            "properties": {
+                "address": {
                     "type": "string"
                 },
-                "deadlineTime": {
+                "created_at": {
                     "type": "string"
                 },
                 "deleted_at": {
@@ -5127,21 +16673,18 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "label": {
+                "name": {
                     "type": "string"
                 },
-                "scheduleId": {
+                "phone": {
                     "type": "string"
                 },
-                "score": {
-                    "type": "integer"
-                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.Group": {
+        "user_service.BranchSetting": {
             "type": "object",
             "properties": {
                 "branchId": {
@@ -5150,339 +16693,486 @@ const docTemplate = `{
                 "created_at": {
                     "type": "string"
                 },
-                "deleted_at": {
+                "eventWeekdays": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "isDefault": {
+                    "type": "boolean"
+                },
+                "lessonDurationMinutes": {
                     "type": "integer"
                 },
-                "id": {
+                "paymentDueDay": {
+                    "type": "integer"
+                },
+                "phonePattern": {
                     "type": "string"
                 },
-                "suppportTeacherId": {
+                "registrationCutoffHours": {
+                    "type": "integer"
+                },
+                "timezone": {
                     "type": "string"
                 },
-                "teacherId": {
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "user_service.ConvertLead": {
+            "type": "object",
+            "properties": {
+                "groupName": {
                     "type": "string"
                 },
-                "type": {
+                "id": {
                     "type": "string"
                 },
-                "updated_at": {
+                "password": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.Journal": {
+        "user_service.CreateAdministration": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "branchId": {
                     "type": "string"
                 },
-                "deleted_at": {
-                    "type": "integer"
+                "fullname": {
+                    "type": "string"
                 },
-                "fromDate": {
+                "ieltsScore": {
+                    "type": "number"
+                },
+                "login": {
                     "type": "string"
                 },
-                "groupId": {
+                "password": {
                     "type": "string"
                 },
-                "id": {
+                "phone": {
+                    "type": "string"
+                },
+                "salary": {
+                    "type": "integer"
+                }
+            }
+        },
+        "user_service.CreateBranch": {
+            "type": "object",
+            "properties": {
+                "address": {
                     "type": "string"
                 },
-                "studentsCount": {
-                    "type": "integer"
-                },
-                "toDate": {
+                "name": {
                     "type": "string"
                 },
-                "updated_at": {
+                "phone": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.Schedule": {
+        "user_service.CreateBranchSetting": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "branchId": {
                     "type": "string"
                 },
-                "date": {
-                    "type": "string"
+                "eventWeekdays": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 },
-                "deleted_at": {
+                "lessonDurationMinutes": {
                     "type": "integer"
                 },
-                "endTime": {
-                    "type": "string"
+                "paymentDueDay": {
+                    "type": "integer"
                 },
-                "id": {
+                "phonePattern": {
                     "type": "string"
                 },
-                "journalId": {
-                    "type": "string"
+                "registrationCutoffHours": {
+                    "type": "integer"
                 },
-                "lesson": {
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "user_service.CreateGuardian": {
+            "type": "object",
+            "properties": {
+                "fullname": {
                     "type": "string"
                 },
-                "startTime": {
+                "password": {
                     "type": "string"
                 },
-                "updated_at": {
+                "phone": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.StudentPayment": {
+        "user_service.CreateIeltsResult": {
             "type": "object",
             "properties": {
-                "administration_id": {
+                "certificateNumber": {
                     "type": "string"
                 },
-                "created_at": {
-                    "type": "string"
+                "listening": {
+                    "type": "number"
                 },
-                "deleted_at": {
-                    "type": "integer"
+                "overall": {
+                    "type": "number"
                 },
-                "group_id": {
-                    "type": "string"
+                "reading": {
+                    "type": "number"
                 },
-                "id": {
-                    "type": "string"
+                "speaking": {
+                    "type": "number"
                 },
-                "paidsum": {
-                    "type": "integer"
+                "staffId": {
+                    "type": "string"
                 },
-                "student_id": {
+                "staffType": {
                     "type": "string"
                 },
-                "updated_at": {
+                "testDate": {
                     "type": "string"
+                },
+                "writing": {
+                    "type": "number"
                 }
             }
         },
-        "schedule_service.StudentTask": {
+        "user_service.CreateLead": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "branchId": {
                     "type": "string"
                 },
-                "deleted_at": {
-                    "type": "integer"
+                "desiredLevel": {
+                    "type": "string"
                 },
-                "id": {
+                "fullname": {
                     "type": "string"
                 },
-                "studentId": {
+                "note": {
                     "type": "string"
                 },
-                "taskId": {
+                "phone": {
                     "type": "string"
                 },
-                "updated_at": {
+                "source": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.Task": {
+        "user_service.CreateManager": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "deadlineDate": {
+                "branchId": {
                     "type": "string"
                 },
-                "deadlineTime": {
+                "fullname": {
                     "type": "string"
                 },
-                "deleted_at": {
-                    "type": "integer"
-                },
-                "id": {
+                "login": {
                     "type": "string"
                 },
-                "label": {
+                "password": {
                     "type": "string"
                 },
-                "scheduleId": {
+                "phone": {
                     "type": "string"
                 },
-                "score": {
+                "salary": {
                     "type": "integer"
-                },
-                "updated_at": {
-                    "type": "string"
                 }
             }
         },
-        "schedule_service.UpdateEvent": {
+        "user_service.CreateStudent": {
             "type": "object",
             "properties": {
-                "assignStudent": {
+                "branchId": {
                     "type": "string"
                 },
-                "branchId": {
+                "fullname": {
                     "type": "string"
                 },
-                "date": {
+                "groupName": {
                     "type": "string"
                 },
-                "id": {
+                "login": {
                     "type": "string"
                 },
-                "startTime": {
+                "password": {
                     "type": "string"
                 },
-                "topic": {
+                "phone": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.UpdateEventStudent": {
+        "user_service.CreateSupportTeacher": {
             "type": "object",
             "properties": {
-                "eventId": {
+                "branchId": {
                     "type": "string"
                 },
-                "id": {
+                "fullname": {
                     "type": "string"
                 },
-                "studentId": {
+                "ieltsAttemptCount": {
+                    "type": "integer"
+                },
+                "ieltsScore": {
+                    "type": "number"
+                },
+                "login": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "phone": {
                     "type": "string"
+                },
+                "salary": {
+                    "type": "integer"
                 }
             }
         },
-        "schedule_service.UpdateGroup": {
+        "user_service.CreateTeacher": {
             "type": "object",
             "properties": {
                 "branchId": {
                     "type": "string"
                 },
-                "id": {
+                "fullname": {
                     "type": "string"
                 },
-                "suppportTeacherId": {
+                "ieltsAttemptCount": {
+                    "type": "integer"
+                },
+                "ieltsScore": {
+                    "type": "number"
+                },
+                "login": {
                     "type": "string"
                 },
-                "teacherId": {
+                "password": {
                     "type": "string"
                 },
-                "type": {
+                "phone": {
+                    "type": "string"
+                },
+                "salary": {
+                    "type": "number"
+                },
+                "supportTeacherId": {
                     "type": "string"
                 }
             }
         },
-        "schedule_service.UpdateJournal": {
+        "user_service.EmptyAdmin": {
+            "type": "object"
+        },
+        "user_service.EmptyBranch": {
+            "type": "object"
+        },
+        "user_service.EmptyBranchSetting": {
+            "type": "object"
+        },
+        "user_service.EmptyGuardian": {
+            "type": "object"
+        },
+        "user_service.EmptyIeltsResult": {
+            "type": "object"
+        },
+        "user_service.EmptyLead": {
+            "type": "object"
+        },
+        "user_service.EmptyManager": {
+            "type": "object"
+        },
+        "user_service.EmptySTeacher": {
+            "type": "object"
+        },
+        "user_service.EmptyTeacher": {
+            "type": "object"
+        },
+        "user_service.GetListAdministrationResponse": {
             "type": "object",
             "properties": {
-                "fromDate": {
-                    "type": "string"
-                },
-                "groupId": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
+                "administrations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user_service.Administration"
+                    }
                 },
-                "studentsCount": {
+                "count": {
                     "type": "integer"
+                }
+            }
+        },
+        "user_service.GetListBranchResponse": {
+            "type": "object",
+            "properties": {
+                "branches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user_service.Branch"
+                    }
                 },
-                "toDate": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
                 }
             }
         },
-        "schedule_service.UpdateSchedule": {
+        "user_service.GetListBranchSettingResponse": {
             "type": "object",
             "properties": {
-                "date": {
-                    "type": "string"
+                "branchSettings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user_service.BranchSetting"
+                    }
                 },
-                "endTime": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "user_service.GetListExpiringIeltsResponse": {
+            "type": "object",
+            "properties": {
+                "alerts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user_service.IeltsExpiryAlert"
+                    }
                 },
-                "id": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "user_service.GetListGuardianResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
                 },
-                "journalId": {
-                    "type": "string"
+                "guardians": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user_service.Guardian"
+                    }
+                }
+            }
+        },
+        "user_service.GetListIeltsResultResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
                 },
-                "lesson": {
-                    "type": "string"
+                "ieltsResults": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user_service.IeltsResult"
+                    }
+                }
+            }
+        },
+        "user_service.GetListLeadResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
                 },
-                "startTime": {
-                    "type": "string"
+                "leads": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user_service.Lead"
+                    }
                 }
             }
         },
-        "schedule_service.UpdateStudentPayment": {
+        "user_service.GetListManagerResponse": {
             "type": "object",
             "properties": {
-                "administration_id": {
-                    "type": "string"
-                },
-                "group_id": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "paidsum": {
+                "count": {
                     "type": "integer"
                 },
-                "student_id": {
-                    "type": "string"
+                "managers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user_service.Manager"
+                    }
                 }
             }
         },
-        "schedule_service.UpdateStudentTask": {
+        "user_service.GetListStudentResponse": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
-                },
-                "studentId": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
                 },
-                "taskId": {
-                    "type": "string"
+                "students": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user_service.Student"
+                    }
                 }
             }
         },
-        "schedule_service.UpdateTask": {
+        "user_service.GetListSupportTeacherResponse": {
             "type": "object",
             "properties": {
-                "deadlineDate": {
-                    "type": "string"
-                },
-                "deadlineTime": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "label": {
-                    "type": "string"
-                },
-                "scheduleId": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
                 },
-                "score": {
+                "supportTeachers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user_service.SupportTeacher"
+                    }
+                }
+            }
+        },
+        "user_service.GetListTeacherResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
                     "type": "integer"
+                },
+                "teachers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user_service.Teacher"
+                    }
                 }
             }
         },
-        "user_service.Administration": {
+        "user_service.GetReportAdministrationResponse": {
             "type": "object",
             "properties": {
-                "branchId": {
+                "branch_id": {
                     "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
                 "deleted_at": {
-                    "type": "integer"
+                    "type": "string"
                 },
                 "fullname": {
                     "type": "string"
@@ -5490,7 +17180,7 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "ieltsScore": {
+                "ielts_score": {
                     "type": "number"
                 },
                 "login": {
@@ -5505,85 +17195,48 @@ const docTemplate = `{
                 "salary": {
                     "type": "integer"
                 },
+                "totalsum": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "user_service.Branch": {
+        "user_service.GetReportListTeacherResponse": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "deleted_at": {
+                "Count": {
                     "type": "integer"
                 },
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "phone": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
+                "GetTeacherResponse": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user_service.GetReportTeacherResponse"
+                    }
                 }
             }
         },
-        "user_service.CreateAdministration": {
+        "user_service.GetReportTeacherResponse": {
             "type": "object",
             "properties": {
-                "branchId": {
-                    "type": "string"
-                },
-                "fullname": {
+                "branch_id": {
                     "type": "string"
                 },
-                "ieltsScore": {
-                    "type": "number"
-                },
-                "login": {
+                "created_at": {
                     "type": "string"
                 },
-                "password": {
+                "fullname": {
                     "type": "string"
                 },
-                "phone": {
+                "id": {
                     "type": "string"
                 },
-                "salary": {
+                "ielts_attempt_count": {
                     "type": "integer"
-                }
-            }
-        },
-        "user_service.CreateBranch": {
-            "type": "object",
-            "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
                 },
-                "phone": {
-                    "type": "string"
-                }
-            }
-        },
-        "user_service.CreateManager": {
-            "type": "object",
-            "properties": {
-                "branchId": {
-                    "type": "string"
-                },
-                "fullname": {
-                    "type": "string"
+                "ielts_score": {
+                    "type": "number"
                 },
                 "login": {
                     "type": "string"
@@ -5596,62 +17249,48 @@ const docTemplate = `{
                 },
                 "salary": {
                     "type": "integer"
-                }
-            }
-        },
-        "user_service.CreateStudent": {
-            "type": "object",
-            "properties": {
-                "branchId": {
-                    "type": "string"
-                },
-                "fullname": {
-                    "type": "string"
-                },
-                "groupName": {
-                    "type": "string"
                 },
-                "login": {
+                "support_teacher_id": {
                     "type": "string"
                 },
-                "password": {
+                "totalsum": {
                     "type": "string"
                 },
-                "phone": {
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "user_service.CreateSupportTeacher": {
+        "user_service.Guardian": {
             "type": "object",
             "properties": {
-                "branchId": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/user_service.GuardianChild"
+                    }
+                },
+                "created_at": {
                     "type": "string"
                 },
                 "fullname": {
                     "type": "string"
                 },
-                "ieltsAttemptCount": {
-                    "type": "integer"
-                },
-                "ieltsScore": {
-                    "type": "number"
-                },
-                "login": {
+                "id": {
                     "type": "string"
                 },
-                "password": {
+                "login": {
                     "type": "string"
                 },
                 "phone": {
                     "type": "string"
                 },
-                "salary": {
-                    "type": "integer"
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "user_service.CreateTeacher": {
+        "user_service.GuardianChild": {
             "type": "object",
             "properties": {
                 "branchId": {
@@ -5660,138 +17299,132 @@ const docTemplate = `{
                 "fullname": {
                     "type": "string"
                 },
-                "ieltsAttemptCount": {
-                    "type": "integer"
-                },
-                "ieltsScore": {
-                    "type": "number"
-                },
-                "login": {
+                "groupName": {
                     "type": "string"
                 },
-                "password": {
+                "login": {
                     "type": "string"
                 },
                 "phone": {
                     "type": "string"
                 },
-                "salary": {
-                    "type": "number"
-                },
-                "supportTeacherId": {
-                    "type": "string"
-                }
-            }
-        },
-        "user_service.EmptyAdmin": {
-            "type": "object"
-        },
-        "user_service.EmptyBranch": {
-            "type": "object"
-        },
-        "user_service.EmptyManager": {
-            "type": "object"
-        },
-        "user_service.EmptySTeacher": {
-            "type": "object"
-        },
-        "user_service.EmptyTeacher": {
-            "type": "object"
-        },
-        "user_service.GetListAdministrationResponse": {
-            "type": "object",
-            "properties": {
-                "administrations": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/user_service.Administration"
-                    }
-                },
-                "count": {
-                    "type": "integer"
-                }
-            }
-        },
-        "user_service.GetListBranchResponse": {
-            "type": "object",
-            "properties": {
-                "branches": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/user_service.Branch"
-                    }
+                "relation": {
+                    "type": "string"
                 },
-                "count": {
-                    "type": "integer"
+                "studentId": {
+                    "type": "string"
                 }
             }
         },
-        "user_service.GetListManagerResponse": {
+        "user_service.GuardianStudent": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "guardianId": {
+                    "type": "string"
                 },
-                "managers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/user_service.Manager"
-                    }
-                }
-            }
-        },
-        "user_service.GetListStudentResponse": {
-            "type": "object",
-            "properties": {
-                "count": {
-                    "type": "integer"
+                "relation": {
+                    "type": "string"
                 },
-                "students": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/user_service.Student"
-                    }
+                "studentId": {
+                    "type": "string"
                 }
             }
         },
-        "user_service.GetListSupportTeacherResponse": {
+        "user_service.IeltsExpiryAlert": {
             "type": "object",
             "properties": {
-                "count": {
+                "branchId": {
+                    "type": "string"
+                },
+                "certificateNumber": {
+                    "type": "string"
+                },
+                "daysLeft": {
                     "type": "integer"
                 },
-                "supportTeachers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/user_service.SupportTeacher"
-                    }
+                "expiresAt": {
+                    "type": "string"
+                },
+                "overall": {
+                    "type": "number"
+                },
+                "resultId": {
+                    "type": "string"
+                },
+                "staffId": {
+                    "type": "string"
+                },
+                "staffName": {
+                    "type": "string"
+                },
+                "staffType": {
+                    "type": "string"
                 }
             }
         },
-        "user_service.GetListTeacherResponse": {
+        "user_service.IeltsResult": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "certificateNumber": {
+                    "type": "string"
                 },
-                "teachers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/user_service.Teacher"
-                    }
+                "created_at": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isExpired": {
+                    "type": "boolean"
+                },
+                "listening": {
+                    "type": "number"
+                },
+                "overall": {
+                    "type": "number"
+                },
+                "reading": {
+                    "type": "number"
+                },
+                "speaking": {
+                    "type": "number"
+                },
+                "staffId": {
+                    "type": "string"
+                },
+                "staffName": {
+                    "type": "string"
+                },
+                "staffType": {
+                    "type": "string"
+                },
+                "testDate": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "writing": {
+                    "type": "number"
                 }
             }
         },
-        "user_service.GetReportAdministrationResponse": {
+        "user_service.Lead": {
             "type": "object",
             "properties": {
-                "branch_id": {
+                "branchId": {
+                    "type": "string"
+                },
+                "convertedAt": {
                     "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "deleted_at": {
+                "desiredLevel": {
                     "type": "string"
                 },
                 "fullname": {
@@ -5800,22 +17433,43 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "ielts_score": {
-                    "type": "number"
-                },
-                "login": {
+                "lostReason": {
                     "type": "string"
                 },
-                "password": {
+                "note": {
                     "type": "string"
                 },
                 "phone": {
                     "type": "string"
                 },
-                "salary": {
-                    "type": "integer"
+                "source": {
+                    "type": "string"
                 },
-                "totalsum": {
+                "status": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentLogin": {
+                    "type": "string"
+                },
+                "trialAttendedAt": {
+                    "type": "string"
+                },
+                "trialDate": {
+                    "type": "string"
+                },
+                "trialGroupId": {
+                    "type": "string"
+                },
+                "trialGroupName": {
+                    "type": "string"
+                },
+                "trialScheduleId": {
+                    "type": "string"
+                },
+                "trialStartTime": {
                     "type": "string"
                 },
                 "updated_at": {
@@ -5823,61 +17477,55 @@ const docTemplate = `{
                 }
             }
         },
-        "user_service.GetReportListTeacherResponse": {
+        "user_service.LeadConversionReport": {
             "type": "object",
             "properties": {
-                "Count": {
-                    "type": "integer"
+                "fromDate": {
+                    "type": "string"
                 },
-                "GetTeacherResponse": {
+                "rows": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/user_service.GetReportTeacherResponse"
+                        "$ref": "#/definitions/user_service.LeadConversionRow"
                     }
+                },
+                "toDate": {
+                    "type": "string"
+                },
+                "total": {
+                    "$ref": "#/definitions/user_service.LeadConversionRow"
                 }
             }
         },
-        "user_service.GetReportTeacherResponse": {
+        "user_service.LeadConversionRow": {
             "type": "object",
             "properties": {
-                "branch_id": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "fullname": {
+                "branchId": {
                     "type": "string"
                 },
-                "id": {
+                "branchName": {
                     "type": "string"
                 },
-                "ielts_attempt_count": {
-                    "type": "integer"
-                },
-                "ielts_score": {
+                "conversionRate": {
                     "type": "number"
                 },
-                "login": {
-                    "type": "string"
-                },
-                "password": {
-                    "type": "string"
+                "converted": {
+                    "type": "integer"
                 },
-                "phone": {
-                    "type": "string"
+                "leads": {
+                    "type": "integer"
                 },
-                "salary": {
+                "lost": {
                     "type": "integer"
                 },
-                "support_teacher_id": {
+                "source": {
                     "type": "string"
                 },
-                "totalsum": {
-                    "type": "string"
+                "trialsAttended": {
+                    "type": "integer"
                 },
-                "updated_at": {
-                    "type": "string"
+                "trialsBooked": {
+                    "type": "integer"
                 }
             }
         },
@@ -6107,6 +17755,121 @@ const docTemplate = `{
                 }
             }
         },
+        "user_service.UpdateBranchSetting": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                },
+                "eventWeekdays": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "lessonDurationMinutes": {
+                    "type": "integer"
+                },
+                "paymentDueDay": {
+                    "type": "integer"
+                },
+                "phonePattern": {
+                    "type": "string"
+                },
+                "registrationCutoffHours": {
+                    "type": "integer"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "user_service.UpdateGuardian": {
+            "type": "object",
+            "properties": {
+                "fullname": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                }
+            }
+        },
+        "user_service.UpdateIeltsResult": {
+            "type": "object",
+            "properties": {
+                "certificateNumber": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "listening": {
+                    "type": "number"
+                },
+                "overall": {
+                    "type": "number"
+                },
+                "reading": {
+                    "type": "number"
+                },
+                "speaking": {
+                    "type": "number"
+                },
+                "testDate": {
+                    "type": "string"
+                },
+                "writing": {
+                    "type": "number"
+                }
+            }
+        },
+        "user_service.UpdateLead": {
+            "type": "object",
+            "properties": {
+                "branchId": {
+                    "type": "string"
+                },
+                "desiredLevel": {
+                    "type": "string"
+                },
+                "fullname": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "user_service.UpdateLeadStatus": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "lostReason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
         "user_service.UpdateManager": {
             "type": "object",
             "properties": {
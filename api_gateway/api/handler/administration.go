@@ -14,7 +14,7 @@ import (
 // @Security ApiKeyAuth
 // @Router        /CreateAdministration [post]
 // @Summary       Create administration
-// @Description   API for creating administration
+// @Description   API for creating administration. ieltsScore is derived from the IELTS results and ignored here.
 // @Tags          administration
 // @Accept        json
 // @Produce       json
@@ -45,7 +45,13 @@ func (h *handler) CreateAdministration(c *gin.Context) {
 		return
 	}
 
-	if err := helpers.ValidatePhone(req.Phone); err != nil {
+	setting, err := h.branchSetting(c.Request.Context(), req.BranchId)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting branch settings")
+		return
+	}
+
+	if err := helpers.ValidatePhone(req.Phone, setting.PhonePattern); err != nil {
 		handleGrpcErrWithDescription(c, h.log, err, "error while validating phone number"+req.Phone)
 		return
 	}
@@ -168,7 +174,7 @@ func (h *handler) GetAdministrationByID(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Router          /UpdateAdministration/{id} [PUT]
 // @Summary         Update a administration by ID
-// @Description     API for updating a administration by ID
+// @Description     API for updating a administration by ID. ieltsScore is derived from the IELTS results and ignored here.
 // @Tags            administration
 // @Accept          json
 // @Produce         json
@@ -201,7 +207,13 @@ func (h *handler) UpdateAdministrationr(c *gin.Context) {
 		return
 	}
 
-	if err := helpers.ValidatePhone(req.Phone); err != nil {
+	setting, err := h.branchSetting(c.Request.Context(), req.BranchId)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting branch settings")
+		return
+	}
+
+	if err := helpers.ValidatePhone(req.Phone, setting.PhonePattern); err != nil {
 		handleGrpcErrWithDescription(c, h.log, err, "error while validating phone number"+req.Phone)
 		return
 	}
@@ -261,7 +273,7 @@ func (h *handler) DeleteAdministration(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Router         /AdministrationReportList [get]
 // @Summary        Get List of Administrations
-// @Description    API for getting a list of administrations
+// @Description    API for getting a list of administrations with totalsum, what locked payroll runs paid them
 // @Tags           report
 // @Accept         json
 // @Produce        json
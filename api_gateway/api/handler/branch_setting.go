@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"user_api_gateway/genproto/user_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// branchSetting returns the rules of the branch, or the defaults when the
+// branch has none of its own.
+func (h *handler) branchSetting(ctx context.Context, branchId string) (*user_service.BranchSetting, error) {
+	return h.grpcClient.BranchSettingService().GetByID(ctx, &user_service.BranchSettingPrimaryKey{BranchId: branchId})
+}
+
+// @Security ApiKeyAuth
+// @Router        /CreateBranchSetting [post]
+// @Summary       Create branch settings
+// @Description   API for creating the rules of a branch. Event weekdays are ISO (1 = Monday, 7 = Sunday); fields left empty get the defaults.
+// @Tags          branch_setting
+// @Accept        json
+// @Produce       json
+// @Param         branch_setting body user_service.CreateBranchSetting true "Branch Setting"
+// @Success       200 {object} user_service.BranchSetting
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreateBranchSetting(c *gin.Context) {
+	var (
+		req  user_service.CreateBranchSetting
+		resp *user_service.BranchSetting
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.BranchSettingService().Create(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create branch setting")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListBranchSetting [GET]
+// @Summary        Get list of branch settings
+// @Description    API for getting the branches that have their own settings
+// @Tags           branch_setting
+// @Accept         json
+// @Produce        json
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} user_service.GetListBranchSettingResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListBranchSetting(c *gin.Context) {
+	var (
+		req  user_service.GetListBranchSettingRequest
+		resp *user_service.GetListBranchSettingResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.Page = page
+	req.Limit = limit
+
+	resp, err = h.grpcClient.BranchSettingService().GetList(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetByIdBranchSetting/{id} [GET]
+// @Summary        Get settings of a branch
+// @Description    API for getting the rules of a branch. Branches without settings get the defaults with isDefault set.
+// @Tags           branch_setting
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Branch ID"
+// @Success        200 {object} user_service.BranchSetting
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetBranchSettingByID(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *user_service.BranchSetting
+		err  error
+	)
+
+	if _, err = getAuthInfo(c); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	resp, err = h.branchSetting(c.Request.Context(), id)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /UpdateBranchSetting/{id} [PUT]
+// @Summary       Update settings of a branch
+// @Description   API for replacing the rules of a branch. Fields left empty get the defaults.
+// @Tags          branch_setting
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Branch ID"
+// @Param         branch_setting body user_service.UpdateBranchSetting true "Branch Setting"
+// @Success       200 {object} user_service.BranchSetting
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) UpdateBranchSetting(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		req  user_service.UpdateBranchSetting
+		resp *user_service.BranchSetting
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.BranchId = id
+	resp, err = h.grpcClient.BranchSettingService().Update(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeleteBranchSetting/{id} [DELETE]
+// @Summary       Delete settings of a branch
+// @Description   API for deleting the settings of a branch, which then falls back to the defaults
+// @Tags          branch_setting
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Branch ID"
+// @Success       200 {object} user_service.EmptyBranchSetting
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeleteBranchSetting(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *user_service.EmptyBranchSetting
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.BranchSettingService().Delete(c.Request.Context(), &user_service.BranchSettingPrimaryKey{BranchId: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,297 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user_api_gateway/genproto/schedule_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Security ApiKeyAuth
+// @Router        /OpenCashShift [post]
+// @Summary       Open a cash shift
+// @Description   API for an administrator to open their cash shift with the opening float in the drawer. Payments they record go to this shift until it is closed.
+// @Tags          cash_shift
+// @Accept        json
+// @Produce       json
+// @Param         shift body schedule_service.OpenCashShift true "Cash Shift"
+// @Success       200 {object} schedule_service.CashShift
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) OpenCashShift(c *gin.Context) {
+	var (
+		req  schedule_service.OpenCashShift
+		resp *schedule_service.CashShift
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "Only administrators open cash shifts")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.AdministrationId = data.UserID
+
+	resp, err = h.grpcClient.CashShiftService().Open(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to open cash shift")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /CloseCashShift/{id} [post]
+// @Summary       Close a cash shift
+// @Description   API for closing a cash shift with the amounts counted per payment method (cash, card, transfer). Returns the Z-report: expected against counted per method, with mismatches flagged. Administrators can only close their own shift.
+// @Tags          cash_shift
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Cash Shift ID"
+// @Param         shift body schedule_service.CloseCashShift true "Counted amounts"
+// @Success       200 {object} schedule_service.CashShift
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CloseCashShift(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		req  schedule_service.CloseCashShift
+		resp *schedule_service.CashShift
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.Id = id
+	req.AdministrationId = ""
+	if data.UserRole == "Administration" {
+		req.AdministrationId = data.UserID
+	}
+
+	resp, err = h.grpcClient.CashShiftService().Close(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to close cash shift")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /ApproveCashShift/{id} [post]
+// @Summary       Approve a cash shift
+// @Description   API for a manager to approve the Z-report of a closed shift. Shifts with a mismatch need a note.
+// @Tags          cash_shift
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Cash Shift ID"
+// @Param         shift body schedule_service.ApproveCashShift true "Approval"
+// @Success       200 {object} schedule_service.CashShift
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) ApproveCashShift(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		req  schedule_service.ApproveCashShift
+		resp *schedule_service.CashShift
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.Id = id
+	req.ApprovedBy = data.UserID
+
+	resp, err = h.grpcClient.CashShiftService().Approve(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to approve cash shift")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetByIdCashShift/{id} [GET]
+// @Summary        Get a cash shift
+// @Description    API for getting a cash shift with its totals per payment method. Open shifts show what they are expected to hold so far. Administrators can only get their own shifts.
+// @Tags           cash_shift
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Cash Shift ID"
+// @Success        200 {object} schedule_service.CashShift
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetCashShiftByID(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.CashShift
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	resp, err = h.grpcClient.CashShiftService().GetByID(c.Request.Context(), &schedule_service.CashShiftPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	if data.UserRole == "Administration" && resp.AdministrationId != data.UserID {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "This cash shift belongs to another administrator")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetCurrentCashShift [GET]
+// @Summary        Get the open cash shift
+// @Description    API for an administrator to get their open cash shift
+// @Tags           cash_shift
+// @Accept         json
+// @Produce        json
+// @Success        200 {object} schedule_service.CashShift
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetCurrentCashShift(c *gin.Context) {
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "Only administrators have cash shifts")
+		return
+	}
+
+	resp, err := h.grpcClient.CashShiftService().GetCurrent(c.Request.Context(), &schedule_service.CurrentCashShiftRequest{AdministrationId: data.UserID})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListCashShift [GET]
+// @Summary        Get list of cash shifts
+// @Description    API for getting cash shifts, newest first. Administrators only see their own.
+// @Tags           cash_shift
+// @Accept         json
+// @Produce        json
+// @Param          administrationId query string false "Administration ID"
+// @Param          branchId query string false "Branch ID"
+// @Param          status query string false "open, closed or approved"
+// @Param          mismatchOnly query bool false "Only shifts with a mismatch"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetListCashShiftResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListCashShift(c *gin.Context) {
+	var (
+		req  schedule_service.GetListCashShiftRequest
+		resp *schedule_service.GetListCashShiftResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	mismatchOnly, err := strconv.ParseBool(c.DefaultQuery("mismatchOnly", "false"))
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing mismatchOnly")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.AdministrationId = c.Query("administrationId")
+	req.BranchId = c.Query("branchId")
+	req.Status = c.Query("status")
+	req.MismatchOnly = mismatchOnly
+	req.Page = page
+	req.Limit = limit
+
+	if data.UserRole == "Administration" {
+		req.AdministrationId = data.UserID
+	}
+
+	resp, err = h.grpcClient.CashShiftService().GetList(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
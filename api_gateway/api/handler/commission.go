@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"user_api_gateway/genproto/schedule_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Security ApiKeyAuth
+// @Router        /CreateCommissionRule [post]
+// @Summary       Create commission rule
+// @Description   API for setting the percentage (rate, such as "5.00") of collected tuition a teacher or support_teacher earns from the month of validFrom on. A rule is for one staff member, one group type (beginner, elementary, intermediate, ielts) or both; the most specific rule wins. Refunds and reversals claw the commission back.
+// @Tags          commission
+// @Accept        json
+// @Produce       json
+// @Param         rule body schedule_service.CreateCommissionRule true "Commission Rule"
+// @Success       200 {object} schedule_service.CommissionRule
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreateCommissionRule(c *gin.Context) {
+	var (
+		req  schedule_service.CreateCommissionRule
+		resp *schedule_service.CommissionRule
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.CommissionService().CreateRule(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create commission rule")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListCommissionRule [GET]
+// @Summary        Get list of commission rules
+// @Description    API for getting commission rules by staff type, staff member and group type
+// @Tags           commission
+// @Accept         json
+// @Produce        json
+// @Param          staffType query string false "Staff type"
+// @Param          staffId query string false "Staff ID"
+// @Param          groupType query string false "Group type"
+// @Success        200 {object} schedule_service.GetListCommissionRuleResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListCommissionRule(c *gin.Context) {
+	var (
+		resp *schedule_service.GetListCommissionRuleResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	req := &schedule_service.GetListCommissionRuleRequest{
+		StaffType: c.Query("staffType"),
+		StaffId:   c.Query("staffId"),
+		GroupType: c.Query("groupType"),
+	}
+
+	resp, err = h.grpcClient.CommissionService().GetListRule(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeleteCommissionRule/{id} [DELETE]
+// @Summary       Delete a commission rule
+// @Description   API for deleting a commission rule. Locked payroll runs keep the commissions they paid.
+// @Tags          commission
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Commission Rule ID"
+// @Success       200 {object} schedule_service.EmptyCommission
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeleteCommissionRule(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.EmptyCommission
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.CommissionService().DeleteRule(c.Request.Context(), &schedule_service.CommissionRulePrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetCommissionReport [GET]
+// @Summary        Get commission report
+// @Description    API for getting the commissions earned on the tuition collected in a month (YYYY-MM, current month when empty), a row per staff member and group, with the amounts clawed back by refunds and reversals
+// @Tags           commission
+// @Accept         json
+// @Produce        json
+// @Param          period query string false "Month (YYYY-MM)"
+// @Param          staffType query string false "Staff type"
+// @Param          staffId query string false "Staff ID"
+// @Success        200 {object} schedule_service.CommissionReport
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetCommissionReport(c *gin.Context) {
+	var (
+		resp *schedule_service.CommissionReport
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	req := &schedule_service.CommissionReportRequest{
+		Period:    c.Query("period"),
+		StaffType: c.Query("staffType"),
+		StaffId:   c.Query("staffId"),
+	}
+
+	resp, err = h.grpcClient.CommissionService().GetReport(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,388 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user_api_gateway/genproto/schedule_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Security ApiKeyAuth
+// @Router        /CreateDiscount [post]
+// @Summary       Create discount
+// @Description   API for giving a student a sibling, scholarship or other discount, optionally for one group only and between validFrom and validTo (YYYY-MM-DD). kind is percent or fixed and value a decimal string. Discounts that are not stackable are never combined with others.
+// @Tags          discount
+// @Accept        json
+// @Produce       json
+// @Param         discount body schedule_service.CreateDiscount true "Discount"
+// @Success       200 {object} schedule_service.Discount
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreateDiscount(c *gin.Context) {
+	var (
+		req  schedule_service.CreateDiscount
+		resp *schedule_service.Discount
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.DiscountService().Create(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create discount")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListDiscount [GET]
+// @Summary        Get list of discounts
+// @Description    API for getting discounts, optionally only those in force on activeOn (YYYY-MM-DD). Students only see their own.
+// @Tags           discount
+// @Accept         json
+// @Produce        json
+// @Param          studentId query string false "Student ID"
+// @Param          groupId query string false "Group ID"
+// @Param          category query string false "sibling, scholarship, promo or other"
+// @Param          activeOn query string false "Date"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetListDiscountResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListDiscount(c *gin.Context) {
+	var (
+		req  schedule_service.GetListDiscountRequest
+		resp *schedule_service.GetListDiscountResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	req.StudentId = c.Query("studentId")
+
+	switch data.UserRole {
+	case "SuperAdmin", "Manager", "Administration":
+	case "Student":
+		req.StudentId = data.UserID
+	default:
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to see discounts")
+		return
+	}
+
+	req.GroupId = c.Query("groupId")
+	req.Category = c.Query("category")
+	req.ActiveOn = c.Query("activeOn")
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.Page = page
+	req.Limit = limit
+
+	resp, err = h.grpcClient.DiscountService().GetList(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeleteDiscount/{id} [DELETE]
+// @Summary       Delete a discount
+// @Description   API for ending a discount. Invoices already issued keep it.
+// @Tags          discount
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Discount ID"
+// @Success       200 {object} schedule_service.EmptyDiscount
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeleteDiscount(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.EmptyDiscount
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.DiscountService().Delete(c.Request.Context(), &schedule_service.DiscountPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /CreatePromoCode [post]
+// @Summary       Create promo code
+// @Description   API for creating a promo code, optionally for one branch. It can be redeemed between validFrom and validTo, at most maxUses times (0 is unlimited), and the discount lasts for months billing months.
+// @Tags          discount
+// @Accept        json
+// @Produce       json
+// @Param         promo_code body schedule_service.CreatePromoCode true "Promo Code"
+// @Success       200 {object} schedule_service.PromoCode
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreatePromoCode(c *gin.Context) {
+	var (
+		req  schedule_service.CreatePromoCode
+		resp *schedule_service.PromoCode
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.DiscountService().CreatePromo(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create promo code")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListPromoCode [GET]
+// @Summary        Get list of promo codes
+// @Description    API for getting promo codes with how many times they were used
+// @Tags           discount
+// @Accept         json
+// @Produce        json
+// @Param          branchId query string false "Branch ID"
+// @Param          search query string false "Code search"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetListPromoCodeResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListPromoCode(c *gin.Context) {
+	var (
+		req  schedule_service.GetListPromoCodeRequest
+		resp *schedule_service.GetListPromoCodeResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req.BranchId = c.Query("branchId")
+	req.Search = c.Query("search")
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.Page = page
+	req.Limit = limit
+
+	resp, err = h.grpcClient.DiscountService().GetListPromo(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeletePromoCode/{id} [DELETE]
+// @Summary       Delete a promo code
+// @Description   API for withdrawing a promo code. Discounts already redeemed with it stay in force.
+// @Tags          discount
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Promo Code ID"
+// @Success       200 {object} schedule_service.EmptyDiscount
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeletePromoCode(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.EmptyDiscount
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.DiscountService().DeletePromo(c.Request.Context(), &schedule_service.PromoCodePrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /RedeemPromoCode [post]
+// @Summary       Redeem a promo code
+// @Description   API for turning a promo code into a discount for a student. Students redeem codes for themselves; staff can redeem them for any student.
+// @Tags          discount
+// @Accept        json
+// @Produce       json
+// @Param         request body schedule_service.RedeemPromoCodeRequest true "Promo Code"
+// @Success       200 {object} schedule_service.Discount
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) RedeemPromoCode(c *gin.Context) {
+	var (
+		req  schedule_service.RedeemPromoCodeRequest
+		resp *schedule_service.Discount
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	switch data.UserRole {
+	case "SuperAdmin", "Manager", "Administration":
+	case "Student":
+		req.StudentId = data.UserID
+	default:
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to redeem promo codes")
+		return
+	}
+
+	resp, err = h.grpcClient.DiscountService().RedeemPromo(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to redeem promo code")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetDiscountReport [GET]
+// @Summary        Get discount report
+// @Description    API for getting discount totals per branch, month and category between fromPeriod and toPeriod (YYYY-MM)
+// @Tags           discount
+// @Accept         json
+// @Produce        json
+// @Param          branchId query string false "Branch ID"
+// @Param          fromPeriod query string false "From month"
+// @Param          toPeriod query string false "To month"
+// @Success        200 {object} schedule_service.DiscountReport
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetDiscountReport(c *gin.Context) {
+	var (
+		resp *schedule_service.DiscountReport
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	req := &schedule_service.DiscountReportRequest{
+		BranchId:   c.Query("branchId"),
+		FromPeriod: c.Query("fromPeriod"),
+		ToPeriod:   c.Query("toPeriod"),
+	}
+
+	resp, err = h.grpcClient.DiscountService().GetReport(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,343 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"user_api_gateway/genproto/schedule_service"
+	"user_api_gateway/pkg/pdf"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Security ApiKeyAuth
+// @Router          /StudentPayment/{id}/receipt.pdf [GET]
+// @Summary         Download a payment receipt
+// @Description     API for getting the receipt of a student payment as a PDF, numbered per branch. Students can only get receipts of their own payments.
+// @Tags            document
+// @Produce         application/pdf
+// @Param           id path string true "Student Payment ID"
+// @Success         200 {file} file
+// @Failure         404 {object} models.ResponseError
+// @Failure         500 {object} models.ResponseError
+func (h *handler) GetPaymentReceiptPDF(c *gin.Context) {
+	id := c.Param("id")
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "Student" && data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to get receipts")
+		return
+	}
+
+	receipt, err := h.grpcClient.DocumentService().GetReceipt(c.Request.Context(), &schedule_service.PaymentReceiptRequest{PaymentId: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to get payment receipt")
+		return
+	}
+
+	if data.UserRole == "Student" && receipt.StudentId != data.UserID {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "This payment belongs to another student")
+		return
+	}
+
+	doc := pdf.New()
+	doc.Heading(fmt.Sprintf("Payment receipt No. %d", receipt.Number))
+	doc.Text(receipt.BranchName)
+	if receipt.BranchAddress != "" {
+		doc.Text(receipt.BranchAddress)
+	}
+	doc.Text("Phone: " + receipt.BranchPhone)
+	doc.Space(1)
+	doc.Text("Date: " + shortTimestamp(receipt.CreatedAt))
+	doc.Text("Student: " + strings.TrimSpace(receipt.StudentName+", "+receipt.StudentPhone))
+	if receipt.GroupName != "" {
+		doc.Text("Group: " + receipt.GroupName)
+	}
+	doc.Text("Amount paid: " + receipt.PaidSum)
+	doc.Text("Received by: " + receipt.AdministrationName)
+	doc.Space(2)
+	doc.Text("Signature: ______________________")
+
+	sendPDF(c, fmt.Sprintf("receipt-%d.pdf", receipt.Number), doc)
+}
+
+// @Security ApiKeyAuth
+// @Router          /Student/{id}/contract.pdf [GET]
+// @Summary         Download an enrollment contract
+// @Description     API for rendering an enrollment contract of a student as a PDF. Without a template the newest one of the student's branch is used, then the newest shared one. Fields without a value are left blank.
+// @Tags            document
+// @Produce         application/pdf
+// @Param           id path string true "Student ID"
+// @Param           templateId query string false "Contract Template ID"
+// @Param           groupId query string false "Group ID"
+// @Success         200 {file} file
+// @Failure         404 {object} models.ResponseError
+// @Failure         500 {object} models.ResponseError
+func (h *handler) GetStudentContractPDF(c *gin.Context) {
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	contract, err := h.grpcClient.DocumentService().RenderContract(c.Request.Context(), &schedule_service.RenderContractRequest{
+		TemplateId: c.Query("templateId"),
+		StudentId:  c.Param("id"),
+		GroupId:    c.Query("groupId"),
+	})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to render contract")
+		return
+	}
+
+	doc := pdf.New()
+	doc.Heading(contract.Title)
+	doc.Text(contract.Body)
+
+	sendPDF(c, "contract-"+contract.StudentId+".pdf", doc)
+}
+
+// @Security ApiKeyAuth
+// @Router        /CreateContractTemplate [post]
+// @Summary       Create contract template
+// @Description   API for creating an enrollment contract template. The body may use placeholders such as {{studentName}}; GetListContractTemplate lists them. Templates without a branch are shared.
+// @Tags          document
+// @Accept        json
+// @Produce       json
+// @Param         template body schedule_service.CreateContractTemplate true "Contract Template"
+// @Success       200 {object} schedule_service.ContractTemplate
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreateContractTemplate(c *gin.Context) {
+	var (
+		req  schedule_service.CreateContractTemplate
+		resp *schedule_service.ContractTemplate
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.DocumentService().CreateTemplate(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create contract template")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetByIdContractTemplate/{id} [GET]
+// @Summary        Get a contract template
+// @Description    API for getting a contract template by ID
+// @Tags           document
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Contract Template ID"
+// @Success        200 {object} schedule_service.ContractTemplate
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetContractTemplateByID(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.ContractTemplate
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	resp, err = h.grpcClient.DocumentService().GetTemplate(c.Request.Context(), &schedule_service.ContractTemplatePrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListContractTemplate [GET]
+// @Summary        Get list of contract templates
+// @Description    API for getting contract templates of a branch, including the shared ones, and the placeholders templates can use
+// @Tags           document
+// @Accept         json
+// @Produce        json
+// @Param          branchId query string false "Branch ID"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetListContractTemplateResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListContractTemplate(c *gin.Context) {
+	var (
+		req  schedule_service.GetListContractTemplateRequest
+		resp *schedule_service.GetListContractTemplateResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.BranchId = c.Query("branchId")
+	req.Page = page
+	req.Limit = limit
+
+	resp, err = h.grpcClient.DocumentService().GetListTemplate(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /UpdateContractTemplate/{id} [PUT]
+// @Summary       Update a contract template
+// @Description   API for changing the name and body of a contract template
+// @Tags          document
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Contract Template ID"
+// @Param         template body schedule_service.UpdateContractTemplate true "Contract Template"
+// @Success       200 {object} schedule_service.ContractTemplate
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) UpdateContractTemplate(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		req  schedule_service.UpdateContractTemplate
+		resp *schedule_service.ContractTemplate
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.Id = id
+	resp, err = h.grpcClient.DocumentService().UpdateTemplate(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeleteContractTemplate/{id} [DELETE]
+// @Summary       Delete a contract template
+// @Description   API for deleting a contract template
+// @Tags          document
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Contract Template ID"
+// @Success       200 {object} schedule_service.EmptyDocument
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeleteContractTemplate(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.EmptyDocument
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.DocumentService().DeleteTemplate(c.Request.Context(), &schedule_service.ContractTemplatePrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func sendPDF(c *gin.Context, filename string, doc *pdf.Document) {
+	c.Header("Content-Disposition", fmt.Sprintf("inline; filename=%q", filename))
+	c.Data(http.StatusOK, "application/pdf", doc.Bytes())
+}
+
+// shortTimestamp drops the fractional seconds of a database timestamp.
+func shortTimestamp(value string) string {
+	if len(value) > 19 {
+		return value[:19]
+	}
+	return value
+}
@@ -4,22 +4,21 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
-	"time"
-	"user_api_gateway/api/helpers"
 	"user_api_gateway/genproto/schedule_service"
 
 	"github.com/gin-gonic/gin"
+	"github.com/skip2/go-qrcode"
 )
 
 // @Security ApiKeyAuth
 // @Router         /CreateEventStudent [post]
 // @Summary        Create event student
-// @Description    API for creating event student
+// @Description    API for registering the current student for an event. Registration closes cutoffHours before the event starts; when the event is full the student is waitlisted.
 // @Tags           event_student
 // @Accept         json
 // @Produce        json
 // @Param          event_student body schedule_service.CreateEventStudent true "Event Student"
-// @Success        200 {object} schedule_service.CreateEventStudent
+// @Success        200 {object} schedule_service.GetEventStudent
 // @Failure        404 {object} models.ResponseError
 // @Failure        500 {object} models.ResponseError
 func (h *handler) CreateEventStudent(c *gin.Context) {
@@ -45,11 +44,7 @@ func (h *handler) CreateEventStudent(c *gin.Context) {
 		return
 	}
 
-	currentTime := time.Now()
-
-	if err := helpers.CheckEventRegistration(currentTime); err != nil {
-		handleGrpcErrWithDescription(c, h.log, err, "you are late to register")
-	}
+	req.StudentId = data.UserID
 
 	resp, err = h.grpcClient.EventStudentService().Create(c.Request.Context(), &req)
 	if err != nil {
@@ -158,52 +153,6 @@ func (h *handler) GetEventStudentByID(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
-// @Security ApiKeyAuth
-// @Router          /UpdateEventStudent/{id} [PUT]
-// @Summary         Update an event student by ID
-// @Description     API for updating an event student by ID
-// @Tags            event_student
-// @Accept          json
-// @Produce         json
-// @Param           id path string true "Event Student ID"
-// @Param           event_student body schedule_service.UpdateEventStudent true "Event Student"
-// @Success         200 {object} schedule_service.UpdateEventStudent
-// @Failure         404 {object} models.ResponseError
-// @Failure         500 {object} models.ResponseError
-func (h *handler) UpdateEventStudent(c *gin.Context) {
-	var (
-		id   = c.Param("id")
-		req  schedule_service.UpdateEventStudent
-		resp *schedule_service.GetEventStudent
-		err  error
-	)
-
-	data, err := getAuthInfo(c)
-	if err != nil {
-		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
-		return
-	}
-
-	if data.UserRole != "Student" {
-		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a Student")
-		return
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
-		return
-	}
-
-	req.Id = id
-	resp, err = h.grpcClient.EventStudentService().Update(c.Request.Context(), &req)
-	if err != nil {
-		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
-		return
-	}
-
-	c.JSON(http.StatusOK, resp)
-}
-
 // @Security ApiKeyAuth
 // @Router         /DeleteEventStudent/{id} [DELETE]
 // @Summary        Delete an event student by ID
@@ -270,3 +219,179 @@ func (h *handler) GetStudentWithEventsByID(c *gin.Context) {
 
 	c.JSON(http.StatusOK, resp)
 }
+
+// @Security ApiKeyAuth
+// @Router          /CancelEventStudent/{id} [post]
+// @Summary         Cancel an event registration
+// @Description     API for cancelling an event registration before the event's cutoff. Students can only cancel their own registrations; the freed seat goes to the first waitlisted student.
+// @Tags            event_student
+// @Accept          json
+// @Produce         json
+// @Param           id path string true "Event Student ID"
+// @Success         200 {object} schedule_service.GetEventStudent
+// @Failure         404 {object} models.ResponseError
+// @Failure         500 {object} models.ResponseError
+func (h *handler) CancelEventStudent(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.GetEventStudent
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "Student" && data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to cancel event registrations")
+		return
+	}
+
+	req := &schedule_service.CancelEventStudent{
+		Id: id,
+	}
+	if data.UserRole == "Student" {
+		req.StudentId = data.UserID
+	}
+
+	resp, err = h.grpcClient.EventStudentService().Cancel(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to cancel event registration")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router          /GetEventCheckInQR/{id} [GET]
+// @Summary         Get the check-in QR code of an event registration
+// @Description     API for getting the signed check-in code of a registration as a PNG image. Students can only get codes of their own registrations.
+// @Tags            event_student
+// @Produce         png
+// @Param           id path string true "Event Student ID"
+// @Success         200 {file} file
+// @Failure         404 {object} models.ResponseError
+// @Failure         500 {object} models.ResponseError
+func (h *handler) GetEventCheckInQR(c *gin.Context) {
+	id := c.Param("id")
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "Student" && data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to get check-in codes")
+		return
+	}
+
+	code, err := h.grpcClient.EventStudentService().GetCheckInCode(c.Request.Context(), &schedule_service.EventStudentPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to get check-in code")
+		return
+	}
+
+	if data.UserRole == "Student" && code.StudentId != data.UserID {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "This registration belongs to another student")
+		return
+	}
+
+	png, err := qrcode.Encode(code.Payload, qrcode.Medium, 256)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to render check-in code")
+		return
+	}
+
+	c.Data(http.StatusOK, "image/png", png)
+}
+
+// @Security ApiKeyAuth
+// @Router          /CheckInEventStudent [post]
+// @Summary         Check a student in to an event
+// @Description     API for marking a registered student as attended, either by the scanned QR payload or by event and student ID
+// @Tags            event_student
+// @Accept          json
+// @Produce         json
+// @Param           check_in body schedule_service.CheckInEventStudent true "Check In"
+// @Success         200 {object} schedule_service.GetEventStudent
+// @Failure         404 {object} models.ResponseError
+// @Failure         500 {object} models.ResponseError
+func (h *handler) CheckInEventStudent(c *gin.Context) {
+	var (
+		req  schedule_service.CheckInEventStudent
+		resp *schedule_service.GetEventStudent
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" && data.UserRole != "SupportTeacher" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to check students in")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.CheckedInBy = data.UserID
+
+	resp, err = h.grpcClient.EventStudentService().CheckIn(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to check in")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router          /GetEventAttendance/{id} [GET]
+// @Summary         Get attendance summary of an event
+// @Description     API for getting registered, waitlisted, cancelled, attended and no-show counts of an event together with its registered students
+// @Tags            event_student
+// @Accept          json
+// @Produce         json
+// @Param           id path string true "Event ID"
+// @Success         200 {object} schedule_service.EventAttendance
+// @Failure         404 {object} models.ResponseError
+// @Failure         500 {object} models.ResponseError
+func (h *handler) GetEventAttendance(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.EventAttendance
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" && data.UserRole != "SupportTeacher" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to view event attendance")
+		return
+	}
+
+	req := &schedule_service.EventAttendanceRequest{
+		EventId: id,
+	}
+
+	resp, err = h.grpcClient.EventStudentService().GetAttendance(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -13,7 +13,7 @@ import (
 // @Security ApiKeyAuth
 // @Router         /CreateEvent [post]
 // @Summary        Create event
-// @Description    API for creating event
+// @Description    API for creating event. An event given a roomId needs an endTime, must fit in the room and cannot overlap its other bookings; without a capacity it takes the room's.
 // @Tags           event
 // @Accept         json
 // @Produce        json
@@ -44,8 +44,14 @@ func (h *handler) CreateEvent(c *gin.Context) {
 		return
 	}
 
-	if err := helpers.IsSunday(req.Date); err != nil {
-		handleGrpcErrWithDescription(c, h.log, err, "this date is not sunday!")
+	setting, err := h.branchSetting(c.Request.Context(), req.BranchId)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting branch settings")
+		return
+	}
+
+	if err := helpers.IsAllowedWeekday(req.Date, setting.EventWeekdays); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "events are not held on this day")
 		return
 	}
 
@@ -159,7 +165,7 @@ func (h *handler) GetEventByID(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Router         /UpdateEvent/{id} [PUT]
 // @Summary        Update an event by ID
-// @Description    API for updating an event by ID
+// @Description    API for updating an event by ID. An event given a roomId needs an endTime, must fit in the room and cannot overlap its other bookings.
 // @Tags           event
 // @Accept         json
 // @Produce        json
@@ -192,6 +198,17 @@ func (h *handler) UpdateEvent(c *gin.Context) {
 		return
 	}
 
+	setting, err := h.branchSetting(c.Request.Context(), req.BranchId)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting branch settings")
+		return
+	}
+
+	if err := helpers.IsAllowedWeekday(req.Date, setting.EventWeekdays); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "events are not held on this day")
+		return
+	}
+
 	req.Id = id
 	resp, err = h.grpcClient.EventService().Update(c.Request.Context(), &req)
 	if err != nil {
@@ -0,0 +1,265 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user_api_gateway/genproto/schedule_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Security ApiKeyAuth
+// @Router         /CreateEventFeedback [post]
+// @Summary        Leave feedback on an event
+// @Description    API for rating an attended event from 1 to 5 with an optional comment. A registration can be rated once, after the event starts.
+// @Tags           event_feedback
+// @Accept         json
+// @Produce        json
+// @Param          feedback body schedule_service.CreateEventFeedback true "Feedback"
+// @Success        200 {object} schedule_service.EventFeedback
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) CreateEventFeedback(c *gin.Context) {
+	var (
+		req  schedule_service.CreateEventFeedback
+		resp *schedule_service.EventFeedback
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "Student" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a Student")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.StudentId = data.UserID
+
+	resp, err = h.grpcClient.EventFeedbackService().Create(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create event feedback")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListEventFeedback [GET]
+// @Summary        Get feedback of an event
+// @Description    API for getting the feedback left on an event, newest first
+// @Tags           event_feedback
+// @Accept         json
+// @Produce        json
+// @Param          eventId query string true "Event ID"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetListEventFeedbackResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListEventFeedback(c *gin.Context) {
+	var (
+		req  schedule_service.GetListEventFeedbackRequest
+		resp *schedule_service.GetListEventFeedbackResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req.EventId = c.Query("eventId")
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.Page = page
+	req.Limit = limit
+
+	resp, err = h.grpcClient.EventFeedbackService().GetList(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetEventRating/{id} [GET]
+// @Summary        Get rating of an event
+// @Description    API for getting the average rating, number of responses and the 1 to 5 distribution of an event
+// @Tags           event_feedback
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Event ID"
+// @Success        200 {object} schedule_service.EventRating
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetEventRating(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.EventRating
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	resp, err = h.grpcClient.EventFeedbackService().GetEventRating(c.Request.Context(), &schedule_service.EventRatingRequest{EventId: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetTopicRatings [GET]
+// @Summary        Get ratings per topic
+// @Description    API for getting average ratings of event topics, optionally within a branch
+// @Tags           event_feedback
+// @Accept         json
+// @Produce        json
+// @Param          branchId query string false "Branch ID"
+// @Param          search query string false "Topic search"
+// @Success        200 {object} schedule_service.TopicRatingResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetTopicRatings(c *gin.Context) {
+	var (
+		resp *schedule_service.TopicRatingResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req := &schedule_service.TopicRatingRequest{
+		BranchId: c.Query("branchId"),
+		Search:   c.Query("search"),
+	}
+
+	resp, err = h.grpcClient.EventFeedbackService().GetTopicRatings(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetLowRatedEvents [GET]
+// @Summary        Get low rated events
+// @Description    API for listing events of a branch whose average rating is at most maxRating (3 by default), lowest first
+// @Tags           event_feedback
+// @Accept         json
+// @Produce        json
+// @Param          branchId query string false "Branch ID"
+// @Param          maxRating query number false "Max average rating"
+// @Param          minResponses query int false "Min number of responses"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.LowRatedEventsResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetLowRatedEvents(c *gin.Context) {
+	var (
+		req  schedule_service.LowRatedEventsRequest
+		resp *schedule_service.LowRatedEventsResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req.BranchId = c.Query("branchId")
+
+	maxRating, err := strconv.ParseFloat(c.DefaultQuery("maxRating", "0"), 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing maxRating")
+		return
+	}
+
+	minResponses, err := strconv.ParseInt(c.DefaultQuery("minResponses", "0"), 10, 32)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing minResponses")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.MaxRating = maxRating
+	req.MinResponses = int32(minResponses)
+	req.Page = page
+	req.Limit = limit
+
+	resp, err = h.grpcClient.EventFeedbackService().GetLowRatedEvents(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
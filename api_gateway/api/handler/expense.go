@@ -0,0 +1,611 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"user_api_gateway/genproto/schedule_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxAttachmentSize matches the limit of the schedule service.
+const maxAttachmentSize = 2 << 20
+
+// @Security ApiKeyAuth
+// @Router        /CreateExpenseCategory [post]
+// @Summary       Create expense category
+// @Description   API for adding an expense category such as rent or utilities
+// @Tags          expense
+// @Accept        json
+// @Produce       json
+// @Param         category body schedule_service.CreateExpenseCategory true "Expense Category"
+// @Success       200 {object} schedule_service.ExpenseCategory
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreateExpenseCategory(c *gin.Context) {
+	var (
+		req  schedule_service.CreateExpenseCategory
+		resp *schedule_service.ExpenseCategory
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.ExpenseService().CreateCategory(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create expense category")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListExpenseCategory [GET]
+// @Summary        Get list of expense categories
+// @Description    API for getting the expense categories
+// @Tags           expense
+// @Accept         json
+// @Produce        json
+// @Success        200 {object} schedule_service.GetListExpenseCategoryResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListExpenseCategory(c *gin.Context) {
+	var (
+		resp *schedule_service.GetListExpenseCategoryResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	resp, err = h.grpcClient.ExpenseService().GetListCategory(c.Request.Context(), &schedule_service.GetListExpenseCategoryRequest{})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeleteExpenseCategory/{id} [DELETE]
+// @Summary       Delete an expense category
+// @Description   API for deleting an expense category. Expenses already recorded keep it.
+// @Tags          expense
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Expense Category ID"
+// @Success       200 {object} schedule_service.EmptyExpense
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeleteExpenseCategory(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.EmptyExpense
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.ExpenseService().DeleteCategory(c.Request.Context(), &schedule_service.ExpenseCategoryPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /CreateExpense [post]
+// @Summary       Create expense
+// @Description   API for recording a branch expense. It stays pending until a manager approves it. Administrators record expenses of their own branch; others must give branchId. The amount is a decimal string such as "1500000.00" and spentOn (YYYY-MM-DD) defaults to today.
+// @Tags          expense
+// @Accept        json
+// @Produce       json
+// @Param         expense body schedule_service.CreateExpense true "Expense"
+// @Success       200 {object} schedule_service.Expense
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreateExpense(c *gin.Context) {
+	var (
+		req  schedule_service.CreateExpense
+		resp *schedule_service.Expense
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	if data.UserRole != "Administration" && req.BranchId == "" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("branchId is required"), "branchId is required")
+		return
+	}
+
+	req.CreatedBy = data.UserID
+
+	resp, err = h.grpcClient.ExpenseService().Create(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create expense")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetByIdExpense/{id} [GET]
+// @Summary        Get an expense
+// @Description    API for getting an expense with its attachments. Administrators can only get expenses they recorded.
+// @Tags           expense
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Expense ID"
+// @Success        200 {object} schedule_service.Expense
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetExpenseByID(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.Expense
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	resp, err = h.expenseOf(c.Request.Context(), data, id)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListExpense [GET]
+// @Summary        Get list of expenses
+// @Description    API for getting expenses by branch, category, status (pending, approved, rejected) and spending dates (YYYY-MM-DD), newest first. Administrators only get expenses they recorded.
+// @Tags           expense
+// @Accept         json
+// @Produce        json
+// @Param          branchId query string false "Branch ID"
+// @Param          categoryId query string false "Expense Category ID"
+// @Param          status query string false "Status"
+// @Param          fromDate query string false "From date"
+// @Param          toDate query string false "To date"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetListExpenseResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListExpense(c *gin.Context) {
+	var (
+		req  schedule_service.GetListExpenseRequest
+		resp *schedule_service.GetListExpenseResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req.BranchId = c.Query("branchId")
+	req.CategoryId = c.Query("categoryId")
+	req.Status = c.Query("status")
+	req.FromDate = c.Query("fromDate")
+	req.ToDate = c.Query("toDate")
+
+	if data.UserRole == "Administration" {
+		req.CreatedBy = data.UserID
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.Page = page
+	req.Limit = limit
+
+	resp, err = h.grpcClient.ExpenseService().GetList(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /UpdateExpense/{id} [PUT]
+// @Summary       Update an expense
+// @Description   API for correcting a pending expense. Administrators can only change expenses they recorded.
+// @Tags          expense
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Expense ID"
+// @Param         expense body schedule_service.UpdateExpense true "Expense"
+// @Success       200 {object} schedule_service.Expense
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) UpdateExpense(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		req  schedule_service.UpdateExpense
+		resp *schedule_service.Expense
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	if _, err = h.expenseOf(c.Request.Context(), data, id); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	req.Id = id
+	resp, err = h.grpcClient.ExpenseService().Update(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeleteExpense/{id} [DELETE]
+// @Summary       Delete an expense
+// @Description   API for deleting a pending expense. Administrators can only delete expenses they recorded.
+// @Tags          expense
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Expense ID"
+// @Success       200 {object} schedule_service.EmptyExpense
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeleteExpense(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.EmptyExpense
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	if _, err = h.expenseOf(c.Request.Context(), data, id); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	resp, err = h.grpcClient.ExpenseService().Delete(c.Request.Context(), &schedule_service.ExpensePrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /ReviewExpense/{id} [post]
+// @Summary       Approve or reject an expense
+// @Description   API for a manager to approve or reject a pending expense (status approved or rejected). Rejections need a note. Only approved expenses count as costs.
+// @Tags          expense
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Expense ID"
+// @Param         review body schedule_service.ReviewExpense true "Review"
+// @Success       200 {object} schedule_service.Expense
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) ReviewExpense(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		req  schedule_service.ReviewExpense
+		resp *schedule_service.Expense
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.Id = id
+	req.ReviewedBy = data.UserID
+
+	resp, err = h.grpcClient.ExpenseService().Review(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to review expense")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /Expense/{id}/attachment [post]
+// @Summary       Attach a file to an expense
+// @Description   API for attaching a receipt or an invoice, up to 2 MB, to a pending expense
+// @Tags          expense
+// @Accept        multipart/form-data
+// @Produce       json
+// @Param         id path string true "Expense ID"
+// @Param         file formData file true "File"
+// @Success       200 {object} schedule_service.ExpenseAttachment
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) AddExpenseAttachment(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.ExpenseAttachment
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "file is required")
+		return
+	}
+
+	if file.Size > maxAttachmentSize {
+		handleGrpcErrWithDescription(c, h.log, errors.New("attachment is larger than 2 MB"), "attachment is larger than 2 MB")
+		return
+	}
+
+	if _, err = h.expenseOf(c.Request.Context(), data, id); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while reading file")
+		return
+	}
+	defer f.Close()
+
+	content, err := io.ReadAll(f)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while reading file")
+		return
+	}
+
+	resp, err = h.grpcClient.ExpenseService().AddAttachment(c.Request.Context(), &schedule_service.CreateExpenseAttachment{
+		ExpenseId:   id,
+		FileName:    file.Filename,
+		ContentType: file.Header.Get("Content-Type"),
+		Content:     content,
+		CreatedBy:   data.UserID,
+	})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to attach file")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /ExpenseAttachment/{id} [GET]
+// @Summary        Download an expense attachment
+// @Description    API for downloading a file attached to an expense
+// @Tags           expense
+// @Produce        octet-stream
+// @Param          id path string true "Expense Attachment ID"
+// @Success        200 {file} file
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetExpenseAttachment(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.ExpenseAttachmentFile
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	resp, err = h.grpcClient.ExpenseService().GetAttachment(c.Request.Context(), &schedule_service.ExpenseAttachmentPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	if _, err = h.expenseOf(c.Request.Context(), data, resp.Attachment.ExpenseId); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.Attachment.FileName))
+	c.Data(http.StatusOK, resp.Attachment.ContentType, resp.Content)
+}
+
+// @Security ApiKeyAuth
+// @Router        /ExpenseAttachment/{id} [DELETE]
+// @Summary       Delete an expense attachment
+// @Description   API for removing a file from a pending expense
+// @Tags          expense
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Expense Attachment ID"
+// @Success       200 {object} schedule_service.EmptyExpense
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeleteExpenseAttachment(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.EmptyExpense
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	if data.UserRole == "Administration" {
+		file, err := h.grpcClient.ExpenseService().GetAttachment(c.Request.Context(), &schedule_service.ExpenseAttachmentPrimaryKey{Id: id})
+		if err != nil {
+			handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+			return
+		}
+
+		if _, err = h.expenseOf(c.Request.Context(), data, file.Attachment.ExpenseId); err != nil {
+			handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+			return
+		}
+	}
+
+	resp, err = h.grpcClient.ExpenseService().DeleteAttachment(c.Request.Context(), &schedule_service.ExpenseAttachmentPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// expenseOf gets an expense the user may work with: administrators only
+// work with the expenses they recorded.
+func (h *handler) expenseOf(ctx context.Context, data AuthInfo, id string) (*schedule_service.Expense, error) {
+	expense, err := h.grpcClient.ExpenseService().GetByID(ctx, &schedule_service.ExpensePrimaryKey{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	if data.UserRole == "Administration" && expense.CreatedBy != data.UserID {
+		return nil, errors.New("Unauthorized")
+	}
+
+	return expense, nil
+}
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"user_api_gateway/genproto/schedule_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Security ApiKeyAuth
+// @Router         /GetRevenueReport [GET]
+// @Summary        Get revenue report
+// @Description    API for getting payments, corrections and net revenue per branch, month and group type. Dates are YYYY-MM-DD and default to the last twelve months.
+// @Tags           finance_report
+// @Accept         json
+// @Produce        json
+// @Param          fromDate query string false "From date"
+// @Param          toDate query string false "To date"
+// @Param          branchId query string false "Branch ID"
+// @Success        200 {object} schedule_service.RevenueReport
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetRevenueReport(c *gin.Context) {
+	var (
+		resp *schedule_service.RevenueReport
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.FinanceReportService().GetRevenue(c.Request.Context(), financeReportRequest(c))
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetCollectionsReport [GET]
+// @Summary        Get collections report
+// @Description    API for getting what each administrator collected per payment method. Dates are YYYY-MM-DD and default to the last twelve months.
+// @Tags           finance_report
+// @Accept         json
+// @Produce        json
+// @Param          fromDate query string false "From date"
+// @Param          toDate query string false "To date"
+// @Param          branchId query string false "Branch ID"
+// @Success        200 {object} schedule_service.CollectionsReport
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetCollectionsReport(c *gin.Context) {
+	var (
+		resp *schedule_service.CollectionsReport
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.FinanceReportService().GetCollections(c.Request.Context(), financeReportRequest(c))
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetDebtAgingReport [GET]
+// @Summary        Get debt aging report
+// @Description    API for getting what students owe today, bucketed by days past the due date: current, 1-30, 31-60, 61-90 and 90+
+// @Tags           finance_report
+// @Accept         json
+// @Produce        json
+// @Param          branchId query string false "Branch ID"
+// @Success        200 {object} schedule_service.DebtAgingReport
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetDebtAgingReport(c *gin.Context) {
+	var (
+		resp *schedule_service.DebtAgingReport
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.FinanceReportService().GetDebtAging(c.Request.Context(), &schedule_service.DebtAgingRequest{BranchId: c.Query("branchId")})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetMonthComparisonReport [GET]
+// @Summary        Get month comparison report
+// @Description    API for comparing each month of the range with the month before: invoiced, discounts, collected and the collection rate. Dates are YYYY-MM-DD and default to the last twelve months.
+// @Tags           finance_report
+// @Accept         json
+// @Produce        json
+// @Param          fromDate query string false "From date"
+// @Param          toDate query string false "To date"
+// @Param          branchId query string false "Branch ID"
+// @Success        200 {object} schedule_service.MonthComparisonReport
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetMonthComparisonReport(c *gin.Context) {
+	var (
+		resp *schedule_service.MonthComparisonReport
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.FinanceReportService().GetMonthComparison(c.Request.Context(), financeReportRequest(c))
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetProfitAndLossReport [GET]
+// @Summary        Get profit and loss report
+// @Description    API for comparing branches by income (net payments), payroll cost (locked payroll runs of the months the range touches) and approved expenses by category, with the profit and margin of each. Dates are YYYY-MM-DD and default to the last twelve months.
+// @Tags           finance_report
+// @Accept         json
+// @Produce        json
+// @Param          fromDate query string false "From date"
+// @Param          toDate query string false "To date"
+// @Param          branchId query string false "Branch ID"
+// @Success        200 {object} schedule_service.ProfitAndLossReport
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetProfitAndLossReport(c *gin.Context) {
+	var (
+		resp *schedule_service.ProfitAndLossReport
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin")
+		return
+	}
+
+	resp, err = h.grpcClient.FinanceReportService().GetProfitAndLoss(c.Request.Context(), financeReportRequest(c))
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// financeReportRequest reads the date range and branch of a report.
+func financeReportRequest(c *gin.Context) *schedule_service.FinanceReportRequest {
+	return &schedule_service.FinanceReportRequest{
+		FromDate: c.Query("fromDate"),
+		ToDate:   c.Query("toDate"),
+		BranchId: c.Query("branchId"),
+	}
+}
@@ -59,6 +59,8 @@ func (h *handler) CreateGroup(c *gin.Context) {
 // @Accept         json
 // @Produce        json
 // @Param          search query string false "Search"
+// @Param          branchId query string false "Branch ID"
+// @Param          name query string false "Exact group name"
 // @Param          page query int false "Page"
 // @Param          limit query int false "Limit"
 // @Success        200 {object} schedule_service.GetListGroupResponse
@@ -83,6 +85,8 @@ func (h *handler) GetListGroup(c *gin.Context) {
 	}
 
 	req.Search = c.Query("search")
+	req.BranchId = c.Query("branchId")
+	req.Name = c.Query("name")
 
 	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
 	if err != nil {
@@ -260,3 +264,88 @@ func (h *handler) GetGroupByIDTeacher(c *gin.Context) {
 
 	c.JSON(http.StatusOK, resp)
 }
+
+// @Security ApiKeyAuth
+// @Router         /PromoteGroup [post]
+// @Summary        Promote group to the next level
+// @Description    API for closing a group's journal and promoting students who meet the attendance and score thresholds. Mode "move" keeps the group and raises its level, mode "new" finishes it and creates a new group for promoted students. Repeating students move to repeatGroupId, a running group of the same branch and level with enough seats; mode "move" requires it when anyone repeats. Seats freed in a moved group go to its waitlist.
+// @Tags           group
+// @Accept         json
+// @Produce        json
+// @Param          promotion body schedule_service.PromoteGroupRequest true "Promotion"
+// @Success        200 {object} schedule_service.PromoteGroupResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) PromoteGroup(c *gin.Context) {
+	var (
+		req  schedule_service.PromoteGroupRequest
+		resp *schedule_service.PromoteGroupResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.GroupService().PromoteGroup(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to promote group")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetPromotion/{id} [GET]
+// @Summary        Get a group promotion by ID
+// @Description    API for getting a group promotion with promoted and repeating students
+// @Tags           group
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Promotion ID"
+// @Success        200 {object} schedule_service.PromoteGroupResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetPromotion(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.PromoteGroupResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req := &schedule_service.PromotionPrimaryKey{
+		Id: id,
+	}
+
+	resp, err = h.grpcClient.GroupService().GetPromotion(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,320 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user_api_gateway/genproto/schedule_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Security ApiKeyAuth
+// @Router         /CreateGroupStudent [post]
+// @Summary        Add student to group
+// @Description    API for adding a student to a group
+// @Tags           group_student
+// @Accept         json
+// @Produce        json
+// @Param          group_student body schedule_service.CreateGroupStudent true "Group Student"
+// @Success        200 {object} schedule_service.GetGroupStudent
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) CreateGroupStudent(c *gin.Context) {
+	var (
+		req  schedule_service.CreateGroupStudent
+		resp *schedule_service.GetGroupStudent
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.GroupStudentService().Create(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to add student to group")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListGroupStudent [GET]
+// @Summary        Get list of group students
+// @Description    API for getting list of group students
+// @Tags           group_student
+// @Accept         json
+// @Produce        json
+// @Param          search query string false "Search"
+// @Param          groupId query string false "Group ID"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetListGroupStudentResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListGroupStudent(c *gin.Context) {
+	var (
+		req  schedule_service.GetListGroupStudentRequest
+		resp *schedule_service.GetListGroupStudentResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req.Search = c.Query("search")
+	req.GroupId = c.Query("groupId")
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.Page = page
+	req.Limit = limit
+
+	resp, err = h.grpcClient.GroupStudentService().GetList(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetByIdGroupStudent/{id} [GET]
+// @Summary        Get a single group student by ID
+// @Description    API for getting a single group student by ID
+// @Tags           group_student
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Group Student ID"
+// @Success        200 {object} schedule_service.GetGroupStudent
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetGroupStudentByID(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.GetGroupStudent
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req := &schedule_service.GroupStudentPrimaryKey{
+		Id: id,
+	}
+
+	resp, err = h.grpcClient.GroupStudentService().GetByID(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router       /DeleteGroupStudent/{id} [DELETE]
+// @Summary      Remove a student from a group
+// @Description  API for removing a student from a group by membership ID
+// @Tags         group_student
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Group Student ID"
+// @Success      200 {object} schedule_service.EmptyGroupStudent
+// @Failure      404 {object} models.ResponseError
+// @Failure      500 {object} models.ResponseError
+func (h *handler) DeleteGroupStudent(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		err  error
+		resp = &schedule_service.EmptyGroupStudent{}
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req := &schedule_service.GroupStudentPrimaryKey{
+		Id: id,
+	}
+
+	resp, err = h.grpcClient.GroupStudentService().Delete(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /JoinWaitlist [post]
+// @Summary        Add student to group waitlist
+// @Description    API for putting a student on the waitlist of a full group. The student is moved into the group automatically when a seat frees up.
+// @Tags           group_student
+// @Accept         json
+// @Produce        json
+// @Param          waitlist body schedule_service.CreateGroupStudent true "Waitlist"
+// @Success        200 {object} schedule_service.WaitlistEntry
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) JoinWaitlist(c *gin.Context) {
+	var (
+		req  schedule_service.CreateGroupStudent
+		resp *schedule_service.WaitlistEntry
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.GroupStudentService().JoinWaitlist(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to add student to waitlist")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetWaitlist [GET]
+// @Summary        Get group waitlist
+// @Description    API for getting the students waiting for a seat in a group, first come first
+// @Tags           group_student
+// @Accept         json
+// @Produce        json
+// @Param          groupId query string true "Group ID"
+// @Success        200 {object} schedule_service.GetWaitlistResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetWaitlist(c *gin.Context) {
+	var (
+		resp *schedule_service.GetWaitlistResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req := &schedule_service.GetWaitlistRequest{
+		GroupId: c.Query("groupId"),
+	}
+
+	resp, err = h.grpcClient.GroupStudentService().GetWaitlist(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router       /LeaveWaitlist/{id} [DELETE]
+// @Summary      Remove a student from a group waitlist
+// @Description  API for removing a waitlist entry by ID
+// @Tags         group_student
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Waitlist Entry ID"
+// @Success      200 {object} schedule_service.EmptyGroupStudent
+// @Failure      404 {object} models.ResponseError
+// @Failure      500 {object} models.ResponseError
+func (h *handler) LeaveWaitlist(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		err  error
+		resp = &schedule_service.EmptyGroupStudent{}
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req := &schedule_service.WaitlistPrimaryKey{
+		Id: id,
+	}
+
+	resp, err = h.grpcClient.GroupStudentService().LeaveWaitlist(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,574 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"user_api_gateway/genproto/schedule_service"
+	"user_api_gateway/genproto/user_service"
+	"user_api_gateway/pkg/etc"
+
+	"github.com/gin-gonic/gin"
+)
+
+// childOf checks that the student is linked to the parent who is logged in.
+func (h *handler) childOf(ctx context.Context, data AuthInfo, studentId string) error {
+	if data.UserRole != "Parent" {
+		return errors.New("Unauthorized")
+	}
+
+	guardian, err := h.grpcClient.GuardianService().GetByID(ctx, &user_service.GuardianPrimaryKey{Id: data.UserID})
+	if err != nil {
+		return err
+	}
+
+	for _, child := range guardian.Children {
+		if child.StudentId == studentId {
+			return nil
+		}
+	}
+
+	return errors.New("Unauthorized")
+}
+
+// @Security ApiKeyAuth
+// @Router        /CreateGuardian [post]
+// @Summary       Create guardian
+// @Description   API for creating a parent or guardian. The login (P00001, P00002, ...) is generated; link students with /LinkGuardianStudent.
+// @Tags          guardian
+// @Accept        json
+// @Produce       json
+// @Param         guardian body user_service.CreateGuardian true "Guardian"
+// @Success       200 {object} user_service.Guardian
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreateGuardian(c *gin.Context) {
+	var (
+		req  user_service.CreateGuardian
+		resp *user_service.Guardian
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	hashedPassword, err := etc.GeneratePasswordHash(req.Password)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while hashing password!")
+		return
+	}
+
+	req.Password = string(hashedPassword)
+
+	resp, err = h.grpcClient.GuardianService().Create(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create guardian")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListGuardian [GET]
+// @Summary        Get list of guardians
+// @Description    API for getting guardians, optionally only those of one student
+// @Tags           guardian
+// @Accept         json
+// @Produce        json
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Param          search query string false "Search"
+// @Param          studentId query string false "Student ID"
+// @Success        200 {object} user_service.GetListGuardianResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListGuardian(c *gin.Context) {
+	var (
+		resp *user_service.GetListGuardianResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req := &user_service.GetListGuardianRequest{
+		Page:      page,
+		Limit:     limit,
+		Search:    c.Query("search"),
+		StudentId: c.Query("studentId"),
+	}
+
+	resp, err = h.grpcClient.GuardianService().GetList(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetByIdGuardian/{id} [GET]
+// @Summary        Get guardian
+// @Description    API for getting a guardian with their children by ID. Parents always get themselves.
+// @Tags           guardian
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Guardian ID"
+// @Success        200 {object} user_service.Guardian
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetGuardianByID(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *user_service.Guardian
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole == "Parent" {
+		id = data.UserID
+	} else if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin or a parent")
+		return
+	}
+
+	resp, err = h.grpcClient.GuardianService().GetByID(c.Request.Context(), &user_service.GuardianPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router          /UpdateGuardian/{id} [PUT]
+// @Summary         Update guardian
+// @Description     API for updating a guardian by ID. An empty password keeps the current one.
+// @Tags            guardian
+// @Accept          json
+// @Produce         json
+// @Param           id path string true "Guardian ID"
+// @Param           guardian body user_service.UpdateGuardian true "Guardian"
+// @Success         200 {object} user_service.Guardian
+// @Failure         404 {object} models.ResponseError
+// @Failure         500 {object} models.ResponseError
+func (h *handler) UpdateGuardian(c *gin.Context) {
+	var (
+		req  user_service.UpdateGuardian
+		resp *user_service.Guardian
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	if req.Password != "" {
+		hashedPassword, err := etc.GeneratePasswordHash(req.Password)
+		if err != nil {
+			handleGrpcErrWithDescription(c, h.log, err, "error while hashing password!")
+			return
+		}
+
+		req.Password = string(hashedPassword)
+	}
+
+	req.Id = c.Param("id")
+
+	resp, err = h.grpcClient.GuardianService().Update(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to update guardian")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeleteGuardian/{id} [DELETE]
+// @Summary       Delete guardian
+// @Description   API for deleting a guardian by ID. They can no longer log in.
+// @Tags          guardian
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Guardian ID"
+// @Success       200 {object} user_service.EmptyGuardian
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeleteGuardian(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *user_service.EmptyGuardian
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	resp, err = h.grpcClient.GuardianService().Delete(c.Request.Context(), &user_service.GuardianPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /LinkGuardianStudent [post]
+// @Summary       Link student to guardian
+// @Description   API for linking a student to a guardian. relation is mother, father, guardian (default) or other; linking again changes it. A student can have several guardians and a guardian several students.
+// @Tags          guardian
+// @Accept        json
+// @Produce       json
+// @Param         link body user_service.GuardianStudent true "Link"
+// @Success       200 {object} user_service.Guardian
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) LinkGuardianStudent(c *gin.Context) {
+	var (
+		req  user_service.GuardianStudent
+		resp *user_service.Guardian
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.GuardianService().LinkStudent(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to link student")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /UnlinkGuardianStudent/{id} [DELETE]
+// @Summary       Unlink student from guardian
+// @Description   API for removing a student from a guardian
+// @Tags          guardian
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Guardian ID"
+// @Param         studentId query string true "Student ID"
+// @Success       200 {object} user_service.EmptyGuardian
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) UnlinkGuardianStudent(c *gin.Context) {
+	var (
+		resp *user_service.EmptyGuardian
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	req := &user_service.GuardianStudentPrimaryKey{
+		GuardianId: c.Param("id"),
+		StudentId:  c.Query("studentId"),
+	}
+
+	resp, err = h.grpcClient.GuardianService().UnlinkStudent(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetChildSchedule/{id} [GET]
+// @Summary        Get child schedule
+// @Description    API for a parent to get the lessons of the groups their child studies in
+// @Tags           guardian
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Student ID"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetListScheduleResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetChildSchedule(c *gin.Context) {
+	var (
+		studentId = c.Param("id")
+		resp      *schedule_service.GetListScheduleResponse
+		err       error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if err := h.childOf(c.Request.Context(), data, studentId); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "This student is not your child")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req := &schedule_service.GetListScheduleRequest{
+		Page:      page,
+		Limit:     limit,
+		StudentId: studentId,
+	}
+
+	resp, err = h.grpcClient.ScheduleService().GetList(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetChildTasks/{id} [GET]
+// @Summary        Get child task scores
+// @Description    API for a parent to get the tasks of their child with their scores
+// @Tags           guardian
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Student ID"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetListStudentTaskResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetChildTasks(c *gin.Context) {
+	var (
+		studentId = c.Param("id")
+		resp      *schedule_service.GetListStudentTaskResponse
+		err       error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if err := h.childOf(c.Request.Context(), data, studentId); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "This student is not your child")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req := &schedule_service.GetListStudentTaskRequest{
+		Page:      page,
+		Limit:     limit,
+		StudentId: studentId,
+	}
+
+	resp, err = h.grpcClient.StudentTaskService().GetList(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetChildPayments/{id} [GET]
+// @Summary        Get child payments
+// @Description    API for a parent to get the payments made for their child
+// @Tags           guardian
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Student ID"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetListStudentPaymentResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetChildPayments(c *gin.Context) {
+	var (
+		studentId = c.Param("id")
+		resp      *schedule_service.GetListStudentPaymentResponse
+		err       error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if err := h.childOf(c.Request.Context(), data, studentId); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "This student is not your child")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req := &schedule_service.GetListStudentPaymentRequest{
+		Page:      page,
+		Limit:     limit,
+		StudentId: studentId,
+	}
+
+	resp, err = h.grpcClient.StudentPaymentService().GetList(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetChildEvents/{id} [GET]
+// @Summary        Get child event registrations
+// @Description    API for a parent to get the events their child registered for and attended
+// @Tags           guardian
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Student ID"
+// @Success        200 {object} schedule_service.GetStudentWithEventsResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetChildEvents(c *gin.Context) {
+	var (
+		studentId = c.Param("id")
+		resp      *schedule_service.GetStudentWithEventsResponse
+		err       error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if err := h.childOf(c.Request.Context(), data, studentId); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "This student is not your child")
+		return
+	}
+
+	resp, err = h.grpcClient.EventStudentService().GetStudentByID(c.Request.Context(), &schedule_service.EventStudentPrimaryKey{Id: studentId})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -179,7 +179,7 @@ func getAuthInfo(c *gin.Context) (AuthInfo, error) {
 	}
 
 	role := m["user_role"].(string)
-	if !(role == "SuperAdmin" || role == "Teacher" || role == "SupportTeacher" || role == "Student" || role == "Manager" || role == "Administration") {
+	if !(role == "SuperAdmin" || role == "Teacher" || role == "SupportTeacher" || role == "Student" || role == "Manager" || role == "Administration" || role == "Parent") {
 		return AuthInfo{}, errors.New("unauthorized")
 	}
 
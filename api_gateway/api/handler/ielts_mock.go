@@ -0,0 +1,364 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user_api_gateway/genproto/schedule_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Security ApiKeyAuth
+// @Router        /CreateMockTest [post]
+// @Summary       Create mock test
+// @Description   API for scheduling a mock IELTS test for an ielts group on testDate (YYYY-MM-DD, today when empty). readingType is academic (default) or general and picks the reading conversion table.
+// @Tags          mock_test
+// @Accept        json
+// @Produce       json
+// @Param         mock_test body schedule_service.CreateMockTest true "Mock Test"
+// @Success       200 {object} schedule_service.MockTest
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreateMockTest(c *gin.Context) {
+	var (
+		req  schedule_service.CreateMockTest
+		resp *schedule_service.MockTest
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Teacher" && data.UserRole != "SupportTeacher" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or a teacher")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.CreatedBy = data.UserID
+
+	resp, err = h.grpcClient.MockTestService().Create(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create mock test")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetByIdMockTest/{id} [GET]
+// @Summary        Get mock test
+// @Description    API for getting a mock test with the result of every student and the average bands
+// @Tags           mock_test
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Mock Test ID"
+// @Success        200 {object} schedule_service.MockTest
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetMockTestByID(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.MockTest
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Teacher" && data.UserRole != "SupportTeacher" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or a teacher")
+		return
+	}
+
+	resp, err = h.grpcClient.MockTestService().GetByID(c.Request.Context(), &schedule_service.MockTestPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListMockTest [GET]
+// @Summary        Get list of mock tests
+// @Description    API for getting mock tests by group and test date range (YYYY-MM-DD), newest first, with their average bands
+// @Tags           mock_test
+// @Accept         json
+// @Produce        json
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Param          groupId query string false "Group ID"
+// @Param          fromDate query string false "From date"
+// @Param          toDate query string false "To date"
+// @Success        200 {object} schedule_service.GetListMockTestResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListMockTest(c *gin.Context) {
+	var (
+		resp *schedule_service.GetListMockTestResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Teacher" && data.UserRole != "SupportTeacher" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or a teacher")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req := &schedule_service.GetListMockTestRequest{
+		Page:     page,
+		Limit:    limit,
+		GroupId:  c.Query("groupId"),
+		FromDate: c.Query("fromDate"),
+		ToDate:   c.Query("toDate"),
+	}
+
+	resp, err = h.grpcClient.MockTestService().GetList(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeleteMockTest/{id} [DELETE]
+// @Summary       Delete mock test
+// @Description   API for deleting a mock test. Its results no longer count towards progress and averages.
+// @Tags          mock_test
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Mock Test ID"
+// @Success       200 {object} schedule_service.EmptyMockTest
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeleteMockTest(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.EmptyMockTest
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.MockTestService().Delete(c.Request.Context(), &schedule_service.MockTestPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /SaveMockTestResult [post]
+// @Summary       Save mock test result
+// @Description   API for recording a student's mock test scores, replacing any earlier ones. listeningRaw and readingRaw are correct answers out of 40 and are converted to bands on the official tables. The four Writing and the four Speaking criterion scores are whole bands from 0 to 9; their mean rounded down to the half band is the paper band. The overall band follows IELTS rounding.
+// @Tags          mock_test
+// @Accept        json
+// @Produce       json
+// @Param         result body schedule_service.SaveMockTestResult true "Mock Test Result"
+// @Success       200 {object} schedule_service.MockTestResult
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) SaveMockTestResult(c *gin.Context) {
+	var (
+		req  schedule_service.SaveMockTestResult
+		resp *schedule_service.MockTestResult
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Teacher" && data.UserRole != "SupportTeacher" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or a teacher")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.MockTestService().SaveResult(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to save mock test result")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeleteMockTestResult/{id} [DELETE]
+// @Summary       Delete mock test result
+// @Description   API for deleting a student's mock test result
+// @Tags          mock_test
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Mock Test Result ID"
+// @Success       200 {object} schedule_service.EmptyMockTest
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeleteMockTestResult(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.EmptyMockTest
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Teacher" && data.UserRole != "SupportTeacher" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or a teacher")
+		return
+	}
+
+	resp, err = h.grpcClient.MockTestService().DeleteResult(c.Request.Context(), &schedule_service.MockTestResultPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetStudentMockProgress/{id} [GET]
+// @Summary        Get student mock test progress
+// @Description    API for getting a student's mock test results over time, oldest first, with the latest and best bands and the change in overall band. Students only get their own.
+// @Tags           mock_test
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Student ID"
+// @Param          fromDate query string false "From date"
+// @Param          toDate query string false "To date"
+// @Success        200 {object} schedule_service.StudentMockProgress
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetStudentMockProgress(c *gin.Context) {
+	var (
+		resp *schedule_service.StudentMockProgress
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	studentId := c.Param("id")
+	if data.UserRole == "Student" {
+		studentId = data.UserID
+	} else if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Teacher" && data.UserRole != "SupportTeacher" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or a teacher or a student")
+		return
+	}
+
+	req := &schedule_service.StudentMockProgressRequest{
+		StudentId: studentId,
+		FromDate:  c.Query("fromDate"),
+		ToDate:    c.Query("toDate"),
+	}
+
+	resp, err = h.grpcClient.MockTestService().GetStudentProgress(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetGroupMockAverages/{id} [GET]
+// @Summary        Get group mock test averages
+// @Description    API for getting the average bands of a group on each mock test, oldest first, and over the whole date range
+// @Tags           mock_test
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Group ID"
+// @Param          fromDate query string false "From date"
+// @Param          toDate query string false "To date"
+// @Success        200 {object} schedule_service.GroupMockAverages
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetGroupMockAverages(c *gin.Context) {
+	var (
+		resp *schedule_service.GroupMockAverages
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Teacher" && data.UserRole != "SupportTeacher" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or a teacher")
+		return
+	}
+
+	req := &schedule_service.GroupMockAveragesRequest{
+		GroupId:  c.Param("id"),
+		FromDate: c.Query("fromDate"),
+		ToDate:   c.Query("toDate"),
+	}
+
+	resp, err = h.grpcClient.MockTestService().GetGroupAverages(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,301 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user_api_gateway/genproto/user_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ieltsStaffTypes maps the roles that have IELTS results to their staff type.
+var ieltsStaffTypes = map[string]string{
+	"Teacher":        "teacher",
+	"SupportTeacher": "support_teacher",
+	"Administration": "administration",
+}
+
+// @Security ApiKeyAuth
+// @Router        /CreateIeltsResult [post]
+// @Summary       Create IELTS result
+// @Description   API for recording an IELTS test of a teacher, support_teacher or administration. Bands go from 0 to 9 in half bands; overall is worked out from the four sub-scores when left empty. The certificate expires two years after testDate (YYYY-MM-DD). The staff member's ieltsScore becomes the overall band of their latest test and ieltsAttemptCount the number of tests.
+// @Tags          ielts_result
+// @Accept        json
+// @Produce       json
+// @Param         ielts_result body user_service.CreateIeltsResult true "IELTS Result"
+// @Success       200 {object} user_service.IeltsResult
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreateIeltsResult(c *gin.Context) {
+	var (
+		req  user_service.CreateIeltsResult
+		resp *user_service.IeltsResult
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.IeltsResultService().Create(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create ielts result")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListIeltsResult [GET]
+// @Summary        Get list of IELTS results
+// @Description    API for getting IELTS results, newest test first. Teachers, support teachers and administrations only get their own.
+// @Tags           ielts_result
+// @Accept         json
+// @Produce        json
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Param          staffType query string false "Staff type"
+// @Param          staffId query string false "Staff ID"
+// @Success        200 {object} user_service.GetListIeltsResultResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListIeltsResult(c *gin.Context) {
+	var (
+		resp *user_service.GetListIeltsResultResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	req := &user_service.GetListIeltsResultRequest{
+		StaffType: c.Query("staffType"),
+		StaffId:   c.Query("staffId"),
+	}
+
+	if staffType, ok := ieltsStaffTypes[data.UserRole]; ok {
+		req.StaffType = staffType
+		req.StaffId = data.UserID
+	} else if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to see IELTS results")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.Page = page
+	req.Limit = limit
+
+	resp, err = h.grpcClient.IeltsResultService().GetList(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetByIdIeltsResult/{id} [GET]
+// @Summary        Get IELTS result
+// @Description    API for getting an IELTS result by ID. Teachers, support teachers and administrations only get their own.
+// @Tags           ielts_result
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "IELTS Result ID"
+// @Success        200 {object} user_service.IeltsResult
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetIeltsResultByID(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *user_service.IeltsResult
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	staffType, isStaff := ieltsStaffTypes[data.UserRole]
+	if !isStaff && data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to see IELTS results")
+		return
+	}
+
+	resp, err = h.grpcClient.IeltsResultService().GetByID(c.Request.Context(), &user_service.IeltsResultPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	if isStaff && (resp.StaffType != staffType || resp.StaffId != data.UserID) {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "This IELTS result is not yours")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router          /UpdateIeltsResult/{id} [PUT]
+// @Summary         Update IELTS result
+// @Description     API for correcting an IELTS result by ID. The staff member's current score and attempt count follow.
+// @Tags            ielts_result
+// @Accept          json
+// @Produce         json
+// @Param           id path string true "IELTS Result ID"
+// @Param           ielts_result body user_service.UpdateIeltsResult true "IELTS Result"
+// @Success         200 {object} user_service.IeltsResult
+// @Failure         404 {object} models.ResponseError
+// @Failure         500 {object} models.ResponseError
+func (h *handler) UpdateIeltsResult(c *gin.Context) {
+	var (
+		req  user_service.UpdateIeltsResult
+		resp *user_service.IeltsResult
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.Id = c.Param("id")
+
+	resp, err = h.grpcClient.IeltsResultService().Update(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to update ielts result")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeleteIeltsResult/{id} [DELETE]
+// @Summary       Delete IELTS result
+// @Description   API for deleting an IELTS result by ID. The staff member's current score and attempt count follow.
+// @Tags          ielts_result
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "IELTS Result ID"
+// @Success       200 {object} user_service.EmptyIeltsResult
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeleteIeltsResult(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *user_service.EmptyIeltsResult
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.IeltsResultService().Delete(c.Request.Context(), &user_service.IeltsResultPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListExpiringIelts [GET]
+// @Summary        Get expiring IELTS certificates
+// @Description    API for getting the staff whose latest IELTS certificate expires within the given number of days (60 when empty) or has already expired, soonest first. daysLeft is negative once expired.
+// @Tags           ielts_result
+// @Accept         json
+// @Produce        json
+// @Param          days query int false "Days ahead"
+// @Param          staffType query string false "Staff type"
+// @Param          branchId query string false "Branch ID"
+// @Success        200 {object} user_service.GetListExpiringIeltsResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListExpiringIelts(c *gin.Context) {
+	var (
+		resp *user_service.GetListExpiringIeltsResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	days, err := strconv.Atoi(c.DefaultQuery("days", "0"))
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing days")
+		return
+	}
+
+	req := &user_service.GetListExpiringIeltsRequest{
+		Days:      int32(days),
+		StaffType: c.Query("staffType"),
+		BranchId:  c.Query("branchId"),
+	}
+
+	resp, err = h.grpcClient.IeltsResultService().GetListExpiring(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -259,4 +259,57 @@ func (h *handler) GetJurnalByIDStudent(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, resp)
-}
\ No newline at end of file
+}
+// @Security ApiKeyAuth
+// @Router         /GetJournalRolloverReport [GET]
+// @Summary        Get journal rollover report
+// @Description    API for getting groups whose journal could not be rolled over to the next month
+// @Tags           journal
+// @Accept         json
+// @Produce        json
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetRolloverReportResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetJournalRolloverReport(c *gin.Context) {
+	var (
+		req  schedule_service.GetRolloverReportRequest
+		resp *schedule_service.GetRolloverReportResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.Page = page
+	req.Limit = limit
+
+	resp, err = h.grpcClient.JournalService().GetRolloverReport(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
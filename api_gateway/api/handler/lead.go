@@ -0,0 +1,456 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user_api_gateway/api/helpers"
+	"user_api_gateway/genproto/user_service"
+	"user_api_gateway/pkg/etc"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Security ApiKeyAuth
+// @Router        /CreateLead [post]
+// @Summary       Create lead
+// @Description   API for recording a prospective student. source is walk_in, call, referral, instagram, telegram, facebook, website or other; desiredLevel is a group type and may be left empty. The lead starts as new.
+// @Tags          lead
+// @Accept        json
+// @Produce       json
+// @Param         lead body user_service.CreateLead true "Lead"
+// @Success       200 {object} user_service.Lead
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreateLead(c *gin.Context) {
+	var (
+		req  user_service.CreateLead
+		resp *user_service.Lead
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	setting, err := h.branchSetting(c.Request.Context(), req.BranchId)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting branch settings")
+		return
+	}
+
+	if err := helpers.ValidatePhone(req.Phone, setting.PhonePattern); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "input valid phone number"+req.Phone)
+		return
+	}
+
+	resp, err = h.grpcClient.LeadService().Create(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create lead")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListLead [GET]
+// @Summary        Get list of leads
+// @Description    API for getting leads, newest first
+// @Tags           lead
+// @Accept         json
+// @Produce        json
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Param          search query string false "Search by name or phone"
+// @Param          status query string false "Status"
+// @Param          source query string false "Source"
+// @Param          branchId query string false "Branch ID"
+// @Success        200 {object} user_service.GetListLeadResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListLead(c *gin.Context) {
+	var (
+		resp *user_service.GetListLeadResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req := &user_service.GetListLeadRequest{
+		Page:     page,
+		Limit:    limit,
+		Search:   c.Query("search"),
+		Status:   c.Query("status"),
+		Source:   c.Query("source"),
+		BranchId: c.Query("branchId"),
+	}
+
+	resp, err = h.grpcClient.LeadService().GetList(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetByIdLead/{id} [GET]
+// @Summary        Get lead
+// @Description    API for getting a lead by ID with their trial lesson and, once converted, their student
+// @Tags           lead
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Lead ID"
+// @Success        200 {object} user_service.Lead
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetLeadByID(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *user_service.Lead
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	resp, err = h.grpcClient.LeadService().GetByID(c.Request.Context(), &user_service.LeadPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router          /UpdateLead/{id} [PUT]
+// @Summary         Update lead
+// @Description     API for updating the details of a lead by ID. The status changes through the status, trial and convert APIs; converted leads cannot be edited.
+// @Tags            lead
+// @Accept          json
+// @Produce         json
+// @Param           id path string true "Lead ID"
+// @Param           lead body user_service.UpdateLead true "Lead"
+// @Success         200 {object} user_service.Lead
+// @Failure         404 {object} models.ResponseError
+// @Failure         500 {object} models.ResponseError
+func (h *handler) UpdateLead(c *gin.Context) {
+	var (
+		req  user_service.UpdateLead
+		resp *user_service.Lead
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	setting, err := h.branchSetting(c.Request.Context(), req.BranchId)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting branch settings")
+		return
+	}
+
+	if err := helpers.ValidatePhone(req.Phone, setting.PhonePattern); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "input valid phone number"+req.Phone)
+		return
+	}
+
+	req.Id = c.Param("id")
+
+	resp, err = h.grpcClient.LeadService().Update(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to update lead")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeleteLead/{id} [DELETE]
+// @Summary       Delete lead
+// @Description   API for deleting a lead by ID
+// @Tags          lead
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Lead ID"
+// @Success       200 {object} user_service.EmptyLead
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeleteLead(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *user_service.EmptyLead
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	resp, err = h.grpcClient.LeadService().Delete(c.Request.Context(), &user_service.LeadPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /UpdateLeadStatus/{id} [post]
+// @Summary       Move lead
+// @Description   API for moving a lead along the pipeline. status is contacted (from new, trial_booked or lost), trial_attended (from trial_booked) or lost (from any open status, with an optional lostReason).
+// @Tags          lead
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Lead ID"
+// @Param         status body user_service.UpdateLeadStatus true "Status"
+// @Success       200 {object} user_service.Lead
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) UpdateLeadStatus(c *gin.Context) {
+	var (
+		req  user_service.UpdateLeadStatus
+		resp *user_service.Lead
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.Id = c.Param("id")
+
+	resp, err = h.grpcClient.LeadService().UpdateStatus(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to update lead status")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /BookLeadTrial/{id} [post]
+// @Summary       Book trial lesson
+// @Description   API for booking a lead into an upcoming lesson (schedule) of a group in their branch. Booking again moves the trial.
+// @Tags          lead
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Lead ID"
+// @Param         trial body user_service.BookLeadTrial true "Trial"
+// @Success       200 {object} user_service.Lead
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) BookLeadTrial(c *gin.Context) {
+	var (
+		req  user_service.BookLeadTrial
+		resp *user_service.Lead
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.Id = c.Param("id")
+
+	resp, err = h.grpcClient.LeadService().BookTrial(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to book trial lesson")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /ConvertLead/{id} [post]
+// @Summary       Convert lead
+// @Description   API for turning an open lead into a student with the lead's name, phone and branch and a generated login (returned as studentLogin). A groupName must name a running group of the lead's branch with a free seat; the student is enrolled in it in the same step, so a full or unknown group leaves the lead open.
+// @Tags          lead
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Lead ID"
+// @Param         student body user_service.ConvertLead true "Student password and group"
+// @Success       200 {object} user_service.Lead
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) ConvertLead(c *gin.Context) {
+	var (
+		req  user_service.ConvertLead
+		resp *user_service.Lead
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	if req.Password == "" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("password is required"), "invalid request body")
+		return
+	}
+
+	hashedPassword, err := etc.GeneratePasswordHash(req.Password)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while hashing password!")
+		return
+	}
+
+	req.Password = string(hashedPassword)
+	req.Id = c.Param("id")
+
+	resp, err = h.grpcClient.LeadService().Convert(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to convert lead")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetLeadConversionReport [GET]
+// @Summary        Get lead conversion report
+// @Description    API for getting, per source and branch, the leads that came in between fromDate and toDate (YYYY-MM-DD), how many got to a trial, attended it, converted or were lost, and the conversion rate in percent
+// @Tags           lead
+// @Accept         json
+// @Produce        json
+// @Param          fromDate query string true "From date"
+// @Param          toDate query string true "To date"
+// @Param          branchId query string false "Branch ID"
+// @Success        200 {object} user_service.LeadConversionReport
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetLeadConversionReport(c *gin.Context) {
+	var (
+		resp *user_service.LeadConversionReport
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	req := &user_service.LeadConversionReportRequest{
+		FromDate: c.Query("fromDate"),
+		ToDate:   c.Query("toDate"),
+		BranchId: c.Query("branchId"),
+	}
+
+	resp, err = h.grpcClient.LeadService().GetConversionReport(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -173,3 +173,31 @@ func (h *handler) SuperAdminLogin(c *gin.Context) {
 
 	c.JSON(http.StatusOK, resp)
 }
+
+// @Security ApiKeyAuth
+// @Router         /LoginParent [post]
+// @Summary        Parent Login
+// @Description    API for parent login. Parents can only read the schedule, task scores, payments and event registrations of their own children.
+// @Tags           login
+// @Accept         json
+// @Produce        json
+// @Param          login body user_service.LoginPasswors true "Parent credentials"
+// @Success        200 {object} user_service.Token
+// @Failure        400 {object} models.ResponseError "Invalid request body"
+// @Failure        500 {object} models.ResponseError "Internal server error"
+func (h *handler) ParentLogin(c *gin.Context) {
+	var req user_service.LoginPasswors
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err := h.grpcClient.LoginService().ParentLogin(c, &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
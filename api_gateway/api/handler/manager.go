@@ -45,7 +45,13 @@ func (h *handler) CreateManager(c *gin.Context) {
 		return
 	}
 
-	if err := helpers.ValidatePhone(req.Phone); err != nil {
+	setting, err := h.branchSetting(c.Request.Context(), req.BranchId)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting branch settings")
+		return
+	}
+
+	if err := helpers.ValidatePhone(req.Phone, setting.PhonePattern); err != nil {
 		handleGrpcErrWithDescription(c, h.log, err, "error while validating phone number"+req.Phone)
 		return
 	}
@@ -0,0 +1,331 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"user_api_gateway/genproto/schedule_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Security ApiKeyAuth
+// @Router        /CreateOnlinePayment [post]
+// @Summary       Start an online payment
+// @Description   API for paying tuition online. Returns the checkout URL of the provider ("click", "payme", or "mock" for offline testing). Students can only pay for themselves.
+// @Tags          online_payment
+// @Accept        json
+// @Produce       json
+// @Param         payment body schedule_service.CreateOnlinePayment true "Online Payment"
+// @Success       200 {object} schedule_service.OnlinePayment
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreateOnlinePayment(c *gin.Context) {
+	var (
+		req  schedule_service.CreateOnlinePayment
+		resp *schedule_service.OnlinePayment
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "Student" && data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to make online payments")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	if data.UserRole == "Student" {
+		req.StudentId = data.UserID
+	}
+
+	resp, err = h.grpcClient.OnlinePaymentService().Create(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to start online payment")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetByIdOnlinePayment/{id} [GET]
+// @Summary        Get an online payment
+// @Description    API for getting an online payment by ID. Students can only get their own payments.
+// @Tags           online_payment
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Online Payment ID"
+// @Success        200 {object} schedule_service.OnlinePayment
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetOnlinePaymentByID(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.OnlinePayment
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "Student" && data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to see online payments")
+		return
+	}
+
+	resp, err = h.grpcClient.OnlinePaymentService().GetByID(c.Request.Context(), &schedule_service.OnlinePaymentPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	if data.UserRole == "Student" && resp.StudentId != data.UserID {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "This payment belongs to another student")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListOnlinePayment [GET]
+// @Summary        Get list of online payments
+// @Description    API for getting online payments, newest first. Students only see their own.
+// @Tags           online_payment
+// @Accept         json
+// @Produce        json
+// @Param          studentId query string false "Student ID"
+// @Param          provider query string false "Provider"
+// @Param          status query string false "pending, paid or cancelled"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetListOnlinePaymentResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListOnlinePayment(c *gin.Context) {
+	var (
+		req  schedule_service.GetListOnlinePaymentRequest
+		resp *schedule_service.GetListOnlinePaymentResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "Student" && data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to see online payments")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.StudentId = c.Query("studentId")
+	req.Provider = c.Query("provider")
+	req.Status = c.Query("status")
+	req.Page = page
+	req.Limit = limit
+
+	if data.UserRole == "Student" {
+		req.StudentId = data.UserID
+	}
+
+	resp, err = h.grpcClient.OnlinePaymentService().GetList(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Router          /PaymentCallback/{provider} [post]
+// @Summary         Payment provider callback
+// @Description     Webhook the payment providers call. Needs no token: the callback signature (or, for Payme, the Authorization header) is verified instead, and the reply is in the provider's own format. Accepts form and JSON bodies; nested JSON keys are joined with dots.
+// @Tags            online_payment
+// @Accept          x-www-form-urlencoded
+// @Produce         json
+// @Param           provider path string true "Provider"
+// @Success         200 {object} object
+// @Failure         404 {object} models.ResponseError
+// @Failure         500 {object} models.ResponseError
+func (h *handler) PaymentCallback(c *gin.Context) {
+	payload := map[string]string{}
+
+	if strings.HasPrefix(c.ContentType(), "application/json") {
+		var body map[string]interface{}
+
+		decoder := json.NewDecoder(c.Request.Body)
+		decoder.UseNumber()
+		if err := decoder.Decode(&body); err != nil {
+			handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+			return
+		}
+
+		flattenPayload(payload, "", body)
+	} else {
+		if err := c.Request.ParseForm(); err != nil {
+			handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+			return
+		}
+
+		for key := range c.Request.PostForm {
+			payload[key] = c.Request.PostForm.Get(key)
+		}
+	}
+
+	resp, err := h.grpcClient.OnlinePaymentService().HandleCallback(c.Request.Context(), &schedule_service.OnlinePaymentCallback{
+		Provider:      c.Param("provider"),
+		Payload:       payload,
+		Authorization: c.GetHeader("Authorization"),
+	})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to handle payment callback")
+		return
+	}
+
+	c.Data(http.StatusOK, "application/json", []byte(resp.Body))
+}
+
+// flattenPayload copies a JSON body into payload, joining the keys of nested
+// objects with dots, as in "params.account.order_id".
+func flattenPayload(payload map[string]string, prefix string, body map[string]interface{}) {
+	for key, value := range body {
+		if nested, ok := value.(map[string]interface{}); ok {
+			flattenPayload(payload, prefix+key+".", nested)
+			continue
+		}
+
+		payload[prefix+key] = fmt.Sprint(value)
+	}
+}
+
+// @Security ApiKeyAuth
+// @Router        /SimulateOnlinePayment/{id} [post]
+// @Summary       Simulate the mock payment provider
+// @Description   API for playing the signed callback of the mock provider for a mock payment: "complete" (default), "prepare" or "cancel". Only works when the mock provider is enabled.
+// @Tags          online_payment
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Online Payment ID"
+// @Param         simulation body schedule_service.SimulateOnlinePayment false "Stage"
+// @Success       200 {object} schedule_service.OnlinePayment
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) SimulateOnlinePayment(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		req  schedule_service.SimulateOnlinePayment
+		resp *schedule_service.OnlinePayment
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "Student" && data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to make online payments")
+		return
+	}
+
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+			return
+		}
+	}
+
+	if data.UserRole == "Student" {
+		onlinePayment, err := h.grpcClient.OnlinePaymentService().GetByID(c.Request.Context(), &schedule_service.OnlinePaymentPrimaryKey{Id: id})
+		if err != nil {
+			handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+			return
+		}
+
+		if onlinePayment.StudentId != data.UserID {
+			handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "This payment belongs to another student")
+			return
+		}
+	}
+
+	req.Id = id
+	resp, err = h.grpcClient.OnlinePaymentService().Simulate(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to simulate online payment")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /ReconcileOnlinePayment [post]
+// @Summary       Reconcile online payments
+// @Description   API for comparing the statement of a provider (dates YYYY-MM-DD, today when empty) with the recorded online payments. Reports missing_locally, missing_at_provider, amount_mismatch and stale_pending issues.
+// @Tags          online_payment
+// @Accept        json
+// @Produce       json
+// @Param         statement body schedule_service.ReconcileOnlinePaymentRequest true "Statement"
+// @Success       200 {object} schedule_service.OnlinePaymentReconciliation
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) ReconcileOnlinePayment(c *gin.Context) {
+	var (
+		req  schedule_service.ReconcileOnlinePaymentRequest
+		resp *schedule_service.OnlinePaymentReconciliation
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.OnlinePaymentService().Reconcile(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to reconcile online payments")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
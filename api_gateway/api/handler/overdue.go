@@ -0,0 +1,286 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user_api_gateway/genproto/schedule_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Security ApiKeyAuth
+// @Router         /GetListOverdue [GET]
+// @Summary        Get list of overdue payments
+// @Description    API for getting invoices past their due date that are not fully paid, most days late first. Payments cover the oldest invoices first.
+// @Tags           overdue
+// @Accept         json
+// @Produce        json
+// @Param          branchId query string false "Branch ID"
+// @Param          studentId query string false "Student ID"
+// @Param          minDaysLate query int false "Min days late"
+// @Param          includeResolved query bool false "Include paid ones"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetListOverdueResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListOverdue(c *gin.Context) {
+	var (
+		req  schedule_service.GetListOverdueRequest
+		resp *schedule_service.GetListOverdueResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req.BranchId = c.Query("branchId")
+	req.StudentId = c.Query("studentId")
+
+	minDaysLate, err := strconv.ParseInt(c.DefaultQuery("minDaysLate", "0"), 10, 32)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing minDaysLate")
+		return
+	}
+
+	includeResolved, err := strconv.ParseBool(c.DefaultQuery("includeResolved", "false"))
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing includeResolved")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.MinDaysLate = int32(minDaysLate)
+	req.IncludeResolved = includeResolved
+	req.Page = page
+	req.Limit = limit
+
+	resp, err = h.grpcClient.OverdueService().GetList(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /CreateStudentHold [post]
+// @Summary       Put a student on hold
+// @Description   API for putting a student on hold. A student on hold cannot register for events or get new tasks until a manager clears the hold.
+// @Tags          overdue
+// @Accept        json
+// @Produce       json
+// @Param         hold body schedule_service.CreateStudentHold true "Hold"
+// @Success       200 {object} schedule_service.StudentHold
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreateStudentHold(c *gin.Context) {
+	var (
+		req  schedule_service.CreateStudentHold
+		resp *schedule_service.StudentHold
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.CreatedBy = data.UserID
+
+	resp, err = h.grpcClient.OverdueService().CreateHold(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to put student on hold")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /ClearStudentHold/{id} [post]
+// @Summary       Clear the hold of a student
+// @Description   API for lifting the hold of a student. A student who is still overdue is not put back on hold for the same overdue payments.
+// @Tags          overdue
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Student ID"
+// @Param         hold body schedule_service.ClearStudentHold true "Note"
+// @Success       200 {object} schedule_service.StudentHold
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) ClearStudentHold(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		req  schedule_service.ClearStudentHold
+		resp *schedule_service.StudentHold
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.StudentId = id
+	req.ClearedBy = data.UserID
+
+	resp, err = h.grpcClient.OverdueService().ClearHold(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to clear student hold")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListStudentHold [GET]
+// @Summary        Get list of student holds
+// @Description    API for getting current and past holds, newest first
+// @Tags           overdue
+// @Accept         json
+// @Produce        json
+// @Param          studentId query string false "Student ID"
+// @Param          activeOnly query bool false "Only holds not cleared yet"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetListStudentHoldResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListStudentHold(c *gin.Context) {
+	var (
+		req  schedule_service.GetListStudentHoldRequest
+		resp *schedule_service.GetListStudentHoldResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req.StudentId = c.Query("studentId")
+
+	activeOnly, err := strconv.ParseBool(c.DefaultQuery("activeOnly", "false"))
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing activeOnly")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.ActiveOnly = activeOnly
+	req.Page = page
+	req.Limit = limit
+
+	resp, err = h.grpcClient.OverdueService().GetListHold(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetStudentHold/{id} [GET]
+// @Summary        Get the hold of a student
+// @Description    API for checking whether a student is on hold. Students can only check themselves.
+// @Tags           overdue
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Student ID"
+// @Success        200 {object} schedule_service.StudentHoldStatus
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetStudentHold(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.StudentHoldStatus
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	switch data.UserRole {
+	case "SuperAdmin", "Manager", "Administration":
+	case "Student":
+		if id != data.UserID {
+			handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You can only check your own hold")
+			return
+		}
+	default:
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to see holds")
+		return
+	}
+
+	resp, err = h.grpcClient.OverdueService().GetHold(c.Request.Context(), &schedule_service.StudentHoldRequest{StudentId: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,533 @@
+package handler
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"user_api_gateway/genproto/schedule_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Security ApiKeyAuth
+// @Router        /CreatePayRule [post]
+// @Summary       Create pay rule
+// @Description   API for setting how a staff member (teacher, support_teacher, administration or manager) is paid from the month of validFrom on: a monthly fee, a rate per lesson delivered, a rate per student present at a lesson, or any mix. Amounts are decimal strings such as "50000.00".
+// @Tags          payroll
+// @Accept        json
+// @Produce       json
+// @Param         rule body schedule_service.CreatePayRule true "Pay Rule"
+// @Success       200 {object} schedule_service.PayRule
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreatePayRule(c *gin.Context) {
+	var (
+		req  schedule_service.CreatePayRule
+		resp *schedule_service.PayRule
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.PayrollService().CreateRule(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create pay rule")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListPayRule [GET]
+// @Summary        Get list of pay rules
+// @Description    API for getting pay rules by staff type and staff member, newest validFrom first
+// @Tags           payroll
+// @Accept         json
+// @Produce        json
+// @Param          staffType query string false "Staff type"
+// @Param          staffId query string false "Staff ID"
+// @Success        200 {object} schedule_service.GetListPayRuleResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListPayRule(c *gin.Context) {
+	var (
+		resp *schedule_service.GetListPayRuleResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	req := &schedule_service.GetListPayRuleRequest{
+		StaffType: c.Query("staffType"),
+		StaffId:   c.Query("staffId"),
+	}
+
+	resp, err = h.grpcClient.PayrollService().GetListRule(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeletePayRule/{id} [DELETE]
+// @Summary       Delete a pay rule
+// @Description   API for deleting a pay rule. Payroll runs already computed keep their amounts.
+// @Tags          payroll
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Pay Rule ID"
+// @Success       200 {object} schedule_service.EmptyPayroll
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeletePayRule(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.EmptyPayroll
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.PayrollService().DeleteRule(c.Request.Context(), &schedule_service.PayRulePrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /CreatePayrollAdjustment [post]
+// @Summary       Create payroll adjustment
+// @Description   API for giving a staff member a bonus or a penalty for a month (YYYY-MM, current month when empty). The month's payroll must not be locked.
+// @Tags          payroll
+// @Accept        json
+// @Produce       json
+// @Param         adjustment body schedule_service.CreatePayrollAdjustment true "Payroll Adjustment"
+// @Success       200 {object} schedule_service.PayrollAdjustment
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreatePayrollAdjustment(c *gin.Context) {
+	var (
+		req  schedule_service.CreatePayrollAdjustment
+		resp *schedule_service.PayrollAdjustment
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.CreatedBy = data.UserID
+
+	resp, err = h.grpcClient.PayrollService().CreateAdjustment(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create payroll adjustment")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListPayrollAdjustment [GET]
+// @Summary        Get list of payroll adjustments
+// @Description    API for getting bonuses and penalties by month (YYYY-MM), staff type and staff member
+// @Tags           payroll
+// @Accept         json
+// @Produce        json
+// @Param          period query string false "Month"
+// @Param          staffType query string false "Staff type"
+// @Param          staffId query string false "Staff ID"
+// @Success        200 {object} schedule_service.GetListPayrollAdjustmentResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListPayrollAdjustment(c *gin.Context) {
+	var (
+		resp *schedule_service.GetListPayrollAdjustmentResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	req := &schedule_service.GetListPayrollAdjustmentRequest{
+		Period:    c.Query("period"),
+		StaffType: c.Query("staffType"),
+		StaffId:   c.Query("staffId"),
+	}
+
+	resp, err = h.grpcClient.PayrollService().GetListAdjustment(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeletePayrollAdjustment/{id} [DELETE]
+// @Summary       Delete a payroll adjustment
+// @Description   API for deleting a bonus or a penalty. The month's payroll must not be locked.
+// @Tags          payroll
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Payroll Adjustment ID"
+// @Success       200 {object} schedule_service.EmptyPayroll
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeletePayrollAdjustment(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.EmptyPayroll
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.PayrollService().DeleteAdjustment(c.Request.Context(), &schedule_service.PayrollAdjustmentPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /CreatePayrollRun [post]
+// @Summary       Create payroll run
+// @Description   API for computing the payroll of a month (YYYY-MM, current month when empty) for every staff member from their pay rule, the lessons delivered so far, their bonuses and penalties, and their commissions on the tuition collected in the month. Staff without a pay rule get their salary. There is one run per month.
+// @Tags          payroll
+// @Accept        json
+// @Produce       json
+// @Param         run body schedule_service.CreatePayrollRun true "Payroll Run"
+// @Success       200 {object} schedule_service.PayrollRun
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreatePayrollRun(c *gin.Context) {
+	var (
+		req  schedule_service.CreatePayrollRun
+		resp *schedule_service.PayrollRun
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.CreatedBy = data.UserID
+
+	resp, err = h.grpcClient.PayrollService().CreateRun(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create payroll run")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /RecalculatePayrollRun/{id} [post]
+// @Summary       Recalculate a payroll run
+// @Description   API for computing a draft payroll run again from the current lessons, pay rules and adjustments
+// @Tags          payroll
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Payroll Run ID"
+// @Success       200 {object} schedule_service.PayrollRun
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) RecalculatePayrollRun(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.PayrollRun
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.PayrollService().RecalculateRun(c.Request.Context(), &schedule_service.PayrollRunPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to recalculate payroll run")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /LockPayrollRun/{id} [post]
+// @Summary       Lock a payroll run
+// @Description   API for locking a payroll run once it is approved. A locked run, and the adjustments of its month, no longer change.
+// @Tags          payroll
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Payroll Run ID"
+// @Success       200 {object} schedule_service.PayrollRun
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) LockPayrollRun(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.PayrollRun
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin")
+		return
+	}
+
+	resp, err = h.grpcClient.PayrollService().LockRun(c.Request.Context(), &schedule_service.LockPayrollRun{Id: id, LockedBy: data.UserID})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to lock payroll run")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetByIdPayrollRun/{id} [GET]
+// @Summary        Get a payroll run
+// @Description    API for getting a payroll run with a line per staff member
+// @Tags           payroll
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Payroll Run ID"
+// @Param          staffType query string false "Staff type"
+// @Param          staffId query string false "Staff ID"
+// @Success        200 {object} schedule_service.PayrollRun
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetPayrollRunByID(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.PayrollRun
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	req := &schedule_service.GetPayrollRunRequest{
+		Id:        id,
+		StaffType: c.Query("staffType"),
+		StaffId:   c.Query("staffId"),
+	}
+
+	resp, err = h.grpcClient.PayrollService().GetRun(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListPayrollRun [GET]
+// @Summary        Get list of payroll runs
+// @Description    API for getting payroll runs, newest month first, without their lines
+// @Tags           payroll
+// @Accept         json
+// @Produce        json
+// @Param          status query string false "draft or locked"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetListPayrollRunResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListPayrollRun(c *gin.Context) {
+	var (
+		req  schedule_service.GetListPayrollRunRequest
+		resp *schedule_service.GetListPayrollRunResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	req.Status = c.Query("status")
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.Page = page
+	req.Limit = limit
+
+	resp, err = h.grpcClient.PayrollService().GetListRun(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /PayrollRun/{id}/export.csv [GET]
+// @Summary        Export a payroll run
+// @Description    API for downloading a payroll run as CSV, a row per staff member, for accounting or the bank
+// @Tags           payroll
+// @Produce        text/csv
+// @Param          id path string true "Payroll Run ID"
+// @Param          staffType query string false "Staff type"
+// @Success        200 {file} file
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) ExportPayrollRun(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.PayrollRun
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.PayrollService().GetRun(c.Request.Context(), &schedule_service.GetPayrollRunRequest{Id: id, StaffType: c.Query("staffType")})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "payroll-"+resp.Period+".csv"))
+	c.Status(http.StatusOK)
+	c.Writer.Header().Set("Content-Type", "text/csv; charset=utf-8")
+
+	w := csv.NewWriter(c.Writer)
+	w.Write([]string{"staffType", "staffId", "staffName", "branchId", "monthlyFee", "lessonCount", "perLesson", "lessonAmount",
+		"studentCount", "perStudent", "studentAmount", "bonuses", "penalties", "commission", "total"})
+	for _, line := range resp.Lines {
+		w.Write([]string{line.StaffType, line.StaffId, line.StaffName, line.BranchId, line.MonthlyFee, strconv.Itoa(int(line.LessonCount)), line.PerLesson,
+			line.LessonAmount, strconv.Itoa(int(line.StudentCount)), line.PerStudent, line.StudentAmount, line.Bonuses, line.Penalties, line.Commission, line.Total})
+	}
+	w.Write([]string{"", "", "", "", "", "", "", "", "", "", "", "", "", "total", resp.Total})
+	w.Flush()
+}
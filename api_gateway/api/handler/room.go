@@ -0,0 +1,276 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user_api_gateway/genproto/schedule_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Security ApiKeyAuth
+// @Router        /CreateRoom [post]
+// @Summary       Create room
+// @Description   API for adding a classroom to a branch. capacity is the number of seats; openTime and closeTime ("15:04") default to 08:00 and 21:00. Lessons and events given the room must fit in its seats and hours and cannot overlap.
+// @Tags          room
+// @Accept        json
+// @Produce       json
+// @Param         room body schedule_service.CreateRoom true "Room"
+// @Success       200 {object} schedule_service.Room
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreateRoom(c *gin.Context) {
+	var (
+		req  schedule_service.CreateRoom
+		resp *schedule_service.Room
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.RoomService().Create(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create room")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListRoom [GET]
+// @Summary        Get list of rooms
+// @Description    API for getting rooms by name
+// @Tags           room
+// @Accept         json
+// @Produce        json
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Param          search query string false "Search by name"
+// @Param          branchId query string false "Branch ID"
+// @Success        200 {object} schedule_service.GetListRoomResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListRoom(c *gin.Context) {
+	var (
+		resp *schedule_service.GetListRoomResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req := &schedule_service.GetListRoomRequest{
+		Page:     page,
+		Limit:    limit,
+		Search:   c.Query("search"),
+		BranchId: c.Query("branchId"),
+	}
+
+	resp, err = h.grpcClient.RoomService().GetList(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetByIdRoom/{id} [GET]
+// @Summary        Get room
+// @Description    API for getting a room by ID
+// @Tags           room
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Room ID"
+// @Success        200 {object} schedule_service.Room
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetRoomByID(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.Room
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	resp, err = h.grpcClient.RoomService().GetByID(c.Request.Context(), &schedule_service.RoomPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router          /UpdateRoom/{id} [PUT]
+// @Summary         Update room
+// @Description     API for updating a room by ID. Empty openTime or closeTime keep the current hours; the change is rejected while upcoming lessons or events would no longer fit.
+// @Tags            room
+// @Accept          json
+// @Produce         json
+// @Param           id path string true "Room ID"
+// @Param           room body schedule_service.UpdateRoom true "Room"
+// @Success         200 {object} schedule_service.Room
+// @Failure         404 {object} models.ResponseError
+// @Failure         500 {object} models.ResponseError
+func (h *handler) UpdateRoom(c *gin.Context) {
+	var (
+		req  schedule_service.UpdateRoom
+		resp *schedule_service.Room
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.Id = c.Param("id")
+
+	resp, err = h.grpcClient.RoomService().Update(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to update room")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeleteRoom/{id} [DELETE]
+// @Summary       Delete room
+// @Description   API for deleting a room by ID. Rooms with upcoming lessons or events cannot be deleted.
+// @Tags          room
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Room ID"
+// @Success       200 {object} schedule_service.EmptyRoom
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeleteRoom(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.EmptyRoom
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager or an Admin")
+		return
+	}
+
+	resp, err = h.grpcClient.RoomService().Delete(c.Request.Context(), &schedule_service.RoomPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetRoomUtilization [GET]
+// @Summary        Room utilization report
+// @Description    API for the share of each room's opening hours that was booked by lessons and events in a week, and how full those bookings were. weekStart may be any day of the week and defaults to the current week.
+// @Tags           room
+// @Accept         json
+// @Produce        json
+// @Param          branchId query string true "Branch ID"
+// @Param          weekStart query string false "Day of the week (2006-01-02)"
+// @Success        200 {object} schedule_service.RoomUtilizationReport
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetRoomUtilization(c *gin.Context) {
+	var (
+		resp *schedule_service.RoomUtilizationReport
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	req := &schedule_service.RoomUtilizationRequest{
+		BranchId:  c.Query("branchId"),
+		WeekStart: c.Query("weekStart"),
+	}
+
+	resp, err = h.grpcClient.RoomService().GetUtilization(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
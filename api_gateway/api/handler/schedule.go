@@ -13,7 +13,7 @@ import (
 // @Security ApiKeyAuth
 // @Router           /CreateSchedule [post]
 // @Summary          Create schedule
-// @Description      API for creating schedule
+// @Description      API for creating schedule. A lesson given a roomId must fit in the room's seats and hours and cannot overlap its other bookings.
 // @Tags             schedule
 // @Accept           json
 // @Produce          json
@@ -154,7 +154,7 @@ func (h *handler) GetListSchedule(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Router         /UpdateSchedule/{id} [put]
 // @Summary        Update a schedule by ID
-// @Description    API for updating a schedule by ID
+// @Description    API for updating a schedule by ID. A lesson given a roomId must fit in the room's seats and hours and cannot overlap its other bookings.
 // @Tags           schedule
 // @Accept         json
 // @Produce        json
@@ -246,6 +246,7 @@ func (h *handler) DeleteSchedule(c *gin.Context) {
 // @Tags           schedule
 // @Accept         json
 // @Produce        json
+// @Param          teacherId query string false "Teacher ID, ignored for teachers who see their own schedule"
 // @Param          weekStartDate query string true "Week Start Date"
 // @Param          weekEndDate query string true "Week End Date"
 // @Success        200 {object} schedule_service.GetListScheduleResponse
@@ -276,7 +277,13 @@ func (h *handler) GetScheduleForWeek(c *gin.Context) {
 		return
 	}
 
+	teacherId := c.Query("teacherId")
+	if data.UserRole == "Teacher" {
+		teacherId = data.UserID
+	}
+
 	req := &schedule_service.GetScheduleForWeekRequest{
+		TeacherId:     teacherId,
 		WeekStartDate: weekStartDate,
 		WeekEndDate:   weekEndDate,
 	}
@@ -297,6 +304,7 @@ func (h *handler) GetScheduleForWeek(c *gin.Context) {
 // @Tags           schedule
 // @Accept         json
 // @Produce        json
+// @Param          teacherId query string false "Teacher ID, ignored for teachers who see their own schedule"
 // @Param          monthStartDate query string true "Month Start Date"
 // @Param          monthEndDate query string true "Month End Date"
 // @Success        200 {object} schedule_service.GetListScheduleResponse
@@ -327,7 +335,13 @@ func (h *handler) GetScheduleForMonth(c *gin.Context) {
 		return
 	}
 
+	teacherId := c.Query("teacherId")
+	if data.UserRole == "Teacher" {
+		teacherId = data.UserID
+	}
+
 	req := &schedule_service.GetScheduleForMonthRequest{
+		TeacherId:      teacherId,
 		MonthStartDate: monthStartDate,
 		MonthEndDate:   monthEndDate,
 	}
@@ -340,3 +354,139 @@ func (h *handler) GetScheduleForMonth(c *gin.Context) {
 
 	c.JSON(http.StatusOK, resp)
 }
+
+// @Security ApiKeyAuth
+// @Router           /MarkAttendance [post]
+// @Summary          Mark lesson attendance
+// @Description      API for marking whether a student attended a lesson
+// @Tags             schedule
+// @Accept           json
+// @Produce          json
+// @Param            attendance body schedule_service.MarkAttendanceRequest true "Attendance"
+// @Success          200 {object} schedule_service.LessonAttendance
+// @Failure          404 {object} models.ResponseError
+// @Failure          500 {object} models.ResponseError
+func (h *handler) MarkAttendance(c *gin.Context) {
+	var (
+		req  schedule_service.MarkAttendanceRequest
+		resp *schedule_service.LessonAttendance
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" && data.UserRole != "Teacher" && data.UserRole != "SupportTeacher" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to mark attendance")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.ScheduleService().MarkAttendance(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to mark attendance")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router           /AssignSubstitute [post]
+// @Summary          Assign substitute teacher
+// @Description      API for assigning a substitute teacher to a single lesson. An empty teacherId removes the substitute.
+// @Tags             schedule
+// @Accept           json
+// @Produce          json
+// @Param            substitute body schedule_service.AssignSubstituteRequest true "Substitute"
+// @Success          200 {object} schedule_service.GetSchedule
+// @Failure          404 {object} models.ResponseError
+// @Failure          500 {object} models.ResponseError
+func (h *handler) AssignSubstitute(c *gin.Context) {
+	var (
+		req  schedule_service.AssignSubstituteRequest
+		resp *schedule_service.GetSchedule
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.ScheduleService().AssignSubstitute(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to assign substitute teacher")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetTeacherLessonCount [get]
+// @Summary        Get delivered lesson count of a teacher
+// @Description    API for counting lessons a teacher actually taught in a period, including lessons covered as a substitute
+// @Tags           schedule
+// @Accept         json
+// @Produce        json
+// @Param          teacherId query string true "Teacher ID"
+// @Param          fromDate query string true "From Date"
+// @Param          toDate query string true "To Date"
+// @Success        200 {object} schedule_service.TeacherLessonCount
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetTeacherLessonCount(c *gin.Context) {
+	var (
+		resp *schedule_service.TeacherLessonCount
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager")
+		return
+	}
+
+	req := &schedule_service.TeacherLessonCountRequest{
+		TeacherId: c.Query("teacherId"),
+		FromDate:  c.Query("fromDate"),
+		ToDate:    c.Query("toDate"),
+	}
+
+	if req.TeacherId == "" || req.FromDate == "" || req.ToDate == "" {
+		handleGrpcErrWithDescription(c, h.log, fmt.Errorf("missing required parameters"), "missing required parameters")
+		return
+	}
+
+	resp, err = h.grpcClient.ScheduleService().GetTeacherLessonCount(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to count teacher lessons")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -14,7 +14,7 @@ import (
 // @Security ApiKeyAuth
 // @Router        /CreateStudent [post]
 // @Summary       Create student
-// @Description   API for creating student
+// @Description   API for creating student. A groupName must name a running group of the branch with a free seat; the student is created and enrolled in it together.
 // @Tags          student
 // @Accept        json
 // @Produce       json
@@ -45,7 +45,13 @@ func (h *handler) CreateStudent(c *gin.Context) {
 		return
 	}
 
-	if err := helpers.ValidatePhone(req.Phone); err != nil {
+	setting, err := h.branchSetting(c.Request.Context(), req.BranchId)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting branch settings")
+		return
+	}
+
+	if err := helpers.ValidatePhone(req.Phone, setting.PhonePattern); err != nil {
 		handleGrpcErrWithDescription(c, h.log, err, "input valid phone number"+req.Phone)
 		return
 	}
@@ -168,7 +174,7 @@ func (h *handler) GetStudentByID(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Router          /UpdateStudent/{id} [PUT]
 // @Summary         Update a student by ID
-// @Description     API for updating a student by ID
+// @Description     API for updating a student by ID. groupName follows group membership and is not changed here; use the group student APIs.
 // @Tags            student
 // @Accept          json
 // @Produce         json
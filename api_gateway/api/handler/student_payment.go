@@ -12,7 +12,7 @@ import (
 // @Security ApiKeyAuth
 // @Router        /CreateStudentPayment [post]
 // @Summary       Create student payment
-// @Description   API for creating student payment
+// @Description   API for recording a student payment. The amount is a decimal string such as "450000.00". Payments cannot be changed afterwards, only reversed or refunded. paymentMethod is cash (default), card or transfer; administrators need an open cash shift.
 // @Tags          student_payment
 // @Accept        json
 // @Produce       json
@@ -43,6 +43,10 @@ func (h *handler) CreateStudentPayment(c *gin.Context) {
 		return
 	}
 
+	if data.UserRole == "Administration" {
+		req.AdministrationId = data.UserID
+	}
+
 	resp, err = h.grpcClient.StudentPaymentService().Create(c.Request.Context(), &req)
 	if err != nil {
 		handleGrpcErrWithDescription(c, h.log, err, "failed to create student payment")
@@ -96,11 +100,15 @@ func (h *handler) GetStudentPaymentByID(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Router         /GetListStudentPayment [get]
 // @Summary        Get list of student payments
-// @Description    API for getting list of student payments
+// @Description    API for getting the payment ledger in the order entries were recorded, each with the running balance of its student
 // @Tags           student_payment
 // @Accept 		   json
 // @Produce        json
 // @Param          search query string false "Search"
+// @Param          studentId query string false "Student ID"
+// @Param          groupId query string false "Group ID"
+// @Param          entryType query string false "payment, reversal or refund"
+// @Param          shiftId query string false "Cash Shift ID"
 // @Param          page query int false "Page"
 // @Param          limit query int false "Limit"
 // @Success        200 {object} schedule_service.GetListStudentPaymentResponse
@@ -125,6 +133,10 @@ func (h *handler) GetListStudentPayment(c *gin.Context) {
 	}
 
 	req.Search = c.Query("search")
+	req.StudentId = c.Query("studentId")
+	req.GroupId = c.Query("groupId")
+	req.EntryType = c.Query("entryType")
+	req.ShiftId = c.Query("shiftId")
 
 	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
 	if err != nil {
@@ -151,25 +163,25 @@ func (h *handler) GetListStudentPayment(c *gin.Context) {
 }
 
 // @Security ApiKeyAuth
-// @Router          /UpdateStudentPayment/{id} [put]
-// @Summary         Update a student payment by ID
-// @Description     API for updating a student payment by ID
+// @Router          /ReverseStudentPayment/{id} [post]
+// @Summary         Reverse a student payment
+// @Description     API for cancelling a payment recorded by mistake. Payments are never changed; a negative reversal entry linked to the payment is added instead. A reason is required.
 // @Tags            student_payment
 // @Accept          json
 // @Produce         json
 // @Param           id path string true "Student Payment ID"
-// @Param           student_payment body schedule_service.UpdateStudentPayment true "Student Payment"
+// @Param           reversal body schedule_service.ReverseStudentPayment true "Reversal"
 // @Success         200 {object} schedule_service.GetStudentPayment
 // @Failure         404 {object} models.ResponseError
 // @Failure         500 {object} models.ResponseError
-func (h *handler) UpdateStudentPayment(c *gin.Context) {
+func (h *handler) ReverseStudentPayment(c *gin.Context) {
 	var (
 		id   = c.Param("id")
-		req  schedule_service.UpdateStudentPayment
+		req  schedule_service.ReverseStudentPayment
 		resp *schedule_service.GetStudentPayment
 		err  error
 	)
-	
+
 	data, err := getAuthInfo(c)
 	if err != nil {
 		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
@@ -187,9 +199,13 @@ func (h *handler) UpdateStudentPayment(c *gin.Context) {
 	}
 
 	req.Id = id
-	resp, err = h.grpcClient.StudentPaymentService().Update(c.Request.Context(), &req)
+	if data.UserRole == "Administration" {
+		req.AdministrationId = data.UserID
+	}
+
+	resp, err = h.grpcClient.StudentPaymentService().Reverse(c.Request.Context(), &req)
 	if err != nil {
-		handleGrpcErrWithDescription(c, h.log, err, "failed to update student payment")
+		handleGrpcErrWithDescription(c, h.log, err, "failed to reverse student payment")
 		return
 	}
 
@@ -197,20 +213,22 @@ func (h *handler) UpdateStudentPayment(c *gin.Context) {
 }
 
 // @Security ApiKeyAuth
-// @Router          /DeleteStudentPayment/{id} [delete]
-// @Summary         Delete a student payment by ID
-// @Description     API for deleting a student payment by ID
+// @Router          /RefundStudentPayment/{id} [post]
+// @Summary         Refund a student payment
+// @Description     API for paying back all or part of a payment. The refund is a negative entry linked to the payment, approved by the manager or super admin making the request. A reason is required.
 // @Tags            student_payment
 // @Accept          json
 // @Produce         json
 // @Param           id path string true "Student Payment ID"
-// @Success         200 {object} schedule_service.EmptyStudentPayment
+// @Param           refund body schedule_service.RefundStudentPayment true "Refund"
+// @Success         200 {object} schedule_service.GetStudentPayment
 // @Failure         404 {object} models.ResponseError
 // @Failure         500 {object} models.ResponseError
-func (h *handler) DeleteStudentPayment(c *gin.Context) {
+func (h *handler) RefundStudentPayment(c *gin.Context) {
 	var (
 		id   = c.Param("id")
-		resp = &schedule_service.EmptyStudentPayment{}
+		req  schedule_service.RefundStudentPayment
+		resp *schedule_service.GetStudentPayment
 		err  error
 	)
 
@@ -221,17 +239,21 @@ func (h *handler) DeleteStudentPayment(c *gin.Context) {
 	}
 
 	if data.UserRole != "Manager" && data.UserRole != "SuperAdmin" {
-		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager")
 		return
 	}
 
-	req := &schedule_service.StudentPaymentPrimaryKey{
-		Id: id,
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
 	}
 
-	resp, err = h.grpcClient.StudentPaymentService().Delete(c.Request.Context(), req)
+	req.Id = id
+	req.ApprovedBy = data.UserID
+
+	resp, err = h.grpcClient.StudentPaymentService().Refund(c.Request.Context(), &req)
 	if err != nil {
-		handleGrpcErrWithDescription(c, h.log, err, "failed to delete student payment")
+		handleGrpcErrWithDescription(c, h.log, err, "failed to refund student payment")
 		return
 	}
 
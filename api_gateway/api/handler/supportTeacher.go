@@ -14,7 +14,7 @@ import (
 // @Security ApiKeyAuth
 // @Router        /CreateSupportTeacher [post]
 // @Summary       Create support teacher
-// @Description   API for creating support teacher
+// @Description   API for creating support teacher. ieltsScore and ieltsAttemptCount are derived from the IELTS results and ignored here.
 // @Tags          support_teacher
 // @Accept        json
 // @Produce       json
@@ -45,7 +45,13 @@ func (h *handler) CreateSupportTeacher(c *gin.Context) {
 		return
 	}
 
-	if err := helpers.ValidatePhone(req.Phone); err != nil {
+	setting, err := h.branchSetting(c.Request.Context(), req.BranchId)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting branch settings")
+		return
+	}
+
+	if err := helpers.ValidatePhone(req.Phone, setting.PhonePattern); err != nil {
 		handleGrpcErrWithDescription(c, h.log, err, "error while validating phone number"+req.Phone)
 		return
 	}
@@ -168,7 +174,7 @@ func (h *handler) GetSupportTeacherByID(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Router          /UpdateSupportTeacher/{id} [PUT]
 // @Summary         Update a support teacher by ID
-// @Description     API for updating a support teacher by ID
+// @Description     API for updating a support teacher by ID. ieltsScore and ieltsAttemptCount are derived from the IELTS results and ignored here.
 // @Tags            support_teacher
 // @Accept          json
 // @Produce         json
@@ -256,7 +262,7 @@ func (h *handler) DeleteSupportTeacher(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Router         /SupportTeacherReportList [get]
 // @Summary        Get List of Support Teachers
-// @Description    API for getting a list of support teachers
+// @Description    API for getting a list of support teachers with totalsum, what locked payroll runs paid them
 // @Tags           report
 // @Accept         json
 // @Produce        json
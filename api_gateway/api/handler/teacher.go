@@ -14,7 +14,7 @@ import (
 // @Security ApiKeyAuth
 // @Router        /CreateTeacher [post]
 // @Summary       Create teacher
-// @Description   API for creating teacher
+// @Description   API for creating teacher. ieltsScore and ieltsAttemptCount are derived from the IELTS results and ignored here.
 // @Tags          teacher
 // @Accept        json
 // @Produce       json
@@ -45,7 +45,13 @@ func (h *handler) CreateTeacher(c *gin.Context) {
 		return
 	}
 
-	if err := helpers.ValidatePhone(req.Phone); err != nil {
+	setting, err := h.branchSetting(c.Request.Context(), req.BranchId)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting branch settings")
+		return
+	}
+
+	if err := helpers.ValidatePhone(req.Phone, setting.PhonePattern); err != nil {
 		handleGrpcErrWithDescription(c, h.log, err, "error while validating phone number"+req.Phone)
 		return
 	}
@@ -168,7 +174,7 @@ func (h *handler) GetTeacherByID(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Router          /UpdateTeacher/{id} [PUT]
 // @Summary         Update a teacher by ID
-// @Description     API for updating a teacher by ID
+// @Description     API for updating a teacher by ID. ieltsScore and ieltsAttemptCount are derived from the IELTS results and ignored here.
 // @Tags            teacher
 // @Accept          json
 // @Produce         json
@@ -256,7 +262,7 @@ func (h *handler) DeleteTeacher(c *gin.Context) {
 // @Security ApiKeyAuth
 // @Router        /TeacherReportList [get]
 // @Summary       Get List of Teachers
-// @Description   API for getting a list of teachers
+// @Description   API for getting a list of teachers with totalsum, what locked payroll runs paid them
 // @Tags          report
 // @Accept        json
 // @Produce       json
@@ -0,0 +1,357 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"user_api_gateway/genproto/schedule_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Security ApiKeyAuth
+// @Router         /CreateAvailability [post]
+// @Summary        Create availability window
+// @Description    API for adding a weekly availability window of a teacher or a support teacher. Teachers and support teachers can only add their own windows.
+// @Tags           timetable
+// @Accept         json
+// @Produce        json
+// @Param          availability body schedule_service.CreateTeacherAvailability true "Availability"
+// @Success        200 {object} schedule_service.TeacherAvailability
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) CreateAvailability(c *gin.Context) {
+	var (
+		req  schedule_service.CreateTeacherAvailability
+		resp *schedule_service.TeacherAvailability
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" && data.UserRole != "Teacher" && data.UserRole != "SupportTeacher" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to manage availability")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	switch data.UserRole {
+	case "Teacher":
+		req.TeacherId, req.SupportTeacherId = data.UserID, ""
+	case "SupportTeacher":
+		req.TeacherId, req.SupportTeacherId = "", data.UserID
+	}
+
+	resp, err = h.grpcClient.TimetableService().CreateAvailability(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create availability")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListAvailability [GET]
+// @Summary        Get list of availability windows
+// @Description    API for getting availability windows by teacher, support teacher or branch
+// @Tags           timetable
+// @Accept         json
+// @Produce        json
+// @Param          teacherId query string false "Teacher ID"
+// @Param          supportTeacherId query string false "Support Teacher ID"
+// @Param          branchId query string false "Branch ID"
+// @Success        200 {object} schedule_service.GetListAvailabilityResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListAvailability(c *gin.Context) {
+	var (
+		resp *schedule_service.GetListAvailabilityResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" && data.UserRole != "Teacher" && data.UserRole != "SupportTeacher" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to view availability")
+		return
+	}
+
+	req := &schedule_service.GetListAvailabilityRequest{
+		TeacherId:        c.Query("teacherId"),
+		SupportTeacherId: c.Query("supportTeacherId"),
+		BranchId:         c.Query("branchId"),
+	}
+
+	resp, err = h.grpcClient.TimetableService().GetListAvailability(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router       /DeleteAvailability/{id} [DELETE]
+// @Summary      Delete an availability window by ID
+// @Description  API for deleting an availability window by ID
+// @Tags         timetable
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Availability ID"
+// @Success      200 {object} schedule_service.EmptyTimetable
+// @Failure      404 {object} models.ResponseError
+// @Failure      500 {object} models.ResponseError
+func (h *handler) DeleteAvailability(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		err  error
+		resp = &schedule_service.EmptyTimetable{}
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req := &schedule_service.TeacherAvailabilityPrimaryKey{
+		Id: id,
+	}
+
+	resp, err = h.grpcClient.TimetableService().DeleteAvailability(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /SetLessonRequirement [post]
+// @Summary        Set group lesson requirement
+// @Description    API for setting how many lessons per week and how long a group needs
+// @Tags           timetable
+// @Accept         json
+// @Produce        json
+// @Param          requirement body schedule_service.LessonRequirement true "Lesson Requirement"
+// @Success        200 {object} schedule_service.LessonRequirement
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) SetLessonRequirement(c *gin.Context) {
+	var (
+		req  schedule_service.LessonRequirement
+		resp *schedule_service.LessonRequirement
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.TimetableService().SetLessonRequirement(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to set lesson requirement")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListLessonRequirement [GET]
+// @Summary        Get lesson requirements of a branch
+// @Description    API for getting lesson requirements of the active groups of a branch
+// @Tags           timetable
+// @Accept         json
+// @Produce        json
+// @Param          branchId query string true "Branch ID"
+// @Success        200 {object} schedule_service.GetListLessonRequirementResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListLessonRequirement(c *gin.Context) {
+	var (
+		resp *schedule_service.GetListLessonRequirementResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req := &schedule_service.GetListLessonRequirementRequest{
+		BranchId: c.Query("branchId"),
+	}
+
+	resp, err = h.grpcClient.TimetableService().GetListLessonRequirement(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /ProposeTimetable [post]
+// @Summary        Propose weekly timetable
+// @Description    API for proposing a conflict-free weekly timetable for a branch. No more lessons overlap than the branch has rooms. The proposal is saved as a draft.
+// @Tags           timetable
+// @Accept         json
+// @Produce        json
+// @Param          proposal body schedule_service.ProposeTimetableRequest true "Proposal"
+// @Success        200 {object} schedule_service.TimetableProposal
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) ProposeTimetable(c *gin.Context) {
+	var (
+		req  schedule_service.ProposeTimetableRequest
+		resp *schedule_service.TimetableProposal
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.TimetableService().ProposeTimetable(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to propose timetable")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetTimetableProposal/{id} [GET]
+// @Summary        Get a timetable proposal by ID
+// @Description    API for getting a timetable proposal with its lessons and unscheduled groups
+// @Tags           timetable
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Proposal ID"
+// @Success        200 {object} schedule_service.TimetableProposal
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetTimetableProposal(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.TimetableProposal
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req := &schedule_service.TimetableProposalPrimaryKey{
+		Id: id,
+	}
+
+	resp, err = h.grpcClient.TimetableService().GetProposal(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /CommitTimetable/{id} [post]
+// @Summary        Commit a timetable proposal
+// @Description    API for saving a proposal as recurring lessons and creating the future lessons of open journals in the room each group already uses. The commit fails when a room is taken at a new lesson time.
+// @Tags           timetable
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Proposal ID"
+// @Success        200 {object} schedule_service.CommitTimetableResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) CommitTimetable(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.CommitTimetableResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager")
+		return
+	}
+
+	req := &schedule_service.TimetableProposalPrimaryKey{
+		Id: id,
+	}
+
+	resp, err = h.grpcClient.TimetableService().CommitTimetable(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to commit timetable")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
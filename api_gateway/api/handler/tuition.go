@@ -0,0 +1,327 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"user_api_gateway/genproto/schedule_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Security ApiKeyAuth
+// @Router        /CreateTuitionPlan [post]
+// @Summary       Create tuition plan
+// @Description   API for setting the monthly fee of a group type in a branch from validFrom on. The fee is a decimal string such as "450000.00".
+// @Tags          tuition
+// @Accept        json
+// @Produce       json
+// @Param         plan body schedule_service.CreateTuitionPlan true "Tuition Plan"
+// @Success       200 {object} schedule_service.TuitionPlan
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) CreateTuitionPlan(c *gin.Context) {
+	var (
+		req  schedule_service.CreateTuitionPlan
+		resp *schedule_service.TuitionPlan
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.TuitionService().CreatePlan(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to create tuition plan")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListTuitionPlan [GET]
+// @Summary        Get list of tuition plans
+// @Description    API for getting tuition plans by branch and group type, newest validFrom first
+// @Tags           tuition
+// @Accept         json
+// @Produce        json
+// @Param          branchId query string false "Branch ID"
+// @Param          groupType query string false "Group type"
+// @Success        200 {object} schedule_service.GetListTuitionPlanResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListTuitionPlan(c *gin.Context) {
+	var (
+		resp *schedule_service.GetListTuitionPlanResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req := &schedule_service.GetListTuitionPlanRequest{
+		BranchId:  c.Query("branchId"),
+		GroupType: c.Query("groupType"),
+	}
+
+	resp, err = h.grpcClient.TuitionService().GetListPlan(c.Request.Context(), req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /UpdateTuitionPlan/{id} [PUT]
+// @Summary       Update a tuition plan
+// @Description   API for changing the fee or the start of a tuition plan. Invoices already issued keep their amount.
+// @Tags          tuition
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Tuition Plan ID"
+// @Param         plan body schedule_service.UpdateTuitionPlan true "Tuition Plan"
+// @Success       200 {object} schedule_service.TuitionPlan
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) UpdateTuitionPlan(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		req  schedule_service.UpdateTuitionPlan
+		resp *schedule_service.TuitionPlan
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	req.Id = id
+	resp, err = h.grpcClient.TuitionService().UpdatePlan(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /DeleteTuitionPlan/{id} [DELETE]
+// @Summary       Delete a tuition plan
+// @Description   API for deleting a tuition plan. Invoices already issued are kept.
+// @Tags          tuition
+// @Accept        json
+// @Produce       json
+// @Param         id path string true "Tuition Plan ID"
+// @Success       200 {object} schedule_service.EmptyTuition
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) DeleteTuitionPlan(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.EmptyTuition
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	resp, err = h.grpcClient.TuitionService().DeletePlan(c.Request.Context(), &schedule_service.TuitionPlanPrimaryKey{Id: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router        /GenerateInvoices [post]
+// @Summary       Generate monthly invoices
+// @Description   API for issuing the invoices of a month (YYYY-MM, current month when empty) to every student of a group. Running it again only adds the missing invoices. Groups whose type has no plan are returned.
+// @Tags          tuition
+// @Accept        json
+// @Produce       json
+// @Param         request body schedule_service.GenerateInvoicesRequest true "Period"
+// @Success       200 {object} schedule_service.GenerateInvoicesResponse
+// @Failure       404 {object} models.ResponseError
+// @Failure       500 {object} models.ResponseError
+func (h *handler) GenerateInvoices(c *gin.Context) {
+	var (
+		req  schedule_service.GenerateInvoicesRequest
+		resp *schedule_service.GenerateInvoicesResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a Manager")
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "invalid request body")
+		return
+	}
+
+	resp, err = h.grpcClient.TuitionService().GenerateInvoices(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "failed to generate invoices")
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetListInvoice [GET]
+// @Summary        Get list of invoices
+// @Description    API for getting invoices by student, group or month (YYYY-MM)
+// @Tags           tuition
+// @Accept         json
+// @Produce        json
+// @Param          studentId query string false "Student ID"
+// @Param          groupId query string false "Group ID"
+// @Param          period query string false "Month"
+// @Param          page query int false "Page"
+// @Param          limit query int false "Limit"
+// @Success        200 {object} schedule_service.GetListInvoiceResponse
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetListInvoice(c *gin.Context) {
+	var (
+		req  schedule_service.GetListInvoiceRequest
+		resp *schedule_service.GetListInvoiceResponse
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	if data.UserRole != "SuperAdmin" && data.UserRole != "Manager" && data.UserRole != "Administration" {
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not a SuperAdmin or a manager or an admin")
+		return
+	}
+
+	req.StudentId = c.Query("studentId")
+	req.GroupId = c.Query("groupId")
+	req.Period = c.Query("period")
+
+	page, err := strconv.ParseUint(c.DefaultQuery("page", "1"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing page")
+		return
+	}
+
+	limit, err := strconv.ParseUint(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while parsing limit")
+		return
+	}
+
+	req.Page = page
+	req.Limit = limit
+
+	resp, err = h.grpcClient.TuitionService().GetListInvoice(c.Request.Context(), &req)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Security ApiKeyAuth
+// @Router         /GetStudentBalance/{id} [GET]
+// @Summary        Get balance of a student
+// @Description    API for getting what a student was invoiced, what they paid and what they still owe. Students can only see their own balance.
+// @Tags           tuition
+// @Accept         json
+// @Produce        json
+// @Param          id path string true "Student ID"
+// @Success        200 {object} schedule_service.StudentBalance
+// @Failure        404 {object} models.ResponseError
+// @Failure        500 {object} models.ResponseError
+func (h *handler) GetStudentBalance(c *gin.Context) {
+	var (
+		id   = c.Param("id")
+		resp *schedule_service.StudentBalance
+		err  error
+	)
+
+	data, err := getAuthInfo(c)
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "error while getting auth")
+		return
+	}
+
+	switch data.UserRole {
+	case "SuperAdmin", "Manager", "Administration":
+	case "Student":
+		if id != data.UserID {
+			handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You can only see your own balance")
+			return
+		}
+	default:
+		handleGrpcErrWithDescription(c, h.log, errors.New("Unauthorized"), "You are not allowed to see balances")
+		return
+	}
+
+	resp, err = h.grpcClient.TuitionService().GetStudentBalance(c.Request.Context(), &schedule_service.StudentBalanceRequest{StudentId: id})
+	if err != nil {
+		handleGrpcErrWithDescription(c, h.log, err, "internal server error")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -9,8 +9,12 @@ import (
 	validation "github.com/go-ozzo/ozzo-validation/v3"
 )
 
-func ValidatePhone(phone string) error {
-	re := regexp.MustCompile(`^[+][9][9][8]\d{9}$`)
+// ValidatePhone checks the phone number against the branch's phone pattern.
+func ValidatePhone(phone, pattern string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid phone pattern: %v", err)
+	}
 	if !re.MatchString(phone) {
 		return errors.New("invalid phone number: " + phone)
 	}
@@ -80,37 +84,24 @@ func ValidateUsername(username string) error {
 // 	return nil
 // }
 
-// CheckEventRegistration checks if there are at least 3 hours remaining until the event
-func CheckEventRegistration(eventTime time.Time) error {
-
-	now := time.Now()
-
-	// Combine the date part of 'now' with the time part of 'eventTime'
-	eventDateTime := time.Date(now.Year(), now.Month(), now.Day(), eventTime.Hour(), eventTime.Minute(), eventTime.Second(), 0, now.Location())
-
-	// If the event time for today has already passed, assume it's for the next day
-	if eventDateTime.Before(now) {
-		eventDateTime = eventDateTime.Add(24 * time.Hour)
-	}
-
-	remainingTime := eventDateTime.Sub(now)
-
-	if remainingTime < 3*time.Hour {
-		return fmt.Errorf("error: less than 3 hours remaining until the event")
-	}
-
-	return nil
-}
-
-func IsSunday(date string) error {
+// IsAllowedWeekday checks that the date falls on one of the ISO weekdays
+// (1 = Monday, 7 = Sunday) the branch holds events on.
+func IsAllowedWeekday(date string, weekdays []int32) error {
 	layout := "2006-01-02"
 	t, err := time.Parse(layout, date)
 
 	if err != nil {
 		return err
 	}
-	if t.Weekday() != time.Sunday {
-		return fmt.Errorf("date is not Sunday")
+
+	weekday := int32(t.Weekday())
+	if weekday == 0 {
+		weekday = 7
+	}
+	for _, allowed := range weekdays {
+		if allowed == weekday {
+			return nil
+		}
 	}
-	return err
+	return fmt.Errorf("events are not held on %s in this branch", t.Weekday())
 }
@@ -64,6 +64,13 @@ func New(cnf Config) *gin.Engine {
 	r.PUT("/UpdateBranch/:id", handler.UpdateBranch)
 	r.DELETE("/DeleteBranch/:id", handler.DeleteBranch)
 
+	// BranchSetting
+	r.POST("/CreateBranchSetting", handler.CreateBranchSetting)
+	r.GET("/GetListBranchSetting", handler.GetListBranchSetting)
+	r.GET("/GetByIdBranchSetting/:id", handler.GetBranchSettingByID)
+	r.PUT("/UpdateBranchSetting/:id", handler.UpdateBranchSetting)
+	r.DELETE("/DeleteBranchSetting/:id", handler.DeleteBranchSetting)
+
 	// Manager
 	r.POST("/CreateManager", handler.CreateManager)
 	r.GET("/GetListManager", handler.GetListManager)
@@ -99,15 +106,168 @@ func New(cnf Config) *gin.Engine {
 	r.POST("/LoginSupportTeacher", handler.SupportTeacherLogin)
 	r.POST("/LoginTeacher", handler.TeacherLogin)
 	r.POST("/LoginSuperAdmin", handler.SuperAdminLogin)
+	r.POST("/LoginParent", handler.ParentLogin)
 
 	// EventStudent
 	r.POST("/CreateEventStudent", handler.CreateEventStudent)
 	r.GET("/GetListEventStudent", handler.GetListEventStudent)
 	r.GET("/GetByIdEventStudent/:id", handler.GetEventStudentByID)
-	r.PUT("/UpdateEventStudent/:id", handler.UpdateEventStudent)
 	r.DELETE("/DeleteEventStudent/:id", handler.DeleteEventStudent)
+	r.POST("/CancelEventStudent/:id", handler.CancelEventStudent)
+	r.GET("/GetEventCheckInQR/:id", handler.GetEventCheckInQR)
+	r.POST("/CheckInEventStudent", handler.CheckInEventStudent)
+	r.GET("/GetEventAttendance/:id", handler.GetEventAttendance)
+
+	// EventFeedback
+	r.POST("/CreateEventFeedback", handler.CreateEventFeedback)
+	r.GET("/GetListEventFeedback", handler.GetListEventFeedback)
+	r.GET("/GetEventRating/:id", handler.GetEventRating)
+	r.GET("/GetTopicRatings", handler.GetTopicRatings)
+	r.GET("/GetLowRatedEvents", handler.GetLowRatedEvents)
 	r.GET("/EventStudent/:id", handler.GetStudentWithEventsByID)
 
+	// Tuition
+	r.POST("/CreateTuitionPlan", handler.CreateTuitionPlan)
+	r.GET("/GetListTuitionPlan", handler.GetListTuitionPlan)
+	r.PUT("/UpdateTuitionPlan/:id", handler.UpdateTuitionPlan)
+	r.DELETE("/DeleteTuitionPlan/:id", handler.DeleteTuitionPlan)
+	r.POST("/GenerateInvoices", handler.GenerateInvoices)
+	r.GET("/GetListInvoice", handler.GetListInvoice)
+	r.GET("/GetStudentBalance/:id", handler.GetStudentBalance)
+
+	// Discount
+	r.POST("/CreateDiscount", handler.CreateDiscount)
+	r.GET("/GetListDiscount", handler.GetListDiscount)
+	r.DELETE("/DeleteDiscount/:id", handler.DeleteDiscount)
+	r.POST("/CreatePromoCode", handler.CreatePromoCode)
+	r.GET("/GetListPromoCode", handler.GetListPromoCode)
+	r.DELETE("/DeletePromoCode/:id", handler.DeletePromoCode)
+	r.POST("/RedeemPromoCode", handler.RedeemPromoCode)
+	r.GET("/GetDiscountReport", handler.GetDiscountReport)
+
+	// Overdue
+	r.GET("/GetListOverdue", handler.GetListOverdue)
+	r.POST("/CreateStudentHold", handler.CreateStudentHold)
+	r.POST("/ClearStudentHold/:id", handler.ClearStudentHold)
+	r.GET("/GetListStudentHold", handler.GetListStudentHold)
+	r.GET("/GetStudentHold/:id", handler.GetStudentHold)
+
+	// Document
+	r.GET("/StudentPayment/:id/receipt.pdf", handler.GetPaymentReceiptPDF)
+	r.GET("/Student/:id/contract.pdf", handler.GetStudentContractPDF)
+	r.POST("/CreateContractTemplate", handler.CreateContractTemplate)
+	r.GET("/GetByIdContractTemplate/:id", handler.GetContractTemplateByID)
+	r.GET("/GetListContractTemplate", handler.GetListContractTemplate)
+	r.PUT("/UpdateContractTemplate/:id", handler.UpdateContractTemplate)
+	r.DELETE("/DeleteContractTemplate/:id", handler.DeleteContractTemplate)
+
+	// Online payment
+	r.POST("/CreateOnlinePayment", handler.CreateOnlinePayment)
+	r.GET("/GetByIdOnlinePayment/:id", handler.GetOnlinePaymentByID)
+	r.GET("/GetListOnlinePayment", handler.GetListOnlinePayment)
+	r.POST("/PaymentCallback/:provider", handler.PaymentCallback)
+	r.POST("/SimulateOnlinePayment/:id", handler.SimulateOnlinePayment)
+	r.POST("/ReconcileOnlinePayment", handler.ReconcileOnlinePayment)
+
+	// Cash shift
+	r.POST("/OpenCashShift", handler.OpenCashShift)
+	r.POST("/CloseCashShift/:id", handler.CloseCashShift)
+	r.POST("/ApproveCashShift/:id", handler.ApproveCashShift)
+	r.GET("/GetByIdCashShift/:id", handler.GetCashShiftByID)
+	r.GET("/GetCurrentCashShift", handler.GetCurrentCashShift)
+	r.GET("/GetListCashShift", handler.GetListCashShift)
+
+	// Finance report
+	r.GET("/GetRevenueReport", handler.GetRevenueReport)
+	r.GET("/GetCollectionsReport", handler.GetCollectionsReport)
+	r.GET("/GetDebtAgingReport", handler.GetDebtAgingReport)
+	r.GET("/GetMonthComparisonReport", handler.GetMonthComparisonReport)
+	r.GET("/GetProfitAndLossReport", handler.GetProfitAndLossReport)
+
+	// Payroll
+	r.POST("/CreatePayRule", handler.CreatePayRule)
+	r.GET("/GetListPayRule", handler.GetListPayRule)
+	r.DELETE("/DeletePayRule/:id", handler.DeletePayRule)
+	r.POST("/CreatePayrollAdjustment", handler.CreatePayrollAdjustment)
+	r.GET("/GetListPayrollAdjustment", handler.GetListPayrollAdjustment)
+	r.DELETE("/DeletePayrollAdjustment/:id", handler.DeletePayrollAdjustment)
+	r.POST("/CreatePayrollRun", handler.CreatePayrollRun)
+	r.POST("/RecalculatePayrollRun/:id", handler.RecalculatePayrollRun)
+	r.POST("/LockPayrollRun/:id", handler.LockPayrollRun)
+	r.GET("/GetByIdPayrollRun/:id", handler.GetPayrollRunByID)
+	r.GET("/GetListPayrollRun", handler.GetListPayrollRun)
+	r.GET("/PayrollRun/:id/export.csv", handler.ExportPayrollRun)
+
+	// Expense
+	r.POST("/CreateExpenseCategory", handler.CreateExpenseCategory)
+	r.GET("/GetListExpenseCategory", handler.GetListExpenseCategory)
+	r.DELETE("/DeleteExpenseCategory/:id", handler.DeleteExpenseCategory)
+	r.POST("/CreateExpense", handler.CreateExpense)
+	r.GET("/GetByIdExpense/:id", handler.GetExpenseByID)
+	r.GET("/GetListExpense", handler.GetListExpense)
+	r.PUT("/UpdateExpense/:id", handler.UpdateExpense)
+	r.DELETE("/DeleteExpense/:id", handler.DeleteExpense)
+	r.POST("/ReviewExpense/:id", handler.ReviewExpense)
+	r.POST("/Expense/:id/attachment", handler.AddExpenseAttachment)
+	r.GET("/ExpenseAttachment/:id", handler.GetExpenseAttachment)
+	r.DELETE("/ExpenseAttachment/:id", handler.DeleteExpenseAttachment)
+
+	// Commission
+	r.POST("/CreateCommissionRule", handler.CreateCommissionRule)
+	r.GET("/GetListCommissionRule", handler.GetListCommissionRule)
+	r.DELETE("/DeleteCommissionRule/:id", handler.DeleteCommissionRule)
+	r.GET("/GetCommissionReport", handler.GetCommissionReport)
+
+	// IELTS result
+	r.POST("/CreateIeltsResult", handler.CreateIeltsResult)
+	r.GET("/GetListIeltsResult", handler.GetListIeltsResult)
+	r.GET("/GetByIdIeltsResult/:id", handler.GetIeltsResultByID)
+	r.PUT("/UpdateIeltsResult/:id", handler.UpdateIeltsResult)
+	r.DELETE("/DeleteIeltsResult/:id", handler.DeleteIeltsResult)
+	r.GET("/GetListExpiringIelts", handler.GetListExpiringIelts)
+
+	// Mock test
+	r.POST("/CreateMockTest", handler.CreateMockTest)
+	r.GET("/GetByIdMockTest/:id", handler.GetMockTestByID)
+	r.GET("/GetListMockTest", handler.GetListMockTest)
+	r.DELETE("/DeleteMockTest/:id", handler.DeleteMockTest)
+	r.POST("/SaveMockTestResult", handler.SaveMockTestResult)
+	r.DELETE("/DeleteMockTestResult/:id", handler.DeleteMockTestResult)
+	r.GET("/GetStudentMockProgress/:id", handler.GetStudentMockProgress)
+	r.GET("/GetGroupMockAverages/:id", handler.GetGroupMockAverages)
+
+	// Guardian
+	r.POST("/CreateGuardian", handler.CreateGuardian)
+	r.GET("/GetListGuardian", handler.GetListGuardian)
+	r.GET("/GetByIdGuardian/:id", handler.GetGuardianByID)
+	r.PUT("/UpdateGuardian/:id", handler.UpdateGuardian)
+	r.DELETE("/DeleteGuardian/:id", handler.DeleteGuardian)
+	r.POST("/LinkGuardianStudent", handler.LinkGuardianStudent)
+	r.DELETE("/UnlinkGuardianStudent/:id", handler.UnlinkGuardianStudent)
+	r.GET("/GetChildSchedule/:id", handler.GetChildSchedule)
+	r.GET("/GetChildTasks/:id", handler.GetChildTasks)
+	r.GET("/GetChildPayments/:id", handler.GetChildPayments)
+	r.GET("/GetChildEvents/:id", handler.GetChildEvents)
+
+	// Lead
+	r.POST("/CreateLead", handler.CreateLead)
+	r.GET("/GetListLead", handler.GetListLead)
+	r.GET("/GetByIdLead/:id", handler.GetLeadByID)
+	r.PUT("/UpdateLead/:id", handler.UpdateLead)
+	r.DELETE("/DeleteLead/:id", handler.DeleteLead)
+	r.POST("/UpdateLeadStatus/:id", handler.UpdateLeadStatus)
+	r.POST("/BookLeadTrial/:id", handler.BookLeadTrial)
+	r.POST("/ConvertLead/:id", handler.ConvertLead)
+	r.GET("/GetLeadConversionReport", handler.GetLeadConversionReport)
+
+	// Room
+	r.POST("/CreateRoom", handler.CreateRoom)
+	r.GET("/GetListRoom", handler.GetListRoom)
+	r.GET("/GetByIdRoom/:id", handler.GetRoomByID)
+	r.PUT("/UpdateRoom/:id", handler.UpdateRoom)
+	r.DELETE("/DeleteRoom/:id", handler.DeleteRoom)
+	r.GET("/GetRoomUtilization", handler.GetRoomUtilization)
+
 	// Event
 	r.POST("/CreateEvent", handler.CreateEvent)
 	r.GET("/GetListEvent", handler.GetListEvent)
@@ -122,6 +282,17 @@ func New(cnf Config) *gin.Engine {
 	r.GET("/GroupTeacher/:id", handler.GetGroupByIDTeacher)
 	r.PUT("/UpdateGroup/:id", handler.UpdateGroup)
 	r.DELETE("/DeleteGroup/:id", handler.DeleteGroup)
+	r.POST("/PromoteGroup", handler.PromoteGroup)
+	r.GET("/GetPromotion/:id", handler.GetPromotion)
+
+	// GroupStudent
+	r.POST("/CreateGroupStudent", handler.CreateGroupStudent)
+	r.GET("/GetListGroupStudent", handler.GetListGroupStudent)
+	r.GET("/GetByIdGroupStudent/:id", handler.GetGroupStudentByID)
+	r.DELETE("/DeleteGroupStudent/:id", handler.DeleteGroupStudent)
+	r.POST("/JoinWaitlist", handler.JoinWaitlist)
+	r.GET("/GetWaitlist", handler.GetWaitlist)
+	r.DELETE("/LeaveWaitlist/:id", handler.LeaveWaitlist)
 
 	// Journal
 	r.POST("/CreateJournal", handler.CreateJournal)
@@ -130,6 +301,7 @@ func New(cnf Config) *gin.Engine {
 	r.GET("/GetJurnalsStudent/:id", handler.GetJurnalByIDStudent)
 	r.PUT("/UpdateJournal/:id", handler.UpdateJournal)
 	r.DELETE("/DeleteJournal/:id", handler.DeleteJournal)
+	r.GET("/GetJournalRolloverReport", handler.GetJournalRolloverReport)
 
 	// Schedule 
 	r.POST("/CreateSchedule", handler.CreateSchedule)
@@ -139,13 +311,16 @@ func New(cnf Config) *gin.Engine {
 	r.DELETE("/DeleteSchedule/:id", handler.DeleteSchedule)
 	r.GET("/GetScheduleForWeek", handler.GetScheduleForWeek)
 	r.GET("/GetScheduleForMonth", handler.GetScheduleForMonth)
+	r.POST("/MarkAttendance", handler.MarkAttendance)
+	r.POST("/AssignSubstitute", handler.AssignSubstitute)
+	r.GET("/GetTeacherLessonCount", handler.GetTeacherLessonCount)
 
 	// StudentPayment
 	r.POST("/CreateStudentPayment", handler.CreateStudentPayment)
 	r.GET("/GetListStudentPayment", handler.GetListStudentPayment)
 	r.GET("/GetByIdStudentPayment/:id", handler.GetStudentPaymentByID)
-	r.PUT("/UpdateStudentPayment/:id", handler.UpdateStudentPayment)
-	r.DELETE("/DeleteStudentPayment/:id", handler.DeleteStudentPayment)
+	r.POST("/ReverseStudentPayment/:id", handler.ReverseStudentPayment)
+	r.POST("/RefundStudentPayment/:id", handler.RefundStudentPayment)
 
 	// StudentTask 
 	r.POST("/CreateStudentTask", handler.CreateStudentTask)
@@ -161,6 +336,16 @@ func New(cnf Config) *gin.Engine {
 	r.PUT("/UpdateTask/:id", handler.UpdateTask)
 	r.DELETE("/DeleteTask/:id", handler.DeleteTask)
 
+	// Timetable
+	r.POST("/CreateAvailability", handler.CreateAvailability)
+	r.GET("/GetListAvailability", handler.GetListAvailability)
+	r.DELETE("/DeleteAvailability/:id", handler.DeleteAvailability)
+	r.POST("/SetLessonRequirement", handler.SetLessonRequirement)
+	r.GET("/GetListLessonRequirement", handler.GetListLessonRequirement)
+	r.POST("/ProposeTimetable", handler.ProposeTimetable)
+	r.GET("/GetTimetableProposal/:id", handler.GetTimetableProposal)
+	r.POST("/CommitTimetable/:id", handler.CommitTimetable)
+
 	// Report
 	r.GET("/AdministrationReportList", handler.GetReportListAdministration)
 	r.GET("/TeacherReportList", handler.GetReportListTeacher)
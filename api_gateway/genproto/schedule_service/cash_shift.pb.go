@@ -0,0 +1,1090 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: cash_shift.proto
+
+package schedule_service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CashShiftPrimaryKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CashShiftPrimaryKey) Reset() {
+	*x = CashShiftPrimaryKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_shift_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CashShiftPrimaryKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashShiftPrimaryKey) ProtoMessage() {}
+
+func (x *CashShiftPrimaryKey) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_shift_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashShiftPrimaryKey.ProtoReflect.Descriptor instead.
+func (*CashShiftPrimaryKey) Descriptor() ([]byte, []int) {
+	return file_cash_shift_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CashShiftPrimaryKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type OpenCashShift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdministrationId string `protobuf:"bytes,1,opt,name=administrationId,proto3" json:"administrationId,omitempty"`
+	OpeningFloat     string `protobuf:"bytes,2,opt,name=openingFloat,proto3" json:"openingFloat,omitempty"`
+	Note             string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *OpenCashShift) Reset() {
+	*x = OpenCashShift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_shift_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenCashShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenCashShift) ProtoMessage() {}
+
+func (x *OpenCashShift) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_shift_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenCashShift.ProtoReflect.Descriptor instead.
+func (*OpenCashShift) Descriptor() ([]byte, []int) {
+	return file_cash_shift_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OpenCashShift) GetAdministrationId() string {
+	if x != nil {
+		return x.AdministrationId
+	}
+	return ""
+}
+
+func (x *OpenCashShift) GetOpeningFloat() string {
+	if x != nil {
+		return x.OpeningFloat
+	}
+	return ""
+}
+
+func (x *OpenCashShift) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CashCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentMethod string `protobuf:"bytes,1,opt,name=paymentMethod,proto3" json:"paymentMethod,omitempty"`
+	Amount        string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *CashCount) Reset() {
+	*x = CashCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_shift_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CashCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashCount) ProtoMessage() {}
+
+func (x *CashCount) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_shift_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashCount.ProtoReflect.Descriptor instead.
+func (*CashCount) Descriptor() ([]byte, []int) {
+	return file_cash_shift_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CashCount) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *CashCount) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type CloseCashShift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdministrationId string       `protobuf:"bytes,2,opt,name=administrationId,proto3" json:"administrationId,omitempty"`
+	Counted          []*CashCount `protobuf:"bytes,3,rep,name=counted,proto3" json:"counted,omitempty"`
+	Note             string       `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *CloseCashShift) Reset() {
+	*x = CloseCashShift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_shift_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseCashShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseCashShift) ProtoMessage() {}
+
+func (x *CloseCashShift) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_shift_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseCashShift.ProtoReflect.Descriptor instead.
+func (*CloseCashShift) Descriptor() ([]byte, []int) {
+	return file_cash_shift_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CloseCashShift) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CloseCashShift) GetAdministrationId() string {
+	if x != nil {
+		return x.AdministrationId
+	}
+	return ""
+}
+
+func (x *CloseCashShift) GetCounted() []*CashCount {
+	if x != nil {
+		return x.Counted
+	}
+	return nil
+}
+
+func (x *CloseCashShift) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ApproveCashShift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApprovedBy string `protobuf:"bytes,2,opt,name=approvedBy,proto3" json:"approvedBy,omitempty"`
+	Note       string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *ApproveCashShift) Reset() {
+	*x = ApproveCashShift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_shift_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveCashShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCashShift) ProtoMessage() {}
+
+func (x *ApproveCashShift) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_shift_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCashShift.ProtoReflect.Descriptor instead.
+func (*ApproveCashShift) Descriptor() ([]byte, []int) {
+	return file_cash_shift_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ApproveCashShift) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApproveCashShift) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *ApproveCashShift) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CurrentCashShiftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdministrationId string `protobuf:"bytes,1,opt,name=administrationId,proto3" json:"administrationId,omitempty"`
+}
+
+func (x *CurrentCashShiftRequest) Reset() {
+	*x = CurrentCashShiftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_shift_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentCashShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentCashShiftRequest) ProtoMessage() {}
+
+func (x *CurrentCashShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_shift_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentCashShiftRequest.ProtoReflect.Descriptor instead.
+func (*CurrentCashShiftRequest) Descriptor() ([]byte, []int) {
+	return file_cash_shift_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CurrentCashShiftRequest) GetAdministrationId() string {
+	if x != nil {
+		return x.AdministrationId
+	}
+	return ""
+}
+
+type CashShiftTotal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentMethod string `protobuf:"bytes,1,opt,name=paymentMethod,proto3" json:"paymentMethod,omitempty"`
+	Expected      string `protobuf:"bytes,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Counted       string `protobuf:"bytes,3,opt,name=counted,proto3" json:"counted,omitempty"`
+	Difference    string `protobuf:"bytes,4,opt,name=difference,proto3" json:"difference,omitempty"`
+	Mismatch      bool   `protobuf:"varint,5,opt,name=mismatch,proto3" json:"mismatch,omitempty"`
+}
+
+func (x *CashShiftTotal) Reset() {
+	*x = CashShiftTotal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_shift_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CashShiftTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashShiftTotal) ProtoMessage() {}
+
+func (x *CashShiftTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_shift_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashShiftTotal.ProtoReflect.Descriptor instead.
+func (*CashShiftTotal) Descriptor() ([]byte, []int) {
+	return file_cash_shift_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CashShiftTotal) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *CashShiftTotal) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *CashShiftTotal) GetCounted() string {
+	if x != nil {
+		return x.Counted
+	}
+	return ""
+}
+
+func (x *CashShiftTotal) GetDifference() string {
+	if x != nil {
+		return x.Difference
+	}
+	return ""
+}
+
+func (x *CashShiftTotal) GetMismatch() bool {
+	if x != nil {
+		return x.Mismatch
+	}
+	return false
+}
+
+type CashShift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdministrationId   string            `protobuf:"bytes,2,opt,name=administrationId,proto3" json:"administrationId,omitempty"`
+	AdministrationName string            `protobuf:"bytes,3,opt,name=administrationName,proto3" json:"administrationName,omitempty"`
+	BranchId           string            `protobuf:"bytes,4,opt,name=branchId,proto3" json:"branchId,omitempty"`
+	OpeningFloat       string            `protobuf:"bytes,5,opt,name=openingFloat,proto3" json:"openingFloat,omitempty"`
+	Status             string            `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Mismatch           bool              `protobuf:"varint,7,opt,name=mismatch,proto3" json:"mismatch,omitempty"`
+	Note               string            `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	CloseNote          string            `protobuf:"bytes,9,opt,name=closeNote,proto3" json:"closeNote,omitempty"`
+	OpenedAt           string            `protobuf:"bytes,10,opt,name=openedAt,proto3" json:"openedAt,omitempty"`
+	ClosedAt           string            `protobuf:"bytes,11,opt,name=closedAt,proto3" json:"closedAt,omitempty"`
+	ApprovedBy         string            `protobuf:"bytes,12,opt,name=approvedBy,proto3" json:"approvedBy,omitempty"`
+	ApprovedAt         string            `protobuf:"bytes,13,opt,name=approvedAt,proto3" json:"approvedAt,omitempty"`
+	ApprovalNote       string            `protobuf:"bytes,14,opt,name=approvalNote,proto3" json:"approvalNote,omitempty"`
+	PaymentCount       int64             `protobuf:"varint,15,opt,name=paymentCount,proto3" json:"paymentCount,omitempty"`
+	Totals             []*CashShiftTotal `protobuf:"bytes,16,rep,name=totals,proto3" json:"totals,omitempty"`
+}
+
+func (x *CashShift) Reset() {
+	*x = CashShift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_shift_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CashShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashShift) ProtoMessage() {}
+
+func (x *CashShift) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_shift_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashShift.ProtoReflect.Descriptor instead.
+func (*CashShift) Descriptor() ([]byte, []int) {
+	return file_cash_shift_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CashShift) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CashShift) GetAdministrationId() string {
+	if x != nil {
+		return x.AdministrationId
+	}
+	return ""
+}
+
+func (x *CashShift) GetAdministrationName() string {
+	if x != nil {
+		return x.AdministrationName
+	}
+	return ""
+}
+
+func (x *CashShift) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+func (x *CashShift) GetOpeningFloat() string {
+	if x != nil {
+		return x.OpeningFloat
+	}
+	return ""
+}
+
+func (x *CashShift) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CashShift) GetMismatch() bool {
+	if x != nil {
+		return x.Mismatch
+	}
+	return false
+}
+
+func (x *CashShift) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CashShift) GetCloseNote() string {
+	if x != nil {
+		return x.CloseNote
+	}
+	return ""
+}
+
+func (x *CashShift) GetOpenedAt() string {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return ""
+}
+
+func (x *CashShift) GetClosedAt() string {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return ""
+}
+
+func (x *CashShift) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *CashShift) GetApprovedAt() string {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return ""
+}
+
+func (x *CashShift) GetApprovalNote() string {
+	if x != nil {
+		return x.ApprovalNote
+	}
+	return ""
+}
+
+func (x *CashShift) GetPaymentCount() int64 {
+	if x != nil {
+		return x.PaymentCount
+	}
+	return 0
+}
+
+func (x *CashShift) GetTotals() []*CashShiftTotal {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type GetListCashShiftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdministrationId string `protobuf:"bytes,1,opt,name=administrationId,proto3" json:"administrationId,omitempty"`
+	BranchId         string `protobuf:"bytes,2,opt,name=branchId,proto3" json:"branchId,omitempty"`
+	Status           string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	MismatchOnly     bool   `protobuf:"varint,4,opt,name=mismatchOnly,proto3" json:"mismatchOnly,omitempty"`
+	Page             uint64 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Limit            uint64 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetListCashShiftRequest) Reset() {
+	*x = GetListCashShiftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_shift_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListCashShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListCashShiftRequest) ProtoMessage() {}
+
+func (x *GetListCashShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_shift_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListCashShiftRequest.ProtoReflect.Descriptor instead.
+func (*GetListCashShiftRequest) Descriptor() ([]byte, []int) {
+	return file_cash_shift_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetListCashShiftRequest) GetAdministrationId() string {
+	if x != nil {
+		return x.AdministrationId
+	}
+	return ""
+}
+
+func (x *GetListCashShiftRequest) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+func (x *GetListCashShiftRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetListCashShiftRequest) GetMismatchOnly() bool {
+	if x != nil {
+		return x.MismatchOnly
+	}
+	return false
+}
+
+func (x *GetListCashShiftRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetListCashShiftRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetListCashShiftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count  int64        `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Shifts []*CashShift `protobuf:"bytes,2,rep,name=shifts,proto3" json:"shifts,omitempty"`
+}
+
+func (x *GetListCashShiftResponse) Reset() {
+	*x = GetListCashShiftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cash_shift_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListCashShiftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListCashShiftResponse) ProtoMessage() {}
+
+func (x *GetListCashShiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_shift_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListCashShiftResponse.ProtoReflect.Descriptor instead.
+func (*GetListCashShiftResponse) Descriptor() ([]byte, []int) {
+	return file_cash_shift_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetListCashShiftResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetListCashShiftResponse) GetShifts() []*CashShift {
+	if x != nil {
+		return x.Shifts
+	}
+	return nil
+}
+
+var File_cash_shift_proto protoreflect.FileDescriptor
+
+var file_cash_shift_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x22, 0x25, 0x0a, 0x13, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x0d, 0x4f,
+	0x70, 0x65, 0x6e, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x2a, 0x0a, 0x10,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x22, 0x49, 0x0a, 0x09, 0x43, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x0e,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a,
+	0x0a, 0x10, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x61, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x56, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x45, 0x0a,
+	0x17, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0xa8, 0x01, 0x0a, 0x0e, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22,
+	0x97, 0x04, 0x0a, 0x09, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a,
+	0x10, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x4e, 0x6f, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x38, 0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x52, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x69, 0x73,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x65, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x52, 0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x32, 0xff, 0x03, 0x0a, 0x10, 0x43,
+	0x61, 0x73, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x46, 0x0a, 0x04, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x1f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x43,
+	0x61, 0x73, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x73, 0x68,
+	0x53, 0x68, 0x69, 0x66, 0x74, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x12, 0x20, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x22, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x1a, 0x1b, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x25, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61,
+	0x73, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65,
+	0x79, 0x1a, 0x1b, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x22, 0x00,
+	0x12, 0x56, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x29,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x73,
+	0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x29, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x73, 0x68, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19,
+	0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_cash_shift_proto_rawDescOnce sync.Once
+	file_cash_shift_proto_rawDescData = file_cash_shift_proto_rawDesc
+)
+
+func file_cash_shift_proto_rawDescGZIP() []byte {
+	file_cash_shift_proto_rawDescOnce.Do(func() {
+		file_cash_shift_proto_rawDescData = protoimpl.X.CompressGZIP(file_cash_shift_proto_rawDescData)
+	})
+	return file_cash_shift_proto_rawDescData
+}
+
+var file_cash_shift_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_cash_shift_proto_goTypes = []interface{}{
+	(*CashShiftPrimaryKey)(nil),      // 0: schedule_service.CashShiftPrimaryKey
+	(*OpenCashShift)(nil),            // 1: schedule_service.OpenCashShift
+	(*CashCount)(nil),                // 2: schedule_service.CashCount
+	(*CloseCashShift)(nil),           // 3: schedule_service.CloseCashShift
+	(*ApproveCashShift)(nil),         // 4: schedule_service.ApproveCashShift
+	(*CurrentCashShiftRequest)(nil),  // 5: schedule_service.CurrentCashShiftRequest
+	(*CashShiftTotal)(nil),           // 6: schedule_service.CashShiftTotal
+	(*CashShift)(nil),                // 7: schedule_service.CashShift
+	(*GetListCashShiftRequest)(nil),  // 8: schedule_service.GetListCashShiftRequest
+	(*GetListCashShiftResponse)(nil), // 9: schedule_service.GetListCashShiftResponse
+}
+var file_cash_shift_proto_depIdxs = []int32{
+	2, // 0: schedule_service.CloseCashShift.counted:type_name -> schedule_service.CashCount
+	6, // 1: schedule_service.CashShift.totals:type_name -> schedule_service.CashShiftTotal
+	7, // 2: schedule_service.GetListCashShiftResponse.shifts:type_name -> schedule_service.CashShift
+	1, // 3: schedule_service.CashShiftService.Open:input_type -> schedule_service.OpenCashShift
+	3, // 4: schedule_service.CashShiftService.Close:input_type -> schedule_service.CloseCashShift
+	4, // 5: schedule_service.CashShiftService.Approve:input_type -> schedule_service.ApproveCashShift
+	0, // 6: schedule_service.CashShiftService.GetByID:input_type -> schedule_service.CashShiftPrimaryKey
+	5, // 7: schedule_service.CashShiftService.GetCurrent:input_type -> schedule_service.CurrentCashShiftRequest
+	8, // 8: schedule_service.CashShiftService.GetList:input_type -> schedule_service.GetListCashShiftRequest
+	7, // 9: schedule_service.CashShiftService.Open:output_type -> schedule_service.CashShift
+	7, // 10: schedule_service.CashShiftService.Close:output_type -> schedule_service.CashShift
+	7, // 11: schedule_service.CashShiftService.Approve:output_type -> schedule_service.CashShift
+	7, // 12: schedule_service.CashShiftService.GetByID:output_type -> schedule_service.CashShift
+	7, // 13: schedule_service.CashShiftService.GetCurrent:output_type -> schedule_service.CashShift
+	9, // 14: schedule_service.CashShiftService.GetList:output_type -> schedule_service.GetListCashShiftResponse
+	9, // [9:15] is the sub-list for method output_type
+	3, // [3:9] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cash_shift_proto_init() }
+func file_cash_shift_proto_init() {
+	if File_cash_shift_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cash_shift_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CashShiftPrimaryKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_shift_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenCashShift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_shift_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CashCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_shift_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseCashShift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_shift_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveCashShift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_shift_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrentCashShiftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_shift_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CashShiftTotal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_shift_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CashShift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_shift_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListCashShiftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cash_shift_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListCashShiftResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cash_shift_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cash_shift_proto_goTypes,
+		DependencyIndexes: file_cash_shift_proto_depIdxs,
+		MessageInfos:      file_cash_shift_proto_msgTypes,
+	}.Build()
+	File_cash_shift_proto = out.File
+	file_cash_shift_proto_rawDesc = nil
+	file_cash_shift_proto_goTypes = nil
+	file_cash_shift_proto_depIdxs = nil
+}
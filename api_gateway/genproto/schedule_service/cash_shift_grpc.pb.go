@@ -0,0 +1,292 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: cash_shift.proto
+
+package schedule_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	CashShiftService_Open_FullMethodName       = "/schedule_service.CashShiftService/Open"
+	CashShiftService_Close_FullMethodName      = "/schedule_service.CashShiftService/Close"
+	CashShiftService_Approve_FullMethodName    = "/schedule_service.CashShiftService/Approve"
+	CashShiftService_GetByID_FullMethodName    = "/schedule_service.CashShiftService/GetByID"
+	CashShiftService_GetCurrent_FullMethodName = "/schedule_service.CashShiftService/GetCurrent"
+	CashShiftService_GetList_FullMethodName    = "/schedule_service.CashShiftService/GetList"
+)
+
+// CashShiftServiceClient is the client API for CashShiftService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CashShiftServiceClient interface {
+	Open(ctx context.Context, in *OpenCashShift, opts ...grpc.CallOption) (*CashShift, error)
+	Close(ctx context.Context, in *CloseCashShift, opts ...grpc.CallOption) (*CashShift, error)
+	Approve(ctx context.Context, in *ApproveCashShift, opts ...grpc.CallOption) (*CashShift, error)
+	GetByID(ctx context.Context, in *CashShiftPrimaryKey, opts ...grpc.CallOption) (*CashShift, error)
+	GetCurrent(ctx context.Context, in *CurrentCashShiftRequest, opts ...grpc.CallOption) (*CashShift, error)
+	GetList(ctx context.Context, in *GetListCashShiftRequest, opts ...grpc.CallOption) (*GetListCashShiftResponse, error)
+}
+
+type cashShiftServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCashShiftServiceClient(cc grpc.ClientConnInterface) CashShiftServiceClient {
+	return &cashShiftServiceClient{cc}
+}
+
+func (c *cashShiftServiceClient) Open(ctx context.Context, in *OpenCashShift, opts ...grpc.CallOption) (*CashShift, error) {
+	out := new(CashShift)
+	err := c.cc.Invoke(ctx, CashShiftService_Open_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashShiftServiceClient) Close(ctx context.Context, in *CloseCashShift, opts ...grpc.CallOption) (*CashShift, error) {
+	out := new(CashShift)
+	err := c.cc.Invoke(ctx, CashShiftService_Close_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashShiftServiceClient) Approve(ctx context.Context, in *ApproveCashShift, opts ...grpc.CallOption) (*CashShift, error) {
+	out := new(CashShift)
+	err := c.cc.Invoke(ctx, CashShiftService_Approve_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashShiftServiceClient) GetByID(ctx context.Context, in *CashShiftPrimaryKey, opts ...grpc.CallOption) (*CashShift, error) {
+	out := new(CashShift)
+	err := c.cc.Invoke(ctx, CashShiftService_GetByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashShiftServiceClient) GetCurrent(ctx context.Context, in *CurrentCashShiftRequest, opts ...grpc.CallOption) (*CashShift, error) {
+	out := new(CashShift)
+	err := c.cc.Invoke(ctx, CashShiftService_GetCurrent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashShiftServiceClient) GetList(ctx context.Context, in *GetListCashShiftRequest, opts ...grpc.CallOption) (*GetListCashShiftResponse, error) {
+	out := new(GetListCashShiftResponse)
+	err := c.cc.Invoke(ctx, CashShiftService_GetList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CashShiftServiceServer is the server API for CashShiftService service.
+// All implementations should embed UnimplementedCashShiftServiceServer
+// for forward compatibility
+type CashShiftServiceServer interface {
+	Open(context.Context, *OpenCashShift) (*CashShift, error)
+	Close(context.Context, *CloseCashShift) (*CashShift, error)
+	Approve(context.Context, *ApproveCashShift) (*CashShift, error)
+	GetByID(context.Context, *CashShiftPrimaryKey) (*CashShift, error)
+	GetCurrent(context.Context, *CurrentCashShiftRequest) (*CashShift, error)
+	GetList(context.Context, *GetListCashShiftRequest) (*GetListCashShiftResponse, error)
+}
+
+// UnimplementedCashShiftServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedCashShiftServiceServer struct {
+}
+
+func (UnimplementedCashShiftServiceServer) Open(context.Context, *OpenCashShift) (*CashShift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Open not implemented")
+}
+func (UnimplementedCashShiftServiceServer) Close(context.Context, *CloseCashShift) (*CashShift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+}
+func (UnimplementedCashShiftServiceServer) Approve(context.Context, *ApproveCashShift) (*CashShift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
+func (UnimplementedCashShiftServiceServer) GetByID(context.Context, *CashShiftPrimaryKey) (*CashShift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedCashShiftServiceServer) GetCurrent(context.Context, *CurrentCashShiftRequest) (*CashShift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrent not implemented")
+}
+func (UnimplementedCashShiftServiceServer) GetList(context.Context, *GetListCashShiftRequest) (*GetListCashShiftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+
+// UnsafeCashShiftServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CashShiftServiceServer will
+// result in compilation errors.
+type UnsafeCashShiftServiceServer interface {
+	mustEmbedUnimplementedCashShiftServiceServer()
+}
+
+func RegisterCashShiftServiceServer(s grpc.ServiceRegistrar, srv CashShiftServiceServer) {
+	s.RegisterService(&CashShiftService_ServiceDesc, srv)
+}
+
+func _CashShiftService_Open_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenCashShift)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashShiftServiceServer).Open(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashShiftService_Open_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashShiftServiceServer).Open(ctx, req.(*OpenCashShift))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashShiftService_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseCashShift)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashShiftServiceServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashShiftService_Close_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashShiftServiceServer).Close(ctx, req.(*CloseCashShift))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashShiftService_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveCashShift)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashShiftServiceServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashShiftService_Approve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashShiftServiceServer).Approve(ctx, req.(*ApproveCashShift))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashShiftService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashShiftPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashShiftServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashShiftService_GetByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashShiftServiceServer).GetByID(ctx, req.(*CashShiftPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashShiftService_GetCurrent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrentCashShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashShiftServiceServer).GetCurrent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashShiftService_GetCurrent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashShiftServiceServer).GetCurrent(ctx, req.(*CurrentCashShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashShiftService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListCashShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashShiftServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashShiftService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashShiftServiceServer).GetList(ctx, req.(*GetListCashShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CashShiftService_ServiceDesc is the grpc.ServiceDesc for CashShiftService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CashShiftService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule_service.CashShiftService",
+	HandlerType: (*CashShiftServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Open",
+			Handler:    _CashShiftService_Open_Handler,
+		},
+		{
+			MethodName: "Close",
+			Handler:    _CashShiftService_Close_Handler,
+		},
+		{
+			MethodName: "Approve",
+			Handler:    _CashShiftService_Approve_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _CashShiftService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetCurrent",
+			Handler:    _CashShiftService_GetCurrent_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _CashShiftService_GetList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cash_shift.proto",
+}
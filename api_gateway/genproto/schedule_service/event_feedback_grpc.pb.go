@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: event_feedback.proto
+
+package schedule_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	EventFeedbackService_Create_FullMethodName            = "/schedule_service.EventFeedbackService/Create"
+	EventFeedbackService_GetList_FullMethodName           = "/schedule_service.EventFeedbackService/GetList"
+	EventFeedbackService_GetEventRating_FullMethodName    = "/schedule_service.EventFeedbackService/GetEventRating"
+	EventFeedbackService_GetTopicRatings_FullMethodName   = "/schedule_service.EventFeedbackService/GetTopicRatings"
+	EventFeedbackService_GetLowRatedEvents_FullMethodName = "/schedule_service.EventFeedbackService/GetLowRatedEvents"
+)
+
+// EventFeedbackServiceClient is the client API for EventFeedbackService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EventFeedbackServiceClient interface {
+	Create(ctx context.Context, in *CreateEventFeedback, opts ...grpc.CallOption) (*EventFeedback, error)
+	GetList(ctx context.Context, in *GetListEventFeedbackRequest, opts ...grpc.CallOption) (*GetListEventFeedbackResponse, error)
+	GetEventRating(ctx context.Context, in *EventRatingRequest, opts ...grpc.CallOption) (*EventRating, error)
+	GetTopicRatings(ctx context.Context, in *TopicRatingRequest, opts ...grpc.CallOption) (*TopicRatingResponse, error)
+	GetLowRatedEvents(ctx context.Context, in *LowRatedEventsRequest, opts ...grpc.CallOption) (*LowRatedEventsResponse, error)
+}
+
+type eventFeedbackServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventFeedbackServiceClient(cc grpc.ClientConnInterface) EventFeedbackServiceClient {
+	return &eventFeedbackServiceClient{cc}
+}
+
+func (c *eventFeedbackServiceClient) Create(ctx context.Context, in *CreateEventFeedback, opts ...grpc.CallOption) (*EventFeedback, error) {
+	out := new(EventFeedback)
+	err := c.cc.Invoke(ctx, EventFeedbackService_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventFeedbackServiceClient) GetList(ctx context.Context, in *GetListEventFeedbackRequest, opts ...grpc.CallOption) (*GetListEventFeedbackResponse, error) {
+	out := new(GetListEventFeedbackResponse)
+	err := c.cc.Invoke(ctx, EventFeedbackService_GetList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventFeedbackServiceClient) GetEventRating(ctx context.Context, in *EventRatingRequest, opts ...grpc.CallOption) (*EventRating, error) {
+	out := new(EventRating)
+	err := c.cc.Invoke(ctx, EventFeedbackService_GetEventRating_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventFeedbackServiceClient) GetTopicRatings(ctx context.Context, in *TopicRatingRequest, opts ...grpc.CallOption) (*TopicRatingResponse, error) {
+	out := new(TopicRatingResponse)
+	err := c.cc.Invoke(ctx, EventFeedbackService_GetTopicRatings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventFeedbackServiceClient) GetLowRatedEvents(ctx context.Context, in *LowRatedEventsRequest, opts ...grpc.CallOption) (*LowRatedEventsResponse, error) {
+	out := new(LowRatedEventsResponse)
+	err := c.cc.Invoke(ctx, EventFeedbackService_GetLowRatedEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventFeedbackServiceServer is the server API for EventFeedbackService service.
+// All implementations should embed UnimplementedEventFeedbackServiceServer
+// for forward compatibility
+type EventFeedbackServiceServer interface {
+	Create(context.Context, *CreateEventFeedback) (*EventFeedback, error)
+	GetList(context.Context, *GetListEventFeedbackRequest) (*GetListEventFeedbackResponse, error)
+	GetEventRating(context.Context, *EventRatingRequest) (*EventRating, error)
+	GetTopicRatings(context.Context, *TopicRatingRequest) (*TopicRatingResponse, error)
+	GetLowRatedEvents(context.Context, *LowRatedEventsRequest) (*LowRatedEventsResponse, error)
+}
+
+// UnimplementedEventFeedbackServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedEventFeedbackServiceServer struct {
+}
+
+func (UnimplementedEventFeedbackServiceServer) Create(context.Context, *CreateEventFeedback) (*EventFeedback, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedEventFeedbackServiceServer) GetList(context.Context, *GetListEventFeedbackRequest) (*GetListEventFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedEventFeedbackServiceServer) GetEventRating(context.Context, *EventRatingRequest) (*EventRating, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventRating not implemented")
+}
+func (UnimplementedEventFeedbackServiceServer) GetTopicRatings(context.Context, *TopicRatingRequest) (*TopicRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicRatings not implemented")
+}
+func (UnimplementedEventFeedbackServiceServer) GetLowRatedEvents(context.Context, *LowRatedEventsRequest) (*LowRatedEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLowRatedEvents not implemented")
+}
+
+// UnsafeEventFeedbackServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventFeedbackServiceServer will
+// result in compilation errors.
+type UnsafeEventFeedbackServiceServer interface {
+	mustEmbedUnimplementedEventFeedbackServiceServer()
+}
+
+func RegisterEventFeedbackServiceServer(s grpc.ServiceRegistrar, srv EventFeedbackServiceServer) {
+	s.RegisterService(&EventFeedbackService_ServiceDesc, srv)
+}
+
+func _EventFeedbackService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventFeedback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventFeedbackServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventFeedbackService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventFeedbackServiceServer).Create(ctx, req.(*CreateEventFeedback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventFeedbackService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListEventFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventFeedbackServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventFeedbackService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventFeedbackServiceServer).GetList(ctx, req.(*GetListEventFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventFeedbackService_GetEventRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventFeedbackServiceServer).GetEventRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventFeedbackService_GetEventRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventFeedbackServiceServer).GetEventRating(ctx, req.(*EventRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventFeedbackService_GetTopicRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventFeedbackServiceServer).GetTopicRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventFeedbackService_GetTopicRatings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventFeedbackServiceServer).GetTopicRatings(ctx, req.(*TopicRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventFeedbackService_GetLowRatedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LowRatedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventFeedbackServiceServer).GetLowRatedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventFeedbackService_GetLowRatedEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventFeedbackServiceServer).GetLowRatedEvents(ctx, req.(*LowRatedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EventFeedbackService_ServiceDesc is the grpc.ServiceDesc for EventFeedbackService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventFeedbackService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule_service.EventFeedbackService",
+	HandlerType: (*EventFeedbackServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _EventFeedbackService_Create_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _EventFeedbackService_GetList_Handler,
+		},
+		{
+			MethodName: "GetEventRating",
+			Handler:    _EventFeedbackService_GetEventRating_Handler,
+		},
+		{
+			MethodName: "GetTopicRatings",
+			Handler:    _EventFeedbackService_GetTopicRatings_Handler,
+		},
+		{
+			MethodName: "GetLowRatedEvents",
+			Handler:    _EventFeedbackService_GetLowRatedEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "event_feedback.proto",
+}
@@ -0,0 +1,514 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: expense.proto
+
+package schedule_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ExpenseService_CreateCategory_FullMethodName   = "/schedule_service.ExpenseService/CreateCategory"
+	ExpenseService_GetListCategory_FullMethodName  = "/schedule_service.ExpenseService/GetListCategory"
+	ExpenseService_DeleteCategory_FullMethodName   = "/schedule_service.ExpenseService/DeleteCategory"
+	ExpenseService_Create_FullMethodName           = "/schedule_service.ExpenseService/Create"
+	ExpenseService_GetByID_FullMethodName          = "/schedule_service.ExpenseService/GetByID"
+	ExpenseService_GetList_FullMethodName          = "/schedule_service.ExpenseService/GetList"
+	ExpenseService_Update_FullMethodName           = "/schedule_service.ExpenseService/Update"
+	ExpenseService_Delete_FullMethodName           = "/schedule_service.ExpenseService/Delete"
+	ExpenseService_Review_FullMethodName           = "/schedule_service.ExpenseService/Review"
+	ExpenseService_AddAttachment_FullMethodName    = "/schedule_service.ExpenseService/AddAttachment"
+	ExpenseService_GetAttachment_FullMethodName    = "/schedule_service.ExpenseService/GetAttachment"
+	ExpenseService_DeleteAttachment_FullMethodName = "/schedule_service.ExpenseService/DeleteAttachment"
+)
+
+// ExpenseServiceClient is the client API for ExpenseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExpenseServiceClient interface {
+	CreateCategory(ctx context.Context, in *CreateExpenseCategory, opts ...grpc.CallOption) (*ExpenseCategory, error)
+	GetListCategory(ctx context.Context, in *GetListExpenseCategoryRequest, opts ...grpc.CallOption) (*GetListExpenseCategoryResponse, error)
+	DeleteCategory(ctx context.Context, in *ExpenseCategoryPrimaryKey, opts ...grpc.CallOption) (*EmptyExpense, error)
+	Create(ctx context.Context, in *CreateExpense, opts ...grpc.CallOption) (*Expense, error)
+	GetByID(ctx context.Context, in *ExpensePrimaryKey, opts ...grpc.CallOption) (*Expense, error)
+	GetList(ctx context.Context, in *GetListExpenseRequest, opts ...grpc.CallOption) (*GetListExpenseResponse, error)
+	Update(ctx context.Context, in *UpdateExpense, opts ...grpc.CallOption) (*Expense, error)
+	Delete(ctx context.Context, in *ExpensePrimaryKey, opts ...grpc.CallOption) (*EmptyExpense, error)
+	Review(ctx context.Context, in *ReviewExpense, opts ...grpc.CallOption) (*Expense, error)
+	AddAttachment(ctx context.Context, in *CreateExpenseAttachment, opts ...grpc.CallOption) (*ExpenseAttachment, error)
+	GetAttachment(ctx context.Context, in *ExpenseAttachmentPrimaryKey, opts ...grpc.CallOption) (*ExpenseAttachmentFile, error)
+	DeleteAttachment(ctx context.Context, in *ExpenseAttachmentPrimaryKey, opts ...grpc.CallOption) (*EmptyExpense, error)
+}
+
+type expenseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExpenseServiceClient(cc grpc.ClientConnInterface) ExpenseServiceClient {
+	return &expenseServiceClient{cc}
+}
+
+func (c *expenseServiceClient) CreateCategory(ctx context.Context, in *CreateExpenseCategory, opts ...grpc.CallOption) (*ExpenseCategory, error) {
+	out := new(ExpenseCategory)
+	err := c.cc.Invoke(ctx, ExpenseService_CreateCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) GetListCategory(ctx context.Context, in *GetListExpenseCategoryRequest, opts ...grpc.CallOption) (*GetListExpenseCategoryResponse, error) {
+	out := new(GetListExpenseCategoryResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_GetListCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) DeleteCategory(ctx context.Context, in *ExpenseCategoryPrimaryKey, opts ...grpc.CallOption) (*EmptyExpense, error) {
+	out := new(EmptyExpense)
+	err := c.cc.Invoke(ctx, ExpenseService_DeleteCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) Create(ctx context.Context, in *CreateExpense, opts ...grpc.CallOption) (*Expense, error) {
+	out := new(Expense)
+	err := c.cc.Invoke(ctx, ExpenseService_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) GetByID(ctx context.Context, in *ExpensePrimaryKey, opts ...grpc.CallOption) (*Expense, error) {
+	out := new(Expense)
+	err := c.cc.Invoke(ctx, ExpenseService_GetByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) GetList(ctx context.Context, in *GetListExpenseRequest, opts ...grpc.CallOption) (*GetListExpenseResponse, error) {
+	out := new(GetListExpenseResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_GetList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) Update(ctx context.Context, in *UpdateExpense, opts ...grpc.CallOption) (*Expense, error) {
+	out := new(Expense)
+	err := c.cc.Invoke(ctx, ExpenseService_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) Delete(ctx context.Context, in *ExpensePrimaryKey, opts ...grpc.CallOption) (*EmptyExpense, error) {
+	out := new(EmptyExpense)
+	err := c.cc.Invoke(ctx, ExpenseService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) Review(ctx context.Context, in *ReviewExpense, opts ...grpc.CallOption) (*Expense, error) {
+	out := new(Expense)
+	err := c.cc.Invoke(ctx, ExpenseService_Review_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) AddAttachment(ctx context.Context, in *CreateExpenseAttachment, opts ...grpc.CallOption) (*ExpenseAttachment, error) {
+	out := new(ExpenseAttachment)
+	err := c.cc.Invoke(ctx, ExpenseService_AddAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) GetAttachment(ctx context.Context, in *ExpenseAttachmentPrimaryKey, opts ...grpc.CallOption) (*ExpenseAttachmentFile, error) {
+	out := new(ExpenseAttachmentFile)
+	err := c.cc.Invoke(ctx, ExpenseService_GetAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) DeleteAttachment(ctx context.Context, in *ExpenseAttachmentPrimaryKey, opts ...grpc.CallOption) (*EmptyExpense, error) {
+	out := new(EmptyExpense)
+	err := c.cc.Invoke(ctx, ExpenseService_DeleteAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExpenseServiceServer is the server API for ExpenseService service.
+// All implementations should embed UnimplementedExpenseServiceServer
+// for forward compatibility
+type ExpenseServiceServer interface {
+	CreateCategory(context.Context, *CreateExpenseCategory) (*ExpenseCategory, error)
+	GetListCategory(context.Context, *GetListExpenseCategoryRequest) (*GetListExpenseCategoryResponse, error)
+	DeleteCategory(context.Context, *ExpenseCategoryPrimaryKey) (*EmptyExpense, error)
+	Create(context.Context, *CreateExpense) (*Expense, error)
+	GetByID(context.Context, *ExpensePrimaryKey) (*Expense, error)
+	GetList(context.Context, *GetListExpenseRequest) (*GetListExpenseResponse, error)
+	Update(context.Context, *UpdateExpense) (*Expense, error)
+	Delete(context.Context, *ExpensePrimaryKey) (*EmptyExpense, error)
+	Review(context.Context, *ReviewExpense) (*Expense, error)
+	AddAttachment(context.Context, *CreateExpenseAttachment) (*ExpenseAttachment, error)
+	GetAttachment(context.Context, *ExpenseAttachmentPrimaryKey) (*ExpenseAttachmentFile, error)
+	DeleteAttachment(context.Context, *ExpenseAttachmentPrimaryKey) (*EmptyExpense, error)
+}
+
+// UnimplementedExpenseServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedExpenseServiceServer struct {
+}
+
+func (UnimplementedExpenseServiceServer) CreateCategory(context.Context, *CreateExpenseCategory) (*ExpenseCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedExpenseServiceServer) GetListCategory(context.Context, *GetListExpenseCategoryRequest) (*GetListExpenseCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListCategory not implemented")
+}
+func (UnimplementedExpenseServiceServer) DeleteCategory(context.Context, *ExpenseCategoryPrimaryKey) (*EmptyExpense, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedExpenseServiceServer) Create(context.Context, *CreateExpense) (*Expense, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedExpenseServiceServer) GetByID(context.Context, *ExpensePrimaryKey) (*Expense, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedExpenseServiceServer) GetList(context.Context, *GetListExpenseRequest) (*GetListExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedExpenseServiceServer) Update(context.Context, *UpdateExpense) (*Expense, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedExpenseServiceServer) Delete(context.Context, *ExpensePrimaryKey) (*EmptyExpense, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedExpenseServiceServer) Review(context.Context, *ReviewExpense) (*Expense, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Review not implemented")
+}
+func (UnimplementedExpenseServiceServer) AddAttachment(context.Context, *CreateExpenseAttachment) (*ExpenseAttachment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAttachment not implemented")
+}
+func (UnimplementedExpenseServiceServer) GetAttachment(context.Context, *ExpenseAttachmentPrimaryKey) (*ExpenseAttachmentFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachment not implemented")
+}
+func (UnimplementedExpenseServiceServer) DeleteAttachment(context.Context, *ExpenseAttachmentPrimaryKey) (*EmptyExpense, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+
+// UnsafeExpenseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExpenseServiceServer will
+// result in compilation errors.
+type UnsafeExpenseServiceServer interface {
+	mustEmbedUnimplementedExpenseServiceServer()
+}
+
+func RegisterExpenseServiceServer(s grpc.ServiceRegistrar, srv ExpenseServiceServer) {
+	s.RegisterService(&ExpenseService_ServiceDesc, srv)
+}
+
+func _ExpenseService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExpenseCategory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).CreateCategory(ctx, req.(*CreateExpenseCategory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_GetListCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListExpenseCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).GetListCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_GetListCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).GetListCategory(ctx, req.(*GetListExpenseCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseCategoryPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).DeleteCategory(ctx, req.(*ExpenseCategoryPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExpense)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).Create(ctx, req.(*CreateExpense))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpensePrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_GetByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).GetByID(ctx, req.(*ExpensePrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).GetList(ctx, req.(*GetListExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExpense)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).Update(ctx, req.(*UpdateExpense))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpensePrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).Delete(ctx, req.(*ExpensePrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_Review_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewExpense)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).Review(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_Review_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).Review(ctx, req.(*ReviewExpense))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_AddAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExpenseAttachment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).AddAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_AddAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).AddAttachment(ctx, req.(*CreateExpenseAttachment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_GetAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseAttachmentPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).GetAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_GetAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).GetAttachment(ctx, req.(*ExpenseAttachmentPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseAttachmentPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).DeleteAttachment(ctx, req.(*ExpenseAttachmentPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExpenseService_ServiceDesc is the grpc.ServiceDesc for ExpenseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExpenseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule_service.ExpenseService",
+	HandlerType: (*ExpenseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCategory",
+			Handler:    _ExpenseService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "GetListCategory",
+			Handler:    _ExpenseService_GetListCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _ExpenseService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _ExpenseService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _ExpenseService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _ExpenseService_GetList_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ExpenseService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ExpenseService_Delete_Handler,
+		},
+		{
+			MethodName: "Review",
+			Handler:    _ExpenseService_Review_Handler,
+		},
+		{
+			MethodName: "AddAttachment",
+			Handler:    _ExpenseService_AddAttachment_Handler,
+		},
+		{
+			MethodName: "GetAttachment",
+			Handler:    _ExpenseService_GetAttachment_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _ExpenseService_DeleteAttachment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "expense.proto",
+}
@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: finance_report.proto
+
+package schedule_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	FinanceReportService_GetRevenue_FullMethodName         = "/schedule_service.FinanceReportService/GetRevenue"
+	FinanceReportService_GetCollections_FullMethodName     = "/schedule_service.FinanceReportService/GetCollections"
+	FinanceReportService_GetDebtAging_FullMethodName       = "/schedule_service.FinanceReportService/GetDebtAging"
+	FinanceReportService_GetMonthComparison_FullMethodName = "/schedule_service.FinanceReportService/GetMonthComparison"
+	FinanceReportService_GetProfitAndLoss_FullMethodName   = "/schedule_service.FinanceReportService/GetProfitAndLoss"
+)
+
+// FinanceReportServiceClient is the client API for FinanceReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FinanceReportServiceClient interface {
+	GetRevenue(ctx context.Context, in *FinanceReportRequest, opts ...grpc.CallOption) (*RevenueReport, error)
+	GetCollections(ctx context.Context, in *FinanceReportRequest, opts ...grpc.CallOption) (*CollectionsReport, error)
+	GetDebtAging(ctx context.Context, in *DebtAgingRequest, opts ...grpc.CallOption) (*DebtAgingReport, error)
+	GetMonthComparison(ctx context.Context, in *FinanceReportRequest, opts ...grpc.CallOption) (*MonthComparisonReport, error)
+	GetProfitAndLoss(ctx context.Context, in *FinanceReportRequest, opts ...grpc.CallOption) (*ProfitAndLossReport, error)
+}
+
+type financeReportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFinanceReportServiceClient(cc grpc.ClientConnInterface) FinanceReportServiceClient {
+	return &financeReportServiceClient{cc}
+}
+
+func (c *financeReportServiceClient) GetRevenue(ctx context.Context, in *FinanceReportRequest, opts ...grpc.CallOption) (*RevenueReport, error) {
+	out := new(RevenueReport)
+	err := c.cc.Invoke(ctx, FinanceReportService_GetRevenue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeReportServiceClient) GetCollections(ctx context.Context, in *FinanceReportRequest, opts ...grpc.CallOption) (*CollectionsReport, error) {
+	out := new(CollectionsReport)
+	err := c.cc.Invoke(ctx, FinanceReportService_GetCollections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeReportServiceClient) GetDebtAging(ctx context.Context, in *DebtAgingRequest, opts ...grpc.CallOption) (*DebtAgingReport, error) {
+	out := new(DebtAgingReport)
+	err := c.cc.Invoke(ctx, FinanceReportService_GetDebtAging_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeReportServiceClient) GetMonthComparison(ctx context.Context, in *FinanceReportRequest, opts ...grpc.CallOption) (*MonthComparisonReport, error) {
+	out := new(MonthComparisonReport)
+	err := c.cc.Invoke(ctx, FinanceReportService_GetMonthComparison_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeReportServiceClient) GetProfitAndLoss(ctx context.Context, in *FinanceReportRequest, opts ...grpc.CallOption) (*ProfitAndLossReport, error) {
+	out := new(ProfitAndLossReport)
+	err := c.cc.Invoke(ctx, FinanceReportService_GetProfitAndLoss_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FinanceReportServiceServer is the server API for FinanceReportService service.
+// All implementations should embed UnimplementedFinanceReportServiceServer
+// for forward compatibility
+type FinanceReportServiceServer interface {
+	GetRevenue(context.Context, *FinanceReportRequest) (*RevenueReport, error)
+	GetCollections(context.Context, *FinanceReportRequest) (*CollectionsReport, error)
+	GetDebtAging(context.Context, *DebtAgingRequest) (*DebtAgingReport, error)
+	GetMonthComparison(context.Context, *FinanceReportRequest) (*MonthComparisonReport, error)
+	GetProfitAndLoss(context.Context, *FinanceReportRequest) (*ProfitAndLossReport, error)
+}
+
+// UnimplementedFinanceReportServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedFinanceReportServiceServer struct {
+}
+
+func (UnimplementedFinanceReportServiceServer) GetRevenue(context.Context, *FinanceReportRequest) (*RevenueReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevenue not implemented")
+}
+func (UnimplementedFinanceReportServiceServer) GetCollections(context.Context, *FinanceReportRequest) (*CollectionsReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollections not implemented")
+}
+func (UnimplementedFinanceReportServiceServer) GetDebtAging(context.Context, *DebtAgingRequest) (*DebtAgingReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDebtAging not implemented")
+}
+func (UnimplementedFinanceReportServiceServer) GetMonthComparison(context.Context, *FinanceReportRequest) (*MonthComparisonReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMonthComparison not implemented")
+}
+func (UnimplementedFinanceReportServiceServer) GetProfitAndLoss(context.Context, *FinanceReportRequest) (*ProfitAndLossReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfitAndLoss not implemented")
+}
+
+// UnsafeFinanceReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FinanceReportServiceServer will
+// result in compilation errors.
+type UnsafeFinanceReportServiceServer interface {
+	mustEmbedUnimplementedFinanceReportServiceServer()
+}
+
+func RegisterFinanceReportServiceServer(s grpc.ServiceRegistrar, srv FinanceReportServiceServer) {
+	s.RegisterService(&FinanceReportService_ServiceDesc, srv)
+}
+
+func _FinanceReportService_GetRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinanceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceReportServiceServer).GetRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceReportService_GetRevenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceReportServiceServer).GetRevenue(ctx, req.(*FinanceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceReportService_GetCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinanceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceReportServiceServer).GetCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceReportService_GetCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceReportServiceServer).GetCollections(ctx, req.(*FinanceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceReportService_GetDebtAging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebtAgingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceReportServiceServer).GetDebtAging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceReportService_GetDebtAging_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceReportServiceServer).GetDebtAging(ctx, req.(*DebtAgingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceReportService_GetMonthComparison_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinanceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceReportServiceServer).GetMonthComparison(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceReportService_GetMonthComparison_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceReportServiceServer).GetMonthComparison(ctx, req.(*FinanceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceReportService_GetProfitAndLoss_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinanceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceReportServiceServer).GetProfitAndLoss(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceReportService_GetProfitAndLoss_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceReportServiceServer).GetProfitAndLoss(ctx, req.(*FinanceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FinanceReportService_ServiceDesc is the grpc.ServiceDesc for FinanceReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FinanceReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule_service.FinanceReportService",
+	HandlerType: (*FinanceReportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRevenue",
+			Handler:    _FinanceReportService_GetRevenue_Handler,
+		},
+		{
+			MethodName: "GetCollections",
+			Handler:    _FinanceReportService_GetCollections_Handler,
+		},
+		{
+			MethodName: "GetDebtAging",
+			Handler:    _FinanceReportService_GetDebtAging_Handler,
+		},
+		{
+			MethodName: "GetMonthComparison",
+			Handler:    _FinanceReportService_GetMonthComparison_Handler,
+		},
+		{
+			MethodName: "GetProfitAndLoss",
+			Handler:    _FinanceReportService_GetProfitAndLoss_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance_report.proto",
+}
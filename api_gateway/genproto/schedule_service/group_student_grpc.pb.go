@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: group_student.proto
+
+package schedule_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GroupStudentService_Create_FullMethodName        = "/schedule_service.GroupStudentService/Create"
+	GroupStudentService_GetByID_FullMethodName       = "/schedule_service.GroupStudentService/GetByID"
+	GroupStudentService_GetList_FullMethodName       = "/schedule_service.GroupStudentService/GetList"
+	GroupStudentService_Delete_FullMethodName        = "/schedule_service.GroupStudentService/Delete"
+	GroupStudentService_JoinWaitlist_FullMethodName  = "/schedule_service.GroupStudentService/JoinWaitlist"
+	GroupStudentService_GetWaitlist_FullMethodName   = "/schedule_service.GroupStudentService/GetWaitlist"
+	GroupStudentService_LeaveWaitlist_FullMethodName = "/schedule_service.GroupStudentService/LeaveWaitlist"
+)
+
+// GroupStudentServiceClient is the client API for GroupStudentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GroupStudentServiceClient interface {
+	Create(ctx context.Context, in *CreateGroupStudent, opts ...grpc.CallOption) (*GetGroupStudent, error)
+	GetByID(ctx context.Context, in *GroupStudentPrimaryKey, opts ...grpc.CallOption) (*GetGroupStudent, error)
+	GetList(ctx context.Context, in *GetListGroupStudentRequest, opts ...grpc.CallOption) (*GetListGroupStudentResponse, error)
+	Delete(ctx context.Context, in *GroupStudentPrimaryKey, opts ...grpc.CallOption) (*EmptyGroupStudent, error)
+	JoinWaitlist(ctx context.Context, in *CreateGroupStudent, opts ...grpc.CallOption) (*WaitlistEntry, error)
+	GetWaitlist(ctx context.Context, in *GetWaitlistRequest, opts ...grpc.CallOption) (*GetWaitlistResponse, error)
+	LeaveWaitlist(ctx context.Context, in *WaitlistPrimaryKey, opts ...grpc.CallOption) (*EmptyGroupStudent, error)
+}
+
+type groupStudentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroupStudentServiceClient(cc grpc.ClientConnInterface) GroupStudentServiceClient {
+	return &groupStudentServiceClient{cc}
+}
+
+func (c *groupStudentServiceClient) Create(ctx context.Context, in *CreateGroupStudent, opts ...grpc.CallOption) (*GetGroupStudent, error) {
+	out := new(GetGroupStudent)
+	err := c.cc.Invoke(ctx, GroupStudentService_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupStudentServiceClient) GetByID(ctx context.Context, in *GroupStudentPrimaryKey, opts ...grpc.CallOption) (*GetGroupStudent, error) {
+	out := new(GetGroupStudent)
+	err := c.cc.Invoke(ctx, GroupStudentService_GetByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupStudentServiceClient) GetList(ctx context.Context, in *GetListGroupStudentRequest, opts ...grpc.CallOption) (*GetListGroupStudentResponse, error) {
+	out := new(GetListGroupStudentResponse)
+	err := c.cc.Invoke(ctx, GroupStudentService_GetList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupStudentServiceClient) Delete(ctx context.Context, in *GroupStudentPrimaryKey, opts ...grpc.CallOption) (*EmptyGroupStudent, error) {
+	out := new(EmptyGroupStudent)
+	err := c.cc.Invoke(ctx, GroupStudentService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupStudentServiceClient) JoinWaitlist(ctx context.Context, in *CreateGroupStudent, opts ...grpc.CallOption) (*WaitlistEntry, error) {
+	out := new(WaitlistEntry)
+	err := c.cc.Invoke(ctx, GroupStudentService_JoinWaitlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupStudentServiceClient) GetWaitlist(ctx context.Context, in *GetWaitlistRequest, opts ...grpc.CallOption) (*GetWaitlistResponse, error) {
+	out := new(GetWaitlistResponse)
+	err := c.cc.Invoke(ctx, GroupStudentService_GetWaitlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupStudentServiceClient) LeaveWaitlist(ctx context.Context, in *WaitlistPrimaryKey, opts ...grpc.CallOption) (*EmptyGroupStudent, error) {
+	out := new(EmptyGroupStudent)
+	err := c.cc.Invoke(ctx, GroupStudentService_LeaveWaitlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupStudentServiceServer is the server API for GroupStudentService service.
+// All implementations should embed UnimplementedGroupStudentServiceServer
+// for forward compatibility
+type GroupStudentServiceServer interface {
+	Create(context.Context, *CreateGroupStudent) (*GetGroupStudent, error)
+	GetByID(context.Context, *GroupStudentPrimaryKey) (*GetGroupStudent, error)
+	GetList(context.Context, *GetListGroupStudentRequest) (*GetListGroupStudentResponse, error)
+	Delete(context.Context, *GroupStudentPrimaryKey) (*EmptyGroupStudent, error)
+	JoinWaitlist(context.Context, *CreateGroupStudent) (*WaitlistEntry, error)
+	GetWaitlist(context.Context, *GetWaitlistRequest) (*GetWaitlistResponse, error)
+	LeaveWaitlist(context.Context, *WaitlistPrimaryKey) (*EmptyGroupStudent, error)
+}
+
+// UnimplementedGroupStudentServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedGroupStudentServiceServer struct {
+}
+
+func (UnimplementedGroupStudentServiceServer) Create(context.Context, *CreateGroupStudent) (*GetGroupStudent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedGroupStudentServiceServer) GetByID(context.Context, *GroupStudentPrimaryKey) (*GetGroupStudent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedGroupStudentServiceServer) GetList(context.Context, *GetListGroupStudentRequest) (*GetListGroupStudentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedGroupStudentServiceServer) Delete(context.Context, *GroupStudentPrimaryKey) (*EmptyGroupStudent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedGroupStudentServiceServer) JoinWaitlist(context.Context, *CreateGroupStudent) (*WaitlistEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (UnimplementedGroupStudentServiceServer) GetWaitlist(context.Context, *GetWaitlistRequest) (*GetWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitlist not implemented")
+}
+func (UnimplementedGroupStudentServiceServer) LeaveWaitlist(context.Context, *WaitlistPrimaryKey) (*EmptyGroupStudent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+
+// UnsafeGroupStudentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupStudentServiceServer will
+// result in compilation errors.
+type UnsafeGroupStudentServiceServer interface {
+	mustEmbedUnimplementedGroupStudentServiceServer()
+}
+
+func RegisterGroupStudentServiceServer(s grpc.ServiceRegistrar, srv GroupStudentServiceServer) {
+	s.RegisterService(&GroupStudentService_ServiceDesc, srv)
+}
+
+func _GroupStudentService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupStudent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupStudentServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupStudentService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupStudentServiceServer).Create(ctx, req.(*CreateGroupStudent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupStudentService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupStudentPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupStudentServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupStudentService_GetByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupStudentServiceServer).GetByID(ctx, req.(*GroupStudentPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupStudentService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListGroupStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupStudentServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupStudentService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupStudentServiceServer).GetList(ctx, req.(*GetListGroupStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupStudentService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupStudentPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupStudentServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupStudentService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupStudentServiceServer).Delete(ctx, req.(*GroupStudentPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupStudentService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupStudent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupStudentServiceServer).JoinWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupStudentService_JoinWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupStudentServiceServer).JoinWaitlist(ctx, req.(*CreateGroupStudent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupStudentService_GetWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupStudentServiceServer).GetWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupStudentService_GetWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupStudentServiceServer).GetWaitlist(ctx, req.(*GetWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupStudentService_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitlistPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupStudentServiceServer).LeaveWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupStudentService_LeaveWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupStudentServiceServer).LeaveWaitlist(ctx, req.(*WaitlistPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GroupStudentService_ServiceDesc is the grpc.ServiceDesc for GroupStudentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GroupStudentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule_service.GroupStudentService",
+	HandlerType: (*GroupStudentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _GroupStudentService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _GroupStudentService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _GroupStudentService_GetList_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _GroupStudentService_Delete_Handler,
+		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _GroupStudentService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "GetWaitlist",
+			Handler:    _GroupStudentService_GetWaitlist_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _GroupStudentService_LeaveWaitlist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "group_student.proto",
+}
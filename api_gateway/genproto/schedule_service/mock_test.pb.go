@@ -0,0 +1,1660 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: mock_test.proto
+
+package schedule_service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EmptyMockTest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EmptyMockTest) Reset() {
+	*x = EmptyMockTest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mock_test_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyMockTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyMockTest) ProtoMessage() {}
+
+func (x *EmptyMockTest) ProtoReflect() protoreflect.Message {
+	mi := &file_mock_test_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyMockTest.ProtoReflect.Descriptor instead.
+func (*EmptyMockTest) Descriptor() ([]byte, []int) {
+	return file_mock_test_proto_rawDescGZIP(), []int{0}
+}
+
+type MockTestPrimaryKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *MockTestPrimaryKey) Reset() {
+	*x = MockTestPrimaryKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mock_test_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockTestPrimaryKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockTestPrimaryKey) ProtoMessage() {}
+
+func (x *MockTestPrimaryKey) ProtoReflect() protoreflect.Message {
+	mi := &file_mock_test_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockTestPrimaryKey.ProtoReflect.Descriptor instead.
+func (*MockTestPrimaryKey) Descriptor() ([]byte, []int) {
+	return file_mock_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MockTestPrimaryKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateMockTest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId     string `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TestDate    string `protobuf:"bytes,3,opt,name=testDate,proto3" json:"testDate,omitempty"`
+	ReadingType string `protobuf:"bytes,4,opt,name=readingType,proto3" json:"readingType,omitempty"`
+	CreatedBy   string `protobuf:"bytes,5,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+}
+
+func (x *CreateMockTest) Reset() {
+	*x = CreateMockTest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mock_test_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMockTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMockTest) ProtoMessage() {}
+
+func (x *CreateMockTest) ProtoReflect() protoreflect.Message {
+	mi := &file_mock_test_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMockTest.ProtoReflect.Descriptor instead.
+func (*CreateMockTest) Descriptor() ([]byte, []int) {
+	return file_mock_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateMockTest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateMockTest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateMockTest) GetTestDate() string {
+	if x != nil {
+		return x.TestDate
+	}
+	return ""
+}
+
+func (x *CreateMockTest) GetReadingType() string {
+	if x != nil {
+		return x.ReadingType
+	}
+	return ""
+}
+
+func (x *CreateMockTest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type MockBands struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listening float32 `protobuf:"fixed32,1,opt,name=listening,proto3" json:"listening,omitempty"`
+	Reading   float32 `protobuf:"fixed32,2,opt,name=reading,proto3" json:"reading,omitempty"`
+	Writing   float32 `protobuf:"fixed32,3,opt,name=writing,proto3" json:"writing,omitempty"`
+	Speaking  float32 `protobuf:"fixed32,4,opt,name=speaking,proto3" json:"speaking,omitempty"`
+	Overall   float32 `protobuf:"fixed32,5,opt,name=overall,proto3" json:"overall,omitempty"`
+}
+
+func (x *MockBands) Reset() {
+	*x = MockBands{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mock_test_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockBands) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockBands) ProtoMessage() {}
+
+func (x *MockBands) ProtoReflect() protoreflect.Message {
+	mi := &file_mock_test_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockBands.ProtoReflect.Descriptor instead.
+func (*MockBands) Descriptor() ([]byte, []int) {
+	return file_mock_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MockBands) GetListening() float32 {
+	if x != nil {
+		return x.Listening
+	}
+	return 0
+}
+
+func (x *MockBands) GetReading() float32 {
+	if x != nil {
+		return x.Reading
+	}
+	return 0
+}
+
+func (x *MockBands) GetWriting() float32 {
+	if x != nil {
+		return x.Writing
+	}
+	return 0
+}
+
+func (x *MockBands) GetSpeaking() float32 {
+	if x != nil {
+		return x.Speaking
+	}
+	return 0
+}
+
+func (x *MockBands) GetOverall() float32 {
+	if x != nil {
+		return x.Overall
+	}
+	return 0
+}
+
+type MockTest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId     string            `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName   string            `protobuf:"bytes,3,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	Title       string            `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	TestDate    string            `protobuf:"bytes,5,opt,name=testDate,proto3" json:"testDate,omitempty"`
+	ReadingType string            `protobuf:"bytes,6,opt,name=readingType,proto3" json:"readingType,omitempty"`
+	CreatedBy   string            `protobuf:"bytes,7,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt   string            `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ResultCount int32             `protobuf:"varint,9,opt,name=resultCount,proto3" json:"resultCount,omitempty"`
+	Average     *MockBands        `protobuf:"bytes,10,opt,name=average,proto3" json:"average,omitempty"`
+	Results     []*MockTestResult `protobuf:"bytes,11,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *MockTest) Reset() {
+	*x = MockTest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mock_test_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockTest) ProtoMessage() {}
+
+func (x *MockTest) ProtoReflect() protoreflect.Message {
+	mi := &file_mock_test_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockTest.ProtoReflect.Descriptor instead.
+func (*MockTest) Descriptor() ([]byte, []int) {
+	return file_mock_test_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MockTest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MockTest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *MockTest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *MockTest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MockTest) GetTestDate() string {
+	if x != nil {
+		return x.TestDate
+	}
+	return ""
+}
+
+func (x *MockTest) GetReadingType() string {
+	if x != nil {
+		return x.ReadingType
+	}
+	return ""
+}
+
+func (x *MockTest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *MockTest) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *MockTest) GetResultCount() int32 {
+	if x != nil {
+		return x.ResultCount
+	}
+	return 0
+}
+
+func (x *MockTest) GetAverage() *MockBands {
+	if x != nil {
+		return x.Average
+	}
+	return nil
+}
+
+func (x *MockTest) GetResults() []*MockTestResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetListMockTestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit    uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	GroupId  string `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	FromDate string `protobuf:"bytes,4,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate   string `protobuf:"bytes,5,opt,name=toDate,proto3" json:"toDate,omitempty"`
+}
+
+func (x *GetListMockTestRequest) Reset() {
+	*x = GetListMockTestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mock_test_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListMockTestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListMockTestRequest) ProtoMessage() {}
+
+func (x *GetListMockTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mock_test_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListMockTestRequest.ProtoReflect.Descriptor instead.
+func (*GetListMockTestRequest) Descriptor() ([]byte, []int) {
+	return file_mock_test_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetListMockTestRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetListMockTestRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetListMockTestRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetListMockTestRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetListMockTestRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type GetListMockTestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count     int64       `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	MockTests []*MockTest `protobuf:"bytes,2,rep,name=mockTests,proto3" json:"mockTests,omitempty"`
+}
+
+func (x *GetListMockTestResponse) Reset() {
+	*x = GetListMockTestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mock_test_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListMockTestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListMockTestResponse) ProtoMessage() {}
+
+func (x *GetListMockTestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mock_test_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListMockTestResponse.ProtoReflect.Descriptor instead.
+func (*GetListMockTestResponse) Descriptor() ([]byte, []int) {
+	return file_mock_test_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetListMockTestResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetListMockTestResponse) GetMockTests() []*MockTest {
+	if x != nil {
+		return x.MockTests
+	}
+	return nil
+}
+
+type SaveMockTestResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MockTestId            string `protobuf:"bytes,1,opt,name=mockTestId,proto3" json:"mockTestId,omitempty"`
+	StudentId             string `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	ListeningRaw          int32  `protobuf:"varint,3,opt,name=listeningRaw,proto3" json:"listeningRaw,omitempty"`
+	ReadingRaw            int32  `protobuf:"varint,4,opt,name=readingRaw,proto3" json:"readingRaw,omitempty"`
+	WritingTaskResponse   int32  `protobuf:"varint,5,opt,name=writingTaskResponse,proto3" json:"writingTaskResponse,omitempty"`
+	WritingCoherence      int32  `protobuf:"varint,6,opt,name=writingCoherence,proto3" json:"writingCoherence,omitempty"`
+	WritingLexical        int32  `protobuf:"varint,7,opt,name=writingLexical,proto3" json:"writingLexical,omitempty"`
+	WritingGrammar        int32  `protobuf:"varint,8,opt,name=writingGrammar,proto3" json:"writingGrammar,omitempty"`
+	SpeakingFluency       int32  `protobuf:"varint,9,opt,name=speakingFluency,proto3" json:"speakingFluency,omitempty"`
+	SpeakingLexical       int32  `protobuf:"varint,10,opt,name=speakingLexical,proto3" json:"speakingLexical,omitempty"`
+	SpeakingGrammar       int32  `protobuf:"varint,11,opt,name=speakingGrammar,proto3" json:"speakingGrammar,omitempty"`
+	SpeakingPronunciation int32  `protobuf:"varint,12,opt,name=speakingPronunciation,proto3" json:"speakingPronunciation,omitempty"`
+}
+
+func (x *SaveMockTestResult) Reset() {
+	*x = SaveMockTestResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mock_test_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveMockTestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveMockTestResult) ProtoMessage() {}
+
+func (x *SaveMockTestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mock_test_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveMockTestResult.ProtoReflect.Descriptor instead.
+func (*SaveMockTestResult) Descriptor() ([]byte, []int) {
+	return file_mock_test_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SaveMockTestResult) GetMockTestId() string {
+	if x != nil {
+		return x.MockTestId
+	}
+	return ""
+}
+
+func (x *SaveMockTestResult) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SaveMockTestResult) GetListeningRaw() int32 {
+	if x != nil {
+		return x.ListeningRaw
+	}
+	return 0
+}
+
+func (x *SaveMockTestResult) GetReadingRaw() int32 {
+	if x != nil {
+		return x.ReadingRaw
+	}
+	return 0
+}
+
+func (x *SaveMockTestResult) GetWritingTaskResponse() int32 {
+	if x != nil {
+		return x.WritingTaskResponse
+	}
+	return 0
+}
+
+func (x *SaveMockTestResult) GetWritingCoherence() int32 {
+	if x != nil {
+		return x.WritingCoherence
+	}
+	return 0
+}
+
+func (x *SaveMockTestResult) GetWritingLexical() int32 {
+	if x != nil {
+		return x.WritingLexical
+	}
+	return 0
+}
+
+func (x *SaveMockTestResult) GetWritingGrammar() int32 {
+	if x != nil {
+		return x.WritingGrammar
+	}
+	return 0
+}
+
+func (x *SaveMockTestResult) GetSpeakingFluency() int32 {
+	if x != nil {
+		return x.SpeakingFluency
+	}
+	return 0
+}
+
+func (x *SaveMockTestResult) GetSpeakingLexical() int32 {
+	if x != nil {
+		return x.SpeakingLexical
+	}
+	return 0
+}
+
+func (x *SaveMockTestResult) GetSpeakingGrammar() int32 {
+	if x != nil {
+		return x.SpeakingGrammar
+	}
+	return 0
+}
+
+func (x *SaveMockTestResult) GetSpeakingPronunciation() int32 {
+	if x != nil {
+		return x.SpeakingPronunciation
+	}
+	return 0
+}
+
+type MockTestResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                    string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MockTestId            string     `protobuf:"bytes,2,opt,name=mockTestId,proto3" json:"mockTestId,omitempty"`
+	Title                 string     `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	TestDate              string     `protobuf:"bytes,4,opt,name=testDate,proto3" json:"testDate,omitempty"`
+	StudentId             string     `protobuf:"bytes,5,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName           string     `protobuf:"bytes,6,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	ListeningRaw          int32      `protobuf:"varint,7,opt,name=listeningRaw,proto3" json:"listeningRaw,omitempty"`
+	ReadingRaw            int32      `protobuf:"varint,8,opt,name=readingRaw,proto3" json:"readingRaw,omitempty"`
+	WritingTaskResponse   int32      `protobuf:"varint,9,opt,name=writingTaskResponse,proto3" json:"writingTaskResponse,omitempty"`
+	WritingCoherence      int32      `protobuf:"varint,10,opt,name=writingCoherence,proto3" json:"writingCoherence,omitempty"`
+	WritingLexical        int32      `protobuf:"varint,11,opt,name=writingLexical,proto3" json:"writingLexical,omitempty"`
+	WritingGrammar        int32      `protobuf:"varint,12,opt,name=writingGrammar,proto3" json:"writingGrammar,omitempty"`
+	SpeakingFluency       int32      `protobuf:"varint,13,opt,name=speakingFluency,proto3" json:"speakingFluency,omitempty"`
+	SpeakingLexical       int32      `protobuf:"varint,14,opt,name=speakingLexical,proto3" json:"speakingLexical,omitempty"`
+	SpeakingGrammar       int32      `protobuf:"varint,15,opt,name=speakingGrammar,proto3" json:"speakingGrammar,omitempty"`
+	SpeakingPronunciation int32      `protobuf:"varint,16,opt,name=speakingPronunciation,proto3" json:"speakingPronunciation,omitempty"`
+	Bands                 *MockBands `protobuf:"bytes,17,opt,name=bands,proto3" json:"bands,omitempty"`
+}
+
+func (x *MockTestResult) Reset() {
+	*x = MockTestResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mock_test_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockTestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockTestResult) ProtoMessage() {}
+
+func (x *MockTestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mock_test_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockTestResult.ProtoReflect.Descriptor instead.
+func (*MockTestResult) Descriptor() ([]byte, []int) {
+	return file_mock_test_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MockTestResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MockTestResult) GetMockTestId() string {
+	if x != nil {
+		return x.MockTestId
+	}
+	return ""
+}
+
+func (x *MockTestResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MockTestResult) GetTestDate() string {
+	if x != nil {
+		return x.TestDate
+	}
+	return ""
+}
+
+func (x *MockTestResult) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *MockTestResult) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *MockTestResult) GetListeningRaw() int32 {
+	if x != nil {
+		return x.ListeningRaw
+	}
+	return 0
+}
+
+func (x *MockTestResult) GetReadingRaw() int32 {
+	if x != nil {
+		return x.ReadingRaw
+	}
+	return 0
+}
+
+func (x *MockTestResult) GetWritingTaskResponse() int32 {
+	if x != nil {
+		return x.WritingTaskResponse
+	}
+	return 0
+}
+
+func (x *MockTestResult) GetWritingCoherence() int32 {
+	if x != nil {
+		return x.WritingCoherence
+	}
+	return 0
+}
+
+func (x *MockTestResult) GetWritingLexical() int32 {
+	if x != nil {
+		return x.WritingLexical
+	}
+	return 0
+}
+
+func (x *MockTestResult) GetWritingGrammar() int32 {
+	if x != nil {
+		return x.WritingGrammar
+	}
+	return 0
+}
+
+func (x *MockTestResult) GetSpeakingFluency() int32 {
+	if x != nil {
+		return x.SpeakingFluency
+	}
+	return 0
+}
+
+func (x *MockTestResult) GetSpeakingLexical() int32 {
+	if x != nil {
+		return x.SpeakingLexical
+	}
+	return 0
+}
+
+func (x *MockTestResult) GetSpeakingGrammar() int32 {
+	if x != nil {
+		return x.SpeakingGrammar
+	}
+	return 0
+}
+
+func (x *MockTestResult) GetSpeakingPronunciation() int32 {
+	if x != nil {
+		return x.SpeakingPronunciation
+	}
+	return 0
+}
+
+func (x *MockTestResult) GetBands() *MockBands {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+type MockTestResultPrimaryKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *MockTestResultPrimaryKey) Reset() {
+	*x = MockTestResultPrimaryKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mock_test_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockTestResultPrimaryKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockTestResultPrimaryKey) ProtoMessage() {}
+
+func (x *MockTestResultPrimaryKey) ProtoReflect() protoreflect.Message {
+	mi := &file_mock_test_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockTestResultPrimaryKey.ProtoReflect.Descriptor instead.
+func (*MockTestResultPrimaryKey) Descriptor() ([]byte, []int) {
+	return file_mock_test_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MockTestResultPrimaryKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StudentMockProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StudentId string `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	FromDate  string `protobuf:"bytes,2,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate    string `protobuf:"bytes,3,opt,name=toDate,proto3" json:"toDate,omitempty"`
+}
+
+func (x *StudentMockProgressRequest) Reset() {
+	*x = StudentMockProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mock_test_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentMockProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentMockProgressRequest) ProtoMessage() {}
+
+func (x *StudentMockProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mock_test_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentMockProgressRequest.ProtoReflect.Descriptor instead.
+func (*StudentMockProgressRequest) Descriptor() ([]byte, []int) {
+	return file_mock_test_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StudentMockProgressRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentMockProgressRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *StudentMockProgressRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type StudentMockProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StudentId     string            `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName   string            `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	Results       []*MockTestResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	Latest        *MockBands        `protobuf:"bytes,4,opt,name=latest,proto3" json:"latest,omitempty"`
+	Best          *MockBands        `protobuf:"bytes,5,opt,name=best,proto3" json:"best,omitempty"`
+	OverallChange float32           `protobuf:"fixed32,6,opt,name=overallChange,proto3" json:"overallChange,omitempty"`
+}
+
+func (x *StudentMockProgress) Reset() {
+	*x = StudentMockProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mock_test_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentMockProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentMockProgress) ProtoMessage() {}
+
+func (x *StudentMockProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_mock_test_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentMockProgress.ProtoReflect.Descriptor instead.
+func (*StudentMockProgress) Descriptor() ([]byte, []int) {
+	return file_mock_test_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StudentMockProgress) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentMockProgress) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *StudentMockProgress) GetResults() []*MockTestResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *StudentMockProgress) GetLatest() *MockBands {
+	if x != nil {
+		return x.Latest
+	}
+	return nil
+}
+
+func (x *StudentMockProgress) GetBest() *MockBands {
+	if x != nil {
+		return x.Best
+	}
+	return nil
+}
+
+func (x *StudentMockProgress) GetOverallChange() float32 {
+	if x != nil {
+		return x.OverallChange
+	}
+	return 0
+}
+
+type GroupMockAveragesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId  string `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	FromDate string `protobuf:"bytes,2,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate   string `protobuf:"bytes,3,opt,name=toDate,proto3" json:"toDate,omitempty"`
+}
+
+func (x *GroupMockAveragesRequest) Reset() {
+	*x = GroupMockAveragesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mock_test_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMockAveragesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMockAveragesRequest) ProtoMessage() {}
+
+func (x *GroupMockAveragesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mock_test_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMockAveragesRequest.ProtoReflect.Descriptor instead.
+func (*GroupMockAveragesRequest) Descriptor() ([]byte, []int) {
+	return file_mock_test_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GroupMockAveragesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupMockAveragesRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GroupMockAveragesRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type GroupMockAverages struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId   string      `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName string      `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	Tests     []*MockTest `protobuf:"bytes,3,rep,name=tests,proto3" json:"tests,omitempty"`
+	Average   *MockBands  `protobuf:"bytes,4,opt,name=average,proto3" json:"average,omitempty"`
+}
+
+func (x *GroupMockAverages) Reset() {
+	*x = GroupMockAverages{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mock_test_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupMockAverages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMockAverages) ProtoMessage() {}
+
+func (x *GroupMockAverages) ProtoReflect() protoreflect.Message {
+	mi := &file_mock_test_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMockAverages.ProtoReflect.Descriptor instead.
+func (*GroupMockAverages) Descriptor() ([]byte, []int) {
+	return file_mock_test_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GroupMockAverages) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupMockAverages) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *GroupMockAverages) GetTests() []*MockTest {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+func (x *GroupMockAverages) GetAverage() *MockBands {
+	if x != nil {
+		return x.Average
+	}
+	return nil
+}
+
+var File_mock_test_proto protoreflect.FileDescriptor
+
+var file_mock_test_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x6d, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x6f, 0x63, 0x6b,
+	0x54, 0x65, 0x73, 0x74, 0x22, 0x24, 0x0a, 0x12, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74,
+	0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x0e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x93, 0x01, 0x0a, 0x09, 0x4d, 0x6f,
+	0x63, 0x6b, 0x42, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x18, 0x0a, 0x07, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x07, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x65,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x73, 0x70, 0x65,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x22,
+	0xf7, 0x02, 0x0a, 0x08, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65,
+	0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
+	0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x42,
+	0x61, 0x6e, 0x64, 0x73, 0x52, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x22, 0x69, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a,
+	0x09, 0x6d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x52, 0x09, 0x6d, 0x6f,
+	0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x73, 0x22, 0xf8, 0x03, 0x0a, 0x12, 0x53, 0x61, 0x76, 0x65,
+	0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x77, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x77,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x77, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x77,
+	0x12, 0x30, 0x0a, 0x13, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x77,
+	0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x68,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x77, 0x72,
+	0x69, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x68, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x61, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4c,
+	0x65, 0x78, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e,
+	0x67, 0x47, 0x72, 0x61, 0x6d, 0x6d, 0x61, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x61, 0x6d, 0x6d, 0x61, 0x72, 0x12, 0x28,
+	0x0a, 0x0f, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x46, 0x6c, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x78, 0x69, 0x63,
+	0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x47, 0x72,
+	0x61, 0x6d, 0x6d, 0x61, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x70, 0x65,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x61, 0x6d, 0x6d, 0x61, 0x72, 0x12, 0x34, 0x0a, 0x15,
+	0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x6e, 0x75, 0x6e, 0x63, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x73, 0x70, 0x65,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x6e, 0x75, 0x6e, 0x63, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x8b, 0x05, 0x0a, 0x0e, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x63, 0x6b, 0x54,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x77, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x77, 0x12, 0x30, 0x0a, 0x13, 0x77,
+	0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e,
+	0x67, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x10, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x68, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x68, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x77, 0x72, 0x69,
+	0x74, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x61,
+	0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x61, 0x6d,
+	0x6d, 0x61, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x77, 0x72, 0x69, 0x74, 0x69,
+	0x6e, 0x67, 0x47, 0x72, 0x61, 0x6d, 0x6d, 0x61, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x70, 0x65,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4c,
+	0x65, 0x78, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x70,
+	0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x28, 0x0a,
+	0x0f, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x61, 0x6d, 0x6d, 0x61, 0x72,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x47, 0x72, 0x61, 0x6d, 0x6d, 0x61, 0x72, 0x12, 0x34, 0x0a, 0x15, 0x73, 0x70, 0x65, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x6e, 0x75, 0x6e, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x50, 0x72, 0x6f, 0x6e, 0x75, 0x6e, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a,
+	0x05, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4d, 0x6f, 0x63, 0x6b, 0x42, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x05, 0x62, 0x61, 0x6e, 0x64, 0x73,
+	0x22, 0x2a, 0x0a, 0x18, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6e, 0x0a, 0x1a,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x22, 0x9d, 0x02, 0x0a,
+	0x13, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x33, 0x0a, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x42, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x06, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x62, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x42, 0x61, 0x6e, 0x64, 0x73,
+	0x52, 0x04, 0x62, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c,
+	0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x68, 0x0a, 0x18,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x11, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x42,
+	0x61, 0x6e, 0x64, 0x73, 0x52, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x32, 0xea, 0x05,
+	0x0a, 0x0f, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x48, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x24, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65,
+	0x73, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x1a, 0x1a, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x07, 0x47, 0x65,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x06,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65,
+	0x73, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x1a, 0x1f, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x22, 0x00, 0x12,
+	0x56, 0x0a, 0x0a, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x24, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x54,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x4b, 0x65, 0x79, 0x1a, 0x1f, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x4d, 0x6f, 0x63, 0x6b,
+	0x54, 0x65, 0x73, 0x74, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x6f, 0x63, 0x6b,
+	0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x73, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x67, 0x65,
+	0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mock_test_proto_rawDescOnce sync.Once
+	file_mock_test_proto_rawDescData = file_mock_test_proto_rawDesc
+)
+
+func file_mock_test_proto_rawDescGZIP() []byte {
+	file_mock_test_proto_rawDescOnce.Do(func() {
+		file_mock_test_proto_rawDescData = protoimpl.X.CompressGZIP(file_mock_test_proto_rawDescData)
+	})
+	return file_mock_test_proto_rawDescData
+}
+
+var file_mock_test_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_mock_test_proto_goTypes = []interface{}{
+	(*EmptyMockTest)(nil),              // 0: schedule_service.EmptyMockTest
+	(*MockTestPrimaryKey)(nil),         // 1: schedule_service.MockTestPrimaryKey
+	(*CreateMockTest)(nil),             // 2: schedule_service.CreateMockTest
+	(*MockBands)(nil),                  // 3: schedule_service.MockBands
+	(*MockTest)(nil),                   // 4: schedule_service.MockTest
+	(*GetListMockTestRequest)(nil),     // 5: schedule_service.GetListMockTestRequest
+	(*GetListMockTestResponse)(nil),    // 6: schedule_service.GetListMockTestResponse
+	(*SaveMockTestResult)(nil),         // 7: schedule_service.SaveMockTestResult
+	(*MockTestResult)(nil),             // 8: schedule_service.MockTestResult
+	(*MockTestResultPrimaryKey)(nil),   // 9: schedule_service.MockTestResultPrimaryKey
+	(*StudentMockProgressRequest)(nil), // 10: schedule_service.StudentMockProgressRequest
+	(*StudentMockProgress)(nil),        // 11: schedule_service.StudentMockProgress
+	(*GroupMockAveragesRequest)(nil),   // 12: schedule_service.GroupMockAveragesRequest
+	(*GroupMockAverages)(nil),          // 13: schedule_service.GroupMockAverages
+}
+var file_mock_test_proto_depIdxs = []int32{
+	3,  // 0: schedule_service.MockTest.average:type_name -> schedule_service.MockBands
+	8,  // 1: schedule_service.MockTest.results:type_name -> schedule_service.MockTestResult
+	4,  // 2: schedule_service.GetListMockTestResponse.mockTests:type_name -> schedule_service.MockTest
+	3,  // 3: schedule_service.MockTestResult.bands:type_name -> schedule_service.MockBands
+	8,  // 4: schedule_service.StudentMockProgress.results:type_name -> schedule_service.MockTestResult
+	3,  // 5: schedule_service.StudentMockProgress.latest:type_name -> schedule_service.MockBands
+	3,  // 6: schedule_service.StudentMockProgress.best:type_name -> schedule_service.MockBands
+	4,  // 7: schedule_service.GroupMockAverages.tests:type_name -> schedule_service.MockTest
+	3,  // 8: schedule_service.GroupMockAverages.average:type_name -> schedule_service.MockBands
+	2,  // 9: schedule_service.MockTestService.Create:input_type -> schedule_service.CreateMockTest
+	1,  // 10: schedule_service.MockTestService.GetByID:input_type -> schedule_service.MockTestPrimaryKey
+	5,  // 11: schedule_service.MockTestService.GetList:input_type -> schedule_service.GetListMockTestRequest
+	1,  // 12: schedule_service.MockTestService.Delete:input_type -> schedule_service.MockTestPrimaryKey
+	7,  // 13: schedule_service.MockTestService.SaveResult:input_type -> schedule_service.SaveMockTestResult
+	9,  // 14: schedule_service.MockTestService.DeleteResult:input_type -> schedule_service.MockTestResultPrimaryKey
+	10, // 15: schedule_service.MockTestService.GetStudentProgress:input_type -> schedule_service.StudentMockProgressRequest
+	12, // 16: schedule_service.MockTestService.GetGroupAverages:input_type -> schedule_service.GroupMockAveragesRequest
+	4,  // 17: schedule_service.MockTestService.Create:output_type -> schedule_service.MockTest
+	4,  // 18: schedule_service.MockTestService.GetByID:output_type -> schedule_service.MockTest
+	6,  // 19: schedule_service.MockTestService.GetList:output_type -> schedule_service.GetListMockTestResponse
+	0,  // 20: schedule_service.MockTestService.Delete:output_type -> schedule_service.EmptyMockTest
+	8,  // 21: schedule_service.MockTestService.SaveResult:output_type -> schedule_service.MockTestResult
+	0,  // 22: schedule_service.MockTestService.DeleteResult:output_type -> schedule_service.EmptyMockTest
+	11, // 23: schedule_service.MockTestService.GetStudentProgress:output_type -> schedule_service.StudentMockProgress
+	13, // 24: schedule_service.MockTestService.GetGroupAverages:output_type -> schedule_service.GroupMockAverages
+	17, // [17:25] is the sub-list for method output_type
+	9,  // [9:17] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_mock_test_proto_init() }
+func file_mock_test_proto_init() {
+	if File_mock_test_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mock_test_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyMockTest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mock_test_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockTestPrimaryKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mock_test_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMockTest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mock_test_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockBands); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mock_test_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockTest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mock_test_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListMockTestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mock_test_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListMockTestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mock_test_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveMockTestResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mock_test_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockTestResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mock_test_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockTestResultPrimaryKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mock_test_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentMockProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mock_test_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentMockProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mock_test_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMockAveragesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mock_test_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMockAverages); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mock_test_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mock_test_proto_goTypes,
+		DependencyIndexes: file_mock_test_proto_depIdxs,
+		MessageInfos:      file_mock_test_proto_msgTypes,
+	}.Build()
+	File_mock_test_proto = out.File
+	file_mock_test_proto_rawDesc = nil
+	file_mock_test_proto_goTypes = nil
+	file_mock_test_proto_depIdxs = nil
+}
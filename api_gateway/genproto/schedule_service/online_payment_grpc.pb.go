@@ -0,0 +1,292 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: online_payment.proto
+
+package schedule_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	OnlinePaymentService_Create_FullMethodName         = "/schedule_service.OnlinePaymentService/Create"
+	OnlinePaymentService_GetByID_FullMethodName        = "/schedule_service.OnlinePaymentService/GetByID"
+	OnlinePaymentService_GetList_FullMethodName        = "/schedule_service.OnlinePaymentService/GetList"
+	OnlinePaymentService_HandleCallback_FullMethodName = "/schedule_service.OnlinePaymentService/HandleCallback"
+	OnlinePaymentService_Simulate_FullMethodName       = "/schedule_service.OnlinePaymentService/Simulate"
+	OnlinePaymentService_Reconcile_FullMethodName      = "/schedule_service.OnlinePaymentService/Reconcile"
+)
+
+// OnlinePaymentServiceClient is the client API for OnlinePaymentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OnlinePaymentServiceClient interface {
+	Create(ctx context.Context, in *CreateOnlinePayment, opts ...grpc.CallOption) (*OnlinePayment, error)
+	GetByID(ctx context.Context, in *OnlinePaymentPrimaryKey, opts ...grpc.CallOption) (*OnlinePayment, error)
+	GetList(ctx context.Context, in *GetListOnlinePaymentRequest, opts ...grpc.CallOption) (*GetListOnlinePaymentResponse, error)
+	HandleCallback(ctx context.Context, in *OnlinePaymentCallback, opts ...grpc.CallOption) (*OnlinePaymentCallbackReply, error)
+	Simulate(ctx context.Context, in *SimulateOnlinePayment, opts ...grpc.CallOption) (*OnlinePayment, error)
+	Reconcile(ctx context.Context, in *ReconcileOnlinePaymentRequest, opts ...grpc.CallOption) (*OnlinePaymentReconciliation, error)
+}
+
+type onlinePaymentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOnlinePaymentServiceClient(cc grpc.ClientConnInterface) OnlinePaymentServiceClient {
+	return &onlinePaymentServiceClient{cc}
+}
+
+func (c *onlinePaymentServiceClient) Create(ctx context.Context, in *CreateOnlinePayment, opts ...grpc.CallOption) (*OnlinePayment, error) {
+	out := new(OnlinePayment)
+	err := c.cc.Invoke(ctx, OnlinePaymentService_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onlinePaymentServiceClient) GetByID(ctx context.Context, in *OnlinePaymentPrimaryKey, opts ...grpc.CallOption) (*OnlinePayment, error) {
+	out := new(OnlinePayment)
+	err := c.cc.Invoke(ctx, OnlinePaymentService_GetByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onlinePaymentServiceClient) GetList(ctx context.Context, in *GetListOnlinePaymentRequest, opts ...grpc.CallOption) (*GetListOnlinePaymentResponse, error) {
+	out := new(GetListOnlinePaymentResponse)
+	err := c.cc.Invoke(ctx, OnlinePaymentService_GetList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onlinePaymentServiceClient) HandleCallback(ctx context.Context, in *OnlinePaymentCallback, opts ...grpc.CallOption) (*OnlinePaymentCallbackReply, error) {
+	out := new(OnlinePaymentCallbackReply)
+	err := c.cc.Invoke(ctx, OnlinePaymentService_HandleCallback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onlinePaymentServiceClient) Simulate(ctx context.Context, in *SimulateOnlinePayment, opts ...grpc.CallOption) (*OnlinePayment, error) {
+	out := new(OnlinePayment)
+	err := c.cc.Invoke(ctx, OnlinePaymentService_Simulate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onlinePaymentServiceClient) Reconcile(ctx context.Context, in *ReconcileOnlinePaymentRequest, opts ...grpc.CallOption) (*OnlinePaymentReconciliation, error) {
+	out := new(OnlinePaymentReconciliation)
+	err := c.cc.Invoke(ctx, OnlinePaymentService_Reconcile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OnlinePaymentServiceServer is the server API for OnlinePaymentService service.
+// All implementations should embed UnimplementedOnlinePaymentServiceServer
+// for forward compatibility
+type OnlinePaymentServiceServer interface {
+	Create(context.Context, *CreateOnlinePayment) (*OnlinePayment, error)
+	GetByID(context.Context, *OnlinePaymentPrimaryKey) (*OnlinePayment, error)
+	GetList(context.Context, *GetListOnlinePaymentRequest) (*GetListOnlinePaymentResponse, error)
+	HandleCallback(context.Context, *OnlinePaymentCallback) (*OnlinePaymentCallbackReply, error)
+	Simulate(context.Context, *SimulateOnlinePayment) (*OnlinePayment, error)
+	Reconcile(context.Context, *ReconcileOnlinePaymentRequest) (*OnlinePaymentReconciliation, error)
+}
+
+// UnimplementedOnlinePaymentServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedOnlinePaymentServiceServer struct {
+}
+
+func (UnimplementedOnlinePaymentServiceServer) Create(context.Context, *CreateOnlinePayment) (*OnlinePayment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedOnlinePaymentServiceServer) GetByID(context.Context, *OnlinePaymentPrimaryKey) (*OnlinePayment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedOnlinePaymentServiceServer) GetList(context.Context, *GetListOnlinePaymentRequest) (*GetListOnlinePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedOnlinePaymentServiceServer) HandleCallback(context.Context, *OnlinePaymentCallback) (*OnlinePaymentCallbackReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleCallback not implemented")
+}
+func (UnimplementedOnlinePaymentServiceServer) Simulate(context.Context, *SimulateOnlinePayment) (*OnlinePayment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
+}
+func (UnimplementedOnlinePaymentServiceServer) Reconcile(context.Context, *ReconcileOnlinePaymentRequest) (*OnlinePaymentReconciliation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
+}
+
+// UnsafeOnlinePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OnlinePaymentServiceServer will
+// result in compilation errors.
+type UnsafeOnlinePaymentServiceServer interface {
+	mustEmbedUnimplementedOnlinePaymentServiceServer()
+}
+
+func RegisterOnlinePaymentServiceServer(s grpc.ServiceRegistrar, srv OnlinePaymentServiceServer) {
+	s.RegisterService(&OnlinePaymentService_ServiceDesc, srv)
+}
+
+func _OnlinePaymentService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOnlinePayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnlinePaymentServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnlinePaymentService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnlinePaymentServiceServer).Create(ctx, req.(*CreateOnlinePayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnlinePaymentService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnlinePaymentPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnlinePaymentServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnlinePaymentService_GetByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnlinePaymentServiceServer).GetByID(ctx, req.(*OnlinePaymentPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnlinePaymentService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListOnlinePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnlinePaymentServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnlinePaymentService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnlinePaymentServiceServer).GetList(ctx, req.(*GetListOnlinePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnlinePaymentService_HandleCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnlinePaymentCallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnlinePaymentServiceServer).HandleCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnlinePaymentService_HandleCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnlinePaymentServiceServer).HandleCallback(ctx, req.(*OnlinePaymentCallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnlinePaymentService_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateOnlinePayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnlinePaymentServiceServer).Simulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnlinePaymentService_Simulate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnlinePaymentServiceServer).Simulate(ctx, req.(*SimulateOnlinePayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnlinePaymentService_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileOnlinePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnlinePaymentServiceServer).Reconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnlinePaymentService_Reconcile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnlinePaymentServiceServer).Reconcile(ctx, req.(*ReconcileOnlinePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OnlinePaymentService_ServiceDesc is the grpc.ServiceDesc for OnlinePaymentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OnlinePaymentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule_service.OnlinePaymentService",
+	HandlerType: (*OnlinePaymentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _OnlinePaymentService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _OnlinePaymentService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _OnlinePaymentService_GetList_Handler,
+		},
+		{
+			MethodName: "HandleCallback",
+			Handler:    _OnlinePaymentService_HandleCallback_Handler,
+		},
+		{
+			MethodName: "Simulate",
+			Handler:    _OnlinePaymentService_Simulate_Handler,
+		},
+		{
+			MethodName: "Reconcile",
+			Handler:    _OnlinePaymentService_Reconcile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "online_payment.proto",
+}
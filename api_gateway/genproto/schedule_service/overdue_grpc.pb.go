@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: overdue.proto
+
+package schedule_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	OverdueService_GetList_FullMethodName     = "/schedule_service.OverdueService/GetList"
+	OverdueService_CreateHold_FullMethodName  = "/schedule_service.OverdueService/CreateHold"
+	OverdueService_ClearHold_FullMethodName   = "/schedule_service.OverdueService/ClearHold"
+	OverdueService_GetListHold_FullMethodName = "/schedule_service.OverdueService/GetListHold"
+	OverdueService_GetHold_FullMethodName     = "/schedule_service.OverdueService/GetHold"
+)
+
+// OverdueServiceClient is the client API for OverdueService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OverdueServiceClient interface {
+	GetList(ctx context.Context, in *GetListOverdueRequest, opts ...grpc.CallOption) (*GetListOverdueResponse, error)
+	CreateHold(ctx context.Context, in *CreateStudentHold, opts ...grpc.CallOption) (*StudentHold, error)
+	ClearHold(ctx context.Context, in *ClearStudentHold, opts ...grpc.CallOption) (*StudentHold, error)
+	GetListHold(ctx context.Context, in *GetListStudentHoldRequest, opts ...grpc.CallOption) (*GetListStudentHoldResponse, error)
+	GetHold(ctx context.Context, in *StudentHoldRequest, opts ...grpc.CallOption) (*StudentHoldStatus, error)
+}
+
+type overdueServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOverdueServiceClient(cc grpc.ClientConnInterface) OverdueServiceClient {
+	return &overdueServiceClient{cc}
+}
+
+func (c *overdueServiceClient) GetList(ctx context.Context, in *GetListOverdueRequest, opts ...grpc.CallOption) (*GetListOverdueResponse, error) {
+	out := new(GetListOverdueResponse)
+	err := c.cc.Invoke(ctx, OverdueService_GetList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overdueServiceClient) CreateHold(ctx context.Context, in *CreateStudentHold, opts ...grpc.CallOption) (*StudentHold, error) {
+	out := new(StudentHold)
+	err := c.cc.Invoke(ctx, OverdueService_CreateHold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overdueServiceClient) ClearHold(ctx context.Context, in *ClearStudentHold, opts ...grpc.CallOption) (*StudentHold, error) {
+	out := new(StudentHold)
+	err := c.cc.Invoke(ctx, OverdueService_ClearHold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overdueServiceClient) GetListHold(ctx context.Context, in *GetListStudentHoldRequest, opts ...grpc.CallOption) (*GetListStudentHoldResponse, error) {
+	out := new(GetListStudentHoldResponse)
+	err := c.cc.Invoke(ctx, OverdueService_GetListHold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overdueServiceClient) GetHold(ctx context.Context, in *StudentHoldRequest, opts ...grpc.CallOption) (*StudentHoldStatus, error) {
+	out := new(StudentHoldStatus)
+	err := c.cc.Invoke(ctx, OverdueService_GetHold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OverdueServiceServer is the server API for OverdueService service.
+// All implementations should embed UnimplementedOverdueServiceServer
+// for forward compatibility
+type OverdueServiceServer interface {
+	GetList(context.Context, *GetListOverdueRequest) (*GetListOverdueResponse, error)
+	CreateHold(context.Context, *CreateStudentHold) (*StudentHold, error)
+	ClearHold(context.Context, *ClearStudentHold) (*StudentHold, error)
+	GetListHold(context.Context, *GetListStudentHoldRequest) (*GetListStudentHoldResponse, error)
+	GetHold(context.Context, *StudentHoldRequest) (*StudentHoldStatus, error)
+}
+
+// UnimplementedOverdueServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedOverdueServiceServer struct {
+}
+
+func (UnimplementedOverdueServiceServer) GetList(context.Context, *GetListOverdueRequest) (*GetListOverdueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedOverdueServiceServer) CreateHold(context.Context, *CreateStudentHold) (*StudentHold, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHold not implemented")
+}
+func (UnimplementedOverdueServiceServer) ClearHold(context.Context, *ClearStudentHold) (*StudentHold, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearHold not implemented")
+}
+func (UnimplementedOverdueServiceServer) GetListHold(context.Context, *GetListStudentHoldRequest) (*GetListStudentHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListHold not implemented")
+}
+func (UnimplementedOverdueServiceServer) GetHold(context.Context, *StudentHoldRequest) (*StudentHoldStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHold not implemented")
+}
+
+// UnsafeOverdueServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OverdueServiceServer will
+// result in compilation errors.
+type UnsafeOverdueServiceServer interface {
+	mustEmbedUnimplementedOverdueServiceServer()
+}
+
+func RegisterOverdueServiceServer(s grpc.ServiceRegistrar, srv OverdueServiceServer) {
+	s.RegisterService(&OverdueService_ServiceDesc, srv)
+}
+
+func _OverdueService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListOverdueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverdueServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OverdueService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverdueServiceServer).GetList(ctx, req.(*GetListOverdueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OverdueService_CreateHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStudentHold)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverdueServiceServer).CreateHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OverdueService_CreateHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverdueServiceServer).CreateHold(ctx, req.(*CreateStudentHold))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OverdueService_ClearHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearStudentHold)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverdueServiceServer).ClearHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OverdueService_ClearHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverdueServiceServer).ClearHold(ctx, req.(*ClearStudentHold))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OverdueService_GetListHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListStudentHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverdueServiceServer).GetListHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OverdueService_GetListHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverdueServiceServer).GetListHold(ctx, req.(*GetListStudentHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OverdueService_GetHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverdueServiceServer).GetHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OverdueService_GetHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverdueServiceServer).GetHold(ctx, req.(*StudentHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OverdueService_ServiceDesc is the grpc.ServiceDesc for OverdueService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OverdueService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule_service.OverdueService",
+	HandlerType: (*OverdueServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetList",
+			Handler:    _OverdueService_GetList_Handler,
+		},
+		{
+			MethodName: "CreateHold",
+			Handler:    _OverdueService_CreateHold_Handler,
+		},
+		{
+			MethodName: "ClearHold",
+			Handler:    _OverdueService_ClearHold_Handler,
+		},
+		{
+			MethodName: "GetListHold",
+			Handler:    _OverdueService_GetListHold_Handler,
+		},
+		{
+			MethodName: "GetHold",
+			Handler:    _OverdueService_GetHold_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "overdue.proto",
+}
@@ -0,0 +1,292 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: room.proto
+
+package schedule_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	RoomService_Create_FullMethodName         = "/schedule_service.RoomService/Create"
+	RoomService_GetByID_FullMethodName        = "/schedule_service.RoomService/GetByID"
+	RoomService_GetList_FullMethodName        = "/schedule_service.RoomService/GetList"
+	RoomService_Update_FullMethodName         = "/schedule_service.RoomService/Update"
+	RoomService_Delete_FullMethodName         = "/schedule_service.RoomService/Delete"
+	RoomService_GetUtilization_FullMethodName = "/schedule_service.RoomService/GetUtilization"
+)
+
+// RoomServiceClient is the client API for RoomService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RoomServiceClient interface {
+	Create(ctx context.Context, in *CreateRoom, opts ...grpc.CallOption) (*Room, error)
+	GetByID(ctx context.Context, in *RoomPrimaryKey, opts ...grpc.CallOption) (*Room, error)
+	GetList(ctx context.Context, in *GetListRoomRequest, opts ...grpc.CallOption) (*GetListRoomResponse, error)
+	Update(ctx context.Context, in *UpdateRoom, opts ...grpc.CallOption) (*Room, error)
+	Delete(ctx context.Context, in *RoomPrimaryKey, opts ...grpc.CallOption) (*EmptyRoom, error)
+	GetUtilization(ctx context.Context, in *RoomUtilizationRequest, opts ...grpc.CallOption) (*RoomUtilizationReport, error)
+}
+
+type roomServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoomServiceClient(cc grpc.ClientConnInterface) RoomServiceClient {
+	return &roomServiceClient{cc}
+}
+
+func (c *roomServiceClient) Create(ctx context.Context, in *CreateRoom, opts ...grpc.CallOption) (*Room, error) {
+	out := new(Room)
+	err := c.cc.Invoke(ctx, RoomService_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) GetByID(ctx context.Context, in *RoomPrimaryKey, opts ...grpc.CallOption) (*Room, error) {
+	out := new(Room)
+	err := c.cc.Invoke(ctx, RoomService_GetByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) GetList(ctx context.Context, in *GetListRoomRequest, opts ...grpc.CallOption) (*GetListRoomResponse, error) {
+	out := new(GetListRoomResponse)
+	err := c.cc.Invoke(ctx, RoomService_GetList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) Update(ctx context.Context, in *UpdateRoom, opts ...grpc.CallOption) (*Room, error) {
+	out := new(Room)
+	err := c.cc.Invoke(ctx, RoomService_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) Delete(ctx context.Context, in *RoomPrimaryKey, opts ...grpc.CallOption) (*EmptyRoom, error) {
+	out := new(EmptyRoom)
+	err := c.cc.Invoke(ctx, RoomService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) GetUtilization(ctx context.Context, in *RoomUtilizationRequest, opts ...grpc.CallOption) (*RoomUtilizationReport, error) {
+	out := new(RoomUtilizationReport)
+	err := c.cc.Invoke(ctx, RoomService_GetUtilization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoomServiceServer is the server API for RoomService service.
+// All implementations should embed UnimplementedRoomServiceServer
+// for forward compatibility
+type RoomServiceServer interface {
+	Create(context.Context, *CreateRoom) (*Room, error)
+	GetByID(context.Context, *RoomPrimaryKey) (*Room, error)
+	GetList(context.Context, *GetListRoomRequest) (*GetListRoomResponse, error)
+	Update(context.Context, *UpdateRoom) (*Room, error)
+	Delete(context.Context, *RoomPrimaryKey) (*EmptyRoom, error)
+	GetUtilization(context.Context, *RoomUtilizationRequest) (*RoomUtilizationReport, error)
+}
+
+// UnimplementedRoomServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedRoomServiceServer struct {
+}
+
+func (UnimplementedRoomServiceServer) Create(context.Context, *CreateRoom) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedRoomServiceServer) GetByID(context.Context, *RoomPrimaryKey) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedRoomServiceServer) GetList(context.Context, *GetListRoomRequest) (*GetListRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedRoomServiceServer) Update(context.Context, *UpdateRoom) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedRoomServiceServer) Delete(context.Context, *RoomPrimaryKey) (*EmptyRoom, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedRoomServiceServer) GetUtilization(context.Context, *RoomUtilizationRequest) (*RoomUtilizationReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUtilization not implemented")
+}
+
+// UnsafeRoomServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoomServiceServer will
+// result in compilation errors.
+type UnsafeRoomServiceServer interface {
+	mustEmbedUnimplementedRoomServiceServer()
+}
+
+func RegisterRoomServiceServer(s grpc.ServiceRegistrar, srv RoomServiceServer) {
+	s.RegisterService(&RoomService_ServiceDesc, srv)
+}
+
+func _RoomService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).Create(ctx, req.(*CreateRoom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoomPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_GetByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).GetByID(ctx, req.(*RoomPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).GetList(ctx, req.(*GetListRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).Update(ctx, req.(*UpdateRoom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoomPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).Delete(ctx, req.(*RoomPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_GetUtilization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoomUtilizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).GetUtilization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_GetUtilization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).GetUtilization(ctx, req.(*RoomUtilizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoomService_ServiceDesc is the grpc.ServiceDesc for RoomService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoomService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule_service.RoomService",
+	HandlerType: (*RoomServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _RoomService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _RoomService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _RoomService_GetList_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _RoomService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _RoomService_Delete_Handler,
+		},
+		{
+			MethodName: "GetUtilization",
+			Handler:    _RoomService_GetUtilization_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "room.proto",
+}
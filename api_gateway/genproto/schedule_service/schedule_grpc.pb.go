@@ -19,13 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ScheduleService_Create_FullMethodName              = "/schedule_service.ScheduleService/Create"
-	ScheduleService_GetByID_FullMethodName             = "/schedule_service.ScheduleService/GetByID"
-	ScheduleService_GetList_FullMethodName             = "/schedule_service.ScheduleService/GetList"
-	ScheduleService_Update_FullMethodName              = "/schedule_service.ScheduleService/Update"
-	ScheduleService_Delete_FullMethodName              = "/schedule_service.ScheduleService/Delete"
-	ScheduleService_GetScheduleForWeek_FullMethodName  = "/schedule_service.ScheduleService/GetScheduleForWeek"
-	ScheduleService_GetScheduleForMonth_FullMethodName = "/schedule_service.ScheduleService/GetScheduleForMonth"
+	ScheduleService_Create_FullMethodName                = "/schedule_service.ScheduleService/Create"
+	ScheduleService_GetByID_FullMethodName               = "/schedule_service.ScheduleService/GetByID"
+	ScheduleService_GetList_FullMethodName               = "/schedule_service.ScheduleService/GetList"
+	ScheduleService_Update_FullMethodName                = "/schedule_service.ScheduleService/Update"
+	ScheduleService_Delete_FullMethodName                = "/schedule_service.ScheduleService/Delete"
+	ScheduleService_GetScheduleForWeek_FullMethodName    = "/schedule_service.ScheduleService/GetScheduleForWeek"
+	ScheduleService_GetScheduleForMonth_FullMethodName   = "/schedule_service.ScheduleService/GetScheduleForMonth"
+	ScheduleService_MarkAttendance_FullMethodName        = "/schedule_service.ScheduleService/MarkAttendance"
+	ScheduleService_AssignSubstitute_FullMethodName      = "/schedule_service.ScheduleService/AssignSubstitute"
+	ScheduleService_GetTeacherLessonCount_FullMethodName = "/schedule_service.ScheduleService/GetTeacherLessonCount"
 )
 
 // ScheduleServiceClient is the client API for ScheduleService service.
@@ -39,6 +42,9 @@ type ScheduleServiceClient interface {
 	Delete(ctx context.Context, in *SchedulePrimaryKey, opts ...grpc.CallOption) (*EmptySchedule, error)
 	GetScheduleForWeek(ctx context.Context, in *GetScheduleForWeekRequest, opts ...grpc.CallOption) (*GetListScheduleResponse, error)
 	GetScheduleForMonth(ctx context.Context, in *GetScheduleForMonthRequest, opts ...grpc.CallOption) (*GetListScheduleResponse, error)
+	MarkAttendance(ctx context.Context, in *MarkAttendanceRequest, opts ...grpc.CallOption) (*LessonAttendance, error)
+	AssignSubstitute(ctx context.Context, in *AssignSubstituteRequest, opts ...grpc.CallOption) (*GetSchedule, error)
+	GetTeacherLessonCount(ctx context.Context, in *TeacherLessonCountRequest, opts ...grpc.CallOption) (*TeacherLessonCount, error)
 }
 
 type scheduleServiceClient struct {
@@ -112,6 +118,33 @@ func (c *scheduleServiceClient) GetScheduleForMonth(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *scheduleServiceClient) MarkAttendance(ctx context.Context, in *MarkAttendanceRequest, opts ...grpc.CallOption) (*LessonAttendance, error) {
+	out := new(LessonAttendance)
+	err := c.cc.Invoke(ctx, ScheduleService_MarkAttendance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) AssignSubstitute(ctx context.Context, in *AssignSubstituteRequest, opts ...grpc.CallOption) (*GetSchedule, error) {
+	out := new(GetSchedule)
+	err := c.cc.Invoke(ctx, ScheduleService_AssignSubstitute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) GetTeacherLessonCount(ctx context.Context, in *TeacherLessonCountRequest, opts ...grpc.CallOption) (*TeacherLessonCount, error) {
+	out := new(TeacherLessonCount)
+	err := c.cc.Invoke(ctx, ScheduleService_GetTeacherLessonCount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScheduleServiceServer is the server API for ScheduleService service.
 // All implementations should embed UnimplementedScheduleServiceServer
 // for forward compatibility
@@ -123,6 +156,9 @@ type ScheduleServiceServer interface {
 	Delete(context.Context, *SchedulePrimaryKey) (*EmptySchedule, error)
 	GetScheduleForWeek(context.Context, *GetScheduleForWeekRequest) (*GetListScheduleResponse, error)
 	GetScheduleForMonth(context.Context, *GetScheduleForMonthRequest) (*GetListScheduleResponse, error)
+	MarkAttendance(context.Context, *MarkAttendanceRequest) (*LessonAttendance, error)
+	AssignSubstitute(context.Context, *AssignSubstituteRequest) (*GetSchedule, error)
+	GetTeacherLessonCount(context.Context, *TeacherLessonCountRequest) (*TeacherLessonCount, error)
 }
 
 // UnimplementedScheduleServiceServer should be embedded to have forward compatible implementations.
@@ -150,6 +186,15 @@ func (UnimplementedScheduleServiceServer) GetScheduleForWeek(context.Context, *G
 func (UnimplementedScheduleServiceServer) GetScheduleForMonth(context.Context, *GetScheduleForMonthRequest) (*GetListScheduleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetScheduleForMonth not implemented")
 }
+func (UnimplementedScheduleServiceServer) MarkAttendance(context.Context, *MarkAttendanceRequest) (*LessonAttendance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAttendance not implemented")
+}
+func (UnimplementedScheduleServiceServer) AssignSubstitute(context.Context, *AssignSubstituteRequest) (*GetSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignSubstitute not implemented")
+}
+func (UnimplementedScheduleServiceServer) GetTeacherLessonCount(context.Context, *TeacherLessonCountRequest) (*TeacherLessonCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeacherLessonCount not implemented")
+}
 
 // UnsafeScheduleServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ScheduleServiceServer will
@@ -288,6 +333,60 @@ func _ScheduleService_GetScheduleForMonth_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScheduleService_MarkAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).MarkAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_MarkAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).MarkAttendance(ctx, req.(*MarkAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_AssignSubstitute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignSubstituteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).AssignSubstitute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_AssignSubstitute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).AssignSubstitute(ctx, req.(*AssignSubstituteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_GetTeacherLessonCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeacherLessonCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).GetTeacherLessonCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_GetTeacherLessonCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).GetTeacherLessonCount(ctx, req.(*TeacherLessonCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScheduleService_ServiceDesc is the grpc.ServiceDesc for ScheduleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -323,6 +422,18 @@ var ScheduleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetScheduleForMonth",
 			Handler:    _ScheduleService_GetScheduleForMonth_Handler,
 		},
+		{
+			MethodName: "MarkAttendance",
+			Handler:    _ScheduleService_MarkAttendance_Handler,
+		},
+		{
+			MethodName: "AssignSubstitute",
+			Handler:    _ScheduleService_AssignSubstitute_Handler,
+		},
+		{
+			MethodName: "GetTeacherLessonCount",
+			Handler:    _ScheduleService_GetTeacherLessonCount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "schedule.proto",
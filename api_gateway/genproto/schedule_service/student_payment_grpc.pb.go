@@ -22,8 +22,8 @@ const (
 	StudentPaymentService_Create_FullMethodName  = "/schedule_service.StudentPaymentService/Create"
 	StudentPaymentService_GetByID_FullMethodName = "/schedule_service.StudentPaymentService/GetByID"
 	StudentPaymentService_GetList_FullMethodName = "/schedule_service.StudentPaymentService/GetList"
-	StudentPaymentService_Update_FullMethodName  = "/schedule_service.StudentPaymentService/Update"
-	StudentPaymentService_Delete_FullMethodName  = "/schedule_service.StudentPaymentService/Delete"
+	StudentPaymentService_Reverse_FullMethodName = "/schedule_service.StudentPaymentService/Reverse"
+	StudentPaymentService_Refund_FullMethodName  = "/schedule_service.StudentPaymentService/Refund"
 )
 
 // StudentPaymentServiceClient is the client API for StudentPaymentService service.
@@ -33,8 +33,8 @@ type StudentPaymentServiceClient interface {
 	Create(ctx context.Context, in *CreateStudentPayment, opts ...grpc.CallOption) (*GetStudentPayment, error)
 	GetByID(ctx context.Context, in *StudentPaymentPrimaryKey, opts ...grpc.CallOption) (*GetStudentPayment, error)
 	GetList(ctx context.Context, in *GetListStudentPaymentRequest, opts ...grpc.CallOption) (*GetListStudentPaymentResponse, error)
-	Update(ctx context.Context, in *UpdateStudentPayment, opts ...grpc.CallOption) (*GetStudentPayment, error)
-	Delete(ctx context.Context, in *StudentPaymentPrimaryKey, opts ...grpc.CallOption) (*EmptyStudentPayment, error)
+	Reverse(ctx context.Context, in *ReverseStudentPayment, opts ...grpc.CallOption) (*GetStudentPayment, error)
+	Refund(ctx context.Context, in *RefundStudentPayment, opts ...grpc.CallOption) (*GetStudentPayment, error)
 }
 
 type studentPaymentServiceClient struct {
@@ -72,18 +72,18 @@ func (c *studentPaymentServiceClient) GetList(ctx context.Context, in *GetListSt
 	return out, nil
 }
 
-func (c *studentPaymentServiceClient) Update(ctx context.Context, in *UpdateStudentPayment, opts ...grpc.CallOption) (*GetStudentPayment, error) {
+func (c *studentPaymentServiceClient) Reverse(ctx context.Context, in *ReverseStudentPayment, opts ...grpc.CallOption) (*GetStudentPayment, error) {
 	out := new(GetStudentPayment)
-	err := c.cc.Invoke(ctx, StudentPaymentService_Update_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, StudentPaymentService_Reverse_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *studentPaymentServiceClient) Delete(ctx context.Context, in *StudentPaymentPrimaryKey, opts ...grpc.CallOption) (*EmptyStudentPayment, error) {
-	out := new(EmptyStudentPayment)
-	err := c.cc.Invoke(ctx, StudentPaymentService_Delete_FullMethodName, in, out, opts...)
+func (c *studentPaymentServiceClient) Refund(ctx context.Context, in *RefundStudentPayment, opts ...grpc.CallOption) (*GetStudentPayment, error) {
+	out := new(GetStudentPayment)
+	err := c.cc.Invoke(ctx, StudentPaymentService_Refund_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -97,8 +97,8 @@ type StudentPaymentServiceServer interface {
 	Create(context.Context, *CreateStudentPayment) (*GetStudentPayment, error)
 	GetByID(context.Context, *StudentPaymentPrimaryKey) (*GetStudentPayment, error)
 	GetList(context.Context, *GetListStudentPaymentRequest) (*GetListStudentPaymentResponse, error)
-	Update(context.Context, *UpdateStudentPayment) (*GetStudentPayment, error)
-	Delete(context.Context, *StudentPaymentPrimaryKey) (*EmptyStudentPayment, error)
+	Reverse(context.Context, *ReverseStudentPayment) (*GetStudentPayment, error)
+	Refund(context.Context, *RefundStudentPayment) (*GetStudentPayment, error)
 }
 
 // UnimplementedStudentPaymentServiceServer should be embedded to have forward compatible implementations.
@@ -114,11 +114,11 @@ func (UnimplementedStudentPaymentServiceServer) GetByID(context.Context, *Studen
 func (UnimplementedStudentPaymentServiceServer) GetList(context.Context, *GetListStudentPaymentRequest) (*GetListStudentPaymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
 }
-func (UnimplementedStudentPaymentServiceServer) Update(context.Context, *UpdateStudentPayment) (*GetStudentPayment, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+func (UnimplementedStudentPaymentServiceServer) Reverse(context.Context, *ReverseStudentPayment) (*GetStudentPayment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reverse not implemented")
 }
-func (UnimplementedStudentPaymentServiceServer) Delete(context.Context, *StudentPaymentPrimaryKey) (*EmptyStudentPayment, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (UnimplementedStudentPaymentServiceServer) Refund(context.Context, *RefundStudentPayment) (*GetStudentPayment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refund not implemented")
 }
 
 // UnsafeStudentPaymentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -186,38 +186,38 @@ func _StudentPaymentService_GetList_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StudentPaymentService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateStudentPayment)
+func _StudentPaymentService_Reverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseStudentPayment)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StudentPaymentServiceServer).Update(ctx, in)
+		return srv.(StudentPaymentServiceServer).Reverse(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StudentPaymentService_Update_FullMethodName,
+		FullMethod: StudentPaymentService_Reverse_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentPaymentServiceServer).Update(ctx, req.(*UpdateStudentPayment))
+		return srv.(StudentPaymentServiceServer).Reverse(ctx, req.(*ReverseStudentPayment))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StudentPaymentService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StudentPaymentPrimaryKey)
+func _StudentPaymentService_Refund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundStudentPayment)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StudentPaymentServiceServer).Delete(ctx, in)
+		return srv.(StudentPaymentServiceServer).Refund(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StudentPaymentService_Delete_FullMethodName,
+		FullMethod: StudentPaymentService_Refund_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentPaymentServiceServer).Delete(ctx, req.(*StudentPaymentPrimaryKey))
+		return srv.(StudentPaymentServiceServer).Refund(ctx, req.(*RefundStudentPayment))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -242,12 +242,12 @@ var StudentPaymentService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _StudentPaymentService_GetList_Handler,
 		},
 		{
-			MethodName: "Update",
-			Handler:    _StudentPaymentService_Update_Handler,
+			MethodName: "Reverse",
+			Handler:    _StudentPaymentService_Reverse_Handler,
 		},
 		{
-			MethodName: "Delete",
-			Handler:    _StudentPaymentService_Delete_Handler,
+			MethodName: "Refund",
+			Handler:    _StudentPaymentService_Refund_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -19,11 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	StudentTaskService_Create_FullMethodName  = "/schedule_service.StudentTaskService/Create"
-	StudentTaskService_GetByID_FullMethodName = "/schedule_service.StudentTaskService/GetByID"
-	StudentTaskService_GetList_FullMethodName = "/schedule_service.StudentTaskService/GetList"
-	StudentTaskService_Update_FullMethodName  = "/schedule_service.StudentTaskService/Update"
-	StudentTaskService_Delete_FullMethodName  = "/schedule_service.StudentTaskService/Delete"
+	StudentTaskService_Create_FullMethodName                = "/schedule_service.StudentTaskService/Create"
+	StudentTaskService_GetByID_FullMethodName               = "/schedule_service.StudentTaskService/GetByID"
+	StudentTaskService_GetList_FullMethodName               = "/schedule_service.StudentTaskService/GetList"
+	StudentTaskService_Update_FullMethodName                = "/schedule_service.StudentTaskService/Update"
+	StudentTaskService_Delete_FullMethodName                = "/schedule_service.StudentTaskService/Delete"
+	StudentTaskService_UpdateScoreforTeacher_FullMethodName = "/schedule_service.StudentTaskService/UpdateScoreforTeacher"
+	StudentTaskService_UpdateScoreforStudent_FullMethodName = "/schedule_service.StudentTaskService/UpdateScoreforStudent"
 )
 
 // StudentTaskServiceClient is the client API for StudentTaskService service.
@@ -35,6 +37,8 @@ type StudentTaskServiceClient interface {
 	GetList(ctx context.Context, in *GetListStudentTaskRequest, opts ...grpc.CallOption) (*GetListStudentTaskResponse, error)
 	Update(ctx context.Context, in *UpdateStudentTask, opts ...grpc.CallOption) (*GetStudentTask, error)
 	Delete(ctx context.Context, in *StudentTaskPrimaryKey, opts ...grpc.CallOption) (*EmptyStudentTask, error)
+	UpdateScoreforTeacher(ctx context.Context, in *UpdateStudentScoreRequest, opts ...grpc.CallOption) (*GetStudentTask, error)
+	UpdateScoreforStudent(ctx context.Context, in *UpdateStudentScoreRequest, opts ...grpc.CallOption) (*GetStudentTask, error)
 }
 
 type studentTaskServiceClient struct {
@@ -90,6 +94,24 @@ func (c *studentTaskServiceClient) Delete(ctx context.Context, in *StudentTaskPr
 	return out, nil
 }
 
+func (c *studentTaskServiceClient) UpdateScoreforTeacher(ctx context.Context, in *UpdateStudentScoreRequest, opts ...grpc.CallOption) (*GetStudentTask, error) {
+	out := new(GetStudentTask)
+	err := c.cc.Invoke(ctx, StudentTaskService_UpdateScoreforTeacher_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentTaskServiceClient) UpdateScoreforStudent(ctx context.Context, in *UpdateStudentScoreRequest, opts ...grpc.CallOption) (*GetStudentTask, error) {
+	out := new(GetStudentTask)
+	err := c.cc.Invoke(ctx, StudentTaskService_UpdateScoreforStudent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StudentTaskServiceServer is the server API for StudentTaskService service.
 // All implementations should embed UnimplementedStudentTaskServiceServer
 // for forward compatibility
@@ -99,6 +121,8 @@ type StudentTaskServiceServer interface {
 	GetList(context.Context, *GetListStudentTaskRequest) (*GetListStudentTaskResponse, error)
 	Update(context.Context, *UpdateStudentTask) (*GetStudentTask, error)
 	Delete(context.Context, *StudentTaskPrimaryKey) (*EmptyStudentTask, error)
+	UpdateScoreforTeacher(context.Context, *UpdateStudentScoreRequest) (*GetStudentTask, error)
+	UpdateScoreforStudent(context.Context, *UpdateStudentScoreRequest) (*GetStudentTask, error)
 }
 
 // UnimplementedStudentTaskServiceServer should be embedded to have forward compatible implementations.
@@ -120,6 +144,12 @@ func (UnimplementedStudentTaskServiceServer) Update(context.Context, *UpdateStud
 func (UnimplementedStudentTaskServiceServer) Delete(context.Context, *StudentTaskPrimaryKey) (*EmptyStudentTask, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedStudentTaskServiceServer) UpdateScoreforTeacher(context.Context, *UpdateStudentScoreRequest) (*GetStudentTask, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScoreforTeacher not implemented")
+}
+func (UnimplementedStudentTaskServiceServer) UpdateScoreforStudent(context.Context, *UpdateStudentScoreRequest) (*GetStudentTask, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScoreforStudent not implemented")
+}
 
 // UnsafeStudentTaskServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to StudentTaskServiceServer will
@@ -222,6 +252,42 @@ func _StudentTaskService_Delete_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StudentTaskService_UpdateScoreforTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStudentScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentTaskServiceServer).UpdateScoreforTeacher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentTaskService_UpdateScoreforTeacher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentTaskServiceServer).UpdateScoreforTeacher(ctx, req.(*UpdateStudentScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentTaskService_UpdateScoreforStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStudentScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentTaskServiceServer).UpdateScoreforStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentTaskService_UpdateScoreforStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentTaskServiceServer).UpdateScoreforStudent(ctx, req.(*UpdateStudentScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StudentTaskService_ServiceDesc is the grpc.ServiceDesc for StudentTaskService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -249,6 +315,14 @@ var StudentTaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _StudentTaskService_Delete_Handler,
 		},
+		{
+			MethodName: "UpdateScoreforTeacher",
+			Handler:    _StudentTaskService_UpdateScoreforTeacher_Handler,
+		},
+		{
+			MethodName: "UpdateScoreforStudent",
+			Handler:    _StudentTaskService_UpdateScoreforStudent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "student_task.proto",
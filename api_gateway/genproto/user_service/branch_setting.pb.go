@@ -0,0 +1,794 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: branch_setting.proto
+
+package user_service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EmptyBranchSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EmptyBranchSetting) Reset() {
+	*x = EmptyBranchSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_branch_setting_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyBranchSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyBranchSetting) ProtoMessage() {}
+
+func (x *EmptyBranchSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_branch_setting_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyBranchSetting.ProtoReflect.Descriptor instead.
+func (*EmptyBranchSetting) Descriptor() ([]byte, []int) {
+	return file_branch_setting_proto_rawDescGZIP(), []int{0}
+}
+
+type BranchSettingPrimaryKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BranchId string `protobuf:"bytes,1,opt,name=branchId,proto3" json:"branchId,omitempty"`
+}
+
+func (x *BranchSettingPrimaryKey) Reset() {
+	*x = BranchSettingPrimaryKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_branch_setting_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BranchSettingPrimaryKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BranchSettingPrimaryKey) ProtoMessage() {}
+
+func (x *BranchSettingPrimaryKey) ProtoReflect() protoreflect.Message {
+	mi := &file_branch_setting_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BranchSettingPrimaryKey.ProtoReflect.Descriptor instead.
+func (*BranchSettingPrimaryKey) Descriptor() ([]byte, []int) {
+	return file_branch_setting_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BranchSettingPrimaryKey) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+type CreateBranchSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BranchId                string  `protobuf:"bytes,1,opt,name=branchId,proto3" json:"branchId,omitempty"`
+	EventWeekdays           []int32 `protobuf:"varint,2,rep,packed,name=eventWeekdays,proto3" json:"eventWeekdays,omitempty"`
+	RegistrationCutoffHours int32   `protobuf:"varint,3,opt,name=registrationCutoffHours,proto3" json:"registrationCutoffHours,omitempty"`
+	PaymentDueDay           int32   `protobuf:"varint,4,opt,name=paymentDueDay,proto3" json:"paymentDueDay,omitempty"`
+	LessonDurationMinutes   int32   `protobuf:"varint,5,opt,name=lessonDurationMinutes,proto3" json:"lessonDurationMinutes,omitempty"`
+	Timezone                string  `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	PhonePattern            string  `protobuf:"bytes,7,opt,name=phonePattern,proto3" json:"phonePattern,omitempty"`
+}
+
+func (x *CreateBranchSetting) Reset() {
+	*x = CreateBranchSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_branch_setting_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBranchSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBranchSetting) ProtoMessage() {}
+
+func (x *CreateBranchSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_branch_setting_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBranchSetting.ProtoReflect.Descriptor instead.
+func (*CreateBranchSetting) Descriptor() ([]byte, []int) {
+	return file_branch_setting_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateBranchSetting) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+func (x *CreateBranchSetting) GetEventWeekdays() []int32 {
+	if x != nil {
+		return x.EventWeekdays
+	}
+	return nil
+}
+
+func (x *CreateBranchSetting) GetRegistrationCutoffHours() int32 {
+	if x != nil {
+		return x.RegistrationCutoffHours
+	}
+	return 0
+}
+
+func (x *CreateBranchSetting) GetPaymentDueDay() int32 {
+	if x != nil {
+		return x.PaymentDueDay
+	}
+	return 0
+}
+
+func (x *CreateBranchSetting) GetLessonDurationMinutes() int32 {
+	if x != nil {
+		return x.LessonDurationMinutes
+	}
+	return 0
+}
+
+func (x *CreateBranchSetting) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateBranchSetting) GetPhonePattern() string {
+	if x != nil {
+		return x.PhonePattern
+	}
+	return ""
+}
+
+type BranchSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BranchId                string  `protobuf:"bytes,1,opt,name=branchId,proto3" json:"branchId,omitempty"`
+	EventWeekdays           []int32 `protobuf:"varint,2,rep,packed,name=eventWeekdays,proto3" json:"eventWeekdays,omitempty"`
+	RegistrationCutoffHours int32   `protobuf:"varint,3,opt,name=registrationCutoffHours,proto3" json:"registrationCutoffHours,omitempty"`
+	PaymentDueDay           int32   `protobuf:"varint,4,opt,name=paymentDueDay,proto3" json:"paymentDueDay,omitempty"`
+	LessonDurationMinutes   int32   `protobuf:"varint,5,opt,name=lessonDurationMinutes,proto3" json:"lessonDurationMinutes,omitempty"`
+	Timezone                string  `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	PhonePattern            string  `protobuf:"bytes,7,opt,name=phonePattern,proto3" json:"phonePattern,omitempty"`
+	IsDefault               bool    `protobuf:"varint,8,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	CreatedAt               string  `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt               string  `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *BranchSetting) Reset() {
+	*x = BranchSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_branch_setting_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BranchSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BranchSetting) ProtoMessage() {}
+
+func (x *BranchSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_branch_setting_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BranchSetting.ProtoReflect.Descriptor instead.
+func (*BranchSetting) Descriptor() ([]byte, []int) {
+	return file_branch_setting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BranchSetting) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+func (x *BranchSetting) GetEventWeekdays() []int32 {
+	if x != nil {
+		return x.EventWeekdays
+	}
+	return nil
+}
+
+func (x *BranchSetting) GetRegistrationCutoffHours() int32 {
+	if x != nil {
+		return x.RegistrationCutoffHours
+	}
+	return 0
+}
+
+func (x *BranchSetting) GetPaymentDueDay() int32 {
+	if x != nil {
+		return x.PaymentDueDay
+	}
+	return 0
+}
+
+func (x *BranchSetting) GetLessonDurationMinutes() int32 {
+	if x != nil {
+		return x.LessonDurationMinutes
+	}
+	return 0
+}
+
+func (x *BranchSetting) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *BranchSetting) GetPhonePattern() string {
+	if x != nil {
+		return x.PhonePattern
+	}
+	return ""
+}
+
+func (x *BranchSetting) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *BranchSetting) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *BranchSetting) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type UpdateBranchSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BranchId                string  `protobuf:"bytes,1,opt,name=branchId,proto3" json:"branchId,omitempty"`
+	EventWeekdays           []int32 `protobuf:"varint,2,rep,packed,name=eventWeekdays,proto3" json:"eventWeekdays,omitempty"`
+	RegistrationCutoffHours int32   `protobuf:"varint,3,opt,name=registrationCutoffHours,proto3" json:"registrationCutoffHours,omitempty"`
+	PaymentDueDay           int32   `protobuf:"varint,4,opt,name=paymentDueDay,proto3" json:"paymentDueDay,omitempty"`
+	LessonDurationMinutes   int32   `protobuf:"varint,5,opt,name=lessonDurationMinutes,proto3" json:"lessonDurationMinutes,omitempty"`
+	Timezone                string  `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	PhonePattern            string  `protobuf:"bytes,7,opt,name=phonePattern,proto3" json:"phonePattern,omitempty"`
+}
+
+func (x *UpdateBranchSetting) Reset() {
+	*x = UpdateBranchSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_branch_setting_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBranchSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBranchSetting) ProtoMessage() {}
+
+func (x *UpdateBranchSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_branch_setting_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBranchSetting.ProtoReflect.Descriptor instead.
+func (*UpdateBranchSetting) Descriptor() ([]byte, []int) {
+	return file_branch_setting_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateBranchSetting) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+func (x *UpdateBranchSetting) GetEventWeekdays() []int32 {
+	if x != nil {
+		return x.EventWeekdays
+	}
+	return nil
+}
+
+func (x *UpdateBranchSetting) GetRegistrationCutoffHours() int32 {
+	if x != nil {
+		return x.RegistrationCutoffHours
+	}
+	return 0
+}
+
+func (x *UpdateBranchSetting) GetPaymentDueDay() int32 {
+	if x != nil {
+		return x.PaymentDueDay
+	}
+	return 0
+}
+
+func (x *UpdateBranchSetting) GetLessonDurationMinutes() int32 {
+	if x != nil {
+		return x.LessonDurationMinutes
+	}
+	return 0
+}
+
+func (x *UpdateBranchSetting) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateBranchSetting) GetPhonePattern() string {
+	if x != nil {
+		return x.PhonePattern
+	}
+	return ""
+}
+
+type GetListBranchSettingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page  uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetListBranchSettingRequest) Reset() {
+	*x = GetListBranchSettingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_branch_setting_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListBranchSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListBranchSettingRequest) ProtoMessage() {}
+
+func (x *GetListBranchSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_branch_setting_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListBranchSettingRequest.ProtoReflect.Descriptor instead.
+func (*GetListBranchSettingRequest) Descriptor() ([]byte, []int) {
+	return file_branch_setting_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetListBranchSettingRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetListBranchSettingRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetListBranchSettingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count          int64            `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	BranchSettings []*BranchSetting `protobuf:"bytes,2,rep,name=branchSettings,proto3" json:"branchSettings,omitempty"`
+}
+
+func (x *GetListBranchSettingResponse) Reset() {
+	*x = GetListBranchSettingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_branch_setting_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListBranchSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListBranchSettingResponse) ProtoMessage() {}
+
+func (x *GetListBranchSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_branch_setting_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListBranchSettingResponse.ProtoReflect.Descriptor instead.
+func (*GetListBranchSettingResponse) Descriptor() ([]byte, []int) {
+	return file_branch_setting_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetListBranchSettingResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetListBranchSettingResponse) GetBranchSettings() []*BranchSetting {
+	if x != nil {
+		return x.BranchSettings
+	}
+	return nil
+}
+
+var File_branch_setting_proto protoreflect.FileDescriptor
+
+var file_branch_setting_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x35, 0x0a, 0x17, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49,
+	0x64, 0x22, 0xad, 0x02, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x65,
+	0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x12, 0x38, 0x0a, 0x17, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x74, 0x6f, 0x66,
+	0x66, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x74, 0x6f, 0x66, 0x66,
+	0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x44, 0x75, 0x65, 0x44, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x65, 0x44, 0x61, 0x79, 0x12, 0x34, 0x0a, 0x15, 0x6c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x6c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x22, 0x83, 0x03, 0x0a, 0x0d, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12,
+	0x24, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x65,
+	0x6b, 0x64, 0x61, 0x79, 0x73, 0x12, 0x38, 0x0a, 0x17, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x65, 0x44, 0x61, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44,
+	0x75, 0x65, 0x44, 0x61, 0x79, 0x12, 0x34, 0x0a, 0x15, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x1a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79,
+	0x73, 0x12, 0x38, 0x0a, 0x17, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x17, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x65, 0x44, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x65, 0x44, 0x61,
+	0x79, 0x12, 0x34, 0x0a, 0x15, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x15, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x47, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x79, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0e, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x32, 0xb8, 0x03, 0x0a, 0x14,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x21,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x1a, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x00,
+	0x12, 0x4f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x25, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b,
+	0x65, 0x79, 0x1a, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22,
+	0x00, 0x12, 0x62, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x21, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x1a, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22,
+	0x00, 0x12, 0x53, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b,
+	0x65, 0x79, 0x1a, 0x20, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x42, 0x17, 0x5a, 0x15, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_branch_setting_proto_rawDescOnce sync.Once
+	file_branch_setting_proto_rawDescData = file_branch_setting_proto_rawDesc
+)
+
+func file_branch_setting_proto_rawDescGZIP() []byte {
+	file_branch_setting_proto_rawDescOnce.Do(func() {
+		file_branch_setting_proto_rawDescData = protoimpl.X.CompressGZIP(file_branch_setting_proto_rawDescData)
+	})
+	return file_branch_setting_proto_rawDescData
+}
+
+var file_branch_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_branch_setting_proto_goTypes = []interface{}{
+	(*EmptyBranchSetting)(nil),           // 0: user_service.EmptyBranchSetting
+	(*BranchSettingPrimaryKey)(nil),      // 1: user_service.BranchSettingPrimaryKey
+	(*CreateBranchSetting)(nil),          // 2: user_service.CreateBranchSetting
+	(*BranchSetting)(nil),                // 3: user_service.BranchSetting
+	(*UpdateBranchSetting)(nil),          // 4: user_service.UpdateBranchSetting
+	(*GetListBranchSettingRequest)(nil),  // 5: user_service.GetListBranchSettingRequest
+	(*GetListBranchSettingResponse)(nil), // 6: user_service.GetListBranchSettingResponse
+}
+var file_branch_setting_proto_depIdxs = []int32{
+	3, // 0: user_service.GetListBranchSettingResponse.branchSettings:type_name -> user_service.BranchSetting
+	2, // 1: user_service.BranchSettingService.Create:input_type -> user_service.CreateBranchSetting
+	1, // 2: user_service.BranchSettingService.GetByID:input_type -> user_service.BranchSettingPrimaryKey
+	5, // 3: user_service.BranchSettingService.GetList:input_type -> user_service.GetListBranchSettingRequest
+	4, // 4: user_service.BranchSettingService.Update:input_type -> user_service.UpdateBranchSetting
+	1, // 5: user_service.BranchSettingService.Delete:input_type -> user_service.BranchSettingPrimaryKey
+	3, // 6: user_service.BranchSettingService.Create:output_type -> user_service.BranchSetting
+	3, // 7: user_service.BranchSettingService.GetByID:output_type -> user_service.BranchSetting
+	6, // 8: user_service.BranchSettingService.GetList:output_type -> user_service.GetListBranchSettingResponse
+	3, // 9: user_service.BranchSettingService.Update:output_type -> user_service.BranchSetting
+	0, // 10: user_service.BranchSettingService.Delete:output_type -> user_service.EmptyBranchSetting
+	6, // [6:11] is the sub-list for method output_type
+	1, // [1:6] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_branch_setting_proto_init() }
+func file_branch_setting_proto_init() {
+	if File_branch_setting_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_branch_setting_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyBranchSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_branch_setting_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BranchSettingPrimaryKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_branch_setting_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBranchSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_branch_setting_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BranchSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_branch_setting_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBranchSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_branch_setting_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListBranchSettingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_branch_setting_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListBranchSettingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_branch_setting_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_branch_setting_proto_goTypes,
+		DependencyIndexes: file_branch_setting_proto_depIdxs,
+		MessageInfos:      file_branch_setting_proto_msgTypes,
+	}.Build()
+	File_branch_setting_proto = out.File
+	file_branch_setting_proto_rawDesc = nil
+	file_branch_setting_proto_goTypes = nil
+	file_branch_setting_proto_depIdxs = nil
+}
@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: guardian.proto
+
+package user_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GuardianService_Create_FullMethodName        = "/user_service.GuardianService/Create"
+	GuardianService_GetByID_FullMethodName       = "/user_service.GuardianService/GetByID"
+	GuardianService_GetList_FullMethodName       = "/user_service.GuardianService/GetList"
+	GuardianService_Update_FullMethodName        = "/user_service.GuardianService/Update"
+	GuardianService_Delete_FullMethodName        = "/user_service.GuardianService/Delete"
+	GuardianService_LinkStudent_FullMethodName   = "/user_service.GuardianService/LinkStudent"
+	GuardianService_UnlinkStudent_FullMethodName = "/user_service.GuardianService/UnlinkStudent"
+)
+
+// GuardianServiceClient is the client API for GuardianService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GuardianServiceClient interface {
+	Create(ctx context.Context, in *CreateGuardian, opts ...grpc.CallOption) (*Guardian, error)
+	GetByID(ctx context.Context, in *GuardianPrimaryKey, opts ...grpc.CallOption) (*Guardian, error)
+	GetList(ctx context.Context, in *GetListGuardianRequest, opts ...grpc.CallOption) (*GetListGuardianResponse, error)
+	Update(ctx context.Context, in *UpdateGuardian, opts ...grpc.CallOption) (*Guardian, error)
+	Delete(ctx context.Context, in *GuardianPrimaryKey, opts ...grpc.CallOption) (*EmptyGuardian, error)
+	LinkStudent(ctx context.Context, in *GuardianStudent, opts ...grpc.CallOption) (*Guardian, error)
+	UnlinkStudent(ctx context.Context, in *GuardianStudentPrimaryKey, opts ...grpc.CallOption) (*EmptyGuardian, error)
+}
+
+type guardianServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGuardianServiceClient(cc grpc.ClientConnInterface) GuardianServiceClient {
+	return &guardianServiceClient{cc}
+}
+
+func (c *guardianServiceClient) Create(ctx context.Context, in *CreateGuardian, opts ...grpc.CallOption) (*Guardian, error) {
+	out := new(Guardian)
+	err := c.cc.Invoke(ctx, GuardianService_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) GetByID(ctx context.Context, in *GuardianPrimaryKey, opts ...grpc.CallOption) (*Guardian, error) {
+	out := new(Guardian)
+	err := c.cc.Invoke(ctx, GuardianService_GetByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) GetList(ctx context.Context, in *GetListGuardianRequest, opts ...grpc.CallOption) (*GetListGuardianResponse, error) {
+	out := new(GetListGuardianResponse)
+	err := c.cc.Invoke(ctx, GuardianService_GetList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) Update(ctx context.Context, in *UpdateGuardian, opts ...grpc.CallOption) (*Guardian, error) {
+	out := new(Guardian)
+	err := c.cc.Invoke(ctx, GuardianService_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) Delete(ctx context.Context, in *GuardianPrimaryKey, opts ...grpc.CallOption) (*EmptyGuardian, error) {
+	out := new(EmptyGuardian)
+	err := c.cc.Invoke(ctx, GuardianService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) LinkStudent(ctx context.Context, in *GuardianStudent, opts ...grpc.CallOption) (*Guardian, error) {
+	out := new(Guardian)
+	err := c.cc.Invoke(ctx, GuardianService_LinkStudent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) UnlinkStudent(ctx context.Context, in *GuardianStudentPrimaryKey, opts ...grpc.CallOption) (*EmptyGuardian, error) {
+	out := new(EmptyGuardian)
+	err := c.cc.Invoke(ctx, GuardianService_UnlinkStudent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GuardianServiceServer is the server API for GuardianService service.
+// All implementations should embed UnimplementedGuardianServiceServer
+// for forward compatibility
+type GuardianServiceServer interface {
+	Create(context.Context, *CreateGuardian) (*Guardian, error)
+	GetByID(context.Context, *GuardianPrimaryKey) (*Guardian, error)
+	GetList(context.Context, *GetListGuardianRequest) (*GetListGuardianResponse, error)
+	Update(context.Context, *UpdateGuardian) (*Guardian, error)
+	Delete(context.Context, *GuardianPrimaryKey) (*EmptyGuardian, error)
+	LinkStudent(context.Context, *GuardianStudent) (*Guardian, error)
+	UnlinkStudent(context.Context, *GuardianStudentPrimaryKey) (*EmptyGuardian, error)
+}
+
+// UnimplementedGuardianServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedGuardianServiceServer struct {
+}
+
+func (UnimplementedGuardianServiceServer) Create(context.Context, *CreateGuardian) (*Guardian, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedGuardianServiceServer) GetByID(context.Context, *GuardianPrimaryKey) (*Guardian, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedGuardianServiceServer) GetList(context.Context, *GetListGuardianRequest) (*GetListGuardianResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedGuardianServiceServer) Update(context.Context, *UpdateGuardian) (*Guardian, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedGuardianServiceServer) Delete(context.Context, *GuardianPrimaryKey) (*EmptyGuardian, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedGuardianServiceServer) LinkStudent(context.Context, *GuardianStudent) (*Guardian, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkStudent not implemented")
+}
+func (UnimplementedGuardianServiceServer) UnlinkStudent(context.Context, *GuardianStudentPrimaryKey) (*EmptyGuardian, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkStudent not implemented")
+}
+
+// UnsafeGuardianServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GuardianServiceServer will
+// result in compilation errors.
+type UnsafeGuardianServiceServer interface {
+	mustEmbedUnimplementedGuardianServiceServer()
+}
+
+func RegisterGuardianServiceServer(s grpc.ServiceRegistrar, srv GuardianServiceServer) {
+	s.RegisterService(&GuardianService_ServiceDesc, srv)
+}
+
+func _GuardianService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGuardian)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).Create(ctx, req.(*CreateGuardian))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuardianPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_GetByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).GetByID(ctx, req.(*GuardianPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).GetList(ctx, req.(*GetListGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGuardian)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).Update(ctx, req.(*UpdateGuardian))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuardianPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).Delete(ctx, req.(*GuardianPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_LinkStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuardianStudent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).LinkStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_LinkStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).LinkStudent(ctx, req.(*GuardianStudent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_UnlinkStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuardianStudentPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).UnlinkStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_UnlinkStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).UnlinkStudent(ctx, req.(*GuardianStudentPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GuardianService_ServiceDesc is the grpc.ServiceDesc for GuardianService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GuardianService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user_service.GuardianService",
+	HandlerType: (*GuardianServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _GuardianService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _GuardianService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _GuardianService_GetList_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _GuardianService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _GuardianService_Delete_Handler,
+		},
+		{
+			MethodName: "LinkStudent",
+			Handler:    _GuardianService_LinkStudent_Handler,
+		},
+		{
+			MethodName: "UnlinkStudent",
+			Handler:    _GuardianService_UnlinkStudent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "guardian.proto",
+}
@@ -0,0 +1,1183 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: ielts_result.proto
+
+package user_service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EmptyIeltsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EmptyIeltsResult) Reset() {
+	*x = EmptyIeltsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ielts_result_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyIeltsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyIeltsResult) ProtoMessage() {}
+
+func (x *EmptyIeltsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ielts_result_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyIeltsResult.ProtoReflect.Descriptor instead.
+func (*EmptyIeltsResult) Descriptor() ([]byte, []int) {
+	return file_ielts_result_proto_rawDescGZIP(), []int{0}
+}
+
+type IeltsResultPrimaryKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *IeltsResultPrimaryKey) Reset() {
+	*x = IeltsResultPrimaryKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ielts_result_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IeltsResultPrimaryKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IeltsResultPrimaryKey) ProtoMessage() {}
+
+func (x *IeltsResultPrimaryKey) ProtoReflect() protoreflect.Message {
+	mi := &file_ielts_result_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IeltsResultPrimaryKey.ProtoReflect.Descriptor instead.
+func (*IeltsResultPrimaryKey) Descriptor() ([]byte, []int) {
+	return file_ielts_result_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IeltsResultPrimaryKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateIeltsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StaffType         string  `protobuf:"bytes,1,opt,name=staffType,proto3" json:"staffType,omitempty"`
+	StaffId           string  `protobuf:"bytes,2,opt,name=staffId,proto3" json:"staffId,omitempty"`
+	TestDate          string  `protobuf:"bytes,3,opt,name=testDate,proto3" json:"testDate,omitempty"`
+	Overall           float32 `protobuf:"fixed32,4,opt,name=overall,proto3" json:"overall,omitempty"`
+	Listening         float32 `protobuf:"fixed32,5,opt,name=listening,proto3" json:"listening,omitempty"`
+	Reading           float32 `protobuf:"fixed32,6,opt,name=reading,proto3" json:"reading,omitempty"`
+	Writing           float32 `protobuf:"fixed32,7,opt,name=writing,proto3" json:"writing,omitempty"`
+	Speaking          float32 `protobuf:"fixed32,8,opt,name=speaking,proto3" json:"speaking,omitempty"`
+	CertificateNumber string  `protobuf:"bytes,9,opt,name=certificateNumber,proto3" json:"certificateNumber,omitempty"`
+}
+
+func (x *CreateIeltsResult) Reset() {
+	*x = CreateIeltsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ielts_result_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateIeltsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIeltsResult) ProtoMessage() {}
+
+func (x *CreateIeltsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ielts_result_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIeltsResult.ProtoReflect.Descriptor instead.
+func (*CreateIeltsResult) Descriptor() ([]byte, []int) {
+	return file_ielts_result_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateIeltsResult) GetStaffType() string {
+	if x != nil {
+		return x.StaffType
+	}
+	return ""
+}
+
+func (x *CreateIeltsResult) GetStaffId() string {
+	if x != nil {
+		return x.StaffId
+	}
+	return ""
+}
+
+func (x *CreateIeltsResult) GetTestDate() string {
+	if x != nil {
+		return x.TestDate
+	}
+	return ""
+}
+
+func (x *CreateIeltsResult) GetOverall() float32 {
+	if x != nil {
+		return x.Overall
+	}
+	return 0
+}
+
+func (x *CreateIeltsResult) GetListening() float32 {
+	if x != nil {
+		return x.Listening
+	}
+	return 0
+}
+
+func (x *CreateIeltsResult) GetReading() float32 {
+	if x != nil {
+		return x.Reading
+	}
+	return 0
+}
+
+func (x *CreateIeltsResult) GetWriting() float32 {
+	if x != nil {
+		return x.Writing
+	}
+	return 0
+}
+
+func (x *CreateIeltsResult) GetSpeaking() float32 {
+	if x != nil {
+		return x.Speaking
+	}
+	return 0
+}
+
+func (x *CreateIeltsResult) GetCertificateNumber() string {
+	if x != nil {
+		return x.CertificateNumber
+	}
+	return ""
+}
+
+type IeltsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StaffType         string  `protobuf:"bytes,2,opt,name=staffType,proto3" json:"staffType,omitempty"`
+	StaffId           string  `protobuf:"bytes,3,opt,name=staffId,proto3" json:"staffId,omitempty"`
+	StaffName         string  `protobuf:"bytes,4,opt,name=staffName,proto3" json:"staffName,omitempty"`
+	TestDate          string  `protobuf:"bytes,5,opt,name=testDate,proto3" json:"testDate,omitempty"`
+	Overall           float32 `protobuf:"fixed32,6,opt,name=overall,proto3" json:"overall,omitempty"`
+	Listening         float32 `protobuf:"fixed32,7,opt,name=listening,proto3" json:"listening,omitempty"`
+	Reading           float32 `protobuf:"fixed32,8,opt,name=reading,proto3" json:"reading,omitempty"`
+	Writing           float32 `protobuf:"fixed32,9,opt,name=writing,proto3" json:"writing,omitempty"`
+	Speaking          float32 `protobuf:"fixed32,10,opt,name=speaking,proto3" json:"speaking,omitempty"`
+	CertificateNumber string  `protobuf:"bytes,11,opt,name=certificateNumber,proto3" json:"certificateNumber,omitempty"`
+	ExpiresAt         string  `protobuf:"bytes,12,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	IsExpired         bool    `protobuf:"varint,13,opt,name=isExpired,proto3" json:"isExpired,omitempty"`
+	CreatedAt         string  `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         string  `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *IeltsResult) Reset() {
+	*x = IeltsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ielts_result_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IeltsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IeltsResult) ProtoMessage() {}
+
+func (x *IeltsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ielts_result_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IeltsResult.ProtoReflect.Descriptor instead.
+func (*IeltsResult) Descriptor() ([]byte, []int) {
+	return file_ielts_result_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IeltsResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IeltsResult) GetStaffType() string {
+	if x != nil {
+		return x.StaffType
+	}
+	return ""
+}
+
+func (x *IeltsResult) GetStaffId() string {
+	if x != nil {
+		return x.StaffId
+	}
+	return ""
+}
+
+func (x *IeltsResult) GetStaffName() string {
+	if x != nil {
+		return x.StaffName
+	}
+	return ""
+}
+
+func (x *IeltsResult) GetTestDate() string {
+	if x != nil {
+		return x.TestDate
+	}
+	return ""
+}
+
+func (x *IeltsResult) GetOverall() float32 {
+	if x != nil {
+		return x.Overall
+	}
+	return 0
+}
+
+func (x *IeltsResult) GetListening() float32 {
+	if x != nil {
+		return x.Listening
+	}
+	return 0
+}
+
+func (x *IeltsResult) GetReading() float32 {
+	if x != nil {
+		return x.Reading
+	}
+	return 0
+}
+
+func (x *IeltsResult) GetWriting() float32 {
+	if x != nil {
+		return x.Writing
+	}
+	return 0
+}
+
+func (x *IeltsResult) GetSpeaking() float32 {
+	if x != nil {
+		return x.Speaking
+	}
+	return 0
+}
+
+func (x *IeltsResult) GetCertificateNumber() string {
+	if x != nil {
+		return x.CertificateNumber
+	}
+	return ""
+}
+
+func (x *IeltsResult) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *IeltsResult) GetIsExpired() bool {
+	if x != nil {
+		return x.IsExpired
+	}
+	return false
+}
+
+func (x *IeltsResult) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *IeltsResult) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type UpdateIeltsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TestDate          string  `protobuf:"bytes,2,opt,name=testDate,proto3" json:"testDate,omitempty"`
+	Overall           float32 `protobuf:"fixed32,3,opt,name=overall,proto3" json:"overall,omitempty"`
+	Listening         float32 `protobuf:"fixed32,4,opt,name=listening,proto3" json:"listening,omitempty"`
+	Reading           float32 `protobuf:"fixed32,5,opt,name=reading,proto3" json:"reading,omitempty"`
+	Writing           float32 `protobuf:"fixed32,6,opt,name=writing,proto3" json:"writing,omitempty"`
+	Speaking          float32 `protobuf:"fixed32,7,opt,name=speaking,proto3" json:"speaking,omitempty"`
+	CertificateNumber string  `protobuf:"bytes,8,opt,name=certificateNumber,proto3" json:"certificateNumber,omitempty"`
+}
+
+func (x *UpdateIeltsResult) Reset() {
+	*x = UpdateIeltsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ielts_result_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateIeltsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIeltsResult) ProtoMessage() {}
+
+func (x *UpdateIeltsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ielts_result_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIeltsResult.ProtoReflect.Descriptor instead.
+func (*UpdateIeltsResult) Descriptor() ([]byte, []int) {
+	return file_ielts_result_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateIeltsResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateIeltsResult) GetTestDate() string {
+	if x != nil {
+		return x.TestDate
+	}
+	return ""
+}
+
+func (x *UpdateIeltsResult) GetOverall() float32 {
+	if x != nil {
+		return x.Overall
+	}
+	return 0
+}
+
+func (x *UpdateIeltsResult) GetListening() float32 {
+	if x != nil {
+		return x.Listening
+	}
+	return 0
+}
+
+func (x *UpdateIeltsResult) GetReading() float32 {
+	if x != nil {
+		return x.Reading
+	}
+	return 0
+}
+
+func (x *UpdateIeltsResult) GetWriting() float32 {
+	if x != nil {
+		return x.Writing
+	}
+	return 0
+}
+
+func (x *UpdateIeltsResult) GetSpeaking() float32 {
+	if x != nil {
+		return x.Speaking
+	}
+	return 0
+}
+
+func (x *UpdateIeltsResult) GetCertificateNumber() string {
+	if x != nil {
+		return x.CertificateNumber
+	}
+	return ""
+}
+
+type GetListIeltsResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page      uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	StaffType string `protobuf:"bytes,3,opt,name=staffType,proto3" json:"staffType,omitempty"`
+	StaffId   string `protobuf:"bytes,4,opt,name=staffId,proto3" json:"staffId,omitempty"`
+}
+
+func (x *GetListIeltsResultRequest) Reset() {
+	*x = GetListIeltsResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ielts_result_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListIeltsResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListIeltsResultRequest) ProtoMessage() {}
+
+func (x *GetListIeltsResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ielts_result_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListIeltsResultRequest.ProtoReflect.Descriptor instead.
+func (*GetListIeltsResultRequest) Descriptor() ([]byte, []int) {
+	return file_ielts_result_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetListIeltsResultRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetListIeltsResultRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetListIeltsResultRequest) GetStaffType() string {
+	if x != nil {
+		return x.StaffType
+	}
+	return ""
+}
+
+func (x *GetListIeltsResultRequest) GetStaffId() string {
+	if x != nil {
+		return x.StaffId
+	}
+	return ""
+}
+
+type GetListIeltsResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count        int64          `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	IeltsResults []*IeltsResult `protobuf:"bytes,2,rep,name=ieltsResults,proto3" json:"ieltsResults,omitempty"`
+}
+
+func (x *GetListIeltsResultResponse) Reset() {
+	*x = GetListIeltsResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ielts_result_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListIeltsResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListIeltsResultResponse) ProtoMessage() {}
+
+func (x *GetListIeltsResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ielts_result_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListIeltsResultResponse.ProtoReflect.Descriptor instead.
+func (*GetListIeltsResultResponse) Descriptor() ([]byte, []int) {
+	return file_ielts_result_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetListIeltsResultResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetListIeltsResultResponse) GetIeltsResults() []*IeltsResult {
+	if x != nil {
+		return x.IeltsResults
+	}
+	return nil
+}
+
+type GetListExpiringIeltsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Days      int32  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	StaffType string `protobuf:"bytes,2,opt,name=staffType,proto3" json:"staffType,omitempty"`
+	BranchId  string `protobuf:"bytes,3,opt,name=branchId,proto3" json:"branchId,omitempty"`
+}
+
+func (x *GetListExpiringIeltsRequest) Reset() {
+	*x = GetListExpiringIeltsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ielts_result_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListExpiringIeltsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListExpiringIeltsRequest) ProtoMessage() {}
+
+func (x *GetListExpiringIeltsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ielts_result_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListExpiringIeltsRequest.ProtoReflect.Descriptor instead.
+func (*GetListExpiringIeltsRequest) Descriptor() ([]byte, []int) {
+	return file_ielts_result_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetListExpiringIeltsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetListExpiringIeltsRequest) GetStaffType() string {
+	if x != nil {
+		return x.StaffType
+	}
+	return ""
+}
+
+func (x *GetListExpiringIeltsRequest) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+type IeltsExpiryAlert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StaffType         string  `protobuf:"bytes,1,opt,name=staffType,proto3" json:"staffType,omitempty"`
+	StaffId           string  `protobuf:"bytes,2,opt,name=staffId,proto3" json:"staffId,omitempty"`
+	StaffName         string  `protobuf:"bytes,3,opt,name=staffName,proto3" json:"staffName,omitempty"`
+	BranchId          string  `protobuf:"bytes,4,opt,name=branchId,proto3" json:"branchId,omitempty"`
+	ResultId          string  `protobuf:"bytes,5,opt,name=resultId,proto3" json:"resultId,omitempty"`
+	Overall           float32 `protobuf:"fixed32,6,opt,name=overall,proto3" json:"overall,omitempty"`
+	CertificateNumber string  `protobuf:"bytes,7,opt,name=certificateNumber,proto3" json:"certificateNumber,omitempty"`
+	ExpiresAt         string  `protobuf:"bytes,8,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	DaysLeft          int32   `protobuf:"varint,9,opt,name=daysLeft,proto3" json:"daysLeft,omitempty"`
+}
+
+func (x *IeltsExpiryAlert) Reset() {
+	*x = IeltsExpiryAlert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ielts_result_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IeltsExpiryAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IeltsExpiryAlert) ProtoMessage() {}
+
+func (x *IeltsExpiryAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_ielts_result_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IeltsExpiryAlert.ProtoReflect.Descriptor instead.
+func (*IeltsExpiryAlert) Descriptor() ([]byte, []int) {
+	return file_ielts_result_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IeltsExpiryAlert) GetStaffType() string {
+	if x != nil {
+		return x.StaffType
+	}
+	return ""
+}
+
+func (x *IeltsExpiryAlert) GetStaffId() string {
+	if x != nil {
+		return x.StaffId
+	}
+	return ""
+}
+
+func (x *IeltsExpiryAlert) GetStaffName() string {
+	if x != nil {
+		return x.StaffName
+	}
+	return ""
+}
+
+func (x *IeltsExpiryAlert) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+func (x *IeltsExpiryAlert) GetResultId() string {
+	if x != nil {
+		return x.ResultId
+	}
+	return ""
+}
+
+func (x *IeltsExpiryAlert) GetOverall() float32 {
+	if x != nil {
+		return x.Overall
+	}
+	return 0
+}
+
+func (x *IeltsExpiryAlert) GetCertificateNumber() string {
+	if x != nil {
+		return x.CertificateNumber
+	}
+	return ""
+}
+
+func (x *IeltsExpiryAlert) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *IeltsExpiryAlert) GetDaysLeft() int32 {
+	if x != nil {
+		return x.DaysLeft
+	}
+	return 0
+}
+
+type GetListExpiringIeltsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count  int64               `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Alerts []*IeltsExpiryAlert `protobuf:"bytes,2,rep,name=alerts,proto3" json:"alerts,omitempty"`
+}
+
+func (x *GetListExpiringIeltsResponse) Reset() {
+	*x = GetListExpiringIeltsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ielts_result_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListExpiringIeltsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListExpiringIeltsResponse) ProtoMessage() {}
+
+func (x *GetListExpiringIeltsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ielts_result_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListExpiringIeltsResponse.ProtoReflect.Descriptor instead.
+func (*GetListExpiringIeltsResponse) Descriptor() ([]byte, []int) {
+	return file_ielts_result_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetListExpiringIeltsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetListExpiringIeltsResponse) GetAlerts() []*IeltsExpiryAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+var File_ielts_result_proto protoreflect.FileDescriptor
+
+var file_ielts_result_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x69, 0x65, 0x6c, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x49, 0x65, 0x6c, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x27, 0x0a, 0x15, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x9d, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x66, 0x66, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x66, 0x66, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x76, 0x65,
+	0x72, 0x61, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x77,
+	0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x77, 0x72,
+	0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
+	0xbf, 0x03, 0x0a, 0x0b, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x66, 0x66, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x66, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x66, 0x66,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x08, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xf5, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x65, 0x6c, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x73, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x72, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x08, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x11, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x7d, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x66, 0x66, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x66, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0c,
+	0x69, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c, 0x69,
+	0x65, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x6b, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x65,
+	0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x66, 0x66, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x66, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x22, 0xa2, 0x02, 0x0a, 0x10, 0x49, 0x65, 0x6c,
+	0x74, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x66, 0x66, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x66, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74,
+	0x61, 0x66, 0x66, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x66, 0x66, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x6f, 0x76,
+	0x65, 0x72, 0x61, 0x6c, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x79, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x61, 0x79, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x22, 0x6c, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x49, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x52, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x32, 0x8e, 0x04, 0x0a, 0x12,
+	0x49, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x19, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x65, 0x6c,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x07, 0x47, 0x65,
+	0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x23, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x1a, 0x19, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x27, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x1f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x1a, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x1a, 0x1e,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00,
+	0x12, 0x6a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x12, 0x29, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x49, 0x65, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x65, 0x6c,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x17, 0x5a, 0x15,
+	0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ielts_result_proto_rawDescOnce sync.Once
+	file_ielts_result_proto_rawDescData = file_ielts_result_proto_rawDesc
+)
+
+func file_ielts_result_proto_rawDescGZIP() []byte {
+	file_ielts_result_proto_rawDescOnce.Do(func() {
+		file_ielts_result_proto_rawDescData = protoimpl.X.CompressGZIP(file_ielts_result_proto_rawDescData)
+	})
+	return file_ielts_result_proto_rawDescData
+}
+
+var file_ielts_result_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_ielts_result_proto_goTypes = []interface{}{
+	(*EmptyIeltsResult)(nil),             // 0: user_service.EmptyIeltsResult
+	(*IeltsResultPrimaryKey)(nil),        // 1: user_service.IeltsResultPrimaryKey
+	(*CreateIeltsResult)(nil),            // 2: user_service.CreateIeltsResult
+	(*IeltsResult)(nil),                  // 3: user_service.IeltsResult
+	(*UpdateIeltsResult)(nil),            // 4: user_service.UpdateIeltsResult
+	(*GetListIeltsResultRequest)(nil),    // 5: user_service.GetListIeltsResultRequest
+	(*GetListIeltsResultResponse)(nil),   // 6: user_service.GetListIeltsResultResponse
+	(*GetListExpiringIeltsRequest)(nil),  // 7: user_service.GetListExpiringIeltsRequest
+	(*IeltsExpiryAlert)(nil),             // 8: user_service.IeltsExpiryAlert
+	(*GetListExpiringIeltsResponse)(nil), // 9: user_service.GetListExpiringIeltsResponse
+}
+var file_ielts_result_proto_depIdxs = []int32{
+	3, // 0: user_service.GetListIeltsResultResponse.ieltsResults:type_name -> user_service.IeltsResult
+	8, // 1: user_service.GetListExpiringIeltsResponse.alerts:type_name -> user_service.IeltsExpiryAlert
+	2, // 2: user_service.IeltsResultService.Create:input_type -> user_service.CreateIeltsResult
+	1, // 3: user_service.IeltsResultService.GetByID:input_type -> user_service.IeltsResultPrimaryKey
+	5, // 4: user_service.IeltsResultService.GetList:input_type -> user_service.GetListIeltsResultRequest
+	4, // 5: user_service.IeltsResultService.Update:input_type -> user_service.UpdateIeltsResult
+	1, // 6: user_service.IeltsResultService.Delete:input_type -> user_service.IeltsResultPrimaryKey
+	7, // 7: user_service.IeltsResultService.GetListExpiring:input_type -> user_service.GetListExpiringIeltsRequest
+	3, // 8: user_service.IeltsResultService.Create:output_type -> user_service.IeltsResult
+	3, // 9: user_service.IeltsResultService.GetByID:output_type -> user_service.IeltsResult
+	6, // 10: user_service.IeltsResultService.GetList:output_type -> user_service.GetListIeltsResultResponse
+	3, // 11: user_service.IeltsResultService.Update:output_type -> user_service.IeltsResult
+	0, // 12: user_service.IeltsResultService.Delete:output_type -> user_service.EmptyIeltsResult
+	9, // 13: user_service.IeltsResultService.GetListExpiring:output_type -> user_service.GetListExpiringIeltsResponse
+	8, // [8:14] is the sub-list for method output_type
+	2, // [2:8] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_ielts_result_proto_init() }
+func file_ielts_result_proto_init() {
+	if File_ielts_result_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ielts_result_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyIeltsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ielts_result_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IeltsResultPrimaryKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ielts_result_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateIeltsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ielts_result_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IeltsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ielts_result_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateIeltsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ielts_result_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListIeltsResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ielts_result_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListIeltsResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ielts_result_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListExpiringIeltsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ielts_result_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IeltsExpiryAlert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ielts_result_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListExpiringIeltsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ielts_result_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ielts_result_proto_goTypes,
+		DependencyIndexes: file_ielts_result_proto_depIdxs,
+		MessageInfos:      file_ielts_result_proto_msgTypes,
+	}.Build()
+	File_ielts_result_proto = out.File
+	file_ielts_result_proto_rawDesc = nil
+	file_ielts_result_proto_goTypes = nil
+	file_ielts_result_proto_depIdxs = nil
+}
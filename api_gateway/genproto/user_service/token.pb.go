@@ -143,7 +143,7 @@ var file_token_proto_rawDesc = []byte{
 	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63,
 	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66,
 	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0xb8, 0x03,
+	0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0xfb, 0x03,
 	0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a,
 	0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65,
@@ -171,9 +171,13 @@ var file_token_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
 	0x73, 0x1a, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x00, 0x42, 0x17, 0x5a, 0x15, 0x67, 0x65, 0x6e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x73, 0x1a, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x00, 0x42, 0x17, 0x5a, 0x15, 0x67,
+	0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -200,14 +204,16 @@ var file_token_proto_depIdxs = []int32{
 	0, // 3: user_service.LoginService.SupportTeacherLogin:input_type -> user_service.LoginPasswors
 	0, // 4: user_service.LoginService.TeacherLogin:input_type -> user_service.LoginPasswors
 	0, // 5: user_service.LoginService.SuperAdminLogin:input_type -> user_service.LoginPasswors
-	1, // 6: user_service.LoginService.AdministarationLogin:output_type -> user_service.Token
-	1, // 7: user_service.LoginService.ManagerLogin:output_type -> user_service.Token
-	1, // 8: user_service.LoginService.StudentLogin:output_type -> user_service.Token
-	1, // 9: user_service.LoginService.SupportTeacherLogin:output_type -> user_service.Token
-	1, // 10: user_service.LoginService.TeacherLogin:output_type -> user_service.Token
-	1, // 11: user_service.LoginService.SuperAdminLogin:output_type -> user_service.Token
-	6, // [6:12] is the sub-list for method output_type
-	0, // [0:6] is the sub-list for method input_type
+	0, // 6: user_service.LoginService.ParentLogin:input_type -> user_service.LoginPasswors
+	1, // 7: user_service.LoginService.AdministarationLogin:output_type -> user_service.Token
+	1, // 8: user_service.LoginService.ManagerLogin:output_type -> user_service.Token
+	1, // 9: user_service.LoginService.StudentLogin:output_type -> user_service.Token
+	1, // 10: user_service.LoginService.SupportTeacherLogin:output_type -> user_service.Token
+	1, // 11: user_service.LoginService.TeacherLogin:output_type -> user_service.Token
+	1, // 12: user_service.LoginService.SuperAdminLogin:output_type -> user_service.Token
+	1, // 13: user_service.LoginService.ParentLogin:output_type -> user_service.Token
+	7, // [7:14] is the sub-list for method output_type
+	0, // [0:7] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -25,6 +25,7 @@ const (
 	LoginService_SupportTeacherLogin_FullMethodName  = "/user_service.LoginService/SupportTeacherLogin"
 	LoginService_TeacherLogin_FullMethodName         = "/user_service.LoginService/TeacherLogin"
 	LoginService_SuperAdminLogin_FullMethodName      = "/user_service.LoginService/SuperAdminLogin"
+	LoginService_ParentLogin_FullMethodName          = "/user_service.LoginService/ParentLogin"
 )
 
 // LoginServiceClient is the client API for LoginService service.
@@ -37,6 +38,7 @@ type LoginServiceClient interface {
 	SupportTeacherLogin(ctx context.Context, in *LoginPasswors, opts ...grpc.CallOption) (*Token, error)
 	TeacherLogin(ctx context.Context, in *LoginPasswors, opts ...grpc.CallOption) (*Token, error)
 	SuperAdminLogin(ctx context.Context, in *LoginPasswors, opts ...grpc.CallOption) (*Token, error)
+	ParentLogin(ctx context.Context, in *LoginPasswors, opts ...grpc.CallOption) (*Token, error)
 }
 
 type loginServiceClient struct {
@@ -101,6 +103,15 @@ func (c *loginServiceClient) SuperAdminLogin(ctx context.Context, in *LoginPassw
 	return out, nil
 }
 
+func (c *loginServiceClient) ParentLogin(ctx context.Context, in *LoginPasswors, opts ...grpc.CallOption) (*Token, error) {
+	out := new(Token)
+	err := c.cc.Invoke(ctx, LoginService_ParentLogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoginServiceServer is the server API for LoginService service.
 // All implementations should embed UnimplementedLoginServiceServer
 // for forward compatibility
@@ -111,6 +122,7 @@ type LoginServiceServer interface {
 	SupportTeacherLogin(context.Context, *LoginPasswors) (*Token, error)
 	TeacherLogin(context.Context, *LoginPasswors) (*Token, error)
 	SuperAdminLogin(context.Context, *LoginPasswors) (*Token, error)
+	ParentLogin(context.Context, *LoginPasswors) (*Token, error)
 }
 
 // UnimplementedLoginServiceServer should be embedded to have forward compatible implementations.
@@ -135,6 +147,9 @@ func (UnimplementedLoginServiceServer) TeacherLogin(context.Context, *LoginPassw
 func (UnimplementedLoginServiceServer) SuperAdminLogin(context.Context, *LoginPasswors) (*Token, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperAdminLogin not implemented")
 }
+func (UnimplementedLoginServiceServer) ParentLogin(context.Context, *LoginPasswors) (*Token, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParentLogin not implemented")
+}
 
 // UnsafeLoginServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to LoginServiceServer will
@@ -255,6 +270,24 @@ func _LoginService_SuperAdminLogin_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoginService_ParentLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginPasswors)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServiceServer).ParentLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoginService_ParentLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServiceServer).ParentLogin(ctx, req.(*LoginPasswors))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LoginService_ServiceDesc is the grpc.ServiceDesc for LoginService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -286,6 +319,10 @@ var LoginService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SuperAdminLogin",
 			Handler:    _LoginService_SuperAdminLogin_Handler,
 		},
+		{
+			MethodName: "ParentLogin",
+			Handler:    _LoginService_ParentLogin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "token.proto",
@@ -16,6 +16,7 @@ import (
 type GrpcClientI interface {
 	AdministrationService() pc.AdministrationServiceClient
 	BranchService() pc.BranchServiceClient
+	BranchSettingService() pc.BranchSettingServiceClient
 	ManagerService() pc.ManagerServiceClient
 	StudentService() pc.StudentServiceClient
 	SupportTeacherService() pc.SupportTeacherServiceClient
@@ -24,11 +25,29 @@ type GrpcClientI interface {
 	EventStudentService() sc.EventServiceClient
 	EventService() sc.EventServiceClient
 	GroupService() sc.GroupServiceClient
+	GroupStudentService() sc.GroupStudentServiceClient
 	JournalService() sc.JournalServiceClient
 	ScheduleService() sc.ScheduleServiceClient
 	StudentPaymentService() sc.StudentPaymentServiceClient
 	StudentTaskService() sc.StudentTaskServiceClient
 	TaskService() sc.TaskServiceClient
+	TimetableService() sc.TimetableServiceClient
+	EventFeedbackService() sc.EventFeedbackServiceClient
+	TuitionService() sc.TuitionServiceClient
+	DiscountService() sc.DiscountServiceClient
+	OverdueService() sc.OverdueServiceClient
+	DocumentService() sc.DocumentServiceClient
+	OnlinePaymentService() sc.OnlinePaymentServiceClient
+	CashShiftService() sc.CashShiftServiceClient
+	FinanceReportService() sc.FinanceReportServiceClient
+	PayrollService() sc.PayrollServiceClient
+	ExpenseService() sc.ExpenseServiceClient
+	CommissionService() sc.CommissionServiceClient
+	IeltsResultService() pc.IeltsResultServiceClient
+	MockTestService() sc.MockTestServiceClient
+	GuardianService() pc.GuardianServiceClient
+	LeadService() pc.LeadServiceClient
+	RoomService() sc.RoomServiceClient
 }
 
 // GrpcClient ...
@@ -63,6 +82,7 @@ func New(cfg config.Config) (*GrpcClient, error) {
 		connections: map[string]interface{}{
 			"administration_service": pc.NewAdministrationServiceClient(connUser),
 			"branch_service":         pc.NewBranchServiceClient(connUser),
+			"branch_setting_service": pc.NewBranchSettingServiceClient(connUser),
 			"manager_service":        pc.NewManagerServiceClient(connUser),
 			"student_service":        pc.NewStudentServiceClient(connUser),
 			"supportTeacher_service": pc.NewSupportTeacherServiceClient(connUser),
@@ -71,11 +91,29 @@ func New(cfg config.Config) (*GrpcClient, error) {
 			"event_student":          sc.NewEventStudentServiceClient(connSchedule),
 			"event":                  sc.NewEventServiceClient(connSchedule),
 			"group":                  sc.NewGroupServiceClient(connSchedule),
+			"group_student":          sc.NewGroupStudentServiceClient(connSchedule),
 			"journal":                sc.NewJournalServiceClient(connSchedule),
 			"schedule":               sc.NewScheduleServiceClient(connSchedule),
 			"student_payment":        sc.NewStudentPaymentServiceClient(connSchedule),
 			"student_task":           sc.NewStudentTaskServiceClient(connSchedule),
 			"task":                   sc.NewTaskServiceClient(connSchedule),
+			"timetable":              sc.NewTimetableServiceClient(connSchedule),
+			"event_feedback":         sc.NewEventFeedbackServiceClient(connSchedule),
+			"tuition":                sc.NewTuitionServiceClient(connSchedule),
+			"discount":               sc.NewDiscountServiceClient(connSchedule),
+			"overdue":                sc.NewOverdueServiceClient(connSchedule),
+			"document":               sc.NewDocumentServiceClient(connSchedule),
+			"onlinePayment":          sc.NewOnlinePaymentServiceClient(connSchedule),
+			"cashShift":              sc.NewCashShiftServiceClient(connSchedule),
+			"financeReport":          sc.NewFinanceReportServiceClient(connSchedule),
+			"payroll":                sc.NewPayrollServiceClient(connSchedule),
+			"expense":                sc.NewExpenseServiceClient(connSchedule),
+			"commission":             sc.NewCommissionServiceClient(connSchedule),
+			"ielts_result_service":   pc.NewIeltsResultServiceClient(connUser),
+			"mock_test":              sc.NewMockTestServiceClient(connSchedule),
+			"guardian_service":       pc.NewGuardianServiceClient(connUser),
+			"lead_service":           pc.NewLeadServiceClient(connUser),
+			"room_service":           sc.NewRoomServiceClient(connSchedule),
 		},
 	}, nil
 }
@@ -100,6 +138,16 @@ func (g *GrpcClient) BranchService() pc.BranchServiceClient {
 	return client
 }
 
+// BranchSettingService returns the BranchSettingServiceClient
+func (g *GrpcClient) BranchSettingService() pc.BranchSettingServiceClient {
+	client, ok := g.connections["branch_setting_service"].(pc.BranchSettingServiceClient)
+	if !ok {
+		log.Println("failed to assert type for branch setting")
+		return nil
+	}
+	return client
+}
+
 // ManagerService returns the ManagerServiceClient
 func (g *GrpcClient) ManagerService() pc.ManagerServiceClient {
 	client, ok := g.connections["manager_service"].(pc.ManagerServiceClient)
@@ -177,6 +225,16 @@ func (g *GrpcClient) GroupService() sc.GroupServiceClient {
 	return client
 }
 
+// GroupStudentService returns the GroupStudentServiceClient
+func (g *GrpcClient) GroupStudentService() sc.GroupStudentServiceClient {
+	client, ok := g.connections["group_student"].(sc.GroupStudentServiceClient)
+	if !ok {
+		log.Println("failed to assert type for group student")
+		return nil
+	}
+	return client
+}
+
 // StudentService returns the StudentServiceClient
 func (g *GrpcClient) JournalService() sc.JournalServiceClient {
 	client, ok := g.connections["journal"].(sc.JournalServiceClient)
@@ -223,6 +281,16 @@ func (g *GrpcClient) TaskService() sc.TaskServiceClient {
 	return client
 }
 
+// TimetableService returns the TimetableServiceClient
+func (g *GrpcClient) TimetableService() sc.TimetableServiceClient {
+	client, ok := g.connections["timetable"].(sc.TimetableServiceClient)
+	if !ok {
+		log.Println("failed to assert type for timetable")
+		return nil
+	}
+	return client
+}
+
 func (g *GrpcClient) CloseConnections() {
 	for key, conn := range g.connections {
 		if c, ok := conn.(*grpc.ClientConn); ok {
@@ -233,3 +301,163 @@ func (g *GrpcClient) CloseConnections() {
 		}
 	}
 }
+
+// EventFeedbackService returns the EventFeedbackServiceClient
+func (g *GrpcClient) EventFeedbackService() sc.EventFeedbackServiceClient {
+	client, ok := g.connections["event_feedback"].(sc.EventFeedbackServiceClient)
+	if !ok {
+		log.Println("failed to assert type for event feedback")
+		return nil
+	}
+	return client
+}
+
+// TuitionService returns the TuitionServiceClient
+func (g *GrpcClient) TuitionService() sc.TuitionServiceClient {
+	client, ok := g.connections["tuition"].(sc.TuitionServiceClient)
+	if !ok {
+		log.Println("failed to assert type for tuition")
+		return nil
+	}
+	return client
+}
+
+// DiscountService returns the DiscountServiceClient
+func (g *GrpcClient) DiscountService() sc.DiscountServiceClient {
+	client, ok := g.connections["discount"].(sc.DiscountServiceClient)
+	if !ok {
+		log.Println("failed to assert type for discount")
+		return nil
+	}
+	return client
+}
+
+// OverdueService returns the OverdueServiceClient
+func (g *GrpcClient) OverdueService() sc.OverdueServiceClient {
+	client, ok := g.connections["overdue"].(sc.OverdueServiceClient)
+	if !ok {
+		log.Println("failed to assert type for overdue")
+		return nil
+	}
+	return client
+}
+
+// DocumentService returns the DocumentServiceClient
+func (g *GrpcClient) DocumentService() sc.DocumentServiceClient {
+	client, ok := g.connections["document"].(sc.DocumentServiceClient)
+	if !ok {
+		log.Println("failed to assert type for document")
+		return nil
+	}
+	return client
+}
+
+// OnlinePaymentService returns the OnlinePaymentServiceClient
+func (g *GrpcClient) OnlinePaymentService() sc.OnlinePaymentServiceClient {
+	client, ok := g.connections["onlinePayment"].(sc.OnlinePaymentServiceClient)
+	if !ok {
+		log.Println("failed to assert type for online payment")
+		return nil
+	}
+	return client
+}
+
+// CashShiftService returns the CashShiftServiceClient
+func (g *GrpcClient) CashShiftService() sc.CashShiftServiceClient {
+	client, ok := g.connections["cashShift"].(sc.CashShiftServiceClient)
+	if !ok {
+		log.Println("failed to assert type for cash shift")
+		return nil
+	}
+	return client
+}
+
+// FinanceReportService returns the FinanceReportServiceClient
+func (g *GrpcClient) FinanceReportService() sc.FinanceReportServiceClient {
+	client, ok := g.connections["financeReport"].(sc.FinanceReportServiceClient)
+	if !ok {
+		log.Println("failed to assert type for finance report")
+		return nil
+	}
+	return client
+}
+
+// PayrollService returns the PayrollServiceClient
+func (g *GrpcClient) PayrollService() sc.PayrollServiceClient {
+	client, ok := g.connections["payroll"].(sc.PayrollServiceClient)
+	if !ok {
+		log.Println("failed to assert type for payroll")
+		return nil
+	}
+	return client
+}
+
+// ExpenseService returns the ExpenseServiceClient
+func (g *GrpcClient) ExpenseService() sc.ExpenseServiceClient {
+	client, ok := g.connections["expense"].(sc.ExpenseServiceClient)
+	if !ok {
+		log.Println("failed to assert type for expense")
+		return nil
+	}
+	return client
+}
+
+// CommissionService returns the CommissionServiceClient
+func (g *GrpcClient) CommissionService() sc.CommissionServiceClient {
+	client, ok := g.connections["commission"].(sc.CommissionServiceClient)
+	if !ok {
+		log.Println("failed to assert type for commission")
+		return nil
+	}
+	return client
+}
+
+// IeltsResultService returns the IeltsResultServiceClient
+func (g *GrpcClient) IeltsResultService() pc.IeltsResultServiceClient {
+	client, ok := g.connections["ielts_result_service"].(pc.IeltsResultServiceClient)
+	if !ok {
+		log.Println("failed to assert type for IeltsResultServiceClient")
+		return nil
+	}
+	return client
+}
+
+// MockTestService returns the MockTestServiceClient
+func (g *GrpcClient) MockTestService() sc.MockTestServiceClient {
+	client, ok := g.connections["mock_test"].(sc.MockTestServiceClient)
+	if !ok {
+		log.Println("failed to assert type for mock test")
+		return nil
+	}
+	return client
+}
+
+// GuardianService returns the GuardianServiceClient
+func (g *GrpcClient) GuardianService() pc.GuardianServiceClient {
+	client, ok := g.connections["guardian_service"].(pc.GuardianServiceClient)
+	if !ok {
+		log.Println("failed to assert type for guardian service")
+		return nil
+	}
+	return client
+}
+
+// LeadService returns the LeadServiceClient
+func (g *GrpcClient) LeadService() pc.LeadServiceClient {
+	client, ok := g.connections["lead_service"].(pc.LeadServiceClient)
+	if !ok {
+		log.Println("failed to assert type for lead service")
+		return nil
+	}
+	return client
+}
+
+// RoomService returns the RoomServiceClient
+func (g *GrpcClient) RoomService() sc.RoomServiceClient {
+	client, ok := g.connections["room_service"].(sc.RoomServiceClient)
+	if !ok {
+		log.Println("failed to assert type for room service")
+		return nil
+	}
+	return client
+}
@@ -0,0 +1,227 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Page geometry of an A4 page in points.
+const (
+	pageWidth  = 595.28
+	pageHeight = 841.89
+	margin     = 56.0
+	leading    = 1.4
+)
+
+const (
+	fontRegular = "F1"
+	fontBold    = "F2"
+)
+
+// Document is a plain text PDF laid out top to bottom on A4 pages. It uses
+// the standard Helvetica fonts, which cover Latin text only; characters
+// outside WinAnsi, such as Cyrillic, are printed as '?'.
+type Document struct {
+	pages []*bytes.Buffer
+	y     float64
+}
+
+func New() *Document {
+	d := &Document{}
+	d.newPage()
+	return d
+}
+
+// Heading writes a bold line of text.
+func (d *Document) Heading(text string) {
+	d.write(fontBold, 16, text)
+	d.Space(0.5)
+}
+
+// Text writes text wrapped to the page width. Each line break starts a new
+// paragraph.
+func (d *Document) Text(text string) {
+	for _, paragraph := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		d.write(fontRegular, 11, paragraph)
+	}
+}
+
+// Space leaves the height of the given number of text lines blank.
+func (d *Document) Space(lines float64) {
+	d.y -= lines * 11 * leading
+	if d.y < margin {
+		d.newPage()
+	}
+}
+
+func (d *Document) write(font string, size float64, text string) {
+	lines := wrap(encode(text), size, pageWidth-2*margin)
+	if len(lines) == 0 {
+		d.Space(1)
+		return
+	}
+
+	for _, line := range lines {
+		if d.y-size < margin {
+			d.newPage()
+		}
+		d.y -= size * leading
+
+		fmt.Fprintf(d.pages[len(d.pages)-1], "BT /%s %.0f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, margin, d.y, escape(line))
+	}
+}
+
+func (d *Document) newPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+	d.y = pageHeight - margin
+}
+
+// Bytes renders the document.
+func (d *Document) Bytes() []byte {
+	var (
+		out     bytes.Buffer
+		offsets []int
+	)
+
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /%s 3 0 R /%s 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, fontRegular, fontBold, 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return out.Bytes()
+}
+
+// wrap splits WinAnsi text into lines no wider than width, breaking words
+// that do not fit on a line of their own.
+func wrap(text []byte, size, width float64) [][]byte {
+	var (
+		lines [][]byte
+		line  []byte
+	)
+
+	for _, word := range bytes.Fields(text) {
+		candidate := word
+		if len(line) > 0 {
+			candidate = append(append(append([]byte{}, line...), ' '), word...)
+		}
+
+		if measure(candidate, size) <= width {
+			line = candidate
+			continue
+		}
+
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+
+		line = nil
+		for _, b := range word {
+			if len(line) > 0 && measure(append(line, b), size) > width {
+				lines = append(lines, line)
+				line = nil
+			}
+			line = append(line, b)
+		}
+	}
+
+	if len(line) > 0 {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func measure(text []byte, size float64) float64 {
+	var units int
+	for _, b := range text {
+		units += charWidth(b)
+	}
+	return float64(units) * size / 1000
+}
+
+func escape(text []byte) string {
+	var out strings.Builder
+	for _, b := range text {
+		switch {
+		case b == '\\' || b == '(' || b == ')':
+			out.WriteByte('\\')
+			out.WriteByte(b)
+		case b < 32 || b > 126:
+			fmt.Fprintf(&out, "\\%03o", b)
+		default:
+			out.WriteByte(b)
+		}
+	}
+	return out.String()
+}
+
+// winAnsi maps the characters WinAnsi places in 0x80-0x9F. Latin-1
+// characters keep their code.
+var winAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '‘': 0x91, '’': 0x92,
+	'“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, 'ʻ': 0x91, 'ʼ': 0x92,
+}
+
+func encode(text string) []byte {
+	out := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r == '\t':
+			out = append(out, ' ')
+		case r >= 32 && r <= 126, r >= 160 && r <= 255:
+			out = append(out, byte(r))
+		case winAnsi[r] != 0:
+			out = append(out, winAnsi[r])
+		case r < 32:
+		default:
+			out = append(out, '?')
+		}
+	}
+	return out
+}
+
+// helveticaWidths are the Helvetica glyph widths of characters 32 to 126 in
+// thousandths of the font size.
+var helveticaWidths = [...]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+// charWidth measures a WinAnsi character, rounding bold text and characters
+// beyond ASCII up so that lines never overflow.
+func charWidth(b byte) int {
+	if b >= 32 && b <= 126 {
+		return helveticaWidths[b-32] * 11 / 10
+	}
+	return 1000
+}
@@ -6,6 +6,8 @@ import (
 	"schedule_service/config"
 	"schedule_service/grpc"
 	"schedule_service/grpc/client"
+	"schedule_service/jobs"
+	"schedule_service/pkg/notifier"
 	"schedule_service/storage/postgres"
 
 	"github.com/saidamir98/udevs_pkg/logger"
@@ -39,6 +41,13 @@ func main() {
 		log.Panic("client.NewGrpcClients", logger.Error(err))
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go jobs.NewJournalRollover(log, pgStore, cfg.JournalRolloverInterval).Run(ctx)
+	go jobs.NewInvoiceGeneration(log, pgStore, cfg.InvoiceGenerationInterval).Run(ctx)
+	go jobs.NewOverdueCheck(log, pgStore, notifier.New(cfg.NotifyWebhookURL, log), cfg.OverdueCheckInterval, cfg.OverdueReminderInterval, cfg.OverdueHoldAfterDays).Run(ctx)
+
 	grpcServer := grpc.SetUpServer(cfg, log, pgStore, svcs)
 
 	lis, err := net.Listen("tcp", cfg.ScheduleServicePort)
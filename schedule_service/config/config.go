@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cast"
@@ -35,6 +37,29 @@ type Config struct {
 	ScheduleServicePort string
 
 	PostgresMaxConnections int32
+
+	JournalRolloverInterval time.Duration
+
+	InvoiceGenerationInterval time.Duration
+
+	OverdueCheckInterval    time.Duration
+	OverdueReminderInterval time.Duration
+	OverdueHoldAfterDays    int32
+
+	NotifyWebhookURL string
+
+	CheckInSecret string
+
+	ClickServiceID  string
+	ClickMerchantID string
+	ClickSecretKey  string
+
+	PaymeMerchantID  string
+	PaymeKey         string
+	PaymeCheckoutURL string
+
+	MockPaymentSecret      string
+	MockPaymentCheckoutURL string
 }
 
 // Load ...
@@ -59,6 +84,29 @@ func Load() Config {
 	config.ScheduleServiceHost = cast.ToString(getOrReturnDefaultValue("USER_SERVICE_HOST", "localhost"))
 	config.ScheduleServicePort = cast.ToString(getOrReturnDefaultValue("USER_SEVICE_PORT", ":8081"))
 
+	config.JournalRolloverInterval = getPositiveDuration("JOURNAL_ROLLOVER_INTERVAL", time.Hour)
+
+	config.InvoiceGenerationInterval = getPositiveDuration("INVOICE_GENERATION_INTERVAL", 24*time.Hour)
+
+	config.OverdueCheckInterval = getPositiveDuration("OVERDUE_CHECK_INTERVAL", 24*time.Hour)
+	config.OverdueReminderInterval = getPositiveDuration("OVERDUE_REMINDER_INTERVAL", 72*time.Hour)
+	config.OverdueHoldAfterDays = cast.ToInt32(getOrReturnDefaultValue("OVERDUE_HOLD_AFTER_DAYS", 0))
+
+	config.NotifyWebhookURL = cast.ToString(getOrReturnDefaultValue("NOTIFY_WEBHOOK_URL", ""))
+
+	config.CheckInSecret = cast.ToString(getOrReturnDefaultValue("CHECKIN_SECRET", ""))
+
+	config.ClickServiceID = cast.ToString(getOrReturnDefaultValue("CLICK_SERVICE_ID", ""))
+	config.ClickMerchantID = cast.ToString(getOrReturnDefaultValue("CLICK_MERCHANT_ID", ""))
+	config.ClickSecretKey = cast.ToString(getOrReturnDefaultValue("CLICK_SECRET_KEY", ""))
+
+	config.PaymeMerchantID = cast.ToString(getOrReturnDefaultValue("PAYME_MERCHANT_ID", ""))
+	config.PaymeKey = cast.ToString(getOrReturnDefaultValue("PAYME_KEY", ""))
+	config.PaymeCheckoutURL = cast.ToString(getOrReturnDefaultValue("PAYME_CHECKOUT_URL", "https://checkout.paycom.uz"))
+
+	config.MockPaymentSecret = cast.ToString(getOrReturnDefaultValue("MOCK_PAYMENT_SECRET", ""))
+	config.MockPaymentCheckoutURL = cast.ToString(getOrReturnDefaultValue("MOCK_PAYMENT_CHECKOUT_URL", "http://localhost:8080/SimulateOnlinePayment"))
+
 	return config
 }
 
@@ -71,3 +119,25 @@ func getOrReturnDefaultValue(key string, defaultValue interface{}) interface{} {
 
 	return defaultValue
 }
+
+// getPositiveDuration reads a duration such as "1h"; a bare number is taken
+// as seconds. It falls back to the default when the value is missing,
+// malformed or under a second, since the jobs would otherwise spin.
+func getPositiveDuration(key string, defaultValue time.Duration) time.Duration {
+	raw, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+
+	value, err := time.ParseDuration(raw)
+	if seconds, parseErr := strconv.ParseFloat(raw, 64); parseErr == nil {
+		value, err = time.Duration(seconds*float64(time.Second)), nil
+	}
+
+	if err != nil || value < time.Second {
+		fmt.Printf("%s must be a duration of at least 1s, using %s\n", key, defaultValue)
+		return defaultValue
+	}
+
+	return value
+}
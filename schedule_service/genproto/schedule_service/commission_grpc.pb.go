@@ -0,0 +1,218 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: commission.proto
+
+package schedule_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	CommissionService_CreateRule_FullMethodName  = "/schedule_service.CommissionService/CreateRule"
+	CommissionService_GetListRule_FullMethodName = "/schedule_service.CommissionService/GetListRule"
+	CommissionService_DeleteRule_FullMethodName  = "/schedule_service.CommissionService/DeleteRule"
+	CommissionService_GetReport_FullMethodName   = "/schedule_service.CommissionService/GetReport"
+)
+
+// CommissionServiceClient is the client API for CommissionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CommissionServiceClient interface {
+	CreateRule(ctx context.Context, in *CreateCommissionRule, opts ...grpc.CallOption) (*CommissionRule, error)
+	GetListRule(ctx context.Context, in *GetListCommissionRuleRequest, opts ...grpc.CallOption) (*GetListCommissionRuleResponse, error)
+	DeleteRule(ctx context.Context, in *CommissionRulePrimaryKey, opts ...grpc.CallOption) (*EmptyCommission, error)
+	GetReport(ctx context.Context, in *CommissionReportRequest, opts ...grpc.CallOption) (*CommissionReport, error)
+}
+
+type commissionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommissionServiceClient(cc grpc.ClientConnInterface) CommissionServiceClient {
+	return &commissionServiceClient{cc}
+}
+
+func (c *commissionServiceClient) CreateRule(ctx context.Context, in *CreateCommissionRule, opts ...grpc.CallOption) (*CommissionRule, error) {
+	out := new(CommissionRule)
+	err := c.cc.Invoke(ctx, CommissionService_CreateRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commissionServiceClient) GetListRule(ctx context.Context, in *GetListCommissionRuleRequest, opts ...grpc.CallOption) (*GetListCommissionRuleResponse, error) {
+	out := new(GetListCommissionRuleResponse)
+	err := c.cc.Invoke(ctx, CommissionService_GetListRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commissionServiceClient) DeleteRule(ctx context.Context, in *CommissionRulePrimaryKey, opts ...grpc.CallOption) (*EmptyCommission, error) {
+	out := new(EmptyCommission)
+	err := c.cc.Invoke(ctx, CommissionService_DeleteRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commissionServiceClient) GetReport(ctx context.Context, in *CommissionReportRequest, opts ...grpc.CallOption) (*CommissionReport, error) {
+	out := new(CommissionReport)
+	err := c.cc.Invoke(ctx, CommissionService_GetReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommissionServiceServer is the server API for CommissionService service.
+// All implementations should embed UnimplementedCommissionServiceServer
+// for forward compatibility
+type CommissionServiceServer interface {
+	CreateRule(context.Context, *CreateCommissionRule) (*CommissionRule, error)
+	GetListRule(context.Context, *GetListCommissionRuleRequest) (*GetListCommissionRuleResponse, error)
+	DeleteRule(context.Context, *CommissionRulePrimaryKey) (*EmptyCommission, error)
+	GetReport(context.Context, *CommissionReportRequest) (*CommissionReport, error)
+}
+
+// UnimplementedCommissionServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedCommissionServiceServer struct {
+}
+
+func (UnimplementedCommissionServiceServer) CreateRule(context.Context, *CreateCommissionRule) (*CommissionRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRule not implemented")
+}
+func (UnimplementedCommissionServiceServer) GetListRule(context.Context, *GetListCommissionRuleRequest) (*GetListCommissionRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListRule not implemented")
+}
+func (UnimplementedCommissionServiceServer) DeleteRule(context.Context, *CommissionRulePrimaryKey) (*EmptyCommission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRule not implemented")
+}
+func (UnimplementedCommissionServiceServer) GetReport(context.Context, *CommissionReportRequest) (*CommissionReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReport not implemented")
+}
+
+// UnsafeCommissionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommissionServiceServer will
+// result in compilation errors.
+type UnsafeCommissionServiceServer interface {
+	mustEmbedUnimplementedCommissionServiceServer()
+}
+
+func RegisterCommissionServiceServer(s grpc.ServiceRegistrar, srv CommissionServiceServer) {
+	s.RegisterService(&CommissionService_ServiceDesc, srv)
+}
+
+func _CommissionService_CreateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommissionRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommissionServiceServer).CreateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommissionService_CreateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommissionServiceServer).CreateRule(ctx, req.(*CreateCommissionRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommissionService_GetListRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListCommissionRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommissionServiceServer).GetListRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommissionService_GetListRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommissionServiceServer).GetListRule(ctx, req.(*GetListCommissionRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommissionService_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommissionRulePrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommissionServiceServer).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommissionService_DeleteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommissionServiceServer).DeleteRule(ctx, req.(*CommissionRulePrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommissionService_GetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommissionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommissionServiceServer).GetReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommissionService_GetReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommissionServiceServer).GetReport(ctx, req.(*CommissionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CommissionService_ServiceDesc is the grpc.ServiceDesc for CommissionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CommissionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule_service.CommissionService",
+	HandlerType: (*CommissionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRule",
+			Handler:    _CommissionService_CreateRule_Handler,
+		},
+		{
+			MethodName: "GetListRule",
+			Handler:    _CommissionService_GetListRule_Handler,
+		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _CommissionService_DeleteRule_Handler,
+		},
+		{
+			MethodName: "GetReport",
+			Handler:    _CommissionService_GetReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "commission.proto",
+}
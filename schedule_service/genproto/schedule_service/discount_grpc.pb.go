@@ -0,0 +1,366 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: discount.proto
+
+package schedule_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DiscountService_Create_FullMethodName       = "/schedule_service.DiscountService/Create"
+	DiscountService_GetList_FullMethodName      = "/schedule_service.DiscountService/GetList"
+	DiscountService_Delete_FullMethodName       = "/schedule_service.DiscountService/Delete"
+	DiscountService_CreatePromo_FullMethodName  = "/schedule_service.DiscountService/CreatePromo"
+	DiscountService_GetListPromo_FullMethodName = "/schedule_service.DiscountService/GetListPromo"
+	DiscountService_DeletePromo_FullMethodName  = "/schedule_service.DiscountService/DeletePromo"
+	DiscountService_RedeemPromo_FullMethodName  = "/schedule_service.DiscountService/RedeemPromo"
+	DiscountService_GetReport_FullMethodName    = "/schedule_service.DiscountService/GetReport"
+)
+
+// DiscountServiceClient is the client API for DiscountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DiscountServiceClient interface {
+	Create(ctx context.Context, in *CreateDiscount, opts ...grpc.CallOption) (*Discount, error)
+	GetList(ctx context.Context, in *GetListDiscountRequest, opts ...grpc.CallOption) (*GetListDiscountResponse, error)
+	Delete(ctx context.Context, in *DiscountPrimaryKey, opts ...grpc.CallOption) (*EmptyDiscount, error)
+	CreatePromo(ctx context.Context, in *CreatePromoCode, opts ...grpc.CallOption) (*PromoCode, error)
+	GetListPromo(ctx context.Context, in *GetListPromoCodeRequest, opts ...grpc.CallOption) (*GetListPromoCodeResponse, error)
+	DeletePromo(ctx context.Context, in *PromoCodePrimaryKey, opts ...grpc.CallOption) (*EmptyDiscount, error)
+	RedeemPromo(ctx context.Context, in *RedeemPromoCodeRequest, opts ...grpc.CallOption) (*Discount, error)
+	GetReport(ctx context.Context, in *DiscountReportRequest, opts ...grpc.CallOption) (*DiscountReport, error)
+}
+
+type discountServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDiscountServiceClient(cc grpc.ClientConnInterface) DiscountServiceClient {
+	return &discountServiceClient{cc}
+}
+
+func (c *discountServiceClient) Create(ctx context.Context, in *CreateDiscount, opts ...grpc.CallOption) (*Discount, error) {
+	out := new(Discount)
+	err := c.cc.Invoke(ctx, DiscountService_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) GetList(ctx context.Context, in *GetListDiscountRequest, opts ...grpc.CallOption) (*GetListDiscountResponse, error) {
+	out := new(GetListDiscountResponse)
+	err := c.cc.Invoke(ctx, DiscountService_GetList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) Delete(ctx context.Context, in *DiscountPrimaryKey, opts ...grpc.CallOption) (*EmptyDiscount, error) {
+	out := new(EmptyDiscount)
+	err := c.cc.Invoke(ctx, DiscountService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) CreatePromo(ctx context.Context, in *CreatePromoCode, opts ...grpc.CallOption) (*PromoCode, error) {
+	out := new(PromoCode)
+	err := c.cc.Invoke(ctx, DiscountService_CreatePromo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) GetListPromo(ctx context.Context, in *GetListPromoCodeRequest, opts ...grpc.CallOption) (*GetListPromoCodeResponse, error) {
+	out := new(GetListPromoCodeResponse)
+	err := c.cc.Invoke(ctx, DiscountService_GetListPromo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) DeletePromo(ctx context.Context, in *PromoCodePrimaryKey, opts ...grpc.CallOption) (*EmptyDiscount, error) {
+	out := new(EmptyDiscount)
+	err := c.cc.Invoke(ctx, DiscountService_DeletePromo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) RedeemPromo(ctx context.Context, in *RedeemPromoCodeRequest, opts ...grpc.CallOption) (*Discount, error) {
+	out := new(Discount)
+	err := c.cc.Invoke(ctx, DiscountService_RedeemPromo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) GetReport(ctx context.Context, in *DiscountReportRequest, opts ...grpc.CallOption) (*DiscountReport, error) {
+	out := new(DiscountReport)
+	err := c.cc.Invoke(ctx, DiscountService_GetReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DiscountServiceServer is the server API for DiscountService service.
+// All implementations should embed UnimplementedDiscountServiceServer
+// for forward compatibility
+type DiscountServiceServer interface {
+	Create(context.Context, *CreateDiscount) (*Discount, error)
+	GetList(context.Context, *GetListDiscountRequest) (*GetListDiscountResponse, error)
+	Delete(context.Context, *DiscountPrimaryKey) (*EmptyDiscount, error)
+	CreatePromo(context.Context, *CreatePromoCode) (*PromoCode, error)
+	GetListPromo(context.Context, *GetListPromoCodeRequest) (*GetListPromoCodeResponse, error)
+	DeletePromo(context.Context, *PromoCodePrimaryKey) (*EmptyDiscount, error)
+	RedeemPromo(context.Context, *RedeemPromoCodeRequest) (*Discount, error)
+	GetReport(context.Context, *DiscountReportRequest) (*DiscountReport, error)
+}
+
+// UnimplementedDiscountServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedDiscountServiceServer struct {
+}
+
+func (UnimplementedDiscountServiceServer) Create(context.Context, *CreateDiscount) (*Discount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedDiscountServiceServer) GetList(context.Context, *GetListDiscountRequest) (*GetListDiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedDiscountServiceServer) Delete(context.Context, *DiscountPrimaryKey) (*EmptyDiscount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedDiscountServiceServer) CreatePromo(context.Context, *CreatePromoCode) (*PromoCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromo not implemented")
+}
+func (UnimplementedDiscountServiceServer) GetListPromo(context.Context, *GetListPromoCodeRequest) (*GetListPromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListPromo not implemented")
+}
+func (UnimplementedDiscountServiceServer) DeletePromo(context.Context, *PromoCodePrimaryKey) (*EmptyDiscount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePromo not implemented")
+}
+func (UnimplementedDiscountServiceServer) RedeemPromo(context.Context, *RedeemPromoCodeRequest) (*Discount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemPromo not implemented")
+}
+func (UnimplementedDiscountServiceServer) GetReport(context.Context, *DiscountReportRequest) (*DiscountReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReport not implemented")
+}
+
+// UnsafeDiscountServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DiscountServiceServer will
+// result in compilation errors.
+type UnsafeDiscountServiceServer interface {
+	mustEmbedUnimplementedDiscountServiceServer()
+}
+
+func RegisterDiscountServiceServer(s grpc.ServiceRegistrar, srv DiscountServiceServer) {
+	s.RegisterService(&DiscountService_ServiceDesc, srv)
+}
+
+func _DiscountService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDiscount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).Create(ctx, req.(*CreateDiscount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).GetList(ctx, req.(*GetListDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscountPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).Delete(ctx, req.(*DiscountPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_CreatePromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).CreatePromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_CreatePromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).CreatePromo(ctx, req.(*CreatePromoCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_GetListPromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListPromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).GetListPromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_GetListPromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).GetListPromo(ctx, req.(*GetListPromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_DeletePromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoCodePrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).DeletePromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_DeletePromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).DeletePromo(ctx, req.(*PromoCodePrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_RedeemPromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemPromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).RedeemPromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_RedeemPromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).RedeemPromo(ctx, req.(*RedeemPromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_GetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscountReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).GetReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_GetReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).GetReport(ctx, req.(*DiscountReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DiscountService_ServiceDesc is the grpc.ServiceDesc for DiscountService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DiscountService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule_service.DiscountService",
+	HandlerType: (*DiscountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _DiscountService_Create_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _DiscountService_GetList_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DiscountService_Delete_Handler,
+		},
+		{
+			MethodName: "CreatePromo",
+			Handler:    _DiscountService_CreatePromo_Handler,
+		},
+		{
+			MethodName: "GetListPromo",
+			Handler:    _DiscountService_GetListPromo_Handler,
+		},
+		{
+			MethodName: "DeletePromo",
+			Handler:    _DiscountService_DeletePromo_Handler,
+		},
+		{
+			MethodName: "RedeemPromo",
+			Handler:    _DiscountService_RedeemPromo_Handler,
+		},
+		{
+			MethodName: "GetReport",
+			Handler:    _DiscountService_GetReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "discount.proto",
+}
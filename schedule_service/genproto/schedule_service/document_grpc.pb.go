@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: document.proto
+
+package schedule_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DocumentService_GetReceipt_FullMethodName      = "/schedule_service.DocumentService/GetReceipt"
+	DocumentService_CreateTemplate_FullMethodName  = "/schedule_service.DocumentService/CreateTemplate"
+	DocumentService_GetTemplate_FullMethodName     = "/schedule_service.DocumentService/GetTemplate"
+	DocumentService_GetListTemplate_FullMethodName = "/schedule_service.DocumentService/GetListTemplate"
+	DocumentService_UpdateTemplate_FullMethodName  = "/schedule_service.DocumentService/UpdateTemplate"
+	DocumentService_DeleteTemplate_FullMethodName  = "/schedule_service.DocumentService/DeleteTemplate"
+	DocumentService_RenderContract_FullMethodName  = "/schedule_service.DocumentService/RenderContract"
+)
+
+// DocumentServiceClient is the client API for DocumentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DocumentServiceClient interface {
+	GetReceipt(ctx context.Context, in *PaymentReceiptRequest, opts ...grpc.CallOption) (*PaymentReceipt, error)
+	CreateTemplate(ctx context.Context, in *CreateContractTemplate, opts ...grpc.CallOption) (*ContractTemplate, error)
+	GetTemplate(ctx context.Context, in *ContractTemplatePrimaryKey, opts ...grpc.CallOption) (*ContractTemplate, error)
+	GetListTemplate(ctx context.Context, in *GetListContractTemplateRequest, opts ...grpc.CallOption) (*GetListContractTemplateResponse, error)
+	UpdateTemplate(ctx context.Context, in *UpdateContractTemplate, opts ...grpc.CallOption) (*ContractTemplate, error)
+	DeleteTemplate(ctx context.Context, in *ContractTemplatePrimaryKey, opts ...grpc.CallOption) (*EmptyDocument, error)
+	RenderContract(ctx context.Context, in *RenderContractRequest, opts ...grpc.CallOption) (*Contract, error)
+}
+
+type documentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDocumentServiceClient(cc grpc.ClientConnInterface) DocumentServiceClient {
+	return &documentServiceClient{cc}
+}
+
+func (c *documentServiceClient) GetReceipt(ctx context.Context, in *PaymentReceiptRequest, opts ...grpc.CallOption) (*PaymentReceipt, error) {
+	out := new(PaymentReceipt)
+	err := c.cc.Invoke(ctx, DocumentService_GetReceipt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) CreateTemplate(ctx context.Context, in *CreateContractTemplate, opts ...grpc.CallOption) (*ContractTemplate, error) {
+	out := new(ContractTemplate)
+	err := c.cc.Invoke(ctx, DocumentService_CreateTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) GetTemplate(ctx context.Context, in *ContractTemplatePrimaryKey, opts ...grpc.CallOption) (*ContractTemplate, error) {
+	out := new(ContractTemplate)
+	err := c.cc.Invoke(ctx, DocumentService_GetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) GetListTemplate(ctx context.Context, in *GetListContractTemplateRequest, opts ...grpc.CallOption) (*GetListContractTemplateResponse, error) {
+	out := new(GetListContractTemplateResponse)
+	err := c.cc.Invoke(ctx, DocumentService_GetListTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) UpdateTemplate(ctx context.Context, in *UpdateContractTemplate, opts ...grpc.CallOption) (*ContractTemplate, error) {
+	out := new(ContractTemplate)
+	err := c.cc.Invoke(ctx, DocumentService_UpdateTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) DeleteTemplate(ctx context.Context, in *ContractTemplatePrimaryKey, opts ...grpc.CallOption) (*EmptyDocument, error) {
+	out := new(EmptyDocument)
+	err := c.cc.Invoke(ctx, DocumentService_DeleteTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) RenderContract(ctx context.Context, in *RenderContractRequest, opts ...grpc.CallOption) (*Contract, error) {
+	out := new(Contract)
+	err := c.cc.Invoke(ctx, DocumentService_RenderContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DocumentServiceServer is the server API for DocumentService service.
+// All implementations should embed UnimplementedDocumentServiceServer
+// for forward compatibility
+type DocumentServiceServer interface {
+	GetReceipt(context.Context, *PaymentReceiptRequest) (*PaymentReceipt, error)
+	CreateTemplate(context.Context, *CreateContractTemplate) (*ContractTemplate, error)
+	GetTemplate(context.Context, *ContractTemplatePrimaryKey) (*ContractTemplate, error)
+	GetListTemplate(context.Context, *GetListContractTemplateRequest) (*GetListContractTemplateResponse, error)
+	UpdateTemplate(context.Context, *UpdateContractTemplate) (*ContractTemplate, error)
+	DeleteTemplate(context.Context, *ContractTemplatePrimaryKey) (*EmptyDocument, error)
+	RenderContract(context.Context, *RenderContractRequest) (*Contract, error)
+}
+
+// UnimplementedDocumentServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedDocumentServiceServer struct {
+}
+
+func (UnimplementedDocumentServiceServer) GetReceipt(context.Context, *PaymentReceiptRequest) (*PaymentReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceipt not implemented")
+}
+func (UnimplementedDocumentServiceServer) CreateTemplate(context.Context, *CreateContractTemplate) (*ContractTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedDocumentServiceServer) GetTemplate(context.Context, *ContractTemplatePrimaryKey) (*ContractTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedDocumentServiceServer) GetListTemplate(context.Context, *GetListContractTemplateRequest) (*GetListContractTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListTemplate not implemented")
+}
+func (UnimplementedDocumentServiceServer) UpdateTemplate(context.Context, *UpdateContractTemplate) (*ContractTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedDocumentServiceServer) DeleteTemplate(context.Context, *ContractTemplatePrimaryKey) (*EmptyDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedDocumentServiceServer) RenderContract(context.Context, *RenderContractRequest) (*Contract, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderContract not implemented")
+}
+
+// UnsafeDocumentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DocumentServiceServer will
+// result in compilation errors.
+type UnsafeDocumentServiceServer interface {
+	mustEmbedUnimplementedDocumentServiceServer()
+}
+
+func RegisterDocumentServiceServer(s grpc.ServiceRegistrar, srv DocumentServiceServer) {
+	s.RegisterService(&DocumentService_ServiceDesc, srv)
+}
+
+func _DocumentService_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_GetReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetReceipt(ctx, req.(*PaymentReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContractTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_CreateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).CreateTemplate(ctx, req.(*CreateContractTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractTemplatePrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_GetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetTemplate(ctx, req.(*ContractTemplatePrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_GetListTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListContractTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetListTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_GetListTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetListTemplate(ctx, req.(*GetListContractTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContractTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).UpdateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_UpdateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).UpdateTemplate(ctx, req.(*UpdateContractTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractTemplatePrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_DeleteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).DeleteTemplate(ctx, req.(*ContractTemplatePrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_RenderContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).RenderContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_RenderContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).RenderContract(ctx, req.(*RenderContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DocumentService_ServiceDesc is the grpc.ServiceDesc for DocumentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DocumentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule_service.DocumentService",
+	HandlerType: (*DocumentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetReceipt",
+			Handler:    _DocumentService_GetReceipt_Handler,
+		},
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _DocumentService_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _DocumentService_GetTemplate_Handler,
+		},
+		{
+			MethodName: "GetListTemplate",
+			Handler:    _DocumentService_GetListTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _DocumentService_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _DocumentService_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "RenderContract",
+			Handler:    _DocumentService_RenderContract_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "document.proto",
+}
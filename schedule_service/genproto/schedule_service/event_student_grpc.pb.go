@@ -22,9 +22,12 @@ const (
 	EventStudentService_Create_FullMethodName         = "/schedule_service.EventStudentService/Create"
 	EventStudentService_GetByID_FullMethodName        = "/schedule_service.EventStudentService/GetByID"
 	EventStudentService_GetList_FullMethodName        = "/schedule_service.EventStudentService/GetList"
-	EventStudentService_Update_FullMethodName         = "/schedule_service.EventStudentService/Update"
 	EventStudentService_Delete_FullMethodName         = "/schedule_service.EventStudentService/Delete"
+	EventStudentService_Cancel_FullMethodName         = "/schedule_service.EventStudentService/Cancel"
 	EventStudentService_GetStudentByID_FullMethodName = "/schedule_service.EventStudentService/GetStudentByID"
+	EventStudentService_GetCheckInCode_FullMethodName = "/schedule_service.EventStudentService/GetCheckInCode"
+	EventStudentService_CheckIn_FullMethodName        = "/schedule_service.EventStudentService/CheckIn"
+	EventStudentService_GetAttendance_FullMethodName  = "/schedule_service.EventStudentService/GetAttendance"
 )
 
 // EventStudentServiceClient is the client API for EventStudentService service.
@@ -34,9 +37,12 @@ type EventStudentServiceClient interface {
 	Create(ctx context.Context, in *CreateEventStudent, opts ...grpc.CallOption) (*GetEventStudent, error)
 	GetByID(ctx context.Context, in *EventStudentPrimaryKey, opts ...grpc.CallOption) (*GetEventStudent, error)
 	GetList(ctx context.Context, in *GetListEventStudentRequest, opts ...grpc.CallOption) (*GetListEventStudentResponse, error)
-	Update(ctx context.Context, in *UpdateEventStudent, opts ...grpc.CallOption) (*GetEventStudent, error)
 	Delete(ctx context.Context, in *EventStudentPrimaryKey, opts ...grpc.CallOption) (*EmptyEventStudent, error)
+	Cancel(ctx context.Context, in *CancelEventStudent, opts ...grpc.CallOption) (*GetEventStudent, error)
 	GetStudentByID(ctx context.Context, in *EventStudentPrimaryKey, opts ...grpc.CallOption) (*GetStudentWithEventsResponse, error)
+	GetCheckInCode(ctx context.Context, in *EventStudentPrimaryKey, opts ...grpc.CallOption) (*CheckInCode, error)
+	CheckIn(ctx context.Context, in *CheckInEventStudent, opts ...grpc.CallOption) (*GetEventStudent, error)
+	GetAttendance(ctx context.Context, in *EventAttendanceRequest, opts ...grpc.CallOption) (*EventAttendance, error)
 }
 
 type eventStudentServiceClient struct {
@@ -74,18 +80,18 @@ func (c *eventStudentServiceClient) GetList(ctx context.Context, in *GetListEven
 	return out, nil
 }
 
-func (c *eventStudentServiceClient) Update(ctx context.Context, in *UpdateEventStudent, opts ...grpc.CallOption) (*GetEventStudent, error) {
-	out := new(GetEventStudent)
-	err := c.cc.Invoke(ctx, EventStudentService_Update_FullMethodName, in, out, opts...)
+func (c *eventStudentServiceClient) Delete(ctx context.Context, in *EventStudentPrimaryKey, opts ...grpc.CallOption) (*EmptyEventStudent, error) {
+	out := new(EmptyEventStudent)
+	err := c.cc.Invoke(ctx, EventStudentService_Delete_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *eventStudentServiceClient) Delete(ctx context.Context, in *EventStudentPrimaryKey, opts ...grpc.CallOption) (*EmptyEventStudent, error) {
-	out := new(EmptyEventStudent)
-	err := c.cc.Invoke(ctx, EventStudentService_Delete_FullMethodName, in, out, opts...)
+func (c *eventStudentServiceClient) Cancel(ctx context.Context, in *CancelEventStudent, opts ...grpc.CallOption) (*GetEventStudent, error) {
+	out := new(GetEventStudent)
+	err := c.cc.Invoke(ctx, EventStudentService_Cancel_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +107,33 @@ func (c *eventStudentServiceClient) GetStudentByID(ctx context.Context, in *Even
 	return out, nil
 }
 
+func (c *eventStudentServiceClient) GetCheckInCode(ctx context.Context, in *EventStudentPrimaryKey, opts ...grpc.CallOption) (*CheckInCode, error) {
+	out := new(CheckInCode)
+	err := c.cc.Invoke(ctx, EventStudentService_GetCheckInCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventStudentServiceClient) CheckIn(ctx context.Context, in *CheckInEventStudent, opts ...grpc.CallOption) (*GetEventStudent, error) {
+	out := new(GetEventStudent)
+	err := c.cc.Invoke(ctx, EventStudentService_CheckIn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventStudentServiceClient) GetAttendance(ctx context.Context, in *EventAttendanceRequest, opts ...grpc.CallOption) (*EventAttendance, error) {
+	out := new(EventAttendance)
+	err := c.cc.Invoke(ctx, EventStudentService_GetAttendance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventStudentServiceServer is the server API for EventStudentService service.
 // All implementations should embed UnimplementedEventStudentServiceServer
 // for forward compatibility
@@ -108,9 +141,12 @@ type EventStudentServiceServer interface {
 	Create(context.Context, *CreateEventStudent) (*GetEventStudent, error)
 	GetByID(context.Context, *EventStudentPrimaryKey) (*GetEventStudent, error)
 	GetList(context.Context, *GetListEventStudentRequest) (*GetListEventStudentResponse, error)
-	Update(context.Context, *UpdateEventStudent) (*GetEventStudent, error)
 	Delete(context.Context, *EventStudentPrimaryKey) (*EmptyEventStudent, error)
+	Cancel(context.Context, *CancelEventStudent) (*GetEventStudent, error)
 	GetStudentByID(context.Context, *EventStudentPrimaryKey) (*GetStudentWithEventsResponse, error)
+	GetCheckInCode(context.Context, *EventStudentPrimaryKey) (*CheckInCode, error)
+	CheckIn(context.Context, *CheckInEventStudent) (*GetEventStudent, error)
+	GetAttendance(context.Context, *EventAttendanceRequest) (*EventAttendance, error)
 }
 
 // UnimplementedEventStudentServiceServer should be embedded to have forward compatible implementations.
@@ -126,15 +162,24 @@ func (UnimplementedEventStudentServiceServer) GetByID(context.Context, *EventStu
 func (UnimplementedEventStudentServiceServer) GetList(context.Context, *GetListEventStudentRequest) (*GetListEventStudentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
 }
-func (UnimplementedEventStudentServiceServer) Update(context.Context, *UpdateEventStudent) (*GetEventStudent, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
-}
 func (UnimplementedEventStudentServiceServer) Delete(context.Context, *EventStudentPrimaryKey) (*EmptyEventStudent, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedEventStudentServiceServer) Cancel(context.Context, *CancelEventStudent) (*GetEventStudent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
 func (UnimplementedEventStudentServiceServer) GetStudentByID(context.Context, *EventStudentPrimaryKey) (*GetStudentWithEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStudentByID not implemented")
 }
+func (UnimplementedEventStudentServiceServer) GetCheckInCode(context.Context, *EventStudentPrimaryKey) (*CheckInCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCheckInCode not implemented")
+}
+func (UnimplementedEventStudentServiceServer) CheckIn(context.Context, *CheckInEventStudent) (*GetEventStudent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedEventStudentServiceServer) GetAttendance(context.Context, *EventAttendanceRequest) (*EventAttendance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendance not implemented")
+}
 
 // UnsafeEventStudentServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EventStudentServiceServer will
@@ -201,38 +246,38 @@ func _EventStudentService_GetList_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventStudentService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateEventStudent)
+func _EventStudentService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventStudentPrimaryKey)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventStudentServiceServer).Update(ctx, in)
+		return srv.(EventStudentServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventStudentService_Update_FullMethodName,
+		FullMethod: EventStudentService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventStudentServiceServer).Update(ctx, req.(*UpdateEventStudent))
+		return srv.(EventStudentServiceServer).Delete(ctx, req.(*EventStudentPrimaryKey))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventStudentService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EventStudentPrimaryKey)
+func _EventStudentService_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelEventStudent)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventStudentServiceServer).Delete(ctx, in)
+		return srv.(EventStudentServiceServer).Cancel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventStudentService_Delete_FullMethodName,
+		FullMethod: EventStudentService_Cancel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventStudentServiceServer).Delete(ctx, req.(*EventStudentPrimaryKey))
+		return srv.(EventStudentServiceServer).Cancel(ctx, req.(*CancelEventStudent))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -255,6 +300,60 @@ func _EventStudentService_GetStudentByID_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventStudentService_GetCheckInCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventStudentPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventStudentServiceServer).GetCheckInCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventStudentService_GetCheckInCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventStudentServiceServer).GetCheckInCode(ctx, req.(*EventStudentPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventStudentService_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInEventStudent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventStudentServiceServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventStudentService_CheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventStudentServiceServer).CheckIn(ctx, req.(*CheckInEventStudent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventStudentService_GetAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventStudentServiceServer).GetAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventStudentService_GetAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventStudentServiceServer).GetAttendance(ctx, req.(*EventAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventStudentService_ServiceDesc is the grpc.ServiceDesc for EventStudentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -274,18 +373,30 @@ var EventStudentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetList",
 			Handler:    _EventStudentService_GetList_Handler,
 		},
-		{
-			MethodName: "Update",
-			Handler:    _EventStudentService_Update_Handler,
-		},
 		{
 			MethodName: "Delete",
 			Handler:    _EventStudentService_Delete_Handler,
 		},
+		{
+			MethodName: "Cancel",
+			Handler:    _EventStudentService_Cancel_Handler,
+		},
 		{
 			MethodName: "GetStudentByID",
 			Handler:    _EventStudentService_GetStudentByID_Handler,
 		},
+		{
+			MethodName: "GetCheckInCode",
+			Handler:    _EventStudentService_GetCheckInCode_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _EventStudentService_CheckIn_Handler,
+		},
+		{
+			MethodName: "GetAttendance",
+			Handler:    _EventStudentService_GetAttendance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "event_student.proto",
@@ -25,6 +25,8 @@ const (
 	GroupService_GetList_FullMethodName        = "/schedule_service.GroupService/GetList"
 	GroupService_Update_FullMethodName         = "/schedule_service.GroupService/Update"
 	GroupService_Delete_FullMethodName         = "/schedule_service.GroupService/Delete"
+	GroupService_PromoteGroup_FullMethodName   = "/schedule_service.GroupService/PromoteGroup"
+	GroupService_GetPromotion_FullMethodName   = "/schedule_service.GroupService/GetPromotion"
 )
 
 // GroupServiceClient is the client API for GroupService service.
@@ -37,6 +39,8 @@ type GroupServiceClient interface {
 	GetList(ctx context.Context, in *GetListGroupRequest, opts ...grpc.CallOption) (*GetListGroupResponse, error)
 	Update(ctx context.Context, in *UpdateGroup, opts ...grpc.CallOption) (*GetGroup, error)
 	Delete(ctx context.Context, in *GroupPrimaryKey, opts ...grpc.CallOption) (*EmptyGroup, error)
+	PromoteGroup(ctx context.Context, in *PromoteGroupRequest, opts ...grpc.CallOption) (*PromoteGroupResponse, error)
+	GetPromotion(ctx context.Context, in *PromotionPrimaryKey, opts ...grpc.CallOption) (*PromoteGroupResponse, error)
 }
 
 type groupServiceClient struct {
@@ -101,6 +105,24 @@ func (c *groupServiceClient) Delete(ctx context.Context, in *GroupPrimaryKey, op
 	return out, nil
 }
 
+func (c *groupServiceClient) PromoteGroup(ctx context.Context, in *PromoteGroupRequest, opts ...grpc.CallOption) (*PromoteGroupResponse, error) {
+	out := new(PromoteGroupResponse)
+	err := c.cc.Invoke(ctx, GroupService_PromoteGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetPromotion(ctx context.Context, in *PromotionPrimaryKey, opts ...grpc.CallOption) (*PromoteGroupResponse, error) {
+	out := new(PromoteGroupResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetPromotion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupServiceServer is the server API for GroupService service.
 // All implementations should embed UnimplementedGroupServiceServer
 // for forward compatibility
@@ -111,6 +133,8 @@ type GroupServiceServer interface {
 	GetList(context.Context, *GetListGroupRequest) (*GetListGroupResponse, error)
 	Update(context.Context, *UpdateGroup) (*GetGroup, error)
 	Delete(context.Context, *GroupPrimaryKey) (*EmptyGroup, error)
+	PromoteGroup(context.Context, *PromoteGroupRequest) (*PromoteGroupResponse, error)
+	GetPromotion(context.Context, *PromotionPrimaryKey) (*PromoteGroupResponse, error)
 }
 
 // UnimplementedGroupServiceServer should be embedded to have forward compatible implementations.
@@ -135,6 +159,12 @@ func (UnimplementedGroupServiceServer) Update(context.Context, *UpdateGroup) (*G
 func (UnimplementedGroupServiceServer) Delete(context.Context, *GroupPrimaryKey) (*EmptyGroup, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedGroupServiceServer) PromoteGroup(context.Context, *PromoteGroupRequest) (*PromoteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteGroup not implemented")
+}
+func (UnimplementedGroupServiceServer) GetPromotion(context.Context, *PromotionPrimaryKey) (*PromoteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromotion not implemented")
+}
 
 // UnsafeGroupServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to GroupServiceServer will
@@ -255,6 +285,42 @@ func _GroupService_Delete_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GroupService_PromoteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).PromoteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_PromoteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).PromoteGroup(ctx, req.(*PromoteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetPromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotionPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetPromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetPromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetPromotion(ctx, req.(*PromotionPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GroupService_ServiceDesc is the grpc.ServiceDesc for GroupService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -286,6 +352,14 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _GroupService_Delete_Handler,
 		},
+		{
+			MethodName: "PromoteGroup",
+			Handler:    _GroupService_PromoteGroup_Handler,
+		},
+		{
+			MethodName: "GetPromotion",
+			Handler:    _GroupService_GetPromotion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "group.proto",
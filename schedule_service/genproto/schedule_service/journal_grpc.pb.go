@@ -19,11 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	JournalService_Create_FullMethodName  = "/schedule_service.JournalService/Create"
-	JournalService_GetByID_FullMethodName = "/schedule_service.JournalService/GetByID"
-	JournalService_GetList_FullMethodName = "/schedule_service.JournalService/GetList"
-	JournalService_Update_FullMethodName  = "/schedule_service.JournalService/Update"
-	JournalService_Delete_FullMethodName  = "/schedule_service.JournalService/Delete"
+	JournalService_Create_FullMethodName            = "/schedule_service.JournalService/Create"
+	JournalService_GetByID_FullMethodName           = "/schedule_service.JournalService/GetByID"
+	JournalService_GetList_FullMethodName           = "/schedule_service.JournalService/GetList"
+	JournalService_Update_FullMethodName            = "/schedule_service.JournalService/Update"
+	JournalService_Delete_FullMethodName            = "/schedule_service.JournalService/Delete"
+	JournalService_GetRolloverReport_FullMethodName = "/schedule_service.JournalService/GetRolloverReport"
 )
 
 // JournalServiceClient is the client API for JournalService service.
@@ -35,6 +36,7 @@ type JournalServiceClient interface {
 	GetList(ctx context.Context, in *GetListJournalRequest, opts ...grpc.CallOption) (*GetListJournalResponse, error)
 	Update(ctx context.Context, in *UpdateJournal, opts ...grpc.CallOption) (*GetJournal, error)
 	Delete(ctx context.Context, in *JournalPrimaryKey, opts ...grpc.CallOption) (*EmptyJournal, error)
+	GetRolloverReport(ctx context.Context, in *GetRolloverReportRequest, opts ...grpc.CallOption) (*GetRolloverReportResponse, error)
 }
 
 type journalServiceClient struct {
@@ -90,6 +92,15 @@ func (c *journalServiceClient) Delete(ctx context.Context, in *JournalPrimaryKey
 	return out, nil
 }
 
+func (c *journalServiceClient) GetRolloverReport(ctx context.Context, in *GetRolloverReportRequest, opts ...grpc.CallOption) (*GetRolloverReportResponse, error) {
+	out := new(GetRolloverReportResponse)
+	err := c.cc.Invoke(ctx, JournalService_GetRolloverReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JournalServiceServer is the server API for JournalService service.
 // All implementations should embed UnimplementedJournalServiceServer
 // for forward compatibility
@@ -99,6 +110,7 @@ type JournalServiceServer interface {
 	GetList(context.Context, *GetListJournalRequest) (*GetListJournalResponse, error)
 	Update(context.Context, *UpdateJournal) (*GetJournal, error)
 	Delete(context.Context, *JournalPrimaryKey) (*EmptyJournal, error)
+	GetRolloverReport(context.Context, *GetRolloverReportRequest) (*GetRolloverReportResponse, error)
 }
 
 // UnimplementedJournalServiceServer should be embedded to have forward compatible implementations.
@@ -120,6 +132,9 @@ func (UnimplementedJournalServiceServer) Update(context.Context, *UpdateJournal)
 func (UnimplementedJournalServiceServer) Delete(context.Context, *JournalPrimaryKey) (*EmptyJournal, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedJournalServiceServer) GetRolloverReport(context.Context, *GetRolloverReportRequest) (*GetRolloverReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRolloverReport not implemented")
+}
 
 // UnsafeJournalServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to JournalServiceServer will
@@ -222,6 +237,24 @@ func _JournalService_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JournalService_GetRolloverReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRolloverReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).GetRolloverReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_GetRolloverReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).GetRolloverReport(ctx, req.(*GetRolloverReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JournalService_ServiceDesc is the grpc.ServiceDesc for JournalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -249,6 +282,10 @@ var JournalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _JournalService_Delete_Handler,
 		},
+		{
+			MethodName: "GetRolloverReport",
+			Handler:    _JournalService_GetRolloverReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "journal.proto",
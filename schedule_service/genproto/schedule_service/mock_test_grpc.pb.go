@@ -0,0 +1,366 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: mock_test.proto
+
+package schedule_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MockTestService_Create_FullMethodName             = "/schedule_service.MockTestService/Create"
+	MockTestService_GetByID_FullMethodName            = "/schedule_service.MockTestService/GetByID"
+	MockTestService_GetList_FullMethodName            = "/schedule_service.MockTestService/GetList"
+	MockTestService_Delete_FullMethodName             = "/schedule_service.MockTestService/Delete"
+	MockTestService_SaveResult_FullMethodName         = "/schedule_service.MockTestService/SaveResult"
+	MockTestService_DeleteResult_FullMethodName       = "/schedule_service.MockTestService/DeleteResult"
+	MockTestService_GetStudentProgress_FullMethodName = "/schedule_service.MockTestService/GetStudentProgress"
+	MockTestService_GetGroupAverages_FullMethodName   = "/schedule_service.MockTestService/GetGroupAverages"
+)
+
+// MockTestServiceClient is the client API for MockTestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MockTestServiceClient interface {
+	Create(ctx context.Context, in *CreateMockTest, opts ...grpc.CallOption) (*MockTest, error)
+	GetByID(ctx context.Context, in *MockTestPrimaryKey, opts ...grpc.CallOption) (*MockTest, error)
+	GetList(ctx context.Context, in *GetListMockTestRequest, opts ...grpc.CallOption) (*GetListMockTestResponse, error)
+	Delete(ctx context.Context, in *MockTestPrimaryKey, opts ...grpc.CallOption) (*EmptyMockTest, error)
+	SaveResult(ctx context.Context, in *SaveMockTestResult, opts ...grpc.CallOption) (*MockTestResult, error)
+	DeleteResult(ctx context.Context, in *MockTestResultPrimaryKey, opts ...grpc.CallOption) (*EmptyMockTest, error)
+	GetStudentProgress(ctx context.Context, in *StudentMockProgressRequest, opts ...grpc.CallOption) (*StudentMockProgress, error)
+	GetGroupAverages(ctx context.Context, in *GroupMockAveragesRequest, opts ...grpc.CallOption) (*GroupMockAverages, error)
+}
+
+type mockTestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMockTestServiceClient(cc grpc.ClientConnInterface) MockTestServiceClient {
+	return &mockTestServiceClient{cc}
+}
+
+func (c *mockTestServiceClient) Create(ctx context.Context, in *CreateMockTest, opts ...grpc.CallOption) (*MockTest, error) {
+	out := new(MockTest)
+	err := c.cc.Invoke(ctx, MockTestService_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockTestServiceClient) GetByID(ctx context.Context, in *MockTestPrimaryKey, opts ...grpc.CallOption) (*MockTest, error) {
+	out := new(MockTest)
+	err := c.cc.Invoke(ctx, MockTestService_GetByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockTestServiceClient) GetList(ctx context.Context, in *GetListMockTestRequest, opts ...grpc.CallOption) (*GetListMockTestResponse, error) {
+	out := new(GetListMockTestResponse)
+	err := c.cc.Invoke(ctx, MockTestService_GetList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockTestServiceClient) Delete(ctx context.Context, in *MockTestPrimaryKey, opts ...grpc.CallOption) (*EmptyMockTest, error) {
+	out := new(EmptyMockTest)
+	err := c.cc.Invoke(ctx, MockTestService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockTestServiceClient) SaveResult(ctx context.Context, in *SaveMockTestResult, opts ...grpc.CallOption) (*MockTestResult, error) {
+	out := new(MockTestResult)
+	err := c.cc.Invoke(ctx, MockTestService_SaveResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockTestServiceClient) DeleteResult(ctx context.Context, in *MockTestResultPrimaryKey, opts ...grpc.CallOption) (*EmptyMockTest, error) {
+	out := new(EmptyMockTest)
+	err := c.cc.Invoke(ctx, MockTestService_DeleteResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockTestServiceClient) GetStudentProgress(ctx context.Context, in *StudentMockProgressRequest, opts ...grpc.CallOption) (*StudentMockProgress, error) {
+	out := new(StudentMockProgress)
+	err := c.cc.Invoke(ctx, MockTestService_GetStudentProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockTestServiceClient) GetGroupAverages(ctx context.Context, in *GroupMockAveragesRequest, opts ...grpc.CallOption) (*GroupMockAverages, error) {
+	out := new(GroupMockAverages)
+	err := c.cc.Invoke(ctx, MockTestService_GetGroupAverages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MockTestServiceServer is the server API for MockTestService service.
+// All implementations should embed UnimplementedMockTestServiceServer
+// for forward compatibility
+type MockTestServiceServer interface {
+	Create(context.Context, *CreateMockTest) (*MockTest, error)
+	GetByID(context.Context, *MockTestPrimaryKey) (*MockTest, error)
+	GetList(context.Context, *GetListMockTestRequest) (*GetListMockTestResponse, error)
+	Delete(context.Context, *MockTestPrimaryKey) (*EmptyMockTest, error)
+	SaveResult(context.Context, *SaveMockTestResult) (*MockTestResult, error)
+	DeleteResult(context.Context, *MockTestResultPrimaryKey) (*EmptyMockTest, error)
+	GetStudentProgress(context.Context, *StudentMockProgressRequest) (*StudentMockProgress, error)
+	GetGroupAverages(context.Context, *GroupMockAveragesRequest) (*GroupMockAverages, error)
+}
+
+// UnimplementedMockTestServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedMockTestServiceServer struct {
+}
+
+func (UnimplementedMockTestServiceServer) Create(context.Context, *CreateMockTest) (*MockTest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedMockTestServiceServer) GetByID(context.Context, *MockTestPrimaryKey) (*MockTest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedMockTestServiceServer) GetList(context.Context, *GetListMockTestRequest) (*GetListMockTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedMockTestServiceServer) Delete(context.Context, *MockTestPrimaryKey) (*EmptyMockTest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedMockTestServiceServer) SaveResult(context.Context, *SaveMockTestResult) (*MockTestResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveResult not implemented")
+}
+func (UnimplementedMockTestServiceServer) DeleteResult(context.Context, *MockTestResultPrimaryKey) (*EmptyMockTest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteResult not implemented")
+}
+func (UnimplementedMockTestServiceServer) GetStudentProgress(context.Context, *StudentMockProgressRequest) (*StudentMockProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentProgress not implemented")
+}
+func (UnimplementedMockTestServiceServer) GetGroupAverages(context.Context, *GroupMockAveragesRequest) (*GroupMockAverages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupAverages not implemented")
+}
+
+// UnsafeMockTestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MockTestServiceServer will
+// result in compilation errors.
+type UnsafeMockTestServiceServer interface {
+	mustEmbedUnimplementedMockTestServiceServer()
+}
+
+func RegisterMockTestServiceServer(s grpc.ServiceRegistrar, srv MockTestServiceServer) {
+	s.RegisterService(&MockTestService_ServiceDesc, srv)
+}
+
+func _MockTestService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMockTest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockTestServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MockTestService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockTestServiceServer).Create(ctx, req.(*CreateMockTest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MockTestService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MockTestPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockTestServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MockTestService_GetByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockTestServiceServer).GetByID(ctx, req.(*MockTestPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MockTestService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListMockTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockTestServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MockTestService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockTestServiceServer).GetList(ctx, req.(*GetListMockTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MockTestService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MockTestPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockTestServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MockTestService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockTestServiceServer).Delete(ctx, req.(*MockTestPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MockTestService_SaveResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveMockTestResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockTestServiceServer).SaveResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MockTestService_SaveResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockTestServiceServer).SaveResult(ctx, req.(*SaveMockTestResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MockTestService_DeleteResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MockTestResultPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockTestServiceServer).DeleteResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MockTestService_DeleteResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockTestServiceServer).DeleteResult(ctx, req.(*MockTestResultPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MockTestService_GetStudentProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentMockProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockTestServiceServer).GetStudentProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MockTestService_GetStudentProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockTestServiceServer).GetStudentProgress(ctx, req.(*StudentMockProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MockTestService_GetGroupAverages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupMockAveragesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockTestServiceServer).GetGroupAverages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MockTestService_GetGroupAverages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockTestServiceServer).GetGroupAverages(ctx, req.(*GroupMockAveragesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MockTestService_ServiceDesc is the grpc.ServiceDesc for MockTestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MockTestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule_service.MockTestService",
+	HandlerType: (*MockTestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _MockTestService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _MockTestService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _MockTestService_GetList_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _MockTestService_Delete_Handler,
+		},
+		{
+			MethodName: "SaveResult",
+			Handler:    _MockTestService_SaveResult_Handler,
+		},
+		{
+			MethodName: "DeleteResult",
+			Handler:    _MockTestService_DeleteResult_Handler,
+		},
+		{
+			MethodName: "GetStudentProgress",
+			Handler:    _MockTestService_GetStudentProgress_Handler,
+		},
+		{
+			MethodName: "GetGroupAverages",
+			Handler:    _MockTestService_GetGroupAverages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mock_test.proto",
+}
@@ -0,0 +1,477 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: payroll.proto
+
+package schedule_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	PayrollService_CreateRule_FullMethodName        = "/schedule_service.PayrollService/CreateRule"
+	PayrollService_GetListRule_FullMethodName       = "/schedule_service.PayrollService/GetListRule"
+	PayrollService_DeleteRule_FullMethodName        = "/schedule_service.PayrollService/DeleteRule"
+	PayrollService_CreateAdjustment_FullMethodName  = "/schedule_service.PayrollService/CreateAdjustment"
+	PayrollService_GetListAdjustment_FullMethodName = "/schedule_service.PayrollService/GetListAdjustment"
+	PayrollService_DeleteAdjustment_FullMethodName  = "/schedule_service.PayrollService/DeleteAdjustment"
+	PayrollService_CreateRun_FullMethodName         = "/schedule_service.PayrollService/CreateRun"
+	PayrollService_RecalculateRun_FullMethodName    = "/schedule_service.PayrollService/RecalculateRun"
+	PayrollService_LockRun_FullMethodName           = "/schedule_service.PayrollService/LockRun"
+	PayrollService_GetRun_FullMethodName            = "/schedule_service.PayrollService/GetRun"
+	PayrollService_GetListRun_FullMethodName        = "/schedule_service.PayrollService/GetListRun"
+)
+
+// PayrollServiceClient is the client API for PayrollService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PayrollServiceClient interface {
+	CreateRule(ctx context.Context, in *CreatePayRule, opts ...grpc.CallOption) (*PayRule, error)
+	GetListRule(ctx context.Context, in *GetListPayRuleRequest, opts ...grpc.CallOption) (*GetListPayRuleResponse, error)
+	DeleteRule(ctx context.Context, in *PayRulePrimaryKey, opts ...grpc.CallOption) (*EmptyPayroll, error)
+	CreateAdjustment(ctx context.Context, in *CreatePayrollAdjustment, opts ...grpc.CallOption) (*PayrollAdjustment, error)
+	GetListAdjustment(ctx context.Context, in *GetListPayrollAdjustmentRequest, opts ...grpc.CallOption) (*GetListPayrollAdjustmentResponse, error)
+	DeleteAdjustment(ctx context.Context, in *PayrollAdjustmentPrimaryKey, opts ...grpc.CallOption) (*EmptyPayroll, error)
+	CreateRun(ctx context.Context, in *CreatePayrollRun, opts ...grpc.CallOption) (*PayrollRun, error)
+	RecalculateRun(ctx context.Context, in *PayrollRunPrimaryKey, opts ...grpc.CallOption) (*PayrollRun, error)
+	LockRun(ctx context.Context, in *LockPayrollRun, opts ...grpc.CallOption) (*PayrollRun, error)
+	GetRun(ctx context.Context, in *GetPayrollRunRequest, opts ...grpc.CallOption) (*PayrollRun, error)
+	GetListRun(ctx context.Context, in *GetListPayrollRunRequest, opts ...grpc.CallOption) (*GetListPayrollRunResponse, error)
+}
+
+type payrollServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPayrollServiceClient(cc grpc.ClientConnInterface) PayrollServiceClient {
+	return &payrollServiceClient{cc}
+}
+
+func (c *payrollServiceClient) CreateRule(ctx context.Context, in *CreatePayRule, opts ...grpc.CallOption) (*PayRule, error) {
+	out := new(PayRule)
+	err := c.cc.Invoke(ctx, PayrollService_CreateRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) GetListRule(ctx context.Context, in *GetListPayRuleRequest, opts ...grpc.CallOption) (*GetListPayRuleResponse, error) {
+	out := new(GetListPayRuleResponse)
+	err := c.cc.Invoke(ctx, PayrollService_GetListRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) DeleteRule(ctx context.Context, in *PayRulePrimaryKey, opts ...grpc.CallOption) (*EmptyPayroll, error) {
+	out := new(EmptyPayroll)
+	err := c.cc.Invoke(ctx, PayrollService_DeleteRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) CreateAdjustment(ctx context.Context, in *CreatePayrollAdjustment, opts ...grpc.CallOption) (*PayrollAdjustment, error) {
+	out := new(PayrollAdjustment)
+	err := c.cc.Invoke(ctx, PayrollService_CreateAdjustment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) GetListAdjustment(ctx context.Context, in *GetListPayrollAdjustmentRequest, opts ...grpc.CallOption) (*GetListPayrollAdjustmentResponse, error) {
+	out := new(GetListPayrollAdjustmentResponse)
+	err := c.cc.Invoke(ctx, PayrollService_GetListAdjustment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) DeleteAdjustment(ctx context.Context, in *PayrollAdjustmentPrimaryKey, opts ...grpc.CallOption) (*EmptyPayroll, error) {
+	out := new(EmptyPayroll)
+	err := c.cc.Invoke(ctx, PayrollService_DeleteAdjustment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) CreateRun(ctx context.Context, in *CreatePayrollRun, opts ...grpc.CallOption) (*PayrollRun, error) {
+	out := new(PayrollRun)
+	err := c.cc.Invoke(ctx, PayrollService_CreateRun_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) RecalculateRun(ctx context.Context, in *PayrollRunPrimaryKey, opts ...grpc.CallOption) (*PayrollRun, error) {
+	out := new(PayrollRun)
+	err := c.cc.Invoke(ctx, PayrollService_RecalculateRun_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) LockRun(ctx context.Context, in *LockPayrollRun, opts ...grpc.CallOption) (*PayrollRun, error) {
+	out := new(PayrollRun)
+	err := c.cc.Invoke(ctx, PayrollService_LockRun_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) GetRun(ctx context.Context, in *GetPayrollRunRequest, opts ...grpc.CallOption) (*PayrollRun, error) {
+	out := new(PayrollRun)
+	err := c.cc.Invoke(ctx, PayrollService_GetRun_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) GetListRun(ctx context.Context, in *GetListPayrollRunRequest, opts ...grpc.CallOption) (*GetListPayrollRunResponse, error) {
+	out := new(GetListPayrollRunResponse)
+	err := c.cc.Invoke(ctx, PayrollService_GetListRun_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PayrollServiceServer is the server API for PayrollService service.
+// All implementations should embed UnimplementedPayrollServiceServer
+// for forward compatibility
+type PayrollServiceServer interface {
+	CreateRule(context.Context, *CreatePayRule) (*PayRule, error)
+	GetListRule(context.Context, *GetListPayRuleRequest) (*GetListPayRuleResponse, error)
+	DeleteRule(context.Context, *PayRulePrimaryKey) (*EmptyPayroll, error)
+	CreateAdjustment(context.Context, *CreatePayrollAdjustment) (*PayrollAdjustment, error)
+	GetListAdjustment(context.Context, *GetListPayrollAdjustmentRequest) (*GetListPayrollAdjustmentResponse, error)
+	DeleteAdjustment(context.Context, *PayrollAdjustmentPrimaryKey) (*EmptyPayroll, error)
+	CreateRun(context.Context, *CreatePayrollRun) (*PayrollRun, error)
+	RecalculateRun(context.Context, *PayrollRunPrimaryKey) (*PayrollRun, error)
+	LockRun(context.Context, *LockPayrollRun) (*PayrollRun, error)
+	GetRun(context.Context, *GetPayrollRunRequest) (*PayrollRun, error)
+	GetListRun(context.Context, *GetListPayrollRunRequest) (*GetListPayrollRunResponse, error)
+}
+
+// UnimplementedPayrollServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedPayrollServiceServer struct {
+}
+
+func (UnimplementedPayrollServiceServer) CreateRule(context.Context, *CreatePayRule) (*PayRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRule not implemented")
+}
+func (UnimplementedPayrollServiceServer) GetListRule(context.Context, *GetListPayRuleRequest) (*GetListPayRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListRule not implemented")
+}
+func (UnimplementedPayrollServiceServer) DeleteRule(context.Context, *PayRulePrimaryKey) (*EmptyPayroll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRule not implemented")
+}
+func (UnimplementedPayrollServiceServer) CreateAdjustment(context.Context, *CreatePayrollAdjustment) (*PayrollAdjustment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAdjustment not implemented")
+}
+func (UnimplementedPayrollServiceServer) GetListAdjustment(context.Context, *GetListPayrollAdjustmentRequest) (*GetListPayrollAdjustmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListAdjustment not implemented")
+}
+func (UnimplementedPayrollServiceServer) DeleteAdjustment(context.Context, *PayrollAdjustmentPrimaryKey) (*EmptyPayroll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAdjustment not implemented")
+}
+func (UnimplementedPayrollServiceServer) CreateRun(context.Context, *CreatePayrollRun) (*PayrollRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRun not implemented")
+}
+func (UnimplementedPayrollServiceServer) RecalculateRun(context.Context, *PayrollRunPrimaryKey) (*PayrollRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecalculateRun not implemented")
+}
+func (UnimplementedPayrollServiceServer) LockRun(context.Context, *LockPayrollRun) (*PayrollRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockRun not implemented")
+}
+func (UnimplementedPayrollServiceServer) GetRun(context.Context, *GetPayrollRunRequest) (*PayrollRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRun not implemented")
+}
+func (UnimplementedPayrollServiceServer) GetListRun(context.Context, *GetListPayrollRunRequest) (*GetListPayrollRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListRun not implemented")
+}
+
+// UnsafePayrollServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PayrollServiceServer will
+// result in compilation errors.
+type UnsafePayrollServiceServer interface {
+	mustEmbedUnimplementedPayrollServiceServer()
+}
+
+func RegisterPayrollServiceServer(s grpc.ServiceRegistrar, srv PayrollServiceServer) {
+	s.RegisterService(&PayrollService_ServiceDesc, srv)
+}
+
+func _PayrollService_CreateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePayRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).CreateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_CreateRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).CreateRule(ctx, req.(*CreatePayRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_GetListRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListPayRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).GetListRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_GetListRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).GetListRule(ctx, req.(*GetListPayRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayRulePrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_DeleteRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).DeleteRule(ctx, req.(*PayRulePrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_CreateAdjustment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePayrollAdjustment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).CreateAdjustment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_CreateAdjustment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).CreateAdjustment(ctx, req.(*CreatePayrollAdjustment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_GetListAdjustment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListPayrollAdjustmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).GetListAdjustment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_GetListAdjustment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).GetListAdjustment(ctx, req.(*GetListPayrollAdjustmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_DeleteAdjustment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayrollAdjustmentPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).DeleteAdjustment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_DeleteAdjustment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).DeleteAdjustment(ctx, req.(*PayrollAdjustmentPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_CreateRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePayrollRun)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).CreateRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_CreateRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).CreateRun(ctx, req.(*CreatePayrollRun))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_RecalculateRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayrollRunPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).RecalculateRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_RecalculateRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).RecalculateRun(ctx, req.(*PayrollRunPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_LockRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockPayrollRun)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).LockRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_LockRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).LockRun(ctx, req.(*LockPayrollRun))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_GetRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayrollRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).GetRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_GetRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).GetRun(ctx, req.(*GetPayrollRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_GetListRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListPayrollRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).GetListRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_GetListRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).GetListRun(ctx, req.(*GetListPayrollRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PayrollService_ServiceDesc is the grpc.ServiceDesc for PayrollService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PayrollService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule_service.PayrollService",
+	HandlerType: (*PayrollServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRule",
+			Handler:    _PayrollService_CreateRule_Handler,
+		},
+		{
+			MethodName: "GetListRule",
+			Handler:    _PayrollService_GetListRule_Handler,
+		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _PayrollService_DeleteRule_Handler,
+		},
+		{
+			MethodName: "CreateAdjustment",
+			Handler:    _PayrollService_CreateAdjustment_Handler,
+		},
+		{
+			MethodName: "GetListAdjustment",
+			Handler:    _PayrollService_GetListAdjustment_Handler,
+		},
+		{
+			MethodName: "DeleteAdjustment",
+			Handler:    _PayrollService_DeleteAdjustment_Handler,
+		},
+		{
+			MethodName: "CreateRun",
+			Handler:    _PayrollService_CreateRun_Handler,
+		},
+		{
+			MethodName: "RecalculateRun",
+			Handler:    _PayrollService_RecalculateRun_Handler,
+		},
+		{
+			MethodName: "LockRun",
+			Handler:    _PayrollService_LockRun_Handler,
+		},
+		{
+			MethodName: "GetRun",
+			Handler:    _PayrollService_GetRun_Handler,
+		},
+		{
+			MethodName: "GetListRun",
+			Handler:    _PayrollService_GetListRun_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "payroll.proto",
+}
@@ -0,0 +1,366 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: timetable.proto
+
+package schedule_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	TimetableService_CreateAvailability_FullMethodName       = "/schedule_service.TimetableService/CreateAvailability"
+	TimetableService_GetListAvailability_FullMethodName      = "/schedule_service.TimetableService/GetListAvailability"
+	TimetableService_DeleteAvailability_FullMethodName       = "/schedule_service.TimetableService/DeleteAvailability"
+	TimetableService_SetLessonRequirement_FullMethodName     = "/schedule_service.TimetableService/SetLessonRequirement"
+	TimetableService_GetListLessonRequirement_FullMethodName = "/schedule_service.TimetableService/GetListLessonRequirement"
+	TimetableService_ProposeTimetable_FullMethodName         = "/schedule_service.TimetableService/ProposeTimetable"
+	TimetableService_GetProposal_FullMethodName              = "/schedule_service.TimetableService/GetProposal"
+	TimetableService_CommitTimetable_FullMethodName          = "/schedule_service.TimetableService/CommitTimetable"
+)
+
+// TimetableServiceClient is the client API for TimetableService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TimetableServiceClient interface {
+	CreateAvailability(ctx context.Context, in *CreateTeacherAvailability, opts ...grpc.CallOption) (*TeacherAvailability, error)
+	GetListAvailability(ctx context.Context, in *GetListAvailabilityRequest, opts ...grpc.CallOption) (*GetListAvailabilityResponse, error)
+	DeleteAvailability(ctx context.Context, in *TeacherAvailabilityPrimaryKey, opts ...grpc.CallOption) (*EmptyTimetable, error)
+	SetLessonRequirement(ctx context.Context, in *LessonRequirement, opts ...grpc.CallOption) (*LessonRequirement, error)
+	GetListLessonRequirement(ctx context.Context, in *GetListLessonRequirementRequest, opts ...grpc.CallOption) (*GetListLessonRequirementResponse, error)
+	ProposeTimetable(ctx context.Context, in *ProposeTimetableRequest, opts ...grpc.CallOption) (*TimetableProposal, error)
+	GetProposal(ctx context.Context, in *TimetableProposalPrimaryKey, opts ...grpc.CallOption) (*TimetableProposal, error)
+	CommitTimetable(ctx context.Context, in *TimetableProposalPrimaryKey, opts ...grpc.CallOption) (*CommitTimetableResponse, error)
+}
+
+type timetableServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimetableServiceClient(cc grpc.ClientConnInterface) TimetableServiceClient {
+	return &timetableServiceClient{cc}
+}
+
+func (c *timetableServiceClient) CreateAvailability(ctx context.Context, in *CreateTeacherAvailability, opts ...grpc.CallOption) (*TeacherAvailability, error) {
+	out := new(TeacherAvailability)
+	err := c.cc.Invoke(ctx, TimetableService_CreateAvailability_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GetListAvailability(ctx context.Context, in *GetListAvailabilityRequest, opts ...grpc.CallOption) (*GetListAvailabilityResponse, error) {
+	out := new(GetListAvailabilityResponse)
+	err := c.cc.Invoke(ctx, TimetableService_GetListAvailability_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) DeleteAvailability(ctx context.Context, in *TeacherAvailabilityPrimaryKey, opts ...grpc.CallOption) (*EmptyTimetable, error) {
+	out := new(EmptyTimetable)
+	err := c.cc.Invoke(ctx, TimetableService_DeleteAvailability_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) SetLessonRequirement(ctx context.Context, in *LessonRequirement, opts ...grpc.CallOption) (*LessonRequirement, error) {
+	out := new(LessonRequirement)
+	err := c.cc.Invoke(ctx, TimetableService_SetLessonRequirement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GetListLessonRequirement(ctx context.Context, in *GetListLessonRequirementRequest, opts ...grpc.CallOption) (*GetListLessonRequirementResponse, error) {
+	out := new(GetListLessonRequirementResponse)
+	err := c.cc.Invoke(ctx, TimetableService_GetListLessonRequirement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) ProposeTimetable(ctx context.Context, in *ProposeTimetableRequest, opts ...grpc.CallOption) (*TimetableProposal, error) {
+	out := new(TimetableProposal)
+	err := c.cc.Invoke(ctx, TimetableService_ProposeTimetable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GetProposal(ctx context.Context, in *TimetableProposalPrimaryKey, opts ...grpc.CallOption) (*TimetableProposal, error) {
+	out := new(TimetableProposal)
+	err := c.cc.Invoke(ctx, TimetableService_GetProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) CommitTimetable(ctx context.Context, in *TimetableProposalPrimaryKey, opts ...grpc.CallOption) (*CommitTimetableResponse, error) {
+	out := new(CommitTimetableResponse)
+	err := c.cc.Invoke(ctx, TimetableService_CommitTimetable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TimetableServiceServer is the server API for TimetableService service.
+// All implementations should embed UnimplementedTimetableServiceServer
+// for forward compatibility
+type TimetableServiceServer interface {
+	CreateAvailability(context.Context, *CreateTeacherAvailability) (*TeacherAvailability, error)
+	GetListAvailability(context.Context, *GetListAvailabilityRequest) (*GetListAvailabilityResponse, error)
+	DeleteAvailability(context.Context, *TeacherAvailabilityPrimaryKey) (*EmptyTimetable, error)
+	SetLessonRequirement(context.Context, *LessonRequirement) (*LessonRequirement, error)
+	GetListLessonRequirement(context.Context, *GetListLessonRequirementRequest) (*GetListLessonRequirementResponse, error)
+	ProposeTimetable(context.Context, *ProposeTimetableRequest) (*TimetableProposal, error)
+	GetProposal(context.Context, *TimetableProposalPrimaryKey) (*TimetableProposal, error)
+	CommitTimetable(context.Context, *TimetableProposalPrimaryKey) (*CommitTimetableResponse, error)
+}
+
+// UnimplementedTimetableServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedTimetableServiceServer struct {
+}
+
+func (UnimplementedTimetableServiceServer) CreateAvailability(context.Context, *CreateTeacherAvailability) (*TeacherAvailability, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAvailability not implemented")
+}
+func (UnimplementedTimetableServiceServer) GetListAvailability(context.Context, *GetListAvailabilityRequest) (*GetListAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListAvailability not implemented")
+}
+func (UnimplementedTimetableServiceServer) DeleteAvailability(context.Context, *TeacherAvailabilityPrimaryKey) (*EmptyTimetable, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAvailability not implemented")
+}
+func (UnimplementedTimetableServiceServer) SetLessonRequirement(context.Context, *LessonRequirement) (*LessonRequirement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLessonRequirement not implemented")
+}
+func (UnimplementedTimetableServiceServer) GetListLessonRequirement(context.Context, *GetListLessonRequirementRequest) (*GetListLessonRequirementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListLessonRequirement not implemented")
+}
+func (UnimplementedTimetableServiceServer) ProposeTimetable(context.Context, *ProposeTimetableRequest) (*TimetableProposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeTimetable not implemented")
+}
+func (UnimplementedTimetableServiceServer) GetProposal(context.Context, *TimetableProposalPrimaryKey) (*TimetableProposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProposal not implemented")
+}
+func (UnimplementedTimetableServiceServer) CommitTimetable(context.Context, *TimetableProposalPrimaryKey) (*CommitTimetableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitTimetable not implemented")
+}
+
+// UnsafeTimetableServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimetableServiceServer will
+// result in compilation errors.
+type UnsafeTimetableServiceServer interface {
+	mustEmbedUnimplementedTimetableServiceServer()
+}
+
+func RegisterTimetableServiceServer(s grpc.ServiceRegistrar, srv TimetableServiceServer) {
+	s.RegisterService(&TimetableService_ServiceDesc, srv)
+}
+
+func _TimetableService_CreateAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeacherAvailability)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).CreateAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_CreateAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).CreateAvailability(ctx, req.(*CreateTeacherAvailability))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GetListAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetListAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetListAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetListAvailability(ctx, req.(*GetListAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_DeleteAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeacherAvailabilityPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).DeleteAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_DeleteAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).DeleteAvailability(ctx, req.(*TeacherAvailabilityPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_SetLessonRequirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LessonRequirement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).SetLessonRequirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_SetLessonRequirement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).SetLessonRequirement(ctx, req.(*LessonRequirement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GetListLessonRequirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListLessonRequirementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetListLessonRequirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetListLessonRequirement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetListLessonRequirement(ctx, req.(*GetListLessonRequirementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_ProposeTimetable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeTimetableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).ProposeTimetable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_ProposeTimetable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).ProposeTimetable(ctx, req.(*ProposeTimetableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GetProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimetableProposalPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetProposal(ctx, req.(*TimetableProposalPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_CommitTimetable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimetableProposalPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).CommitTimetable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_CommitTimetable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).CommitTimetable(ctx, req.(*TimetableProposalPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TimetableService_ServiceDesc is the grpc.ServiceDesc for TimetableService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimetableService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule_service.TimetableService",
+	HandlerType: (*TimetableServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAvailability",
+			Handler:    _TimetableService_CreateAvailability_Handler,
+		},
+		{
+			MethodName: "GetListAvailability",
+			Handler:    _TimetableService_GetListAvailability_Handler,
+		},
+		{
+			MethodName: "DeleteAvailability",
+			Handler:    _TimetableService_DeleteAvailability_Handler,
+		},
+		{
+			MethodName: "SetLessonRequirement",
+			Handler:    _TimetableService_SetLessonRequirement_Handler,
+		},
+		{
+			MethodName: "GetListLessonRequirement",
+			Handler:    _TimetableService_GetListLessonRequirement_Handler,
+		},
+		{
+			MethodName: "ProposeTimetable",
+			Handler:    _TimetableService_ProposeTimetable_Handler,
+		},
+		{
+			MethodName: "GetProposal",
+			Handler:    _TimetableService_GetProposal_Handler,
+		},
+		{
+			MethodName: "CommitTimetable",
+			Handler:    _TimetableService_CommitTimetable_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "timetable.proto",
+}
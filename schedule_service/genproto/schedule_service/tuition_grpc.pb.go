@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: tuition.proto
+
+package schedule_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	TuitionService_CreatePlan_FullMethodName        = "/schedule_service.TuitionService/CreatePlan"
+	TuitionService_GetListPlan_FullMethodName       = "/schedule_service.TuitionService/GetListPlan"
+	TuitionService_UpdatePlan_FullMethodName        = "/schedule_service.TuitionService/UpdatePlan"
+	TuitionService_DeletePlan_FullMethodName        = "/schedule_service.TuitionService/DeletePlan"
+	TuitionService_GenerateInvoices_FullMethodName  = "/schedule_service.TuitionService/GenerateInvoices"
+	TuitionService_GetListInvoice_FullMethodName    = "/schedule_service.TuitionService/GetListInvoice"
+	TuitionService_GetStudentBalance_FullMethodName = "/schedule_service.TuitionService/GetStudentBalance"
+)
+
+// TuitionServiceClient is the client API for TuitionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TuitionServiceClient interface {
+	CreatePlan(ctx context.Context, in *CreateTuitionPlan, opts ...grpc.CallOption) (*TuitionPlan, error)
+	GetListPlan(ctx context.Context, in *GetListTuitionPlanRequest, opts ...grpc.CallOption) (*GetListTuitionPlanResponse, error)
+	UpdatePlan(ctx context.Context, in *UpdateTuitionPlan, opts ...grpc.CallOption) (*TuitionPlan, error)
+	DeletePlan(ctx context.Context, in *TuitionPlanPrimaryKey, opts ...grpc.CallOption) (*EmptyTuition, error)
+	GenerateInvoices(ctx context.Context, in *GenerateInvoicesRequest, opts ...grpc.CallOption) (*GenerateInvoicesResponse, error)
+	GetListInvoice(ctx context.Context, in *GetListInvoiceRequest, opts ...grpc.CallOption) (*GetListInvoiceResponse, error)
+	GetStudentBalance(ctx context.Context, in *StudentBalanceRequest, opts ...grpc.CallOption) (*StudentBalance, error)
+}
+
+type tuitionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTuitionServiceClient(cc grpc.ClientConnInterface) TuitionServiceClient {
+	return &tuitionServiceClient{cc}
+}
+
+func (c *tuitionServiceClient) CreatePlan(ctx context.Context, in *CreateTuitionPlan, opts ...grpc.CallOption) (*TuitionPlan, error) {
+	out := new(TuitionPlan)
+	err := c.cc.Invoke(ctx, TuitionService_CreatePlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tuitionServiceClient) GetListPlan(ctx context.Context, in *GetListTuitionPlanRequest, opts ...grpc.CallOption) (*GetListTuitionPlanResponse, error) {
+	out := new(GetListTuitionPlanResponse)
+	err := c.cc.Invoke(ctx, TuitionService_GetListPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tuitionServiceClient) UpdatePlan(ctx context.Context, in *UpdateTuitionPlan, opts ...grpc.CallOption) (*TuitionPlan, error) {
+	out := new(TuitionPlan)
+	err := c.cc.Invoke(ctx, TuitionService_UpdatePlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tuitionServiceClient) DeletePlan(ctx context.Context, in *TuitionPlanPrimaryKey, opts ...grpc.CallOption) (*EmptyTuition, error) {
+	out := new(EmptyTuition)
+	err := c.cc.Invoke(ctx, TuitionService_DeletePlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tuitionServiceClient) GenerateInvoices(ctx context.Context, in *GenerateInvoicesRequest, opts ...grpc.CallOption) (*GenerateInvoicesResponse, error) {
+	out := new(GenerateInvoicesResponse)
+	err := c.cc.Invoke(ctx, TuitionService_GenerateInvoices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tuitionServiceClient) GetListInvoice(ctx context.Context, in *GetListInvoiceRequest, opts ...grpc.CallOption) (*GetListInvoiceResponse, error) {
+	out := new(GetListInvoiceResponse)
+	err := c.cc.Invoke(ctx, TuitionService_GetListInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tuitionServiceClient) GetStudentBalance(ctx context.Context, in *StudentBalanceRequest, opts ...grpc.CallOption) (*StudentBalance, error) {
+	out := new(StudentBalance)
+	err := c.cc.Invoke(ctx, TuitionService_GetStudentBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TuitionServiceServer is the server API for TuitionService service.
+// All implementations should embed UnimplementedTuitionServiceServer
+// for forward compatibility
+type TuitionServiceServer interface {
+	CreatePlan(context.Context, *CreateTuitionPlan) (*TuitionPlan, error)
+	GetListPlan(context.Context, *GetListTuitionPlanRequest) (*GetListTuitionPlanResponse, error)
+	UpdatePlan(context.Context, *UpdateTuitionPlan) (*TuitionPlan, error)
+	DeletePlan(context.Context, *TuitionPlanPrimaryKey) (*EmptyTuition, error)
+	GenerateInvoices(context.Context, *GenerateInvoicesRequest) (*GenerateInvoicesResponse, error)
+	GetListInvoice(context.Context, *GetListInvoiceRequest) (*GetListInvoiceResponse, error)
+	GetStudentBalance(context.Context, *StudentBalanceRequest) (*StudentBalance, error)
+}
+
+// UnimplementedTuitionServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedTuitionServiceServer struct {
+}
+
+func (UnimplementedTuitionServiceServer) CreatePlan(context.Context, *CreateTuitionPlan) (*TuitionPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlan not implemented")
+}
+func (UnimplementedTuitionServiceServer) GetListPlan(context.Context, *GetListTuitionPlanRequest) (*GetListTuitionPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListPlan not implemented")
+}
+func (UnimplementedTuitionServiceServer) UpdatePlan(context.Context, *UpdateTuitionPlan) (*TuitionPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlan not implemented")
+}
+func (UnimplementedTuitionServiceServer) DeletePlan(context.Context, *TuitionPlanPrimaryKey) (*EmptyTuition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlan not implemented")
+}
+func (UnimplementedTuitionServiceServer) GenerateInvoices(context.Context, *GenerateInvoicesRequest) (*GenerateInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateInvoices not implemented")
+}
+func (UnimplementedTuitionServiceServer) GetListInvoice(context.Context, *GetListInvoiceRequest) (*GetListInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListInvoice not implemented")
+}
+func (UnimplementedTuitionServiceServer) GetStudentBalance(context.Context, *StudentBalanceRequest) (*StudentBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentBalance not implemented")
+}
+
+// UnsafeTuitionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TuitionServiceServer will
+// result in compilation errors.
+type UnsafeTuitionServiceServer interface {
+	mustEmbedUnimplementedTuitionServiceServer()
+}
+
+func RegisterTuitionServiceServer(s grpc.ServiceRegistrar, srv TuitionServiceServer) {
+	s.RegisterService(&TuitionService_ServiceDesc, srv)
+}
+
+func _TuitionService_CreatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTuitionPlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TuitionServiceServer).CreatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TuitionService_CreatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TuitionServiceServer).CreatePlan(ctx, req.(*CreateTuitionPlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TuitionService_GetListPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListTuitionPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TuitionServiceServer).GetListPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TuitionService_GetListPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TuitionServiceServer).GetListPlan(ctx, req.(*GetListTuitionPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TuitionService_UpdatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTuitionPlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TuitionServiceServer).UpdatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TuitionService_UpdatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TuitionServiceServer).UpdatePlan(ctx, req.(*UpdateTuitionPlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TuitionService_DeletePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TuitionPlanPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TuitionServiceServer).DeletePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TuitionService_DeletePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TuitionServiceServer).DeletePlan(ctx, req.(*TuitionPlanPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TuitionService_GenerateInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TuitionServiceServer).GenerateInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TuitionService_GenerateInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TuitionServiceServer).GenerateInvoices(ctx, req.(*GenerateInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TuitionService_GetListInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TuitionServiceServer).GetListInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TuitionService_GetListInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TuitionServiceServer).GetListInvoice(ctx, req.(*GetListInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TuitionService_GetStudentBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TuitionServiceServer).GetStudentBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TuitionService_GetStudentBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TuitionServiceServer).GetStudentBalance(ctx, req.(*StudentBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TuitionService_ServiceDesc is the grpc.ServiceDesc for TuitionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TuitionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule_service.TuitionService",
+	HandlerType: (*TuitionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePlan",
+			Handler:    _TuitionService_CreatePlan_Handler,
+		},
+		{
+			MethodName: "GetListPlan",
+			Handler:    _TuitionService_GetListPlan_Handler,
+		},
+		{
+			MethodName: "UpdatePlan",
+			Handler:    _TuitionService_UpdatePlan_Handler,
+		},
+		{
+			MethodName: "DeletePlan",
+			Handler:    _TuitionService_DeletePlan_Handler,
+		},
+		{
+			MethodName: "GenerateInvoices",
+			Handler:    _TuitionService_GenerateInvoices_Handler,
+		},
+		{
+			MethodName: "GetListInvoice",
+			Handler:    _TuitionService_GetListInvoice_Handler,
+		},
+		{
+			MethodName: "GetStudentBalance",
+			Handler:    _TuitionService_GetStudentBalance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tuition.proto",
+}
@@ -19,11 +19,26 @@ func SetUpServer(cfg config.Config, log logger.LoggerI, strg storage.StorageI, s
 	schedule_service.RegisterEventStudentServiceServer(grpcServer, service.NewEventStudentService(cfg, log, strg, srvc))
 	schedule_service.RegisterEventServiceServer(grpcServer, service.NewEventService(cfg, log, strg, srvc))
 	schedule_service.RegisterGroupServiceServer(grpcServer, service.NewGroupService(cfg, log, strg, srvc))
+	schedule_service.RegisterGroupStudentServiceServer(grpcServer, service.NewGroupStudentService(cfg, log, strg, srvc))
 	schedule_service.RegisterJournalServiceServer(grpcServer, service.NewJournalService(cfg, log, strg, srvc))
 	schedule_service.RegisterScheduleServiceServer(grpcServer, service.NewScheduleService(cfg, log, strg, srvc))
 	schedule_service.RegisterStudentPaymentServiceServer(grpcServer, service.NewStudentPaymentService(cfg, log, strg, srvc))
 	schedule_service.RegisterStudentTaskServiceServer(grpcServer, service.NewStudentTaskService(cfg, log, strg, srvc))
 	schedule_service.RegisterTaskServiceServer(grpcServer, service.NewTaskService(cfg, log, strg, srvc))
+	schedule_service.RegisterTimetableServiceServer(grpcServer, service.NewTimetableService(cfg, log, strg, srvc))
+	schedule_service.RegisterEventFeedbackServiceServer(grpcServer, service.NewEventFeedbackService(cfg, log, strg, srvc))
+	schedule_service.RegisterTuitionServiceServer(grpcServer, service.NewTuitionService(cfg, log, strg, srvc))
+	schedule_service.RegisterDiscountServiceServer(grpcServer, service.NewDiscountService(cfg, log, strg, srvc))
+	schedule_service.RegisterOverdueServiceServer(grpcServer, service.NewOverdueService(cfg, log, strg, srvc))
+	schedule_service.RegisterDocumentServiceServer(grpcServer, service.NewDocumentService(cfg, log, strg, srvc))
+	schedule_service.RegisterOnlinePaymentServiceServer(grpcServer, service.NewOnlinePaymentService(cfg, log, strg, srvc))
+	schedule_service.RegisterCashShiftServiceServer(grpcServer, service.NewCashShiftService(cfg, log, strg, srvc))
+	schedule_service.RegisterFinanceReportServiceServer(grpcServer, service.NewFinanceReportService(cfg, log, strg, srvc))
+	schedule_service.RegisterPayrollServiceServer(grpcServer, service.NewPayrollService(cfg, log, strg, srvc))
+	schedule_service.RegisterExpenseServiceServer(grpcServer, service.NewExpenseService(cfg, log, strg, srvc))
+	schedule_service.RegisterCommissionServiceServer(grpcServer, service.NewCommissionService(cfg, log, strg, srvc))
+	schedule_service.RegisterMockTestServiceServer(grpcServer, service.NewMockTestService(cfg, log, strg, srvc))
+	schedule_service.RegisterRoomServiceServer(grpcServer, service.NewRoomService(cfg, log, strg, srvc))
 	reflection.Register(grpcServer)
 	return
 }
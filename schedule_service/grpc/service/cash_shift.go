@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"schedule_service/config"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/grpc/client"
+	"schedule_service/pkg/money"
+	"schedule_service/pkg/notifier"
+	"schedule_service/storage"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+// deskPaymentMethods are the ways money can be taken at the front desk.
+var deskPaymentMethods = []string{"cash", "card", "transfer"}
+
+type CashShiftService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	notifier notifier.NotifierI
+}
+
+func NewCashShiftService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *CashShiftService {
+	return &CashShiftService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+		notifier: notifier.New(cfg.NotifyWebhookURL, log),
+	}
+}
+
+func (c *CashShiftService) Open(ctx context.Context, req *schedule_service.OpenCashShift) (*schedule_service.CashShift, error) {
+	c.log.Info("---OpenCashShift--->>>", logger.Any("req", req))
+
+	if req.OpeningFloat == "" {
+		req.OpeningFloat = "0"
+	}
+
+	amount, err := money.Amount.Parse(req.OpeningFloat)
+	if err == nil && amount < 0 {
+		err = errors.New("openingFloat cannot be negative")
+	}
+	if err == nil && req.AdministrationId == "" {
+		err = errors.New("administrationId is required")
+	}
+	if err != nil {
+		c.log.Error("---OpenCashShift--->>>", logger.Error(err))
+		return &schedule_service.CashShift{}, err
+	}
+
+	resp, err := c.strg.CashShift().Open(ctx, req)
+	if err != nil {
+		c.log.Error("---OpenCashShift--->>>", logger.Error(err))
+		return &schedule_service.CashShift{}, err
+	}
+
+	return resp, nil
+}
+
+// Close ends a shift with the amounts counted per payment method and
+// returns its Z-report. Mismatches are flagged for the managers.
+func (c *CashShiftService) Close(ctx context.Context, req *schedule_service.CloseCashShift) (*schedule_service.CashShift, error) {
+	c.log.Info("---CloseCashShift--->>>", logger.Any("req", req))
+
+	for _, count := range req.Counted {
+		var err error
+		if !validPaymentMethod(count.PaymentMethod, deskPaymentMethods) {
+			err = fmt.Errorf("invalid payment method: %q", count.PaymentMethod)
+		} else if amount, parseErr := money.Total.Parse(count.Amount); parseErr != nil {
+			err = parseErr
+		} else if amount < 0 {
+			err = errors.New("counted amounts cannot be negative")
+		}
+
+		if err != nil {
+			c.log.Error("---CloseCashShift--->>>", logger.Error(err))
+			return &schedule_service.CashShift{}, err
+		}
+	}
+
+	resp, err := c.strg.CashShift().Close(ctx, req)
+	if err != nil {
+		c.log.Error("---CloseCashShift--->>>", logger.Error(err))
+		return &schedule_service.CashShift{}, err
+	}
+
+	if resp.Mismatch {
+		c.notifier.Notify(ctx, "cash_shift.mismatch", resp)
+	}
+
+	return resp, nil
+}
+
+func (c *CashShiftService) Approve(ctx context.Context, req *schedule_service.ApproveCashShift) (*schedule_service.CashShift, error) {
+	c.log.Info("---ApproveCashShift--->>>", logger.Any("req", req))
+
+	resp, err := c.strg.CashShift().Approve(ctx, req)
+	if err != nil {
+		c.log.Error("---ApproveCashShift--->>>", logger.Error(err))
+		return &schedule_service.CashShift{}, err
+	}
+
+	return resp, nil
+}
+
+func (c *CashShiftService) GetByID(ctx context.Context, req *schedule_service.CashShiftPrimaryKey) (*schedule_service.CashShift, error) {
+	c.log.Info("---GetCashShiftByID--->>>", logger.Any("req", req))
+
+	resp, err := c.strg.CashShift().GetByID(ctx, req)
+	if err != nil {
+		c.log.Error("---GetCashShiftByID--->>>", logger.Error(err))
+		return &schedule_service.CashShift{}, err
+	}
+
+	return resp, nil
+}
+
+func (c *CashShiftService) GetCurrent(ctx context.Context, req *schedule_service.CurrentCashShiftRequest) (*schedule_service.CashShift, error) {
+	c.log.Info("---GetCurrentCashShift--->>>", logger.Any("req", req))
+
+	resp, err := c.strg.CashShift().GetCurrent(ctx, req)
+	if err != nil {
+		c.log.Error("---GetCurrentCashShift--->>>", logger.Error(err))
+		return &schedule_service.CashShift{}, err
+	}
+
+	return resp, nil
+}
+
+func (c *CashShiftService) GetList(ctx context.Context, req *schedule_service.GetListCashShiftRequest) (*schedule_service.GetListCashShiftResponse, error) {
+	c.log.Info("---GetListCashShift--->>>", logger.Any("req", req))
+
+	resp, err := c.strg.CashShift().GetList(ctx, req)
+	if err != nil {
+		c.log.Error("---GetListCashShift--->>>", logger.Error(err))
+		return &schedule_service.GetListCashShiftResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func validPaymentMethod(method string, methods []string) bool {
+	for _, allowed := range methods {
+		if method == allowed {
+			return true
+		}
+	}
+	return false
+}
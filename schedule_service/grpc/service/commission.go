@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"schedule_service/config"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/grpc/client"
+	"schedule_service/pkg/money"
+	"schedule_service/storage"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+type CommissionService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+}
+
+func NewCommissionService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *CommissionService {
+	return &CommissionService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+// CreateRule sets the percentage of collected tuition a teacher or support
+// teacher earns from a month on, for one staff member, one group type or
+// both.
+func (cm *CommissionService) CreateRule(ctx context.Context, req *schedule_service.CreateCommissionRule) (*schedule_service.CommissionRule, error) {
+	cm.log.Info("---CreateCommissionRule--->>>", logger.Any("req", req))
+
+	var err error
+	if req.StaffType != "teacher" && req.StaffType != "support_teacher" {
+		err = errors.New("staffType must be teacher or support_teacher")
+	}
+	if err == nil && req.StaffId == "" && req.GroupType == "" {
+		err = errors.New("staffId or groupType is required")
+	}
+	if err == nil {
+		var rate int64
+		rate, err = money.Amount.ParsePositive(req.Rate)
+		if err == nil && rate > 100*100 {
+			err = errors.New("rate must not exceed 100")
+		}
+	}
+	if err != nil {
+		cm.log.Error("---CreateCommissionRule--->>>", logger.Error(err))
+		return &schedule_service.CommissionRule{}, err
+	}
+
+	resp, err := cm.strg.Commission().CreateRule(ctx, req)
+	if err != nil {
+		cm.log.Error("---CreateCommissionRule--->>>", logger.Error(err))
+		return &schedule_service.CommissionRule{}, err
+	}
+
+	return resp, nil
+}
+
+func (cm *CommissionService) GetListRule(ctx context.Context, req *schedule_service.GetListCommissionRuleRequest) (*schedule_service.GetListCommissionRuleResponse, error) {
+	cm.log.Info("---GetListCommissionRule--->>>", logger.Any("req", req))
+
+	resp, err := cm.strg.Commission().GetListRule(ctx, req)
+	if err != nil {
+		cm.log.Error("---GetListCommissionRule--->>>", logger.Error(err))
+		return &schedule_service.GetListCommissionRuleResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (cm *CommissionService) DeleteRule(ctx context.Context, req *schedule_service.CommissionRulePrimaryKey) (*schedule_service.EmptyCommission, error) {
+	cm.log.Info("---DeleteCommissionRule--->>>", logger.Any("req", req))
+
+	err := cm.strg.Commission().DeleteRule(ctx, req)
+	if err != nil {
+		cm.log.Error("---DeleteCommissionRule--->>>", logger.Error(err))
+		return &schedule_service.EmptyCommission{}, err
+	}
+
+	return &schedule_service.EmptyCommission{}, nil
+}
+
+// GetReport returns the commissions earned on the tuition collected in a
+// month, "2006-01", or the current one.
+func (cm *CommissionService) GetReport(ctx context.Context, req *schedule_service.CommissionReportRequest) (*schedule_service.CommissionReport, error) {
+	cm.log.Info("---GetCommissionReport--->>>", logger.Any("req", req))
+
+	period, err := billingPeriod(req.Period)
+	if err != nil {
+		cm.log.Error("---GetCommissionReport--->>>", logger.Error(err))
+		return &schedule_service.CommissionReport{}, err
+	}
+	req.Period = period
+
+	resp, err := cm.strg.Commission().GetReport(ctx, req)
+	if err != nil {
+		cm.log.Error("---GetCommissionReport--->>>", logger.Error(err))
+		return &schedule_service.CommissionReport{}, err
+	}
+
+	return resp, nil
+}
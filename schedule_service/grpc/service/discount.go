@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"schedule_service/config"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/grpc/client"
+	"schedule_service/pkg/discount"
+	"schedule_service/storage"
+	"strings"
+	"time"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+type DiscountService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+}
+
+func NewDiscountService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *DiscountService {
+	return &DiscountService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+// Create assigns a discount to a student. Promo discounts only come from
+// redeeming a promo code.
+func (d *DiscountService) Create(ctx context.Context, req *schedule_service.CreateDiscount) (*schedule_service.Discount, error) {
+	d.log.Info("---CreateDiscount--->>>", logger.Any("req", req))
+
+	var err error
+	switch {
+	case req.StudentId == "":
+		err = errors.New("studentId is required")
+	case req.Category != "sibling" && req.Category != "scholarship" && req.Category != "other":
+		err = fmt.Errorf("invalid discount category: %q", req.Category)
+	default:
+		if err = discount.Validate(req.Kind, req.Value); err == nil {
+			err = validateDateRange(req.ValidFrom, req.ValidTo)
+		}
+	}
+	if err != nil {
+		d.log.Error("---CreateDiscount--->>>", logger.Error(err))
+		return &schedule_service.Discount{}, err
+	}
+
+	resp, err := d.strg.Discount().Create(ctx, req)
+	if err != nil {
+		d.log.Error("---CreateDiscount--->>>", logger.Error(err))
+		return &schedule_service.Discount{}, err
+	}
+
+	return resp, nil
+}
+
+func (d *DiscountService) GetList(ctx context.Context, req *schedule_service.GetListDiscountRequest) (*schedule_service.GetListDiscountResponse, error) {
+	d.log.Info("---GetListDiscount--->>>", logger.Any("req", req))
+
+	if err := validateDateRange(req.ActiveOn, ""); err != nil {
+		d.log.Error("---GetListDiscount--->>>", logger.Error(err))
+		return &schedule_service.GetListDiscountResponse{}, err
+	}
+
+	resp, err := d.strg.Discount().GetList(ctx, req)
+	if err != nil {
+		d.log.Error("---GetListDiscount--->>>", logger.Error(err))
+		return &schedule_service.GetListDiscountResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (d *DiscountService) Delete(ctx context.Context, req *schedule_service.DiscountPrimaryKey) (*schedule_service.EmptyDiscount, error) {
+	d.log.Info("---DeleteDiscount--->>>", logger.Any("req", req))
+
+	err := d.strg.Discount().Delete(ctx, req)
+	if err != nil {
+		d.log.Error("---DeleteDiscount--->>>", logger.Error(err))
+		return &schedule_service.EmptyDiscount{}, err
+	}
+
+	return &schedule_service.EmptyDiscount{}, nil
+}
+
+// CreatePromo creates a promo code. A code lasts one month unless months is
+// set, and maxUses of 0 means unlimited.
+func (d *DiscountService) CreatePromo(ctx context.Context, req *schedule_service.CreatePromoCode) (*schedule_service.PromoCode, error) {
+	d.log.Info("---CreatePromoCode--->>>", logger.Any("req", req))
+
+	req.Code = strings.TrimSpace(req.Code)
+	if req.Months == 0 {
+		req.Months = 1
+	}
+
+	var err error
+	switch {
+	case req.Code == "":
+		err = errors.New("code is required")
+	case req.Months < 0 || req.MaxUses < 0:
+		err = errors.New("months and maxUses cannot be negative")
+	default:
+		if err = discount.Validate(req.Kind, req.Value); err == nil {
+			err = validateDateRange(req.ValidFrom, req.ValidTo)
+		}
+	}
+	if err != nil {
+		d.log.Error("---CreatePromoCode--->>>", logger.Error(err))
+		return &schedule_service.PromoCode{}, err
+	}
+
+	resp, err := d.strg.Discount().CreatePromo(ctx, req)
+	if err != nil {
+		d.log.Error("---CreatePromoCode--->>>", logger.Error(err))
+		return &schedule_service.PromoCode{}, err
+	}
+
+	return resp, nil
+}
+
+func (d *DiscountService) GetListPromo(ctx context.Context, req *schedule_service.GetListPromoCodeRequest) (*schedule_service.GetListPromoCodeResponse, error) {
+	d.log.Info("---GetListPromoCode--->>>", logger.Any("req", req))
+
+	resp, err := d.strg.Discount().GetListPromo(ctx, req)
+	if err != nil {
+		d.log.Error("---GetListPromoCode--->>>", logger.Error(err))
+		return &schedule_service.GetListPromoCodeResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (d *DiscountService) DeletePromo(ctx context.Context, req *schedule_service.PromoCodePrimaryKey) (*schedule_service.EmptyDiscount, error) {
+	d.log.Info("---DeletePromoCode--->>>", logger.Any("req", req))
+
+	err := d.strg.Discount().DeletePromo(ctx, req)
+	if err != nil {
+		d.log.Error("---DeletePromoCode--->>>", logger.Error(err))
+		return &schedule_service.EmptyDiscount{}, err
+	}
+
+	return &schedule_service.EmptyDiscount{}, nil
+}
+
+func (d *DiscountService) RedeemPromo(ctx context.Context, req *schedule_service.RedeemPromoCodeRequest) (*schedule_service.Discount, error) {
+	d.log.Info("---RedeemPromoCode--->>>", logger.Any("req", req))
+
+	if req.Code == "" || req.StudentId == "" {
+		err := errors.New("code and studentId are required")
+		d.log.Error("---RedeemPromoCode--->>>", logger.Error(err))
+		return &schedule_service.Discount{}, err
+	}
+
+	resp, err := d.strg.Discount().RedeemPromo(ctx, req)
+	if err != nil {
+		d.log.Error("---RedeemPromoCode--->>>", logger.Error(err))
+		return &schedule_service.Discount{}, err
+	}
+
+	return resp, nil
+}
+
+// GetReport sums discounts per branch and month between fromPeriod and
+// toPeriod, both "2006-01" and optional.
+func (d *DiscountService) GetReport(ctx context.Context, req *schedule_service.DiscountReportRequest) (*schedule_service.DiscountReport, error) {
+	d.log.Info("---GetDiscountReport--->>>", logger.Any("req", req))
+
+	for _, period := range []*string{&req.FromPeriod, &req.ToPeriod} {
+		if *period == "" {
+			continue
+		}
+
+		value, err := billingPeriod(*period)
+		if err != nil {
+			d.log.Error("---GetDiscountReport--->>>", logger.Error(err))
+			return &schedule_service.DiscountReport{}, err
+		}
+		*period = value
+	}
+
+	resp, err := d.strg.Discount().GetReport(ctx, req)
+	if err != nil {
+		d.log.Error("---GetDiscountReport--->>>", logger.Error(err))
+		return &schedule_service.DiscountReport{}, err
+	}
+
+	return resp, nil
+}
+
+// validateDateRange checks optional "2006-01-02" dates and that from is not
+// after to.
+func validateDateRange(from, to string) error {
+	var dates []time.Time
+	for _, value := range []string{from, to} {
+		if value == "" {
+			dates = append(dates, time.Time{})
+			continue
+		}
+
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return fmt.Errorf("invalid date: %s", value)
+		}
+		dates = append(dates, date)
+	}
+
+	if !dates[0].IsZero() && !dates[1].IsZero() && dates[1].Before(dates[0]) {
+		return errors.New("validTo cannot be before validFrom")
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"schedule_service/config"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/grpc/client"
+	"schedule_service/pkg/contract"
+	"schedule_service/storage"
+	"strings"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+type DocumentService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+}
+
+func NewDocumentService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *DocumentService {
+	return &DocumentService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (d *DocumentService) GetReceipt(ctx context.Context, req *schedule_service.PaymentReceiptRequest) (*schedule_service.PaymentReceipt, error) {
+	d.log.Info("---GetPaymentReceipt--->>>", logger.Any("req", req))
+
+	resp, err := d.strg.Document().GetReceipt(ctx, req)
+	if err != nil {
+		d.log.Error("---GetPaymentReceipt--->>>", logger.Error(err))
+		return &schedule_service.PaymentReceipt{}, err
+	}
+
+	return resp, nil
+}
+
+func (d *DocumentService) CreateTemplate(ctx context.Context, req *schedule_service.CreateContractTemplate) (*schedule_service.ContractTemplate, error) {
+	d.log.Info("---CreateContractTemplate--->>>", logger.Any("req", req))
+
+	req.Name = strings.TrimSpace(req.Name)
+	if err := validateContractTemplate(req.Name, req.Body); err != nil {
+		d.log.Error("---CreateContractTemplate--->>>", logger.Error(err))
+		return &schedule_service.ContractTemplate{}, err
+	}
+
+	resp, err := d.strg.Document().CreateTemplate(ctx, req)
+	if err != nil {
+		d.log.Error("---CreateContractTemplate--->>>", logger.Error(err))
+		return &schedule_service.ContractTemplate{}, err
+	}
+
+	return resp, nil
+}
+
+func (d *DocumentService) GetTemplate(ctx context.Context, req *schedule_service.ContractTemplatePrimaryKey) (*schedule_service.ContractTemplate, error) {
+	d.log.Info("---GetContractTemplate--->>>", logger.Any("req", req))
+
+	resp, err := d.strg.Document().GetTemplate(ctx, req)
+	if err != nil {
+		d.log.Error("---GetContractTemplate--->>>", logger.Error(err))
+		return &schedule_service.ContractTemplate{}, err
+	}
+
+	return resp, nil
+}
+
+// GetListTemplate also lists the fields templates can use.
+func (d *DocumentService) GetListTemplate(ctx context.Context, req *schedule_service.GetListContractTemplateRequest) (*schedule_service.GetListContractTemplateResponse, error) {
+	d.log.Info("---GetListContractTemplate--->>>", logger.Any("req", req))
+
+	resp, err := d.strg.Document().GetListTemplate(ctx, req)
+	if err != nil {
+		d.log.Error("---GetListContractTemplate--->>>", logger.Error(err))
+		return &schedule_service.GetListContractTemplateResponse{}, err
+	}
+
+	resp.Fields = contract.Fields
+
+	return resp, nil
+}
+
+func (d *DocumentService) UpdateTemplate(ctx context.Context, req *schedule_service.UpdateContractTemplate) (*schedule_service.ContractTemplate, error) {
+	d.log.Info("---UpdateContractTemplate--->>>", logger.Any("req", req))
+
+	req.Name = strings.TrimSpace(req.Name)
+	if err := validateContractTemplate(req.Name, req.Body); err != nil {
+		d.log.Error("---UpdateContractTemplate--->>>", logger.Error(err))
+		return &schedule_service.ContractTemplate{}, err
+	}
+
+	resp, err := d.strg.Document().UpdateTemplate(ctx, req)
+	if err != nil {
+		d.log.Error("---UpdateContractTemplate--->>>", logger.Error(err))
+		return &schedule_service.ContractTemplate{}, err
+	}
+
+	return resp, nil
+}
+
+func (d *DocumentService) DeleteTemplate(ctx context.Context, req *schedule_service.ContractTemplatePrimaryKey) (*schedule_service.EmptyDocument, error) {
+	d.log.Info("---DeleteContractTemplate--->>>", logger.Any("req", req))
+
+	err := d.strg.Document().DeleteTemplate(ctx, req)
+	if err != nil {
+		d.log.Error("---DeleteContractTemplate--->>>", logger.Error(err))
+		return &schedule_service.EmptyDocument{}, err
+	}
+
+	return &schedule_service.EmptyDocument{}, nil
+}
+
+// RenderContract fills a contract template for a student and, optionally,
+// the group they enroll in. Fields without a value are left blank to be
+// filled in by hand.
+func (d *DocumentService) RenderContract(ctx context.Context, req *schedule_service.RenderContractRequest) (*schedule_service.Contract, error) {
+	d.log.Info("---RenderContract--->>>", logger.Any("req", req))
+
+	if req.StudentId == "" {
+		err := errors.New("studentId is required")
+		d.log.Error("---RenderContract--->>>", logger.Error(err))
+		return &schedule_service.Contract{}, err
+	}
+
+	template, values, err := d.strg.Document().GetContractData(ctx, req)
+	if err != nil {
+		d.log.Error("---RenderContract--->>>", logger.Error(err))
+		return &schedule_service.Contract{}, err
+	}
+
+	return &schedule_service.Contract{
+		TemplateId: template.Id,
+		Title:      template.Name,
+		Body:       contract.Render(template.Body, values),
+		StudentId:  req.StudentId,
+		BranchId:   values["branchId"],
+	}, nil
+}
+
+func validateContractTemplate(name, body string) error {
+	if name == "" {
+		return errors.New("name is required")
+	}
+
+	return contract.Validate(body)
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"schedule_service/config"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/grpc/client"
+	"schedule_service/storage"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+type EventFeedbackService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+}
+
+func NewEventFeedbackService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *EventFeedbackService {
+	return &EventFeedbackService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (f *EventFeedbackService) Create(ctx context.Context, req *schedule_service.CreateEventFeedback) (*schedule_service.EventFeedback, error) {
+	f.log.Info("---CreateEventFeedback--->>>", logger.Any("req", req))
+
+	resp, err := f.strg.EventFeedback().Create(ctx, req)
+	if err != nil {
+		f.log.Error("---CreateEventFeedback--->>>", logger.Error(err))
+		return &schedule_service.EventFeedback{}, err
+	}
+
+	return resp, nil
+}
+
+func (f *EventFeedbackService) GetList(ctx context.Context, req *schedule_service.GetListEventFeedbackRequest) (*schedule_service.GetListEventFeedbackResponse, error) {
+	f.log.Info("---GetAllEventFeedback--->>>", logger.Any("req", req))
+
+	resp, err := f.strg.EventFeedback().GetList(ctx, req)
+	if err != nil {
+		f.log.Error("---GetAllEventFeedback--->>>", logger.Error(err))
+		return &schedule_service.GetListEventFeedbackResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (f *EventFeedbackService) GetEventRating(ctx context.Context, req *schedule_service.EventRatingRequest) (*schedule_service.EventRating, error) {
+	f.log.Info("---GetEventRating--->>>", logger.Any("req", req))
+
+	resp, err := f.strg.EventFeedback().GetEventRating(ctx, req)
+	if err != nil {
+		f.log.Error("---GetEventRating--->>>", logger.Error(err))
+		return &schedule_service.EventRating{}, err
+	}
+
+	return resp, nil
+}
+
+func (f *EventFeedbackService) GetTopicRatings(ctx context.Context, req *schedule_service.TopicRatingRequest) (*schedule_service.TopicRatingResponse, error) {
+	f.log.Info("---GetTopicRatings--->>>", logger.Any("req", req))
+
+	resp, err := f.strg.EventFeedback().GetTopicRatings(ctx, req)
+	if err != nil {
+		f.log.Error("---GetTopicRatings--->>>", logger.Error(err))
+		return &schedule_service.TopicRatingResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// GetLowRatedEvents lists events rated 3 or lower by default.
+func (f *EventFeedbackService) GetLowRatedEvents(ctx context.Context, req *schedule_service.LowRatedEventsRequest) (*schedule_service.LowRatedEventsResponse, error) {
+	f.log.Info("---GetLowRatedEvents--->>>", logger.Any("req", req))
+
+	if req.MaxRating <= 0 {
+		req.MaxRating = 3
+	}
+	if req.MinResponses <= 0 {
+		req.MinResponses = 1
+	}
+
+	resp, err := f.strg.EventFeedback().GetLowRatedEvents(ctx, req)
+	if err != nil {
+		f.log.Error("---GetLowRatedEvents--->>>", logger.Error(err))
+		return &schedule_service.LowRatedEventsResponse{}, err
+	}
+
+	return resp, nil
+}
@@ -2,9 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"schedule_service/config"
 	"schedule_service/genproto/schedule_service"
 	"schedule_service/grpc/client"
+	"schedule_service/pkg/checkin"
 	"schedule_service/storage"
 
 	"github.com/saidamir98/udevs_pkg/logger"
@@ -64,18 +67,6 @@ func (f *EventStudentService) GetList(ctx context.Context, req *schedule_service
 	return resp, nil
 }
 
-func (f *EventStudentService) Update(ctx context.Context, req *schedule_service.UpdateEventStudent) (*schedule_service.GetEventStudent, error) {
-	f.log.Info("---UpdateEveCreateEventStudent--->>>", logger.Any("req", req))
-
-	resp, err := f.strg.EventStudent().Update(ctx, req)
-	if err != nil {
-		f.log.Error("---UpdateEveCreateEventStudent--->>>", logger.Error(err))
-		return &schedule_service.GetEventStudent{}, err
-	}
-
-	return resp, nil
-}
-
 func (f *EventStudentService) Delete(ctx context.Context, req *schedule_service.EventStudentPrimaryKey) (*schedule_service.EmptyEventStudent, error) {
 	f.log.Info("---DeleteEveCreateEventStudent--->>>", logger.Any("req", req))
 
@@ -88,6 +79,18 @@ func (f *EventStudentService) Delete(ctx context.Context, req *schedule_service.
 	return &schedule_service.EmptyEventStudent{}, nil
 }
 
+func (f *EventStudentService) Cancel(ctx context.Context, req *schedule_service.CancelEventStudent) (*schedule_service.GetEventStudent, error) {
+	f.log.Info("---CancelEventStudent--->>>", logger.Any("req", req))
+
+	resp, err := f.strg.EventStudent().Cancel(ctx, req)
+	if err != nil {
+		f.log.Error("---CancelEventStudent--->>>", logger.Error(err))
+		return &schedule_service.GetEventStudent{}, err
+	}
+
+	return resp, nil
+}
+
 func (s *EventStudentService) GetStudentByID(ctx context.Context, req *schedule_service.EventStudentPrimaryKey) (*schedule_service.GetStudentWithEventsResponse, error) {
 	s.log.Info("---GetStudentByID--->>>", logger.Any("req", req))
 
@@ -97,4 +100,77 @@ func (s *EventStudentService) GetStudentByID(ctx context.Context, req *schedule_
 		return &schedule_service.GetStudentWithEventsResponse{}, err
 	}
 	return resp, nil
-}
\ No newline at end of file
+}
+
+// errCheckInDisabled is returned for QR codes while CHECKIN_SECRET is unset,
+// so no deployment signs them with a guessable secret.
+var errCheckInDisabled = errors.New("QR check-in is disabled, CHECKIN_SECRET is not set")
+
+// GetCheckInCode returns the signed payload students show as a QR code at the
+// event entrance.
+func (s *EventStudentService) GetCheckInCode(ctx context.Context, req *schedule_service.EventStudentPrimaryKey) (*schedule_service.CheckInCode, error) {
+	s.log.Info("---GetCheckInCode--->>>", logger.Any("req", req))
+
+	if s.cfg.CheckInSecret == "" {
+		s.log.Error("---GetCheckInCode--->>>", logger.Error(errCheckInDisabled))
+		return &schedule_service.CheckInCode{}, errCheckInDisabled
+	}
+
+	eventStudent, err := s.strg.EventStudent().GetByID(ctx, req)
+	if err != nil {
+		s.log.Error("---GetCheckInCode--->>>", logger.Error(err))
+		return &schedule_service.CheckInCode{}, err
+	}
+
+	if eventStudent.Status != "registered" {
+		err = fmt.Errorf("cannot issue a check-in code for a %s registration", eventStudent.Status)
+		s.log.Error("---GetCheckInCode--->>>", logger.Error(err))
+		return &schedule_service.CheckInCode{}, err
+	}
+
+	return &schedule_service.CheckInCode{
+		EventStudentId: eventStudent.Id,
+		EventId:        eventStudent.EventId,
+		StudentId:      eventStudent.StudentId,
+		Payload:        checkin.Sign(s.cfg.CheckInSecret, eventStudent.Id),
+	}, nil
+}
+
+func (s *EventStudentService) CheckIn(ctx context.Context, req *schedule_service.CheckInEventStudent) (*schedule_service.GetEventStudent, error) {
+	s.log.Info("---CheckIn--->>>", logger.Any("req", req))
+
+	var eventStudentId string
+	if req.Payload != "" {
+		if s.cfg.CheckInSecret == "" {
+			s.log.Error("---CheckIn--->>>", logger.Error(errCheckInDisabled))
+			return &schedule_service.GetEventStudent{}, errCheckInDisabled
+		}
+
+		id, err := checkin.Verify(s.cfg.CheckInSecret, req.Payload)
+		if err != nil {
+			s.log.Error("---CheckIn--->>>", logger.Error(err))
+			return &schedule_service.GetEventStudent{}, err
+		}
+		eventStudentId = id
+	}
+
+	resp, err := s.strg.EventStudent().CheckIn(ctx, eventStudentId, req)
+	if err != nil {
+		s.log.Error("---CheckIn--->>>", logger.Error(err))
+		return &schedule_service.GetEventStudent{}, err
+	}
+
+	return resp, nil
+}
+
+func (s *EventStudentService) GetAttendance(ctx context.Context, req *schedule_service.EventAttendanceRequest) (*schedule_service.EventAttendance, error) {
+	s.log.Info("---GetAttendance--->>>", logger.Any("req", req))
+
+	resp, err := s.strg.EventStudent().GetAttendance(ctx, req)
+	if err != nil {
+		s.log.Error("---GetAttendance--->>>", logger.Error(err))
+		return &schedule_service.EventAttendance{}, err
+	}
+
+	return resp, nil
+}
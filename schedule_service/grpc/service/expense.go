@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"schedule_service/config"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/grpc/client"
+	"schedule_service/pkg/money"
+	"schedule_service/storage"
+	"strings"
+	"time"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+// maxAttachmentSize keeps an attachment well inside a gRPC message.
+const maxAttachmentSize = 2 << 20
+
+type ExpenseService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+}
+
+func NewExpenseService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *ExpenseService {
+	return &ExpenseService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (e *ExpenseService) CreateCategory(ctx context.Context, req *schedule_service.CreateExpenseCategory) (*schedule_service.ExpenseCategory, error) {
+	e.log.Info("---CreateExpenseCategory--->>>", logger.Any("req", req))
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		err := errors.New("name is required")
+		e.log.Error("---CreateExpenseCategory--->>>", logger.Error(err))
+		return &schedule_service.ExpenseCategory{}, err
+	}
+
+	resp, err := e.strg.Expense().CreateCategory(ctx, req)
+	if err != nil {
+		e.log.Error("---CreateExpenseCategory--->>>", logger.Error(err))
+		return &schedule_service.ExpenseCategory{}, err
+	}
+
+	return resp, nil
+}
+
+func (e *ExpenseService) GetListCategory(ctx context.Context, req *schedule_service.GetListExpenseCategoryRequest) (*schedule_service.GetListExpenseCategoryResponse, error) {
+	e.log.Info("---GetListExpenseCategory--->>>", logger.Any("req", req))
+
+	resp, err := e.strg.Expense().GetListCategory(ctx, req)
+	if err != nil {
+		e.log.Error("---GetListExpenseCategory--->>>", logger.Error(err))
+		return &schedule_service.GetListExpenseCategoryResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (e *ExpenseService) DeleteCategory(ctx context.Context, req *schedule_service.ExpenseCategoryPrimaryKey) (*schedule_service.EmptyExpense, error) {
+	e.log.Info("---DeleteExpenseCategory--->>>", logger.Any("req", req))
+
+	err := e.strg.Expense().DeleteCategory(ctx, req)
+	if err != nil {
+		e.log.Error("---DeleteExpenseCategory--->>>", logger.Error(err))
+		return &schedule_service.EmptyExpense{}, err
+	}
+
+	return &schedule_service.EmptyExpense{}, nil
+}
+
+// Create records a pending expense, spent today unless spentOn is given.
+func (e *ExpenseService) Create(ctx context.Context, req *schedule_service.CreateExpense) (*schedule_service.Expense, error) {
+	e.log.Info("---CreateExpense--->>>", logger.Any("req", req))
+
+	if err := validExpense(req.Amount, req.SpentOn); err != nil {
+		e.log.Error("---CreateExpense--->>>", logger.Error(err))
+		return &schedule_service.Expense{}, err
+	}
+
+	resp, err := e.strg.Expense().Create(ctx, req)
+	if err != nil {
+		e.log.Error("---CreateExpense--->>>", logger.Error(err))
+		return &schedule_service.Expense{}, err
+	}
+
+	return resp, nil
+}
+
+func (e *ExpenseService) GetByID(ctx context.Context, req *schedule_service.ExpensePrimaryKey) (*schedule_service.Expense, error) {
+	e.log.Info("---GetExpenseByID--->>>", logger.Any("req", req))
+
+	resp, err := e.strg.Expense().GetByID(ctx, req)
+	if err != nil {
+		e.log.Error("---GetExpenseByID--->>>", logger.Error(err))
+		return &schedule_service.Expense{}, err
+	}
+
+	return resp, nil
+}
+
+func (e *ExpenseService) GetList(ctx context.Context, req *schedule_service.GetListExpenseRequest) (*schedule_service.GetListExpenseResponse, error) {
+	e.log.Info("---GetListExpense--->>>", logger.Any("req", req))
+
+	if err := validateDateRange(req.FromDate, req.ToDate); err != nil {
+		e.log.Error("---GetListExpense--->>>", logger.Error(err))
+		return &schedule_service.GetListExpenseResponse{}, err
+	}
+
+	resp, err := e.strg.Expense().GetList(ctx, req)
+	if err != nil {
+		e.log.Error("---GetListExpense--->>>", logger.Error(err))
+		return &schedule_service.GetListExpenseResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (e *ExpenseService) Update(ctx context.Context, req *schedule_service.UpdateExpense) (*schedule_service.Expense, error) {
+	e.log.Info("---UpdateExpense--->>>", logger.Any("req", req))
+
+	if err := validExpense(req.Amount, req.SpentOn); err != nil {
+		e.log.Error("---UpdateExpense--->>>", logger.Error(err))
+		return &schedule_service.Expense{}, err
+	}
+
+	resp, err := e.strg.Expense().Update(ctx, req)
+	if err != nil {
+		e.log.Error("---UpdateExpense--->>>", logger.Error(err))
+		return &schedule_service.Expense{}, err
+	}
+
+	return resp, nil
+}
+
+func (e *ExpenseService) Delete(ctx context.Context, req *schedule_service.ExpensePrimaryKey) (*schedule_service.EmptyExpense, error) {
+	e.log.Info("---DeleteExpense--->>>", logger.Any("req", req))
+
+	err := e.strg.Expense().Delete(ctx, req)
+	if err != nil {
+		e.log.Error("---DeleteExpense--->>>", logger.Error(err))
+		return &schedule_service.EmptyExpense{}, err
+	}
+
+	return &schedule_service.EmptyExpense{}, nil
+}
+
+// Review approves or rejects a pending expense. Rejections need a note.
+func (e *ExpenseService) Review(ctx context.Context, req *schedule_service.ReviewExpense) (*schedule_service.Expense, error) {
+	e.log.Info("---ReviewExpense--->>>", logger.Any("req", req))
+
+	var err error
+	switch {
+	case req.Status != "approved" && req.Status != "rejected":
+		err = errors.New("status must be approved or rejected")
+	case req.Status == "rejected" && strings.TrimSpace(req.Note) == "":
+		err = errors.New("a note is required to reject an expense")
+	}
+	if err != nil {
+		e.log.Error("---ReviewExpense--->>>", logger.Error(err))
+		return &schedule_service.Expense{}, err
+	}
+
+	resp, err := e.strg.Expense().Review(ctx, req)
+	if err != nil {
+		e.log.Error("---ReviewExpense--->>>", logger.Error(err))
+		return &schedule_service.Expense{}, err
+	}
+
+	return resp, nil
+}
+
+func (e *ExpenseService) AddAttachment(ctx context.Context, req *schedule_service.CreateExpenseAttachment) (*schedule_service.ExpenseAttachment, error) {
+	e.log.Info("---AddExpenseAttachment--->>>", logger.String("expenseId", req.ExpenseId), logger.String("fileName", req.FileName), logger.Any("size", len(req.Content)))
+
+	var err error
+	switch {
+	case len(req.Content) == 0:
+		err = errors.New("attachment is empty")
+	case len(req.Content) > maxAttachmentSize:
+		err = errors.New("attachment is larger than 2 MB")
+	case strings.TrimSpace(req.FileName) == "":
+		err = errors.New("fileName is required")
+	}
+	if err != nil {
+		e.log.Error("---AddExpenseAttachment--->>>", logger.Error(err))
+		return &schedule_service.ExpenseAttachment{}, err
+	}
+
+	if req.ContentType == "" {
+		req.ContentType = "application/octet-stream"
+	}
+
+	resp, err := e.strg.Expense().AddAttachment(ctx, req)
+	if err != nil {
+		e.log.Error("---AddExpenseAttachment--->>>", logger.Error(err))
+		return &schedule_service.ExpenseAttachment{}, err
+	}
+
+	return resp, nil
+}
+
+func (e *ExpenseService) GetAttachment(ctx context.Context, req *schedule_service.ExpenseAttachmentPrimaryKey) (*schedule_service.ExpenseAttachmentFile, error) {
+	e.log.Info("---GetExpenseAttachment--->>>", logger.Any("req", req))
+
+	resp, err := e.strg.Expense().GetAttachment(ctx, req)
+	if err != nil {
+		e.log.Error("---GetExpenseAttachment--->>>", logger.Error(err))
+		return &schedule_service.ExpenseAttachmentFile{}, err
+	}
+
+	return resp, nil
+}
+
+func (e *ExpenseService) DeleteAttachment(ctx context.Context, req *schedule_service.ExpenseAttachmentPrimaryKey) (*schedule_service.EmptyExpense, error) {
+	e.log.Info("---DeleteExpenseAttachment--->>>", logger.Any("req", req))
+
+	err := e.strg.Expense().DeleteAttachment(ctx, req)
+	if err != nil {
+		e.log.Error("---DeleteExpenseAttachment--->>>", logger.Error(err))
+		return &schedule_service.EmptyExpense{}, err
+	}
+
+	return &schedule_service.EmptyExpense{}, nil
+}
+
+func validExpense(amount, spentOn string) error {
+	if _, err := money.Large.ParsePositive(amount); err != nil {
+		return err
+	}
+
+	if spentOn != "" {
+		date, err := time.Parse("2006-01-02", spentOn)
+		if err != nil {
+			return errors.New("invalid spentOn: " + spentOn)
+		}
+		if date.After(time.Now()) {
+			return errors.New("spentOn cannot be in the future")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"schedule_service/config"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/grpc/client"
+	"schedule_service/storage"
+	"time"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+type FinanceReportService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+}
+
+func NewFinanceReportService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *FinanceReportService {
+	return &FinanceReportService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (f *FinanceReportService) GetRevenue(ctx context.Context, req *schedule_service.FinanceReportRequest) (*schedule_service.RevenueReport, error) {
+	f.log.Info("---GetRevenueReport--->>>", logger.Any("req", req))
+
+	if err := reportRange(req); err != nil {
+		f.log.Error("---GetRevenueReport--->>>", logger.Error(err))
+		return &schedule_service.RevenueReport{}, err
+	}
+
+	resp, err := f.strg.FinanceReport().GetRevenue(ctx, req)
+	if err != nil {
+		f.log.Error("---GetRevenueReport--->>>", logger.Error(err))
+		return &schedule_service.RevenueReport{}, err
+	}
+
+	return resp, nil
+}
+
+func (f *FinanceReportService) GetCollections(ctx context.Context, req *schedule_service.FinanceReportRequest) (*schedule_service.CollectionsReport, error) {
+	f.log.Info("---GetCollectionsReport--->>>", logger.Any("req", req))
+
+	if err := reportRange(req); err != nil {
+		f.log.Error("---GetCollectionsReport--->>>", logger.Error(err))
+		return &schedule_service.CollectionsReport{}, err
+	}
+
+	resp, err := f.strg.FinanceReport().GetCollections(ctx, req)
+	if err != nil {
+		f.log.Error("---GetCollectionsReport--->>>", logger.Error(err))
+		return &schedule_service.CollectionsReport{}, err
+	}
+
+	return resp, nil
+}
+
+func (f *FinanceReportService) GetDebtAging(ctx context.Context, req *schedule_service.DebtAgingRequest) (*schedule_service.DebtAgingReport, error) {
+	f.log.Info("---GetDebtAgingReport--->>>", logger.Any("req", req))
+
+	resp, err := f.strg.FinanceReport().GetDebtAging(ctx, req)
+	if err != nil {
+		f.log.Error("---GetDebtAgingReport--->>>", logger.Error(err))
+		return &schedule_service.DebtAgingReport{}, err
+	}
+
+	return resp, nil
+}
+
+func (f *FinanceReportService) GetMonthComparison(ctx context.Context, req *schedule_service.FinanceReportRequest) (*schedule_service.MonthComparisonReport, error) {
+	f.log.Info("---GetMonthComparisonReport--->>>", logger.Any("req", req))
+
+	if err := reportRange(req); err != nil {
+		f.log.Error("---GetMonthComparisonReport--->>>", logger.Error(err))
+		return &schedule_service.MonthComparisonReport{}, err
+	}
+
+	resp, err := f.strg.FinanceReport().GetMonthComparison(ctx, req)
+	if err != nil {
+		f.log.Error("---GetMonthComparisonReport--->>>", logger.Error(err))
+		return &schedule_service.MonthComparisonReport{}, err
+	}
+
+	return resp, nil
+}
+
+// GetProfitAndLoss compares branches by income, payroll cost and expenses.
+func (f *FinanceReportService) GetProfitAndLoss(ctx context.Context, req *schedule_service.FinanceReportRequest) (*schedule_service.ProfitAndLossReport, error) {
+	f.log.Info("---GetProfitAndLoss--->>>", logger.Any("req", req))
+
+	if err := reportRange(req); err != nil {
+		f.log.Error("---GetProfitAndLoss--->>>", logger.Error(err))
+		return &schedule_service.ProfitAndLossReport{}, err
+	}
+
+	resp, err := f.strg.FinanceReport().GetProfitAndLoss(ctx, req)
+	if err != nil {
+		f.log.Error("---GetProfitAndLoss--->>>", logger.Error(err))
+		return &schedule_service.ProfitAndLossReport{}, err
+	}
+
+	return resp, nil
+}
+
+// reportRange defaults a report to the last twelve months, the current one
+// included, and checks the dates.
+func reportRange(req *schedule_service.FinanceReportRequest) error {
+	now := time.Now()
+	if req.ToDate == "" {
+		req.ToDate = now.Format("2006-01-02")
+	}
+	if req.FromDate == "" {
+		req.FromDate = time.Date(now.Year(), now.Month()-11, 1, 0, 0, 0, 0, time.Local).Format("2006-01-02")
+	}
+
+	if err := validateDateRange(req.FromDate, req.ToDate); err != nil {
+		if req.ToDate < req.FromDate {
+			return errors.New("toDate cannot be before fromDate")
+		}
+		return err
+	}
+
+	return nil
+}
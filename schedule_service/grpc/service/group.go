@@ -5,6 +5,7 @@ import (
 	"schedule_service/config"
 	"schedule_service/genproto/schedule_service"
 	"schedule_service/grpc/client"
+	"schedule_service/pkg/notifier"
 	"schedule_service/storage"
 
 	"github.com/saidamir98/udevs_pkg/logger"
@@ -15,6 +16,7 @@ type GroupService struct {
 	log      logger.LoggerI
 	strg     storage.StorageI
 	services client.ServiceManagerI
+	notifier notifier.NotifierI
 }
 
 func NewGroupService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *GroupService {
@@ -23,6 +25,7 @@ func NewGroupService(cfg config.Config, log logger.LoggerI, strg storage.Storage
 		log:      log,
 		strg:     strg,
 		services: srvs,
+		notifier: notifier.New(cfg.NotifyWebhookURL, log),
 	}
 }
 
@@ -73,6 +76,9 @@ func (f *GroupService) Update(ctx context.Context, req *schedule_service.UpdateG
 		return &schedule_service.GetGroup{}, err
 	}
 
+	// A larger capacity may have freed seats for waitlisted students.
+	promoteWaitlist(ctx, f.log, f.strg, f.notifier, req.Id)
+
 	return resp, nil
 }
 
@@ -98,4 +104,32 @@ func (s *GroupService) GetByIDTeacher(ctx context.Context, req *schedule_service
 	}
 
 	return resp, nil
-}
\ No newline at end of file
+}
+func (f *GroupService) PromoteGroup(ctx context.Context, req *schedule_service.PromoteGroupRequest) (*schedule_service.PromoteGroupResponse, error) {
+	f.log.Info("---PromoteGroup--->>>", logger.Any("req", req))
+
+	resp, err := f.strg.Group().PromoteGroup(ctx, req)
+	if err != nil {
+		f.log.Error("---PromoteGroup--->>>", logger.Error(err))
+		return &schedule_service.PromoteGroupResponse{}, err
+	}
+
+	// Repeating students left the promoted group, freeing their seats.
+	if resp.Mode == "move" && len(resp.Repeat) > 0 {
+		promoteWaitlist(ctx, f.log, f.strg, f.notifier, resp.FromGroupId)
+	}
+
+	return resp, nil
+}
+
+func (f *GroupService) GetPromotion(ctx context.Context, req *schedule_service.PromotionPrimaryKey) (*schedule_service.PromoteGroupResponse, error) {
+	f.log.Info("---GetPromotion--->>>", logger.Any("req", req))
+
+	resp, err := f.strg.Group().GetPromotion(ctx, req)
+	if err != nil {
+		f.log.Error("---GetPromotion--->>>", logger.Error(err))
+		return &schedule_service.PromoteGroupResponse{}, err
+	}
+
+	return resp, nil
+}
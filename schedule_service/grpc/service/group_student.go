@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"schedule_service/config"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/grpc/client"
+	"schedule_service/pkg/notifier"
+	"schedule_service/storage"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+type GroupStudentService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	notifier notifier.NotifierI
+}
+
+func NewGroupStudentService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *GroupStudentService {
+	return &GroupStudentService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+		notifier: notifier.New(cfg.NotifyWebhookURL, log),
+	}
+}
+
+func (f *GroupStudentService) Create(ctx context.Context, req *schedule_service.CreateGroupStudent) (*schedule_service.GetGroupStudent, error) {
+	f.log.Info("---CreateGroupStudent--->>>", logger.Any("req", req))
+
+	resp, err := f.strg.GroupStudent().Create(ctx, req)
+	if err != nil {
+		f.log.Error("---CreateGroupStudent--->>>", logger.Error(err))
+		return &schedule_service.GetGroupStudent{}, err
+	}
+
+	return resp, nil
+}
+
+func (f *GroupStudentService) GetByID(ctx context.Context, req *schedule_service.GroupStudentPrimaryKey) (*schedule_service.GetGroupStudent, error) {
+	f.log.Info("---GetSingleGroupStudent--->>>", logger.Any("req", req))
+
+	resp, err := f.strg.GroupStudent().GetByID(ctx, req)
+	if err != nil {
+		f.log.Error("---GetSingleGroupStudent--->>>", logger.Error(err))
+		return &schedule_service.GetGroupStudent{}, err
+	}
+
+	return resp, nil
+}
+
+func (f *GroupStudentService) GetList(ctx context.Context, req *schedule_service.GetListGroupStudentRequest) (*schedule_service.GetListGroupStudentResponse, error) {
+	f.log.Info("---GetAllGroupStudent--->>>", logger.Any("req", req))
+
+	resp, err := f.strg.GroupStudent().GetList(ctx, req)
+	if err != nil {
+		f.log.Error("---GetAllGroupStudent--->>>", logger.Error(err))
+		return &schedule_service.GetListGroupStudentResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (f *GroupStudentService) Delete(ctx context.Context, req *schedule_service.GroupStudentPrimaryKey) (*schedule_service.EmptyGroupStudent, error) {
+	f.log.Info("---DeleteGroupStudent--->>>", logger.Any("req", req))
+
+	groupStudent, err := f.strg.GroupStudent().GetByID(ctx, req)
+	if err != nil {
+		f.log.Error("---DeleteGroupStudent--->>>", logger.Error(err))
+		return &schedule_service.EmptyGroupStudent{}, err
+	}
+
+	err = f.strg.GroupStudent().Delete(ctx, req)
+	if err != nil {
+		f.log.Error("---DeleteGroupStudent--->>>", logger.Error(err))
+		return &schedule_service.EmptyGroupStudent{}, err
+	}
+
+	promoteWaitlist(ctx, f.log, f.strg, f.notifier, groupStudent.GroupId)
+
+	return &schedule_service.EmptyGroupStudent{}, nil
+}
+
+func (f *GroupStudentService) JoinWaitlist(ctx context.Context, req *schedule_service.CreateGroupStudent) (*schedule_service.WaitlistEntry, error) {
+	f.log.Info("---JoinWaitlist--->>>", logger.Any("req", req))
+
+	resp, err := f.strg.GroupStudent().JoinWaitlist(ctx, req)
+	if err != nil {
+		f.log.Error("---JoinWaitlist--->>>", logger.Error(err))
+		return &schedule_service.WaitlistEntry{}, err
+	}
+
+	return resp, nil
+}
+
+func (f *GroupStudentService) GetWaitlist(ctx context.Context, req *schedule_service.GetWaitlistRequest) (*schedule_service.GetWaitlistResponse, error) {
+	f.log.Info("---GetWaitlist--->>>", logger.Any("req", req))
+
+	resp, err := f.strg.GroupStudent().GetWaitlist(ctx, req)
+	if err != nil {
+		f.log.Error("---GetWaitlist--->>>", logger.Error(err))
+		return &schedule_service.GetWaitlistResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (f *GroupStudentService) LeaveWaitlist(ctx context.Context, req *schedule_service.WaitlistPrimaryKey) (*schedule_service.EmptyGroupStudent, error) {
+	f.log.Info("---LeaveWaitlist--->>>", logger.Any("req", req))
+
+	err := f.strg.GroupStudent().LeaveWaitlist(ctx, req)
+	if err != nil {
+		f.log.Error("---LeaveWaitlist--->>>", logger.Error(err))
+		return &schedule_service.EmptyGroupStudent{}, err
+	}
+
+	return &schedule_service.EmptyGroupStudent{}, nil
+}
+
+// promoteWaitlist moves waitlisted students into the seats that are free in
+// the group and notifies about each of them. The seat change that triggered
+// it has already succeeded, so failures are only logged.
+func promoteWaitlist(ctx context.Context, log logger.LoggerI, strg storage.StorageI, notify notifier.NotifierI, groupId string) {
+	promoted, err := strg.GroupStudent().PromoteFromWaitlist(ctx, groupId)
+	if err != nil {
+		log.Error("---PromoteFromWaitlist--->>>", logger.Error(err))
+		return
+	}
+
+	for _, entry := range promoted {
+		notify.Notify(ctx, "group.waitlist.promoted", entry)
+	}
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"schedule_service/config"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/grpc/client"
+	"schedule_service/pkg/ielts"
+	"schedule_service/storage"
+	"strings"
+	"time"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+type MockTestService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+}
+
+func NewMockTestService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *MockTestService {
+	return &MockTestService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+// Create schedules a mock IELTS test for an ielts group, on today unless
+// testDate is given, with the academic reading paper unless readingType says
+// general.
+func (m *MockTestService) Create(ctx context.Context, req *schedule_service.CreateMockTest) (*schedule_service.MockTest, error) {
+	m.log.Info("---CreateMockTest--->>>", logger.Any("req", req))
+
+	req.Title = strings.TrimSpace(req.Title)
+	if req.TestDate == "" {
+		req.TestDate = time.Now().Format("2006-01-02")
+	}
+	if req.ReadingType == "" {
+		req.ReadingType = ielts.ReadingAcademic
+	}
+
+	var err error
+	if req.Title == "" {
+		err = errors.New("title is required")
+	}
+	if err == nil {
+		err = validateDateRange(req.TestDate, "")
+	}
+	if err == nil {
+		err = ielts.ValidReadingType(req.ReadingType)
+	}
+	if err != nil {
+		m.log.Error("---CreateMockTest--->>>", logger.Error(err))
+		return &schedule_service.MockTest{}, err
+	}
+
+	resp, err := m.strg.MockTest().Create(ctx, req)
+	if err != nil {
+		m.log.Error("---CreateMockTest--->>>", logger.Error(err))
+		return &schedule_service.MockTest{}, err
+	}
+
+	return resp, nil
+}
+
+func (m *MockTestService) GetByID(ctx context.Context, req *schedule_service.MockTestPrimaryKey) (*schedule_service.MockTest, error) {
+	m.log.Info("---GetMockTestByID--->>>", logger.Any("req", req))
+
+	resp, err := m.strg.MockTest().GetByID(ctx, req)
+	if err != nil {
+		m.log.Error("---GetMockTestByID--->>>", logger.Error(err))
+		return &schedule_service.MockTest{}, err
+	}
+
+	return resp, nil
+}
+
+func (m *MockTestService) GetList(ctx context.Context, req *schedule_service.GetListMockTestRequest) (*schedule_service.GetListMockTestResponse, error) {
+	m.log.Info("---GetListMockTest--->>>", logger.Any("req", req))
+
+	if err := validateDateRange(req.FromDate, req.ToDate); err != nil {
+		m.log.Error("---GetListMockTest--->>>", logger.Error(err))
+		return &schedule_service.GetListMockTestResponse{}, err
+	}
+
+	resp, err := m.strg.MockTest().GetList(ctx, req)
+	if err != nil {
+		m.log.Error("---GetListMockTest--->>>", logger.Error(err))
+		return &schedule_service.GetListMockTestResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (m *MockTestService) Delete(ctx context.Context, req *schedule_service.MockTestPrimaryKey) (*schedule_service.EmptyMockTest, error) {
+	m.log.Info("---DeleteMockTest--->>>", logger.Any("req", req))
+
+	err := m.strg.MockTest().Delete(ctx, req)
+	if err != nil {
+		m.log.Error("---DeleteMockTest--->>>", logger.Error(err))
+		return &schedule_service.EmptyMockTest{}, err
+	}
+
+	return &schedule_service.EmptyMockTest{}, nil
+}
+
+// SaveResult records a student's scores on a mock test. Listening and
+// Reading raw scores are converted to bands on the official tables, Writing
+// and Speaking bands follow from their criterion scores, and the overall band
+// from the four.
+func (m *MockTestService) SaveResult(ctx context.Context, req *schedule_service.SaveMockTestResult) (*schedule_service.MockTestResult, error) {
+	m.log.Info("---SaveMockTestResult--->>>", logger.Any("req", req))
+
+	test, err := m.strg.MockTest().GetByID(ctx, &schedule_service.MockTestPrimaryKey{Id: req.MockTestId})
+	if err != nil {
+		m.log.Error("---SaveMockTestResult--->>>", logger.Error(err))
+		return &schedule_service.MockTestResult{}, err
+	}
+
+	result := &schedule_service.MockTestResult{
+		MockTestId:            req.MockTestId,
+		StudentId:             req.StudentId,
+		ListeningRaw:          req.ListeningRaw,
+		ReadingRaw:            req.ReadingRaw,
+		WritingTaskResponse:   req.WritingTaskResponse,
+		WritingCoherence:      req.WritingCoherence,
+		WritingLexical:        req.WritingLexical,
+		WritingGrammar:        req.WritingGrammar,
+		SpeakingFluency:       req.SpeakingFluency,
+		SpeakingLexical:       req.SpeakingLexical,
+		SpeakingGrammar:       req.SpeakingGrammar,
+		SpeakingPronunciation: req.SpeakingPronunciation,
+		Bands:                 &schedule_service.MockBands{},
+	}
+
+	bands := result.Bands
+	bands.Listening, err = ielts.ListeningBand(req.ListeningRaw)
+	if err == nil {
+		bands.Reading, err = ielts.ReadingBand(test.ReadingType, req.ReadingRaw)
+	}
+	if err == nil {
+		bands.Writing, err = ielts.CriteriaBand(req.WritingTaskResponse, req.WritingCoherence, req.WritingLexical, req.WritingGrammar)
+	}
+	if err == nil {
+		bands.Speaking, err = ielts.CriteriaBand(req.SpeakingFluency, req.SpeakingLexical, req.SpeakingGrammar, req.SpeakingPronunciation)
+	}
+	if err != nil {
+		m.log.Error("---SaveMockTestResult--->>>", logger.Error(err))
+		return &schedule_service.MockTestResult{}, err
+	}
+	bands.Overall = ielts.OverallBand(bands.Listening, bands.Reading, bands.Writing, bands.Speaking)
+
+	resp, err := m.strg.MockTest().SaveResult(ctx, result)
+	if err != nil {
+		m.log.Error("---SaveMockTestResult--->>>", logger.Error(err))
+		return &schedule_service.MockTestResult{}, err
+	}
+
+	return resp, nil
+}
+
+func (m *MockTestService) DeleteResult(ctx context.Context, req *schedule_service.MockTestResultPrimaryKey) (*schedule_service.EmptyMockTest, error) {
+	m.log.Info("---DeleteMockTestResult--->>>", logger.Any("req", req))
+
+	err := m.strg.MockTest().DeleteResult(ctx, req)
+	if err != nil {
+		m.log.Error("---DeleteMockTestResult--->>>", logger.Error(err))
+		return &schedule_service.EmptyMockTest{}, err
+	}
+
+	return &schedule_service.EmptyMockTest{}, nil
+}
+
+func (m *MockTestService) GetStudentProgress(ctx context.Context, req *schedule_service.StudentMockProgressRequest) (*schedule_service.StudentMockProgress, error) {
+	m.log.Info("---GetStudentMockProgress--->>>", logger.Any("req", req))
+
+	if err := validateDateRange(req.FromDate, req.ToDate); err != nil {
+		m.log.Error("---GetStudentMockProgress--->>>", logger.Error(err))
+		return &schedule_service.StudentMockProgress{}, err
+	}
+
+	resp, err := m.strg.MockTest().GetStudentProgress(ctx, req)
+	if err != nil {
+		m.log.Error("---GetStudentMockProgress--->>>", logger.Error(err))
+		return &schedule_service.StudentMockProgress{}, err
+	}
+
+	return resp, nil
+}
+
+func (m *MockTestService) GetGroupAverages(ctx context.Context, req *schedule_service.GroupMockAveragesRequest) (*schedule_service.GroupMockAverages, error) {
+	m.log.Info("---GetGroupMockAverages--->>>", logger.Any("req", req))
+
+	if err := validateDateRange(req.FromDate, req.ToDate); err != nil {
+		m.log.Error("---GetGroupMockAverages--->>>", logger.Error(err))
+		return &schedule_service.GroupMockAverages{}, err
+	}
+
+	resp, err := m.strg.MockTest().GetGroupAverages(ctx, req)
+	if err != nil {
+		m.log.Error("---GetGroupMockAverages--->>>", logger.Error(err))
+		return &schedule_service.GroupMockAverages{}, err
+	}
+
+	return resp, nil
+}
@@ -96,4 +96,15 @@ func (s *JournalService) GetByIDStudent(ctx context.Context, req *schedule_servi
 	}
 
 	return resp, nil
-}
\ No newline at end of file
+}
+func (j *JournalService) GetRolloverReport(ctx context.Context, req *schedule_service.GetRolloverReportRequest) (*schedule_service.GetRolloverReportResponse, error) {
+	j.log.Info("---GetRolloverReport--->>>", logger.Any("req", req))
+
+	resp, err := j.strg.Journal().GetRolloverReport(ctx, req)
+	if err != nil {
+		j.log.Error("---GetRolloverReport--->>>", logger.Error(err))
+		return &schedule_service.GetRolloverReportResponse{}, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"schedule_service/config"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/grpc/client"
+	"schedule_service/pkg/money"
+	"schedule_service/pkg/notifier"
+	"schedule_service/pkg/payment"
+	"schedule_service/storage"
+	"time"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+type OnlinePaymentService struct {
+	cfg       config.Config
+	log       logger.LoggerI
+	strg      storage.StorageI
+	services  client.ServiceManagerI
+	notifier  notifier.NotifierI
+	providers map[string]payment.Provider
+	mock      *payment.Mock
+}
+
+// NewOnlinePaymentService enables Click and Payme when their keys are set and
+// the mock provider when its secret is set.
+func NewOnlinePaymentService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *OnlinePaymentService {
+	o := &OnlinePaymentService{
+		cfg:       cfg,
+		log:       log,
+		strg:      strg,
+		services:  srvs,
+		notifier:  notifier.New(cfg.NotifyWebhookURL, log),
+		providers: map[string]payment.Provider{},
+	}
+
+	if cfg.ClickSecretKey != "" {
+		click := payment.NewClick(cfg.ClickServiceID, cfg.ClickMerchantID, cfg.ClickSecretKey)
+		o.providers[click.Name()] = click
+	}
+
+	if cfg.PaymeKey != "" {
+		payme := payment.NewPayme(cfg.PaymeMerchantID, cfg.PaymeKey, cfg.PaymeCheckoutURL)
+		o.providers[payme.Name()] = payme
+	}
+
+	if cfg.MockPaymentSecret != "" {
+		o.mock = payment.NewMock(cfg.MockPaymentSecret, cfg.MockPaymentCheckoutURL)
+		o.providers[o.mock.Name()] = o.mock
+	}
+
+	return o
+}
+
+// Create starts an online payment and returns the checkout URL the student
+// pays at.
+func (o *OnlinePaymentService) Create(ctx context.Context, req *schedule_service.CreateOnlinePayment) (*schedule_service.OnlinePayment, error) {
+	o.log.Info("---CreateOnlinePayment--->>>", logger.Any("req", req))
+
+	var err error
+	switch {
+	case req.StudentId == "" || req.GroupId == "":
+		err = errors.New("studentId and groupId are required")
+	case o.providers[req.Provider] == nil:
+		err = fmt.Errorf("payment provider %q is not enabled", req.Provider)
+	default:
+		_, err = money.Amount.ParsePositive(req.Amount)
+	}
+	if err != nil {
+		o.log.Error("---CreateOnlinePayment--->>>", logger.Error(err))
+		return &schedule_service.OnlinePayment{}, err
+	}
+
+	resp, err := o.strg.OnlinePayment().Create(ctx, req)
+	if err != nil {
+		o.log.Error("---CreateOnlinePayment--->>>", logger.Error(err))
+		return &schedule_service.OnlinePayment{}, err
+	}
+
+	return o.withCheckoutURL(resp), nil
+}
+
+func (o *OnlinePaymentService) GetByID(ctx context.Context, req *schedule_service.OnlinePaymentPrimaryKey) (*schedule_service.OnlinePayment, error) {
+	o.log.Info("---GetOnlinePaymentByID--->>>", logger.Any("req", req))
+
+	resp, err := o.strg.OnlinePayment().GetByID(ctx, req)
+	if err != nil {
+		o.log.Error("---GetOnlinePaymentByID--->>>", logger.Error(err))
+		return &schedule_service.OnlinePayment{}, err
+	}
+
+	return o.withCheckoutURL(resp), nil
+}
+
+func (o *OnlinePaymentService) GetList(ctx context.Context, req *schedule_service.GetListOnlinePaymentRequest) (*schedule_service.GetListOnlinePaymentResponse, error) {
+	o.log.Info("---GetListOnlinePayment--->>>", logger.Any("req", req))
+
+	resp, err := o.strg.OnlinePayment().GetList(ctx, req)
+	if err != nil {
+		o.log.Error("---GetListOnlinePayment--->>>", logger.Error(err))
+		return &schedule_service.GetListOnlinePaymentResponse{}, err
+	}
+
+	for _, onlinePayment := range resp.OnlinePayments {
+		o.withCheckoutURL(onlinePayment)
+	}
+
+	return resp, nil
+}
+
+// HandleCallback verifies and applies a provider callback. Failures the
+// provider should hear about are reported in the reply body in the
+// provider's own format rather than as an error.
+func (o *OnlinePaymentService) HandleCallback(ctx context.Context, req *schedule_service.OnlinePaymentCallback) (*schedule_service.OnlinePaymentCallbackReply, error) {
+	o.log.Info("---HandleOnlinePaymentCallback--->>>", logger.Any("req", req))
+
+	provider := o.providers[req.Provider]
+	if provider == nil {
+		err := fmt.Errorf("payment provider %q is not enabled", req.Provider)
+		o.log.Error("---HandleOnlinePaymentCallback--->>>", logger.Error(err))
+		return &schedule_service.OnlinePaymentCallbackReply{}, err
+	}
+
+	body, _, _ := o.handleCallback(ctx, provider, req.Authorization, req.Payload)
+
+	return &schedule_service.OnlinePaymentCallbackReply{Body: string(body)}, nil
+}
+
+// Simulate plays the callback of the mock provider for a mock payment, by
+// default the completed one, so the whole flow can be tried offline.
+func (o *OnlinePaymentService) Simulate(ctx context.Context, req *schedule_service.SimulateOnlinePayment) (*schedule_service.OnlinePayment, error) {
+	o.log.Info("---SimulateOnlinePayment--->>>", logger.Any("req", req))
+
+	if req.Stage == "" {
+		req.Stage = payment.StageComplete
+	}
+
+	onlinePayment, err := o.strg.OnlinePayment().GetByID(ctx, &schedule_service.OnlinePaymentPrimaryKey{Id: req.Id})
+	if err == nil && (o.mock == nil || onlinePayment.Provider != o.mock.Name()) {
+		err = errors.New("only payments made with the enabled mock provider can be simulated")
+	}
+	if err != nil {
+		o.log.Error("---SimulateOnlinePayment--->>>", logger.Error(err))
+		return &schedule_service.OnlinePayment{}, err
+	}
+
+	amount, err := money.Amount.Parse(onlinePayment.Amount)
+	if err != nil {
+		o.log.Error("---SimulateOnlinePayment--->>>", logger.Error(err))
+		return &schedule_service.OnlinePayment{}, err
+	}
+
+	_, resp, err := o.handleCallback(ctx, o.mock, "", o.mock.Callback(onlinePayment.Id, "mock-"+onlinePayment.Id, amount, req.Stage))
+	if err != nil {
+		o.log.Error("---SimulateOnlinePayment--->>>", logger.Error(err))
+		return &schedule_service.OnlinePayment{}, err
+	}
+
+	return o.withCheckoutURL(resp), nil
+}
+
+// Reconcile compares the provider statement of fromDate to toDate, both
+// "2006-01-02" and today when empty, with the payments recorded here.
+func (o *OnlinePaymentService) Reconcile(ctx context.Context, req *schedule_service.ReconcileOnlinePaymentRequest) (*schedule_service.OnlinePaymentReconciliation, error) {
+	o.log.Info("---ReconcileOnlinePayment--->>>", logger.Any("req", req))
+
+	today := time.Now().Format("2006-01-02")
+	if req.FromDate == "" {
+		req.FromDate = today
+	}
+	if req.ToDate == "" {
+		req.ToDate = today
+	}
+
+	err := validateDateRange(req.FromDate, req.ToDate)
+	if err == nil && req.Provider == "" {
+		err = errors.New("provider is required")
+	}
+	if err != nil {
+		o.log.Error("---ReconcileOnlinePayment--->>>", logger.Error(err))
+		return &schedule_service.OnlinePaymentReconciliation{}, err
+	}
+
+	resp, err := o.strg.OnlinePayment().Reconcile(ctx, req)
+	if err != nil {
+		o.log.Error("---ReconcileOnlinePayment--->>>", logger.Error(err))
+		return &schedule_service.OnlinePaymentReconciliation{}, err
+	}
+
+	return resp, nil
+}
+
+// handleCallback verifies, applies and logs a callback and returns the reply
+// for the provider along with the payment it changed. The authorization
+// header is checked for providers that use it and is never logged.
+func (o *OnlinePaymentService) handleCallback(ctx context.Context, provider payment.Provider, authorization string, payload map[string]string) ([]byte, *schedule_service.OnlinePayment, error) {
+	var (
+		onlinePayment *schedule_service.OnlinePayment
+		callback      *payment.Callback
+		captured      bool
+		err           error
+	)
+
+	if authorizer, ok := provider.(payment.Authorizer); ok {
+		err = authorizer.Authorize(authorization)
+	}
+	if err == nil {
+		callback, err = provider.Parse(payload)
+	}
+	if err == nil {
+		onlinePayment, captured, err = o.strg.OnlinePayment().Capture(ctx, provider.Name(), callback)
+	}
+	if err != nil {
+		o.log.Error("---HandleOnlinePaymentCallback--->>>", logger.Error(err))
+	}
+
+	if logErr := o.strg.OnlinePayment().LogCallback(ctx, provider.Name(), payload, callback, err); logErr != nil {
+		o.log.Error("---HandleOnlinePaymentCallback--->>>", logger.Error(logErr))
+	}
+
+	if captured {
+		o.notifier.Notify(ctx, "payment.online.paid", onlinePayment)
+	}
+
+	return provider.Reply(payload, callback, err), onlinePayment, err
+}
+
+func (o *OnlinePaymentService) withCheckoutURL(onlinePayment *schedule_service.OnlinePayment) *schedule_service.OnlinePayment {
+	provider := o.providers[onlinePayment.Provider]
+	if onlinePayment.Status != "pending" || provider == nil {
+		return onlinePayment
+	}
+
+	amount, err := money.Amount.Parse(onlinePayment.Amount)
+	if err != nil {
+		return onlinePayment
+	}
+
+	onlinePayment.CheckoutUrl = provider.CheckoutURL(payment.Order{
+		ID:        onlinePayment.Id,
+		Amount:    amount,
+		ReturnURL: onlinePayment.ReturnUrl,
+	})
+
+	return onlinePayment
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"schedule_service/config"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/grpc/client"
+	"schedule_service/pkg/notifier"
+	"schedule_service/storage"
+	"strings"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+type OverdueService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	notifier notifier.NotifierI
+}
+
+func NewOverdueService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *OverdueService {
+	return &OverdueService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+		notifier: notifier.New(cfg.NotifyWebhookURL, log),
+	}
+}
+
+func (o *OverdueService) GetList(ctx context.Context, req *schedule_service.GetListOverdueRequest) (*schedule_service.GetListOverdueResponse, error) {
+	o.log.Info("---GetListOverdue--->>>", logger.Any("req", req))
+
+	resp, err := o.strg.Overdue().GetList(ctx, req)
+	if err != nil {
+		o.log.Error("---GetListOverdue--->>>", logger.Error(err))
+		return &schedule_service.GetListOverdueResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (o *OverdueService) CreateHold(ctx context.Context, req *schedule_service.CreateStudentHold) (*schedule_service.StudentHold, error) {
+	o.log.Info("---CreateStudentHold--->>>", logger.Any("req", req))
+
+	if req.StudentId == "" || strings.TrimSpace(req.Reason) == "" {
+		err := errors.New("studentId and reason are required")
+		o.log.Error("---CreateStudentHold--->>>", logger.Error(err))
+		return &schedule_service.StudentHold{}, err
+	}
+
+	resp, err := o.strg.Overdue().CreateHold(ctx, req)
+	if err != nil {
+		o.log.Error("---CreateStudentHold--->>>", logger.Error(err))
+		return &schedule_service.StudentHold{}, err
+	}
+
+	o.notifier.Notify(ctx, "student.hold.created", resp)
+
+	return resp, nil
+}
+
+func (o *OverdueService) ClearHold(ctx context.Context, req *schedule_service.ClearStudentHold) (*schedule_service.StudentHold, error) {
+	o.log.Info("---ClearStudentHold--->>>", logger.Any("req", req))
+
+	resp, err := o.strg.Overdue().ClearHold(ctx, req)
+	if err != nil {
+		o.log.Error("---ClearStudentHold--->>>", logger.Error(err))
+		return &schedule_service.StudentHold{}, err
+	}
+
+	o.notifier.Notify(ctx, "student.hold.cleared", resp)
+
+	return resp, nil
+}
+
+func (o *OverdueService) GetListHold(ctx context.Context, req *schedule_service.GetListStudentHoldRequest) (*schedule_service.GetListStudentHoldResponse, error) {
+	o.log.Info("---GetListStudentHold--->>>", logger.Any("req", req))
+
+	resp, err := o.strg.Overdue().GetListHold(ctx, req)
+	if err != nil {
+		o.log.Error("---GetListStudentHold--->>>", logger.Error(err))
+		return &schedule_service.GetListStudentHoldResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (o *OverdueService) GetHold(ctx context.Context, req *schedule_service.StudentHoldRequest) (*schedule_service.StudentHoldStatus, error) {
+	o.log.Info("---GetStudentHold--->>>", logger.Any("req", req))
+
+	resp, err := o.strg.Overdue().GetHold(ctx, req)
+	if err != nil {
+		o.log.Error("---GetStudentHold--->>>", logger.Error(err))
+		return &schedule_service.StudentHoldStatus{}, err
+	}
+
+	return resp, nil
+}
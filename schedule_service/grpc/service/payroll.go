@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"schedule_service/config"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/grpc/client"
+	"schedule_service/pkg/money"
+	"schedule_service/pkg/notifier"
+	"schedule_service/storage"
+	"strings"
+	"time"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+// staffTypes are the kinds of staff that can be on the payroll.
+var staffTypes = []string{"teacher", "support_teacher", "administration", "manager"}
+
+type PayrollService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	notifier notifier.NotifierI
+}
+
+func NewPayrollService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *PayrollService {
+	return &PayrollService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+		notifier: notifier.New(cfg.NotifyWebhookURL, log),
+	}
+}
+
+// CreateRule sets how a staff member is paid from a month on: a monthly fee,
+// a rate per lesson delivered, a rate per student present, or any mix.
+func (p *PayrollService) CreateRule(ctx context.Context, req *schedule_service.CreatePayRule) (*schedule_service.PayRule, error) {
+	p.log.Info("---CreatePayRule--->>>", logger.Any("req", req))
+
+	err := validStaffType(req.StaffType)
+	if err == nil {
+		err = payRates(req)
+	}
+	if err != nil {
+		p.log.Error("---CreatePayRule--->>>", logger.Error(err))
+		return &schedule_service.PayRule{}, err
+	}
+
+	resp, err := p.strg.Payroll().CreateRule(ctx, req)
+	if err != nil {
+		p.log.Error("---CreatePayRule--->>>", logger.Error(err))
+		return &schedule_service.PayRule{}, err
+	}
+
+	return resp, nil
+}
+
+func (p *PayrollService) GetListRule(ctx context.Context, req *schedule_service.GetListPayRuleRequest) (*schedule_service.GetListPayRuleResponse, error) {
+	p.log.Info("---GetListPayRule--->>>", logger.Any("req", req))
+
+	resp, err := p.strg.Payroll().GetListRule(ctx, req)
+	if err != nil {
+		p.log.Error("---GetListPayRule--->>>", logger.Error(err))
+		return &schedule_service.GetListPayRuleResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (p *PayrollService) DeleteRule(ctx context.Context, req *schedule_service.PayRulePrimaryKey) (*schedule_service.EmptyPayroll, error) {
+	p.log.Info("---DeletePayRule--->>>", logger.Any("req", req))
+
+	err := p.strg.Payroll().DeleteRule(ctx, req)
+	if err != nil {
+		p.log.Error("---DeletePayRule--->>>", logger.Error(err))
+		return &schedule_service.EmptyPayroll{}, err
+	}
+
+	return &schedule_service.EmptyPayroll{}, nil
+}
+
+// CreateAdjustment adds a bonus or a penalty to a staff member's pay for a
+// month, "2006-01", or the current one.
+func (p *PayrollService) CreateAdjustment(ctx context.Context, req *schedule_service.CreatePayrollAdjustment) (*schedule_service.PayrollAdjustment, error) {
+	p.log.Info("---CreatePayrollAdjustment--->>>", logger.Any("req", req))
+
+	period, err := billingPeriod(req.Period)
+	if err == nil {
+		req.Period = period
+		err = validStaffType(req.StaffType)
+	}
+	if err == nil && req.Kind != "bonus" && req.Kind != "penalty" {
+		err = errors.New("kind must be bonus or penalty")
+	}
+	if err == nil {
+		_, err = money.Large.ParsePositive(req.Amount)
+	}
+	if err == nil && strings.TrimSpace(req.Reason) == "" {
+		err = errors.New("reason is required")
+	}
+	if err != nil {
+		p.log.Error("---CreatePayrollAdjustment--->>>", logger.Error(err))
+		return &schedule_service.PayrollAdjustment{}, err
+	}
+
+	resp, err := p.strg.Payroll().CreateAdjustment(ctx, req)
+	if err != nil {
+		p.log.Error("---CreatePayrollAdjustment--->>>", logger.Error(err))
+		return &schedule_service.PayrollAdjustment{}, err
+	}
+
+	return resp, nil
+}
+
+func (p *PayrollService) GetListAdjustment(ctx context.Context, req *schedule_service.GetListPayrollAdjustmentRequest) (*schedule_service.GetListPayrollAdjustmentResponse, error) {
+	p.log.Info("---GetListPayrollAdjustment--->>>", logger.Any("req", req))
+
+	if req.Period != "" {
+		period, err := billingPeriod(req.Period)
+		if err != nil {
+			p.log.Error("---GetListPayrollAdjustment--->>>", logger.Error(err))
+			return &schedule_service.GetListPayrollAdjustmentResponse{}, err
+		}
+		req.Period = period
+	}
+
+	resp, err := p.strg.Payroll().GetListAdjustment(ctx, req)
+	if err != nil {
+		p.log.Error("---GetListPayrollAdjustment--->>>", logger.Error(err))
+		return &schedule_service.GetListPayrollAdjustmentResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (p *PayrollService) DeleteAdjustment(ctx context.Context, req *schedule_service.PayrollAdjustmentPrimaryKey) (*schedule_service.EmptyPayroll, error) {
+	p.log.Info("---DeletePayrollAdjustment--->>>", logger.Any("req", req))
+
+	err := p.strg.Payroll().DeleteAdjustment(ctx, req)
+	if err != nil {
+		p.log.Error("---DeletePayrollAdjustment--->>>", logger.Error(err))
+		return &schedule_service.EmptyPayroll{}, err
+	}
+
+	return &schedule_service.EmptyPayroll{}, nil
+}
+
+// CreateRun computes the payroll of a month, "2006-01", or the current one.
+// Months that have not started cannot be run.
+func (p *PayrollService) CreateRun(ctx context.Context, req *schedule_service.CreatePayrollRun) (*schedule_service.PayrollRun, error) {
+	p.log.Info("---CreatePayrollRun--->>>", logger.Any("req", req))
+
+	period, err := billingPeriod(req.Period)
+	if err == nil && period > time.Now().Format("2006-01")+"-01" {
+		err = errors.New("payroll cannot be run for a future month")
+	}
+	if err != nil {
+		p.log.Error("---CreatePayrollRun--->>>", logger.Error(err))
+		return &schedule_service.PayrollRun{}, err
+	}
+	req.Period = period
+
+	resp, err := p.strg.Payroll().CreateRun(ctx, req)
+	if err != nil {
+		p.log.Error("---CreatePayrollRun--->>>", logger.Error(err))
+		return &schedule_service.PayrollRun{}, err
+	}
+
+	return resp, nil
+}
+
+func (p *PayrollService) RecalculateRun(ctx context.Context, req *schedule_service.PayrollRunPrimaryKey) (*schedule_service.PayrollRun, error) {
+	p.log.Info("---RecalculatePayrollRun--->>>", logger.Any("req", req))
+
+	resp, err := p.strg.Payroll().RecalculateRun(ctx, req)
+	if err != nil {
+		p.log.Error("---RecalculatePayrollRun--->>>", logger.Error(err))
+		return &schedule_service.PayrollRun{}, err
+	}
+
+	return resp, nil
+}
+
+func (p *PayrollService) LockRun(ctx context.Context, req *schedule_service.LockPayrollRun) (*schedule_service.PayrollRun, error) {
+	p.log.Info("---LockPayrollRun--->>>", logger.Any("req", req))
+
+	resp, err := p.strg.Payroll().LockRun(ctx, req)
+	if err != nil {
+		p.log.Error("---LockPayrollRun--->>>", logger.Error(err))
+		return &schedule_service.PayrollRun{}, err
+	}
+
+	p.notifier.Notify(ctx, "payroll.locked", map[string]interface{}{
+		"runId":      resp.Id,
+		"period":     resp.Period,
+		"staffCount": resp.StaffCount,
+		"total":      resp.Total,
+	})
+
+	return resp, nil
+}
+
+func (p *PayrollService) GetRun(ctx context.Context, req *schedule_service.GetPayrollRunRequest) (*schedule_service.PayrollRun, error) {
+	p.log.Info("---GetPayrollRun--->>>", logger.Any("req", req))
+
+	resp, err := p.strg.Payroll().GetRun(ctx, req)
+	if err != nil {
+		p.log.Error("---GetPayrollRun--->>>", logger.Error(err))
+		return &schedule_service.PayrollRun{}, err
+	}
+
+	return resp, nil
+}
+
+func (p *PayrollService) GetListRun(ctx context.Context, req *schedule_service.GetListPayrollRunRequest) (*schedule_service.GetListPayrollRunResponse, error) {
+	p.log.Info("---GetListPayrollRun--->>>", logger.Any("req", req))
+
+	resp, err := p.strg.Payroll().GetListRun(ctx, req)
+	if err != nil {
+		p.log.Error("---GetListPayrollRun--->>>", logger.Error(err))
+		return &schedule_service.GetListPayrollRunResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func validStaffType(staffType string) error {
+	for _, valid := range staffTypes {
+		if staffType == valid {
+			return nil
+		}
+	}
+
+	return errors.New("staffType must be teacher, support_teacher, administration or manager")
+}
+
+// payRates defaults missing rates to zero and requires at least one of them.
+func payRates(req *schedule_service.CreatePayRule) error {
+	var sum int64
+	for _, rate := range []*string{&req.MonthlyFee, &req.PerLesson, &req.PerStudent} {
+		if *rate == "" {
+			*rate = "0"
+		}
+
+		amount, err := money.Large.Parse(*rate)
+		if err != nil {
+			return err
+		}
+		if amount < 0 {
+			return errors.New("pay rates cannot be negative")
+		}
+		sum += amount
+	}
+
+	if sum == 0 {
+		return errors.New("a pay rule needs a monthly fee, a per-lesson or a per-student rate")
+	}
+
+	return nil
+}
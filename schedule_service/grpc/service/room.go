@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"schedule_service/config"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/grpc/client"
+	"schedule_service/pkg/timetable"
+	"schedule_service/storage"
+	"time"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+type RoomService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+}
+
+func NewRoomService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *RoomService {
+	return &RoomService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (r *RoomService) Create(ctx context.Context, req *schedule_service.CreateRoom) (*schedule_service.Room, error) {
+	r.log.Info("---CreateRoom--->>>", logger.Any("req", req))
+
+	err := validateRoom(req.Name, req.Capacity, req.OpenTime, req.CloseTime)
+	if err == nil && req.BranchId == "" {
+		err = errors.New("branchId is required")
+	}
+	if err != nil {
+		r.log.Error("---CreateRoom--->>>", logger.Error(err))
+		return &schedule_service.Room{}, err
+	}
+
+	resp, err := r.strg.Room().Create(ctx, req)
+	if err != nil {
+		r.log.Error("---CreateRoom--->>>", logger.Error(err))
+		return &schedule_service.Room{}, err
+	}
+
+	return resp, nil
+}
+
+func (r *RoomService) GetByID(ctx context.Context, req *schedule_service.RoomPrimaryKey) (*schedule_service.Room, error) {
+	r.log.Info("---GetSingleRoom--->>>", logger.Any("req", req))
+
+	resp, err := r.strg.Room().GetByID(ctx, req)
+	if err != nil {
+		r.log.Error("---GetSingleRoom--->>>", logger.Error(err))
+		return &schedule_service.Room{}, err
+	}
+
+	return resp, nil
+}
+
+func (r *RoomService) GetList(ctx context.Context, req *schedule_service.GetListRoomRequest) (*schedule_service.GetListRoomResponse, error) {
+	r.log.Info("---GetAllRoom--->>>", logger.Any("req", req))
+
+	resp, err := r.strg.Room().GetList(ctx, req)
+	if err != nil {
+		r.log.Error("---GetAllRoom--->>>", logger.Error(err))
+		return &schedule_service.GetListRoomResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (r *RoomService) Update(ctx context.Context, req *schedule_service.UpdateRoom) (*schedule_service.Room, error) {
+	r.log.Info("---UpdateRoom--->>>", logger.Any("req", req))
+
+	if err := validateRoom(req.Name, req.Capacity, req.OpenTime, req.CloseTime); err != nil {
+		r.log.Error("---UpdateRoom--->>>", logger.Error(err))
+		return &schedule_service.Room{}, err
+	}
+
+	resp, err := r.strg.Room().Update(ctx, req)
+	if err != nil {
+		r.log.Error("---UpdateRoom--->>>", logger.Error(err))
+		return &schedule_service.Room{}, err
+	}
+
+	return resp, nil
+}
+
+func (r *RoomService) Delete(ctx context.Context, req *schedule_service.RoomPrimaryKey) (*schedule_service.EmptyRoom, error) {
+	r.log.Info("---DeleteRoom--->>>", logger.Any("req", req))
+
+	err := r.strg.Room().Delete(ctx, req)
+	if err != nil {
+		r.log.Error("---DeleteRoom--->>>", logger.Error(err))
+		return &schedule_service.EmptyRoom{}, err
+	}
+
+	return &schedule_service.EmptyRoom{}, nil
+}
+
+// GetUtilization reports how much of a branch's rooms was booked in the week
+// holding weekStart, the current week by default.
+func (r *RoomService) GetUtilization(ctx context.Context, req *schedule_service.RoomUtilizationRequest) (*schedule_service.RoomUtilizationReport, error) {
+	r.log.Info("---GetRoomUtilization--->>>", logger.Any("req", req))
+
+	if req.BranchId == "" {
+		err := errors.New("branchId is required")
+		r.log.Error("---GetRoomUtilization--->>>", logger.Error(err))
+		return &schedule_service.RoomUtilizationReport{}, err
+	}
+
+	day := time.Now()
+	if req.WeekStart != "" {
+		var err error
+		day, err = time.Parse("2006-01-02", req.WeekStart)
+		if err != nil {
+			err = fmt.Errorf("invalid weekStart: %s", req.WeekStart)
+			r.log.Error("---GetRoomUtilization--->>>", logger.Error(err))
+			return &schedule_service.RoomUtilizationReport{}, err
+		}
+	}
+	// Weeks start on Monday.
+	req.WeekStart = day.AddDate(0, 0, -(int(day.Weekday())+6)%7).Format("2006-01-02")
+
+	resp, err := r.strg.Room().GetUtilization(ctx, req)
+	if err != nil {
+		r.log.Error("---GetRoomUtilization--->>>", logger.Error(err))
+		return &schedule_service.RoomUtilizationReport{}, err
+	}
+
+	return resp, nil
+}
+
+// validateRoom checks a room's name, capacity and optional "15:04" opening
+// hours.
+func validateRoom(name string, capacity int32, openTime, closeTime string) error {
+	if name == "" {
+		return errors.New("name is required")
+	}
+	if capacity <= 0 {
+		return errors.New("capacity must be positive")
+	}
+
+	for _, value := range []string{openTime, closeTime} {
+		if value == "" {
+			continue
+		}
+		if _, err := timetable.ParseClock(value); err != nil {
+			return err
+		}
+	}
+
+	if openTime != "" && closeTime != "" {
+		opens, _ := timetable.ParseClock(openTime)
+		closes, _ := timetable.ParseClock(closeTime)
+		if closes <= opens {
+			return errors.New("closeTime must be after openTime")
+		}
+	}
+
+	return nil
+}
@@ -101,11 +101,47 @@ func (s *ScheduleService) GetScheduleForMonth(ctx context.Context, req *schedule
 func (s *ScheduleService) GetScheduleForWeek(ctx context.Context, req *schedule_service.GetScheduleForWeekRequest) (*schedule_service.GetListScheduleResponse, error) {
     s.log.Info("---GetScheduleForWeek--->>>", logger.Any("req", req))
 
-    resp, err := s.strg.Schedule().GetScheduleForMonth(ctx, req.TeacherId, req.WeekStartDate, req.WeekEndDate)
+    resp, err := s.strg.Schedule().GetScheduleForWeek(ctx, req.TeacherId, req.WeekStartDate, req.WeekEndDate)
     if err != nil {
         s.log.Error("---GetScheduleForWeek--->>>", logger.Error(err))
         return &schedule_service.GetListScheduleResponse{}, err
     }
 
     return resp, nil
-}
\ No newline at end of file
+}
+
+func (s *ScheduleService) MarkAttendance(ctx context.Context, req *schedule_service.MarkAttendanceRequest) (*schedule_service.LessonAttendance, error) {
+	s.log.Info("---MarkAttendance--->>>", logger.Any("req", req))
+
+	resp, err := s.strg.Schedule().MarkAttendance(ctx, req)
+	if err != nil {
+		s.log.Error("---MarkAttendance--->>>", logger.Error(err))
+		return &schedule_service.LessonAttendance{}, err
+	}
+
+	return resp, nil
+}
+
+func (s *ScheduleService) AssignSubstitute(ctx context.Context, req *schedule_service.AssignSubstituteRequest) (*schedule_service.GetSchedule, error) {
+	s.log.Info("---AssignSubstitute--->>>", logger.Any("req", req))
+
+	resp, err := s.strg.Schedule().AssignSubstitute(ctx, req)
+	if err != nil {
+		s.log.Error("---AssignSubstitute--->>>", logger.Error(err))
+		return &schedule_service.GetSchedule{}, err
+	}
+
+	return resp, nil
+}
+
+func (s *ScheduleService) GetTeacherLessonCount(ctx context.Context, req *schedule_service.TeacherLessonCountRequest) (*schedule_service.TeacherLessonCount, error) {
+	s.log.Info("---GetTeacherLessonCount--->>>", logger.Any("req", req))
+
+	resp, err := s.strg.Schedule().GetTeacherLessonCount(ctx, req)
+	if err != nil {
+		s.log.Error("---GetTeacherLessonCount--->>>", logger.Error(err))
+		return &schedule_service.TeacherLessonCount{}, err
+	}
+
+	return resp, nil
+}
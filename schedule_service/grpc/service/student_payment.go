@@ -2,10 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"schedule_service/config"
 	"schedule_service/genproto/schedule_service"
 	"schedule_service/grpc/client"
+	"schedule_service/pkg/money"
 	"schedule_service/storage"
+	"strings"
 
 	"github.com/saidamir98/udevs_pkg/logger"
 )
@@ -26,9 +30,24 @@ func NewStudentPaymentService(cfg config.Config, log logger.LoggerI, strg storag
 	}
 }
 
+// Create records a payment taken at the front desk, in cash unless another
+// payment method is given.
 func (s *StudentPaymentService) Create(ctx context.Context, req *schedule_service.CreateStudentPayment) (*schedule_service.GetStudentPayment, error) {
 	s.log.Info("---CreateStudentPayment--->>>", logger.Any("req", req))
 
+	if req.PaymentMethod == "" {
+		req.PaymentMethod = "cash"
+	}
+
+	_, err := money.Amount.ParsePositive(req.PaidSum)
+	if err == nil && !validPaymentMethod(req.PaymentMethod, deskPaymentMethods) {
+		err = fmt.Errorf("invalid payment method: %q", req.PaymentMethod)
+	}
+	if err != nil {
+		s.log.Error("---CreateStudentPayment--->>>", logger.Error(err))
+		return &schedule_service.GetStudentPayment{}, err
+	}
+
 	resp, err := s.strg.StudentPayment().Create(ctx, req)
 	if err != nil {
 		s.log.Error("---CreateStudentPayment--->>>", logger.Error(err))
@@ -62,26 +81,46 @@ func (s *StudentPaymentService) GetList(ctx context.Context, req *schedule_servi
 	return resp, nil
 }
 
-func (s *StudentPaymentService) Update(ctx context.Context, req *schedule_service.UpdateStudentPayment) (*schedule_service.GetStudentPayment, error) {
-	s.log.Info("---UpdateStudentPayment--->>>", logger.Any("req", req))
+func (s *StudentPaymentService) Reverse(ctx context.Context, req *schedule_service.ReverseStudentPayment) (*schedule_service.GetStudentPayment, error) {
+	s.log.Info("---ReverseStudentPayment--->>>", logger.Any("req", req))
 
-	resp, err := s.strg.StudentPayment().Update(ctx, req)
+	if strings.TrimSpace(req.Reason) == "" {
+		err := errors.New("reason is required to reverse a payment")
+		s.log.Error("---ReverseStudentPayment--->>>", logger.Error(err))
+		return &schedule_service.GetStudentPayment{}, err
+	}
+
+	resp, err := s.strg.StudentPayment().Reverse(ctx, req)
 	if err != nil {
-		s.log.Error("---UpdateStudentPayment--->>>", logger.Error(err))
+		s.log.Error("---ReverseStudentPayment--->>>", logger.Error(err))
 		return &schedule_service.GetStudentPayment{}, err
 	}
 
 	return resp, nil
 }
 
-func (s *StudentPaymentService) Delete(ctx context.Context, req *schedule_service.StudentPaymentPrimaryKey) (*schedule_service.EmptyStudentPayment, error) {
-	s.log.Info("---DeleteStudentPayment--->>>", logger.Any("req", req))
+func (s *StudentPaymentService) Refund(ctx context.Context, req *schedule_service.RefundStudentPayment) (*schedule_service.GetStudentPayment, error) {
+	s.log.Info("---RefundStudentPayment--->>>", logger.Any("req", req))
+
+	var err error
+	switch {
+	case strings.TrimSpace(req.Reason) == "":
+		err = errors.New("reason is required to refund a payment")
+	case req.ApprovedBy == "":
+		err = errors.New("refund must be approved")
+	default:
+		_, err = money.Amount.ParsePositive(req.PaidSum)
+	}
+	if err != nil {
+		s.log.Error("---RefundStudentPayment--->>>", logger.Error(err))
+		return &schedule_service.GetStudentPayment{}, err
+	}
 
-	err := s.strg.StudentPayment().Delete(ctx, req)
+	resp, err := s.strg.StudentPayment().Refund(ctx, req)
 	if err != nil {
-		s.log.Error("---DeleteStudentPayment--->>>", logger.Error(err))
-		return &schedule_service.EmptyStudentPayment{}, err
+		s.log.Error("---RefundStudentPayment--->>>", logger.Error(err))
+		return &schedule_service.GetStudentPayment{}, err
 	}
 
-	return &schedule_service.EmptyStudentPayment{}, nil
+	return resp, nil
 }
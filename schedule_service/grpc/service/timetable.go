@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"schedule_service/config"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/grpc/client"
+	"schedule_service/pkg/timetable"
+	"schedule_service/storage"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+type TimetableService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+}
+
+func NewTimetableService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *TimetableService {
+	return &TimetableService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (t *TimetableService) CreateAvailability(ctx context.Context, req *schedule_service.CreateTeacherAvailability) (*schedule_service.TeacherAvailability, error) {
+	t.log.Info("---CreateAvailability--->>>", logger.Any("req", req))
+
+	resp, err := t.strg.Timetable().CreateAvailability(ctx, req)
+	if err != nil {
+		t.log.Error("---CreateAvailability--->>>", logger.Error(err))
+		return &schedule_service.TeacherAvailability{}, err
+	}
+
+	return resp, nil
+}
+
+func (t *TimetableService) GetListAvailability(ctx context.Context, req *schedule_service.GetListAvailabilityRequest) (*schedule_service.GetListAvailabilityResponse, error) {
+	t.log.Info("---GetListAvailability--->>>", logger.Any("req", req))
+
+	resp, err := t.strg.Timetable().GetListAvailability(ctx, req)
+	if err != nil {
+		t.log.Error("---GetListAvailability--->>>", logger.Error(err))
+		return &schedule_service.GetListAvailabilityResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (t *TimetableService) DeleteAvailability(ctx context.Context, req *schedule_service.TeacherAvailabilityPrimaryKey) (*schedule_service.EmptyTimetable, error) {
+	t.log.Info("---DeleteAvailability--->>>", logger.Any("req", req))
+
+	err := t.strg.Timetable().DeleteAvailability(ctx, req)
+	if err != nil {
+		t.log.Error("---DeleteAvailability--->>>", logger.Error(err))
+		return &schedule_service.EmptyTimetable{}, err
+	}
+
+	return &schedule_service.EmptyTimetable{}, nil
+}
+
+func (t *TimetableService) SetLessonRequirement(ctx context.Context, req *schedule_service.LessonRequirement) (*schedule_service.LessonRequirement, error) {
+	t.log.Info("---SetLessonRequirement--->>>", logger.Any("req", req))
+
+	resp, err := t.strg.Timetable().SetLessonRequirement(ctx, req)
+	if err != nil {
+		t.log.Error("---SetLessonRequirement--->>>", logger.Error(err))
+		return &schedule_service.LessonRequirement{}, err
+	}
+
+	return resp, nil
+}
+
+func (t *TimetableService) GetListLessonRequirement(ctx context.Context, req *schedule_service.GetListLessonRequirementRequest) (*schedule_service.GetListLessonRequirementResponse, error) {
+	t.log.Info("---GetListLessonRequirement--->>>", logger.Any("req", req))
+
+	resp, err := t.strg.Timetable().GetListLessonRequirement(ctx, req)
+	if err != nil {
+		t.log.Error("---GetListLessonRequirement--->>>", logger.Error(err))
+		return &schedule_service.GetListLessonRequirementResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// ProposeTimetable runs the solver over the branch's groups and stores the
+// result as a draft that can be reviewed and committed later.
+func (t *TimetableService) ProposeTimetable(ctx context.Context, req *schedule_service.ProposeTimetableRequest) (*schedule_service.TimetableProposal, error) {
+	t.log.Info("---ProposeTimetable--->>>", logger.Any("req", req))
+
+	input, err := t.solverInput(ctx, req)
+	if err != nil {
+		t.log.Error("---ProposeTimetable--->>>", logger.Error(err))
+		return &schedule_service.TimetableProposal{}, err
+	}
+
+	result := timetable.Solve(input)
+
+	proposal := &schedule_service.TimetableProposal{
+		BranchId:  req.BranchId,
+		RoomCount: int32(input.Rooms),
+	}
+	for _, lesson := range result.Lessons {
+		proposal.Lessons = append(proposal.Lessons, &schedule_service.ProposedLesson{
+			GroupId:          lesson.GroupID,
+			TeacherId:        lesson.TeacherID,
+			SupportTeacherId: lesson.SupportTeacherID,
+			Weekday:          int32(lesson.Weekday),
+			StartTime:        timetable.FormatClock(lesson.Start),
+			EndTime:          timetable.FormatClock(lesson.End),
+		})
+	}
+	for groupId, reason := range result.Unscheduled {
+		proposal.Unscheduled = append(proposal.Unscheduled, &schedule_service.UnscheduledGroup{
+			GroupId: groupId,
+			Reason:  reason,
+		})
+	}
+
+	resp, err := t.strg.Timetable().SaveProposal(ctx, proposal)
+	if err != nil {
+		t.log.Error("---ProposeTimetable--->>>", logger.Error(err))
+		return &schedule_service.TimetableProposal{}, err
+	}
+
+	return resp, nil
+}
+
+func (t *TimetableService) solverInput(ctx context.Context, req *schedule_service.ProposeTimetableRequest) (timetable.Input, error) {
+	input := timetable.Input{
+		Availability: map[string][]timetable.Window{},
+	}
+
+	// A branch whose rooms are not set up yet is planned without a room
+	// limit.
+	rooms, err := t.strg.Timetable().CountRooms(ctx, req.BranchId)
+	if err != nil {
+		return input, err
+	}
+	input.Rooms = int(rooms)
+
+	requirements, err := t.strg.Timetable().GetListLessonRequirement(ctx, &schedule_service.GetListLessonRequirementRequest{BranchId: req.BranchId})
+	if err != nil {
+		return input, err
+	}
+
+	for _, r := range requirements.Requirements {
+		input.Groups = append(input.Groups, timetable.Group{
+			ID:               r.GroupId,
+			TeacherID:        r.TeacherId,
+			SupportTeacherID: r.SupportTeacherId,
+			LessonsPerWeek:   int(r.LessonsPerWeek),
+			Duration:         int(r.DurationMinutes),
+		})
+	}
+
+	// Teachers may work in several branches, so availability is loaded for
+	// everyone and filtered by the solver through the group's teacher ids.
+	availability, err := t.strg.Timetable().GetListAvailability(ctx, &schedule_service.GetListAvailabilityRequest{})
+	if err != nil {
+		return input, err
+	}
+
+	for _, a := range availability.Availabilities {
+		window, err := toWindow(a.Weekday, a.StartTime, a.EndTime)
+		if err != nil {
+			return input, err
+		}
+
+		owner := a.TeacherId
+		if owner == "" {
+			owner = a.SupportTeacherId
+		}
+		input.Availability[owner] = append(input.Availability[owner], window)
+	}
+
+	sameBranch, otherBranches, err := t.strg.Timetable().GetBusyLessons(ctx, req.BranchId)
+	if err != nil {
+		return input, err
+	}
+
+	for i, lessons := range [][]*schedule_service.ProposedLesson{sameBranch, otherBranches} {
+		for _, l := range lessons {
+			window, err := toWindow(l.Weekday, l.StartTime, l.EndTime)
+			if err != nil {
+				return input, err
+			}
+
+			input.Busy = append(input.Busy, timetable.Lesson{
+				GroupID:          l.GroupId,
+				TeacherID:        l.TeacherId,
+				SupportTeacherID: l.SupportTeacherId,
+				Window:           window,
+				SameBranch:       i == 0,
+			})
+		}
+	}
+
+	return input, nil
+}
+
+func toWindow(weekday int32, startTime, endTime string) (timetable.Window, error) {
+	start, err := timetable.ParseClock(startTime)
+	if err != nil {
+		return timetable.Window{}, err
+	}
+
+	end, err := timetable.ParseClock(endTime)
+	if err != nil {
+		return timetable.Window{}, err
+	}
+
+	return timetable.Window{Weekday: int(weekday), Start: start, End: end}, nil
+}
+
+func (t *TimetableService) GetProposal(ctx context.Context, req *schedule_service.TimetableProposalPrimaryKey) (*schedule_service.TimetableProposal, error) {
+	t.log.Info("---GetProposal--->>>", logger.Any("req", req))
+
+	resp, err := t.strg.Timetable().GetProposal(ctx, req)
+	if err != nil {
+		t.log.Error("---GetProposal--->>>", logger.Error(err))
+		return &schedule_service.TimetableProposal{}, err
+	}
+
+	return resp, nil
+}
+
+func (t *TimetableService) CommitTimetable(ctx context.Context, req *schedule_service.TimetableProposalPrimaryKey) (*schedule_service.CommitTimetableResponse, error) {
+	t.log.Info("---CommitTimetable--->>>", logger.Any("req", req))
+
+	resp, err := t.strg.Timetable().CommitProposal(ctx, req)
+	if err != nil {
+		t.log.Error("---CommitTimetable--->>>", logger.Error(err))
+		return &schedule_service.CommitTimetableResponse{}, err
+	}
+
+	return resp, nil
+}
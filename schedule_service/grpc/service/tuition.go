@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"schedule_service/config"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/grpc/client"
+	"schedule_service/pkg/money"
+	"schedule_service/storage"
+	"time"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+type TuitionService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+}
+
+func NewTuitionService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *TuitionService {
+	return &TuitionService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (t *TuitionService) CreatePlan(ctx context.Context, req *schedule_service.CreateTuitionPlan) (*schedule_service.TuitionPlan, error) {
+	t.log.Info("---CreateTuitionPlan--->>>", logger.Any("req", req))
+
+	if _, err := money.Amount.Parse(req.MonthlyFee); err != nil {
+		t.log.Error("---CreateTuitionPlan--->>>", logger.Error(err))
+		return &schedule_service.TuitionPlan{}, err
+	}
+
+	resp, err := t.strg.Tuition().CreatePlan(ctx, req)
+	if err != nil {
+		t.log.Error("---CreateTuitionPlan--->>>", logger.Error(err))
+		return &schedule_service.TuitionPlan{}, err
+	}
+
+	return resp, nil
+}
+
+func (t *TuitionService) GetListPlan(ctx context.Context, req *schedule_service.GetListTuitionPlanRequest) (*schedule_service.GetListTuitionPlanResponse, error) {
+	t.log.Info("---GetListTuitionPlan--->>>", logger.Any("req", req))
+
+	resp, err := t.strg.Tuition().GetListPlan(ctx, req)
+	if err != nil {
+		t.log.Error("---GetListTuitionPlan--->>>", logger.Error(err))
+		return &schedule_service.GetListTuitionPlanResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (t *TuitionService) UpdatePlan(ctx context.Context, req *schedule_service.UpdateTuitionPlan) (*schedule_service.TuitionPlan, error) {
+	t.log.Info("---UpdateTuitionPlan--->>>", logger.Any("req", req))
+
+	if _, err := money.Amount.Parse(req.MonthlyFee); err != nil {
+		t.log.Error("---UpdateTuitionPlan--->>>", logger.Error(err))
+		return &schedule_service.TuitionPlan{}, err
+	}
+
+	resp, err := t.strg.Tuition().UpdatePlan(ctx, req)
+	if err != nil {
+		t.log.Error("---UpdateTuitionPlan--->>>", logger.Error(err))
+		return &schedule_service.TuitionPlan{}, err
+	}
+
+	return resp, nil
+}
+
+func (t *TuitionService) DeletePlan(ctx context.Context, req *schedule_service.TuitionPlanPrimaryKey) (*schedule_service.EmptyTuition, error) {
+	t.log.Info("---DeleteTuitionPlan--->>>", logger.Any("req", req))
+
+	err := t.strg.Tuition().DeletePlan(ctx, req)
+	if err != nil {
+		t.log.Error("---DeleteTuitionPlan--->>>", logger.Error(err))
+		return &schedule_service.EmptyTuition{}, err
+	}
+
+	return &schedule_service.EmptyTuition{}, nil
+}
+
+// GenerateInvoices invoices the given month, "2006-01", or the current one.
+func (t *TuitionService) GenerateInvoices(ctx context.Context, req *schedule_service.GenerateInvoicesRequest) (*schedule_service.GenerateInvoicesResponse, error) {
+	t.log.Info("---GenerateInvoices--->>>", logger.Any("req", req))
+
+	period, err := billingPeriod(req.Period)
+	if err != nil {
+		t.log.Error("---GenerateInvoices--->>>", logger.Error(err))
+		return &schedule_service.GenerateInvoicesResponse{}, err
+	}
+	req.Period = period
+
+	resp, err := t.strg.Tuition().GenerateInvoices(ctx, req)
+	if err != nil {
+		t.log.Error("---GenerateInvoices--->>>", logger.Error(err))
+		return &schedule_service.GenerateInvoicesResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (t *TuitionService) GetListInvoice(ctx context.Context, req *schedule_service.GetListInvoiceRequest) (*schedule_service.GetListInvoiceResponse, error) {
+	t.log.Info("---GetListInvoice--->>>", logger.Any("req", req))
+
+	if req.Period != "" {
+		period, err := billingPeriod(req.Period)
+		if err != nil {
+			t.log.Error("---GetListInvoice--->>>", logger.Error(err))
+			return &schedule_service.GetListInvoiceResponse{}, err
+		}
+		req.Period = period
+	}
+
+	resp, err := t.strg.Tuition().GetListInvoice(ctx, req)
+	if err != nil {
+		t.log.Error("---GetListInvoice--->>>", logger.Error(err))
+		return &schedule_service.GetListInvoiceResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (t *TuitionService) GetStudentBalance(ctx context.Context, req *schedule_service.StudentBalanceRequest) (*schedule_service.StudentBalance, error) {
+	t.log.Info("---GetStudentBalance--->>>", logger.Any("req", req))
+
+	resp, err := t.strg.Tuition().GetStudentBalance(ctx, req)
+	if err != nil {
+		t.log.Error("---GetStudentBalance--->>>", logger.Error(err))
+		return &schedule_service.StudentBalance{}, err
+	}
+
+	return resp, nil
+}
+
+// billingPeriod converts "2006-01" or a date to the first day of its month.
+// An empty value means the current month.
+func billingPeriod(value string) (string, error) {
+	if value == "" {
+		return time.Now().Format("2006-01") + "-01", nil
+	}
+
+	for _, layout := range []string{"2006-01", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Format("2006-01") + "-01", nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid period: %s", value)
+}
@@ -0,0 +1,60 @@
+package jobs
+
+import (
+	"context"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/storage"
+	"time"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+// InvoiceGeneration invoices the students of active groups for the current
+// month. Generation is idempotent, so running it on every tick only picks up
+// students who joined since the last run.
+type InvoiceGeneration struct {
+	log      logger.LoggerI
+	strg     storage.StorageI
+	interval time.Duration
+}
+
+func NewInvoiceGeneration(log logger.LoggerI, strg storage.StorageI, interval time.Duration) *InvoiceGeneration {
+	return &InvoiceGeneration{
+		log:      log,
+		strg:     strg,
+		interval: interval,
+	}
+}
+
+// Run generates invoices immediately and then on every tick until ctx is
+// cancelled.
+func (g *InvoiceGeneration) Run(ctx context.Context) {
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+
+	for {
+		g.generate(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (g *InvoiceGeneration) generate(ctx context.Context) {
+	resp, err := g.strg.Tuition().GenerateInvoices(ctx, &schedule_service.GenerateInvoicesRequest{
+		Period: time.Now().Format("2006-01") + "-01",
+	})
+	if err != nil {
+		g.log.Error("---InvoiceGeneration--->>>", logger.Error(err))
+		return
+	}
+
+	for _, group := range resp.GroupsWithoutPlan {
+		g.log.Warn("---InvoiceGeneration--->>>", logger.String("group without tuition plan", group.GroupId))
+	}
+
+	g.log.Info("---InvoiceGeneration--->>>", logger.String("period", resp.Period), logger.Any("created", resp.Created))
+}
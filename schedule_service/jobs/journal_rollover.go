@@ -0,0 +1,70 @@
+package jobs
+
+import (
+	"context"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/storage"
+	"time"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+// JournalRollover opens the next journal for every active group once the
+// current journal's toDate has passed.
+type JournalRollover struct {
+	log      logger.LoggerI
+	strg     storage.StorageI
+	interval time.Duration
+}
+
+func NewJournalRollover(log logger.LoggerI, strg storage.StorageI, interval time.Duration) *JournalRollover {
+	return &JournalRollover{
+		log:      log,
+		strg:     strg,
+		interval: interval,
+	}
+}
+
+// Run checks for due journals immediately and then on every tick until ctx
+// is cancelled.
+func (r *JournalRollover) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.rollover(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *JournalRollover) rollover(ctx context.Context) {
+	journals, err := r.strg.Journal().GetDueForRollover(ctx)
+	if err != nil {
+		r.log.Error("---JournalRollover--->>>", logger.Error(err))
+		return
+	}
+
+	for _, journal := range journals {
+		next, err := r.strg.Journal().Rollover(ctx, &schedule_service.JournalPrimaryKey{Id: journal.Id})
+		if err != nil {
+			r.log.Error("---JournalRollover--->>>", logger.String("journal", journal.Id), logger.Error(err))
+
+			err = r.strg.Journal().SaveRolloverFailure(ctx, &schedule_service.RolloverFailure{
+				JournalId: journal.Id,
+				GroupId:   journal.GroupId,
+				Error:     err.Error(),
+			})
+			if err != nil {
+				r.log.Error("---JournalRollover--->>>", logger.Error(err))
+			}
+			continue
+		}
+
+		r.log.Info("---JournalRollover--->>>", logger.String("group", journal.GroupId), logger.String("journal", next.Id))
+	}
+}
@@ -0,0 +1,86 @@
+package jobs
+
+import (
+	"context"
+	"schedule_service/pkg/notifier"
+	"schedule_service/storage"
+	"time"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+// OverdueCheck records invoices that are past due and not fully paid,
+// reminds students of them through the notifier and, when holdAfterDays is
+// set, puts students that many days late on hold.
+type OverdueCheck struct {
+	log           logger.LoggerI
+	strg          storage.StorageI
+	notifier      notifier.NotifierI
+	interval      time.Duration
+	remindEvery   time.Duration
+	holdAfterDays int32
+}
+
+func NewOverdueCheck(log logger.LoggerI, strg storage.StorageI, notify notifier.NotifierI, interval, remindEvery time.Duration, holdAfterDays int32) *OverdueCheck {
+	return &OverdueCheck{
+		log:           log,
+		strg:          strg,
+		notifier:      notify,
+		interval:      interval,
+		remindEvery:   remindEvery,
+		holdAfterDays: holdAfterDays,
+	}
+}
+
+// Run checks immediately and then on every tick until ctx is cancelled.
+func (o *OverdueCheck) Run(ctx context.Context) {
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	for {
+		o.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (o *OverdueCheck) check(ctx context.Context) {
+	open, resolved, err := o.strg.Overdue().Refresh(ctx)
+	if err != nil {
+		o.log.Error("---OverdueCheck--->>>", logger.Error(err))
+		return
+	}
+	o.log.Info("---OverdueCheck--->>>", logger.Any("open", open), logger.Any("resolved", resolved))
+
+	overdues, err := o.strg.Overdue().GetDueForReminder(ctx, o.remindEvery)
+	if err != nil {
+		o.log.Error("---OverdueCheck--->>>", logger.Error(err))
+		return
+	}
+
+	for _, overdue := range overdues {
+		o.notifier.Notify(ctx, "payment.overdue", overdue)
+
+		if err = o.strg.Overdue().MarkReminded(ctx, overdue.Id); err != nil {
+			o.log.Error("---OverdueCheck--->>>", logger.String("overdue", overdue.Id), logger.Error(err))
+		}
+	}
+
+	if o.holdAfterDays <= 0 {
+		return
+	}
+
+	holds, err := o.strg.Overdue().HoldOverdue(ctx, o.holdAfterDays)
+	if err != nil {
+		o.log.Error("---OverdueCheck--->>>", logger.Error(err))
+		return
+	}
+
+	for _, hold := range holds {
+		o.notifier.Notify(ctx, "student.hold.created", hold)
+	}
+}
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "group_promotion_student";
+DROP TABLE IF EXISTS "group_promotion";
+DROP TABLE IF EXISTS "lesson_attendance";
+DROP TABLE IF EXISTS "group_student";
+
+ALTER TABLE "journal" DROP COLUMN IF EXISTS closed_at;
+ALTER TABLE "group" DROP COLUMN IF EXISTS status;
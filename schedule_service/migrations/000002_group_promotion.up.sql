@@ -0,0 +1,54 @@
+ALTER TABLE "group" ADD COLUMN IF NOT EXISTS status VARCHAR(20) DEFAULT 'active' CHECK (status IN ('active', 'finished'));
+ALTER TABLE "journal" ADD COLUMN IF NOT EXISTS closed_at TIMESTAMP;
+ALTER TABLE "student_task" ADD COLUMN IF NOT EXISTS score INT;
+
+CREATE TABLE IF NOT EXISTS "group_student" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    groupId UUID,
+    studentId UUID,
+    FOREIGN KEY (groupId) REFERENCES "group"(id),
+    FOREIGN KEY (studentId) REFERENCES student(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS "lesson_attendance" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    scheduleId UUID,
+    studentId UUID,
+    isPresent BOOLEAN NOT NULL DEFAULT TRUE,
+    FOREIGN KEY (scheduleId) REFERENCES schedule(id),
+    FOREIGN KEY (studentId) REFERENCES student(id),
+    UNIQUE (scheduleId, studentId),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS "group_promotion" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    fromGroupId UUID,
+    toGroupId UUID,
+    journalId UUID,
+    fromLevel VARCHAR(50),
+    toLevel VARCHAR(50),
+    mode VARCHAR(10) CHECK (mode IN ('move', 'new')),
+    minAttendance FLOAT DEFAULT 0,
+    minAverageScore FLOAT DEFAULT 0,
+    FOREIGN KEY (fromGroupId) REFERENCES "group"(id),
+    FOREIGN KEY (toGroupId) REFERENCES "group"(id),
+    FOREIGN KEY (journalId) REFERENCES journal(id),
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS "group_promotion_student" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    promotionId UUID,
+    studentId UUID,
+    attendance FLOAT,
+    averageScore FLOAT,
+    outcome VARCHAR(20) CHECK (outcome IN ('promoted', 'repeat')),
+    FOREIGN KEY (promotionId) REFERENCES group_promotion(id),
+    FOREIGN KEY (studentId) REFERENCES student(id),
+    created_at TIMESTAMP DEFAULT NOW()
+);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS "journal_rollover_failure" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    journalId UUID UNIQUE,
+    groupId UUID,
+    error TEXT,
+    attempts INTEGER DEFAULT 1,
+    FOREIGN KEY (journalId) REFERENCES journal(id),
+    FOREIGN KEY (groupId) REFERENCES "group"(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
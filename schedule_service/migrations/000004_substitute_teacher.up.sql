@@ -0,0 +1 @@
+ALTER TABLE "schedule" ADD COLUMN IF NOT EXISTS substituteTeacherId UUID REFERENCES teacher(id);
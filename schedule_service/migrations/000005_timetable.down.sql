@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "recurring_lesson";
+DROP TABLE IF EXISTS "timetable_proposal_unscheduled";
+DROP TABLE IF EXISTS "timetable_proposal_lesson";
+DROP TABLE IF EXISTS "timetable_proposal";
+DROP TABLE IF EXISTS "group_lesson_requirement";
+DROP TABLE IF EXISTS "teacher_availability";
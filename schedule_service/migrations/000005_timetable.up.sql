@@ -0,0 +1,67 @@
+CREATE TABLE IF NOT EXISTS "teacher_availability" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    teacherId UUID,
+    supportTeacherId UUID,
+    weekday INTEGER NOT NULL CHECK (weekday BETWEEN 1 AND 7),
+    startTime TIME NOT NULL,
+    endTime TIME NOT NULL,
+    FOREIGN KEY (teacherId) REFERENCES teacher(id),
+    FOREIGN KEY (supportTeacherId) REFERENCES support_teacher(id),
+    CHECK ((teacherId IS NULL) <> (supportTeacherId IS NULL)),
+    CHECK (endTime > startTime),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS "group_lesson_requirement" (
+    groupId UUID PRIMARY KEY,
+    lessonsPerWeek INTEGER NOT NULL CHECK (lessonsPerWeek BETWEEN 1 AND 7),
+    durationMinutes INTEGER NOT NULL CHECK (durationMinutes > 0),
+    FOREIGN KEY (groupId) REFERENCES "group"(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS "timetable_proposal" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    branchId UUID,
+    roomCount INTEGER DEFAULT 0,
+    status VARCHAR(20) DEFAULT 'draft' CHECK (status IN ('draft', 'committed')),
+    FOREIGN KEY (branchId) REFERENCES branch(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    committed_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS "timetable_proposal_lesson" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    proposalId UUID,
+    groupId UUID,
+    weekday INTEGER NOT NULL,
+    startTime TIME NOT NULL,
+    endTime TIME NOT NULL,
+    FOREIGN KEY (proposalId) REFERENCES timetable_proposal(id),
+    FOREIGN KEY (groupId) REFERENCES "group"(id)
+);
+
+CREATE TABLE IF NOT EXISTS "timetable_proposal_unscheduled" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    proposalId UUID,
+    groupId UUID,
+    reason TEXT,
+    FOREIGN KEY (proposalId) REFERENCES timetable_proposal(id),
+    FOREIGN KEY (groupId) REFERENCES "group"(id)
+);
+
+CREATE TABLE IF NOT EXISTS "recurring_lesson" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    groupId UUID,
+    proposalId UUID,
+    weekday INTEGER NOT NULL CHECK (weekday BETWEEN 1 AND 7),
+    startTime TIME NOT NULL,
+    endTime TIME NOT NULL,
+    FOREIGN KEY (groupId) REFERENCES "group"(id),
+    FOREIGN KEY (proposalId) REFERENCES timetable_proposal(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "group_waitlist";
+
+ALTER TABLE "group" DROP COLUMN IF EXISTS capacity;
@@ -0,0 +1,17 @@
+ALTER TABLE "group" ADD COLUMN IF NOT EXISTS capacity INTEGER CHECK (capacity > 0);
+
+CREATE TABLE IF NOT EXISTS "group_waitlist" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    groupId UUID,
+    studentId UUID,
+    status VARCHAR(20) DEFAULT 'waiting' CHECK (status IN ('waiting', 'promoted', 'cancelled')),
+    groupStudentId UUID,
+    promoted_at TIMESTAMP,
+    FOREIGN KEY (groupId) REFERENCES "group"(id),
+    FOREIGN KEY (studentId) REFERENCES student(id),
+    FOREIGN KEY (groupStudentId) REFERENCES group_student(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS group_waitlist_waiting_idx ON "group_waitlist" (groupId, studentId) WHERE status = 'waiting';
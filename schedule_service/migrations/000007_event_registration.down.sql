@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS event_student_active_idx;
+
+ALTER TABLE "event_student" DROP COLUMN IF EXISTS status;
+
+ALTER TABLE "event" DROP COLUMN IF EXISTS cutoffHours;
+ALTER TABLE "event" DROP COLUMN IF EXISTS capacity;
@@ -0,0 +1,17 @@
+ALTER TABLE "event" ADD COLUMN IF NOT EXISTS capacity INTEGER CHECK (capacity > 0);
+ALTER TABLE "event" ADD COLUMN IF NOT EXISTS cutoffHours INTEGER NOT NULL DEFAULT 3 CHECK (cutoffHours >= 0);
+
+ALTER TABLE "event_student" ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'registered' CHECK (status IN ('registered', 'waitlisted', 'cancelled'));
+
+UPDATE "event_student" es SET
+    deleted_at = 1
+WHERE es.deleted_at = 0
+  AND EXISTS (
+      SELECT 1 FROM "event_student" o
+      WHERE o.eventId = es.eventId
+        AND o.studentId = es.studentId
+        AND o.deleted_at = 0
+        AND (o.created_at, o.id) < (es.created_at, es.id)
+  );
+
+CREATE UNIQUE INDEX IF NOT EXISTS event_student_active_idx ON "event_student" (eventId, studentId) WHERE deleted_at = 0 AND status <> 'cancelled';
@@ -0,0 +1,2 @@
+ALTER TABLE "event_student" DROP COLUMN IF EXISTS checkedInBy;
+ALTER TABLE "event_student" DROP COLUMN IF EXISTS checkedInAt;
@@ -0,0 +1,2 @@
+ALTER TABLE "event_student" ADD COLUMN IF NOT EXISTS checkedInAt TIMESTAMP;
+ALTER TABLE "event_student" ADD COLUMN IF NOT EXISTS checkedInBy UUID;
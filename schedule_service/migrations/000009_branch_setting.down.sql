@@ -0,0 +1,3 @@
+UPDATE "event" SET cutoffHours = 3 WHERE cutoffHours IS NULL;
+ALTER TABLE "event" ALTER COLUMN cutoffHours SET DEFAULT 3;
+ALTER TABLE "event" ALTER COLUMN cutoffHours SET NOT NULL;
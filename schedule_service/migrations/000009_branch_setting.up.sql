@@ -0,0 +1,4 @@
+-- Events without their own cutoff use the branch_setting table, which user_service migrates.
+ALTER TABLE "event" ALTER COLUMN cutoffHours DROP NOT NULL;
+ALTER TABLE "event" ALTER COLUMN cutoffHours DROP DEFAULT;
+UPDATE "event" SET cutoffHours = NULL WHERE cutoffHours = 3;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS "event_feedback" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    eventStudentId UUID NOT NULL UNIQUE REFERENCES event_student(id),
+    eventId UUID NOT NULL REFERENCES event(id),
+    studentId UUID NOT NULL,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    comment TEXT,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS event_feedback_event_idx ON "event_feedback" (eventId);
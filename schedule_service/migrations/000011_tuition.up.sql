@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS "tuition_plan" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    branchId UUID NOT NULL REFERENCES branch(id),
+    groupType VARCHAR(50) NOT NULL CHECK (groupType IN ('beginner', 'elementary', 'intermediate', 'ielts')),
+    monthlyFee DECIMAL(10, 2) NOT NULL CHECK (monthlyFee >= 0),
+    validFrom DATE NOT NULL DEFAULT date_trunc('month', NOW())::date,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS tuition_plan_active_idx ON "tuition_plan" (branchId, groupType, validFrom) WHERE deleted_at = 0;
+
+CREATE TABLE IF NOT EXISTS "invoice" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    studentId UUID NOT NULL REFERENCES student(id),
+    groupId UUID NOT NULL REFERENCES "group"(id),
+    tuitionPlanId UUID REFERENCES tuition_plan(id),
+    period DATE NOT NULL,
+    amount DECIMAL(10, 2) NOT NULL CHECK (amount >= 0),
+    dueDate DATE NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (studentId, groupId, period)
+);
+
+CREATE INDEX IF NOT EXISTS invoice_student_idx ON "invoice" (studentId);
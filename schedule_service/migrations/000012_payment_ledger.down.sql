@@ -0,0 +1,16 @@
+DROP TRIGGER IF EXISTS student_payment_append_only ON "student_payment";
+DROP FUNCTION IF EXISTS student_payment_append_only();
+
+UPDATE "student_payment" SET deleted_at = 1
+WHERE id IN (SELECT reversalOf FROM "student_payment" WHERE entryType = 'reversal');
+
+DELETE FROM "student_payment" WHERE entryType <> 'payment';
+
+DROP INDEX IF EXISTS student_payment_student_idx;
+DROP INDEX IF EXISTS student_payment_reversal_idx;
+ALTER TABLE "student_payment" DROP CONSTRAINT IF EXISTS student_payment_entry_sign_check;
+ALTER TABLE "student_payment" DROP CONSTRAINT IF EXISTS student_payment_entry_type_check;
+ALTER TABLE "student_payment" DROP COLUMN IF EXISTS approvedBy;
+ALTER TABLE "student_payment" DROP COLUMN IF EXISTS reason;
+ALTER TABLE "student_payment" DROP COLUMN IF EXISTS reversalOf;
+ALTER TABLE "student_payment" DROP COLUMN IF EXISTS entryType;
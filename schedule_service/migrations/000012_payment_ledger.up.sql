@@ -0,0 +1,35 @@
+ALTER TABLE "student_payment" ADD COLUMN IF NOT EXISTS entryType VARCHAR(20) NOT NULL DEFAULT 'payment';
+ALTER TABLE "student_payment" ADD COLUMN IF NOT EXISTS reversalOf UUID REFERENCES "student_payment"(id);
+ALTER TABLE "student_payment" ADD COLUMN IF NOT EXISTS reason TEXT;
+ALTER TABLE "student_payment" ADD COLUMN IF NOT EXISTS approvedBy UUID;
+
+INSERT INTO "student_payment" (studentId, groupId, paidSum, administrationId, entryType, reversalOf, reason, created_at, updated_at)
+SELECT studentId, groupId, -paidSum, administrationId, 'reversal', id, 'deleted before the payment ledger', updated_at, updated_at
+FROM "student_payment"
+WHERE deleted_at <> 0 AND paidSum <> 0;
+
+UPDATE "student_payment" SET deleted_at = 0 WHERE deleted_at <> 0;
+
+ALTER TABLE "student_payment" ADD CONSTRAINT student_payment_entry_type_check
+    CHECK (entryType IN ('payment', 'reversal', 'refund'));
+
+ALTER TABLE "student_payment" ADD CONSTRAINT student_payment_entry_sign_check
+    CHECK ((entryType = 'payment' AND paidSum > 0 AND reversalOf IS NULL)
+        OR (entryType <> 'payment' AND paidSum < 0 AND reversalOf IS NOT NULL)) NOT VALID;
+
+CREATE UNIQUE INDEX IF NOT EXISTS student_payment_reversal_idx
+    ON "student_payment" (reversalOf) WHERE entryType = 'reversal';
+
+CREATE INDEX IF NOT EXISTS student_payment_student_idx
+    ON "student_payment" (studentId, created_at);
+
+CREATE OR REPLACE FUNCTION student_payment_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'student payments are append-only, record a reversal or a refund instead';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS student_payment_append_only ON "student_payment";
+CREATE TRIGGER student_payment_append_only
+    BEFORE UPDATE OR DELETE ON "student_payment"
+    FOR EACH ROW EXECUTE FUNCTION student_payment_append_only();
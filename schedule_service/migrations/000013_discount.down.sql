@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "invoice_discount";
+ALTER TABLE "invoice" DROP COLUMN IF EXISTS discountAmount;
+ALTER TABLE "invoice" DROP COLUMN IF EXISTS baseAmount;
+DROP TABLE IF EXISTS "discount";
+DROP TABLE IF EXISTS "promo_code";
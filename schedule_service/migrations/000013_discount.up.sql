@@ -0,0 +1,51 @@
+CREATE TABLE IF NOT EXISTS "promo_code" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code VARCHAR(50) NOT NULL,
+    branchId UUID REFERENCES branch(id),
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('percent', 'fixed')),
+    value DECIMAL(10, 2) NOT NULL CHECK (value > 0),
+    stackable BOOLEAN NOT NULL DEFAULT FALSE,
+    months INTEGER NOT NULL DEFAULT 1 CHECK (months > 0),
+    maxUses INTEGER NOT NULL DEFAULT 0 CHECK (maxUses >= 0),
+    usedCount INTEGER NOT NULL DEFAULT 0,
+    validFrom DATE NOT NULL DEFAULT NOW()::date,
+    validTo DATE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS promo_code_code_idx ON "promo_code" (UPPER(code)) WHERE deleted_at = 0;
+
+CREATE TABLE IF NOT EXISTS "discount" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    studentId UUID NOT NULL REFERENCES student(id),
+    groupId UUID REFERENCES "group"(id),
+    category VARCHAR(20) NOT NULL CHECK (category IN ('sibling', 'scholarship', 'promo', 'other')),
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('percent', 'fixed')),
+    value DECIMAL(10, 2) NOT NULL CHECK (value > 0),
+    stackable BOOLEAN NOT NULL DEFAULT FALSE,
+    validFrom DATE NOT NULL DEFAULT date_trunc('month', NOW())::date,
+    validTo DATE,
+    promoCodeId UUID REFERENCES promo_code(id),
+    reason TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0,
+    CHECK (validTo IS NULL OR validTo >= validFrom)
+);
+
+CREATE INDEX IF NOT EXISTS discount_student_idx ON "discount" (studentId) WHERE deleted_at = 0;
+CREATE UNIQUE INDEX IF NOT EXISTS discount_promo_code_idx ON "discount" (promoCodeId, studentId) WHERE promoCodeId IS NOT NULL;
+
+ALTER TABLE "invoice" ADD COLUMN IF NOT EXISTS baseAmount DECIMAL(10, 2);
+ALTER TABLE "invoice" ADD COLUMN IF NOT EXISTS discountAmount DECIMAL(10, 2) NOT NULL DEFAULT 0;
+UPDATE "invoice" SET baseAmount = amount WHERE baseAmount IS NULL;
+ALTER TABLE "invoice" ALTER COLUMN baseAmount SET NOT NULL;
+
+CREATE TABLE IF NOT EXISTS "invoice_discount" (
+    invoiceId UUID NOT NULL REFERENCES invoice(id) ON DELETE CASCADE,
+    discountId UUID NOT NULL REFERENCES discount(id),
+    amount DECIMAL(10, 2) NOT NULL CHECK (amount > 0),
+    PRIMARY KEY (invoiceId, discountId)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "student_hold";
+DROP TABLE IF EXISTS "payment_overdue";
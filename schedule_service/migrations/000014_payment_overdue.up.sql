@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS "payment_overdue" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    studentId UUID NOT NULL REFERENCES student(id),
+    groupId UUID NOT NULL REFERENCES "group"(id),
+    period DATE NOT NULL,
+    amountDue DECIMAL(10, 2) NOT NULL,
+    paid DECIMAL(10, 2) NOT NULL,
+    dueDate DATE NOT NULL,
+    daysLate INTEGER NOT NULL,
+    remindedAt TIMESTAMP,
+    resolvedAt TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (studentId, groupId, period)
+);
+
+CREATE INDEX IF NOT EXISTS payment_overdue_open_idx ON "payment_overdue" (studentId) WHERE resolvedAt IS NULL;
+
+CREATE TABLE IF NOT EXISTS "student_hold" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    studentId UUID NOT NULL REFERENCES student(id),
+    reason TEXT NOT NULL,
+    createdBy UUID,
+    clearedAt TIMESTAMP,
+    clearedBy UUID,
+    clearNote TEXT,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS student_hold_active_idx ON "student_hold" (studentId) WHERE clearedAt IS NULL;
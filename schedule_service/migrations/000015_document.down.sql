@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "contract_template";
+DROP TABLE IF EXISTS "payment_receipt";
+DROP TABLE IF EXISTS "receipt_sequence";
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS "receipt_sequence" (
+    branchId UUID PRIMARY KEY REFERENCES branch(id),
+    lastNumber INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS "payment_receipt" (
+    paymentId UUID PRIMARY KEY REFERENCES student_payment(id),
+    branchId UUID NOT NULL REFERENCES branch(id),
+    number INTEGER NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (branchId, number)
+);
+
+INSERT INTO "payment_receipt" (paymentId, branchId, number, created_at)
+SELECT sp.id,
+    COALESCE(g.branchId, s.branchId),
+    ROW_NUMBER() OVER (PARTITION BY COALESCE(g.branchId, s.branchId) ORDER BY sp.created_at, sp.id),
+    sp.created_at
+FROM "student_payment" sp
+LEFT JOIN "group" g ON g.id = sp.groupId
+LEFT JOIN "student" s ON s.id = sp.studentId
+WHERE sp.entryType = 'payment'
+  AND COALESCE(g.branchId, s.branchId) IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+INSERT INTO "receipt_sequence" (branchId, lastNumber)
+SELECT branchId, MAX(number)
+FROM "payment_receipt"
+GROUP BY branchId
+ON CONFLICT DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS "contract_template" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    branchId UUID REFERENCES branch(id),
+    name VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "online_payment_callback";
+DROP TABLE IF EXISTS "online_payment";
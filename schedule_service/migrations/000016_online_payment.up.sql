@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS "online_payment" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    studentId UUID NOT NULL REFERENCES student(id),
+    groupId UUID NOT NULL REFERENCES "group"(id),
+    amount DECIMAL(10, 2) NOT NULL CHECK (amount > 0),
+    provider VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'paid', 'cancelled')),
+    transactionId VARCHAR(255),
+    returnUrl TEXT,
+    studentPaymentId UUID REFERENCES student_payment(id),
+    paidAt TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (provider, transactionId)
+);
+
+CREATE INDEX IF NOT EXISTS online_payment_student_idx ON "online_payment" (studentId);
+
+CREATE TABLE IF NOT EXISTS "online_payment_callback" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    provider VARCHAR(20) NOT NULL,
+    onlinePaymentId UUID REFERENCES online_payment(id),
+    stage VARCHAR(20),
+    payload JSONB NOT NULL,
+    error TEXT,
+    created_at TIMESTAMP DEFAULT NOW()
+);
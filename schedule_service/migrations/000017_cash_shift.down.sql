@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS student_payment_shift_idx;
+ALTER TABLE "student_payment" DROP COLUMN IF EXISTS shiftId;
+ALTER TABLE "student_payment" DROP COLUMN IF EXISTS paymentMethod;
+DROP TABLE IF EXISTS "cash_shift_total";
+DROP TABLE IF EXISTS "cash_shift";
@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS "cash_shift" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    administrationId UUID NOT NULL REFERENCES administration(id),
+    branchId UUID REFERENCES branch(id),
+    openingFloat DECIMAL(10, 2) NOT NULL CHECK (openingFloat >= 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'closed', 'approved')),
+    mismatch BOOLEAN NOT NULL DEFAULT false,
+    note TEXT,
+    closeNote TEXT,
+    openedAt TIMESTAMP DEFAULT NOW(),
+    closedAt TIMESTAMP,
+    approvedBy UUID,
+    approvedAt TIMESTAMP,
+    approvalNote TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS cash_shift_open_idx ON "cash_shift" (administrationId) WHERE status = 'open';
+
+CREATE TABLE IF NOT EXISTS "cash_shift_total" (
+    shiftId UUID NOT NULL REFERENCES cash_shift(id),
+    paymentMethod VARCHAR(20) NOT NULL,
+    expected DECIMAL(14, 2) NOT NULL,
+    counted DECIMAL(14, 2) NOT NULL,
+    difference DECIMAL(14, 2) NOT NULL,
+    PRIMARY KEY (shiftId, paymentMethod)
+);
+
+ALTER TABLE "student_payment" ADD COLUMN IF NOT EXISTS paymentMethod VARCHAR(20) NOT NULL DEFAULT 'cash'
+    CHECK (paymentMethod IN ('cash', 'card', 'transfer', 'online'));
+ALTER TABLE "student_payment" ADD COLUMN IF NOT EXISTS shiftId UUID REFERENCES cash_shift(id);
+
+ALTER TABLE "student_payment" DISABLE TRIGGER student_payment_append_only;
+
+UPDATE "student_payment" SET paymentMethod = 'online'
+WHERE id IN (SELECT studentPaymentId FROM "online_payment")
+   OR reversalOf IN (SELECT studentPaymentId FROM "online_payment");
+
+ALTER TABLE "student_payment" ENABLE TRIGGER student_payment_append_only;
+
+CREATE INDEX IF NOT EXISTS student_payment_shift_idx ON "student_payment" (shiftId);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "payroll_line";
+DROP TABLE IF EXISTS "payroll_run";
+DROP TABLE IF EXISTS "payroll_adjustment";
+DROP TABLE IF EXISTS "pay_rule";
@@ -0,0 +1,62 @@
+CREATE TABLE IF NOT EXISTS "pay_rule" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    staffType VARCHAR(20) NOT NULL CHECK (staffType IN ('teacher', 'support_teacher', 'administration', 'manager')),
+    staffId UUID NOT NULL,
+    monthlyFee DECIMAL(12, 2) NOT NULL DEFAULT 0 CHECK (monthlyFee >= 0),
+    perLesson DECIMAL(12, 2) NOT NULL DEFAULT 0 CHECK (perLesson >= 0),
+    perStudent DECIMAL(12, 2) NOT NULL DEFAULT 0 CHECK (perStudent >= 0),
+    validFrom DATE NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS pay_rule_active_idx ON "pay_rule" (staffType, staffId, validFrom) WHERE deleted_at = 0;
+
+CREATE TABLE IF NOT EXISTS "payroll_adjustment" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    staffType VARCHAR(20) NOT NULL CHECK (staffType IN ('teacher', 'support_teacher', 'administration', 'manager')),
+    staffId UUID NOT NULL,
+    period DATE NOT NULL,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('bonus', 'penalty')),
+    amount DECIMAL(12, 2) NOT NULL CHECK (amount > 0),
+    reason TEXT NOT NULL,
+    createdBy UUID,
+    created_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS payroll_adjustment_period_idx ON "payroll_adjustment" (period, staffType, staffId);
+
+CREATE TABLE IF NOT EXISTS "payroll_run" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    period DATE NOT NULL UNIQUE,
+    status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'locked')),
+    createdBy UUID,
+    lockedBy UUID,
+    lockedAt TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS "payroll_line" (
+    runId UUID NOT NULL REFERENCES payroll_run(id) ON DELETE CASCADE,
+    staffType VARCHAR(20) NOT NULL,
+    staffId UUID NOT NULL,
+    staffName VARCHAR(255) NOT NULL,
+    branchId UUID,
+    ruleId UUID REFERENCES pay_rule(id),
+    monthlyFee DECIMAL(12, 2) NOT NULL,
+    lessonCount INTEGER NOT NULL,
+    perLesson DECIMAL(12, 2) NOT NULL,
+    lessonAmount DECIMAL(12, 2) NOT NULL,
+    studentCount INTEGER NOT NULL,
+    perStudent DECIMAL(12, 2) NOT NULL,
+    studentAmount DECIMAL(12, 2) NOT NULL,
+    bonuses DECIMAL(12, 2) NOT NULL,
+    penalties DECIMAL(12, 2) NOT NULL,
+    total DECIMAL(12, 2) NOT NULL,
+    PRIMARY KEY (runId, staffType, staffId)
+);
+
+CREATE INDEX IF NOT EXISTS payroll_line_staff_idx ON "payroll_line" (staffType, staffId);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "expense_attachment";
+DROP TABLE IF EXISTS "expense";
+DROP TABLE IF EXISTS "expense_category";
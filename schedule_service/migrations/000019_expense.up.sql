@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS "expense_category" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS expense_category_name_idx ON "expense_category" (lower(name)) WHERE deleted_at = 0;
+
+INSERT INTO "expense_category" (name) VALUES ('Rent'), ('Utilities'), ('Books'), ('Marketing'), ('Other')
+ON CONFLICT DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS "expense" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    branchId UUID NOT NULL REFERENCES branch(id),
+    categoryId UUID NOT NULL REFERENCES expense_category(id),
+    amount DECIMAL(12, 2) NOT NULL CHECK (amount > 0),
+    spentOn DATE NOT NULL,
+    description TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    createdBy UUID,
+    reviewedBy UUID,
+    reviewedAt TIMESTAMP,
+    reviewNote TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS expense_branch_idx ON "expense" (branchId, spentOn) WHERE deleted_at = 0;
+
+CREATE TABLE IF NOT EXISTS "expense_attachment" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    expenseId UUID NOT NULL REFERENCES expense(id),
+    fileName VARCHAR(255) NOT NULL,
+    contentType VARCHAR(255) NOT NULL,
+    size INTEGER NOT NULL,
+    content BYTEA NOT NULL,
+    createdBy UUID,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS expense_attachment_expense_idx ON "expense_attachment" (expenseId);
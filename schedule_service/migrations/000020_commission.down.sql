@@ -0,0 +1,4 @@
+ALTER TABLE "student_payment" DROP COLUMN IF EXISTS supportTeacherId;
+ALTER TABLE "student_payment" DROP COLUMN IF EXISTS teacherId;
+ALTER TABLE "payroll_line" DROP COLUMN IF EXISTS commission;
+DROP TABLE IF EXISTS "commission_rule";
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS "commission_rule" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    staffType VARCHAR(20) NOT NULL CHECK (staffType IN ('teacher', 'support_teacher')),
+    staffId UUID,
+    groupType VARCHAR(50) CHECK (groupType IN ('beginner', 'elementary', 'intermediate', 'ielts')),
+    rate DECIMAL(5, 2) NOT NULL CHECK (rate > 0 AND rate <= 100),
+    validFrom DATE NOT NULL,
+    CHECK (staffId IS NOT NULL OR groupType IS NOT NULL),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS commission_rule_active_idx
+    ON "commission_rule" (staffType, COALESCE(staffId::text, ''), COALESCE(groupType, ''), validFrom) WHERE deleted_at = 0;
+
+ALTER TABLE "payroll_line" ADD COLUMN IF NOT EXISTS commission DECIMAL(12, 2) NOT NULL DEFAULT 0;
+
+-- The staff a payment earns commission for, so a later teacher change leaves
+-- past payments and their clawbacks with the teacher who taught the group.
+ALTER TABLE "student_payment" ADD COLUMN IF NOT EXISTS teacherId UUID;
+ALTER TABLE "student_payment" ADD COLUMN IF NOT EXISTS supportTeacherId UUID;
+
+ALTER TABLE "student_payment" DISABLE TRIGGER student_payment_append_only;
+
+UPDATE "student_payment" sp SET teacherId = g.teacherId, supportTeacherId = g.supportTeacherId
+FROM "group" g
+WHERE g.id = sp.groupId;
+
+ALTER TABLE "student_payment" ENABLE TRIGGER student_payment_append_only;
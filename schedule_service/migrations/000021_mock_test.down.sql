@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "mock_test_result";
+DROP TABLE IF EXISTS "mock_test";
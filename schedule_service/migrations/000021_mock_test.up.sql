@@ -0,0 +1,39 @@
+CREATE TABLE IF NOT EXISTS "mock_test" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    groupId UUID NOT NULL REFERENCES "group"(id),
+    title VARCHAR(255) NOT NULL,
+    testDate DATE NOT NULL,
+    readingType VARCHAR(20) NOT NULL DEFAULT 'academic' CHECK (readingType IN ('academic', 'general')),
+    createdBy UUID,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS mock_test_group_date_idx ON "mock_test" (groupId, testDate) WHERE deleted_at = 0;
+
+CREATE TABLE IF NOT EXISTS "mock_test_result" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    mockTestId UUID NOT NULL REFERENCES "mock_test"(id),
+    studentId UUID NOT NULL REFERENCES student(id),
+    listeningRaw INTEGER NOT NULL CHECK (listeningRaw BETWEEN 0 AND 40),
+    listeningBand NUMERIC(2, 1) NOT NULL,
+    readingRaw INTEGER NOT NULL CHECK (readingRaw BETWEEN 0 AND 40),
+    readingBand NUMERIC(2, 1) NOT NULL,
+    writingTaskResponse SMALLINT NOT NULL CHECK (writingTaskResponse BETWEEN 0 AND 9),
+    writingCoherence SMALLINT NOT NULL CHECK (writingCoherence BETWEEN 0 AND 9),
+    writingLexical SMALLINT NOT NULL CHECK (writingLexical BETWEEN 0 AND 9),
+    writingGrammar SMALLINT NOT NULL CHECK (writingGrammar BETWEEN 0 AND 9),
+    writingBand NUMERIC(2, 1) NOT NULL,
+    speakingFluency SMALLINT NOT NULL CHECK (speakingFluency BETWEEN 0 AND 9),
+    speakingLexical SMALLINT NOT NULL CHECK (speakingLexical BETWEEN 0 AND 9),
+    speakingGrammar SMALLINT NOT NULL CHECK (speakingGrammar BETWEEN 0 AND 9),
+    speakingPronunciation SMALLINT NOT NULL CHECK (speakingPronunciation BETWEEN 0 AND 9),
+    speakingBand NUMERIC(2, 1) NOT NULL,
+    overall NUMERIC(2, 1) NOT NULL,
+    UNIQUE (mockTestId, studentId),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS mock_test_result_student_idx ON "mock_test_result" (studentId);
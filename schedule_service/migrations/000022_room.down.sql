@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS event_room_date_idx;
+DROP INDEX IF EXISTS schedule_room_date_idx;
+ALTER TABLE "event" DROP COLUMN IF EXISTS endTime;
+ALTER TABLE "event" DROP COLUMN IF EXISTS roomId;
+ALTER TABLE "schedule" DROP COLUMN IF EXISTS roomId;
+DROP TABLE IF EXISTS "room";
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS "room" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    branchId UUID NOT NULL REFERENCES branch(id),
+    name VARCHAR(255) NOT NULL,
+    capacity INTEGER NOT NULL CHECK (capacity > 0),
+    openTime TIME NOT NULL DEFAULT '08:00',
+    closeTime TIME NOT NULL DEFAULT '21:00',
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0,
+    CHECK (closeTime > openTime)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS room_branch_name_idx ON "room" (branchId, name) WHERE deleted_at = 0;
+
+ALTER TABLE "schedule" ADD COLUMN IF NOT EXISTS roomId UUID REFERENCES room(id);
+
+ALTER TABLE "event" ADD COLUMN IF NOT EXISTS roomId UUID REFERENCES room(id);
+ALTER TABLE "event" ADD COLUMN IF NOT EXISTS endTime TIME CHECK (endTime > startTime);
+
+CREATE INDEX IF NOT EXISTS schedule_room_date_idx ON "schedule" (roomId, date) WHERE deleted_at = 0 AND roomId IS NOT NULL;
+CREATE INDEX IF NOT EXISTS event_room_date_idx ON "event" (roomId, date) WHERE deleted_at = 0 AND roomId IS NOT NULL;
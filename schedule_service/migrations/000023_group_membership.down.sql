@@ -0,0 +1,2 @@
+ALTER TABLE "group_promotion" DROP COLUMN IF EXISTS repeatGroupId;
+DROP INDEX IF EXISTS group_student_member_idx;
@@ -0,0 +1,19 @@
+CREATE UNIQUE INDEX IF NOT EXISTS group_student_member_idx ON "group_student" (groupId, studentId) WHERE deleted_at = 0;
+
+-- Students were linked to groups only by name until group_student; names are
+-- only unique among the running groups of a branch. Students without a
+-- branch or without a single matching group are left for staff to enrol.
+INSERT INTO "group_student" (groupId, studentId)
+SELECT m.groupId, m.studentId
+FROM (
+    SELECT s.id AS studentId, MIN(g.id::text)::uuid AS groupId, COUNT(*) AS matches
+    FROM "student" s
+    JOIN "group" g ON g.name = s.groupName AND g.branchId = s.branchId
+        AND g.deleted_at = 0 AND g.status IS DISTINCT FROM 'finished'
+    WHERE s.deleted_at = 0
+    GROUP BY s.id
+) m
+WHERE m.matches = 1
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE "group_promotion" ADD COLUMN IF NOT EXISTS repeatGroupId UUID REFERENCES "group"(id);
@@ -0,0 +1,2 @@
+ALTER TABLE "schedule" DROP COLUMN IF EXISTS supportTeacherId;
+ALTER TABLE "schedule" DROP COLUMN IF EXISTS teacherId;
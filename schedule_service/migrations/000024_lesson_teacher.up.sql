@@ -0,0 +1,9 @@
+-- The teachers a lesson is paid to, so a later teacher change leaves the
+-- lessons already delivered with the teacher who taught them.
+ALTER TABLE "schedule" ADD COLUMN IF NOT EXISTS teacherId UUID REFERENCES teacher(id);
+ALTER TABLE "schedule" ADD COLUMN IF NOT EXISTS supportTeacherId UUID REFERENCES support_teacher(id);
+
+UPDATE "schedule" s SET teacherId = g.teacherId, supportTeacherId = g.supportTeacherId
+FROM "journal" j
+JOIN "group" g ON g.id = j.groupId
+WHERE j.id = s.journalId;
@@ -0,0 +1,40 @@
+package checkin
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidPayload is returned for payloads that are malformed or were not
+// signed with the current secret.
+var ErrInvalidPayload = errors.New("invalid check-in code")
+
+// Sign returns the QR payload of an event registration: the registration id
+// and its HMAC-SHA256 signature joined by a dot.
+func Sign(secret, eventStudentID string) string {
+	return eventStudentID + "." + signature(secret, eventStudentID)
+}
+
+// Verify checks the payload signature and returns the registration id. No
+// payload is valid without a secret.
+func Verify(secret, payload string) (string, error) {
+	id, sig, ok := strings.Cut(strings.TrimSpace(payload), ".")
+	if !ok || id == "" || secret == "" {
+		return "", ErrInvalidPayload
+	}
+
+	if !hmac.Equal([]byte(sig), []byte(signature(secret, id))) {
+		return "", ErrInvalidPayload
+	}
+
+	return id, nil
+}
+
+func signature(secret, id string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(id))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
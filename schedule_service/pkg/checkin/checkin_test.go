@@ -0,0 +1,45 @@
+package checkin
+
+import "testing"
+
+func TestVerify(t *testing.T) {
+	const (
+		secret = "check-in-secret"
+		id     = "5f0c8a52-7d1e-4b8e-9a57-3c2f1e6d9b41"
+	)
+	signed := Sign(secret, id)
+
+	tests := []struct {
+		name    string
+		secret  string
+		payload string
+		wantErr bool
+	}{
+		{name: "valid", secret: secret, payload: signed},
+		{name: "surrounding whitespace", secret: secret, payload: " " + signed + "\n"},
+		{name: "other secret", secret: "rotated-secret", payload: signed, wantErr: true},
+		{name: "empty secret", secret: "", payload: Sign("", id), wantErr: true},
+		{name: "other registration", secret: secret, payload: "other" + signed[len(id):], wantErr: true},
+		{name: "tampered signature", secret: secret, payload: signed + "x", wantErr: true},
+		{name: "no signature", secret: secret, payload: id, wantErr: true},
+		{name: "no id", secret: secret, payload: signed[len(id):], wantErr: true},
+		{name: "empty", secret: secret, payload: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := Verify(tt.secret, tt.payload)
+		if tt.wantErr {
+			if err != ErrInvalidPayload {
+				t.Errorf("%s: error = %v, want %v", tt.name, err, ErrInvalidPayload)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+			continue
+		}
+		if got != id {
+			t.Errorf("%s: Verify() = %q, want %q", tt.name, got, id)
+		}
+	}
+}
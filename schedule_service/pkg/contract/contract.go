@@ -0,0 +1,62 @@
+package contract
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Fields are the placeholders a contract template may use, written as
+// {{studentName}}.
+var Fields = []string{
+	"studentName",
+	"studentPhone",
+	"branchName",
+	"branchAddress",
+	"branchPhone",
+	"groupName",
+	"groupType",
+	"monthlyFee",
+	"date",
+}
+
+// Blank replaces fields that have no value, such as the group of a contract
+// printed before the student is placed, so they can be filled in by hand.
+const Blank = "________"
+
+var placeholderRe = regexp.MustCompile(`\{\{\s*(\w+)\s*\}\}`)
+
+// Validate checks that a template only uses known fields.
+func Validate(body string) error {
+	if strings.TrimSpace(body) == "" {
+		return fmt.Errorf("template body is required")
+	}
+
+	for _, match := range placeholderRe.FindAllStringSubmatch(body, -1) {
+		if !known(match[1]) {
+			return fmt.Errorf("unknown template field %q, expected one of: %s", match[1], strings.Join(Fields, ", "))
+		}
+	}
+
+	return nil
+}
+
+// Render fills the placeholders of body with values.
+func Render(body string, values map[string]string) string {
+	return placeholderRe.ReplaceAllStringFunc(body, func(placeholder string) string {
+		name := placeholderRe.FindStringSubmatch(placeholder)[1]
+		if value := strings.TrimSpace(values[name]); value != "" {
+			return value
+		}
+		return Blank
+	})
+}
+
+func known(name string) bool {
+	for _, field := range Fields {
+		if field == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package discount
+
+import (
+	"fmt"
+	"schedule_service/pkg/money"
+)
+
+const (
+	KindPercent = "percent"
+	KindFixed   = "fixed"
+)
+
+// Discount is a reduction that may apply to an invoice. Value is a decimal
+// string: a percentage for percent discounts, an amount for fixed ones.
+type Discount struct {
+	ID        string
+	Kind      string
+	Value     string
+	Stackable bool
+}
+
+// Applied is the part of an invoice covered by one discount, in minor units.
+type Applied struct {
+	ID     string
+	Amount int64
+}
+
+// Validate checks the kind and value of a discount. Percentages must be in
+// (0, 100].
+func Validate(kind, value string) error {
+	units, err := money.Amount.ParsePositive(value)
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case KindPercent:
+		if units > 100*100 {
+			return fmt.Errorf("percent discount cannot exceed 100: %s", value)
+		}
+	case KindFixed:
+	default:
+		return fmt.Errorf("invalid discount kind: %q", kind)
+	}
+
+	return nil
+}
+
+// Apply works out which discounts reduce an invoice of base minor units and
+// by how much. Stackable discounts add up; a discount that is not stackable
+// is applied alone. Whichever of the two gives the larger reduction wins,
+// stackable ones on a tie. The total never exceeds base.
+func Apply(base int64, discounts []Discount) ([]Applied, error) {
+	var (
+		stacked      []Applied
+		stackedTotal int64
+		best         Applied
+	)
+
+	for _, d := range discounts {
+		amount, err := reduction(base, d)
+		if err != nil {
+			return nil, err
+		}
+		if amount == 0 {
+			continue
+		}
+
+		if d.Stackable {
+			stacked = append(stacked, Applied{ID: d.ID, Amount: amount})
+			stackedTotal += amount
+		} else if amount > best.Amount {
+			best = Applied{ID: d.ID, Amount: amount}
+		}
+	}
+
+	if best.Amount > stackedTotal {
+		return []Applied{best}, nil
+	}
+
+	remaining := base
+	applied := make([]Applied, 0, len(stacked))
+	for _, a := range stacked {
+		if remaining == 0 {
+			break
+		}
+		if a.Amount > remaining {
+			a.Amount = remaining
+		}
+		remaining -= a.Amount
+		applied = append(applied, a)
+	}
+
+	return applied, nil
+}
+
+// Total sums the applied discounts.
+func Total(applied []Applied) int64 {
+	var total int64
+	for _, a := range applied {
+		total += a.Amount
+	}
+
+	return total
+}
+
+func reduction(base int64, d Discount) (int64, error) {
+	value, err := money.Amount.Parse(d.Value)
+	if err != nil {
+		return 0, err
+	}
+
+	var amount int64
+	switch d.Kind {
+	case KindPercent:
+		// value is in hundredths of a percent; round half up to minor units.
+		amount = (base*value + 5000) / 10000
+	case KindFixed:
+		amount = value
+	default:
+		return 0, fmt.Errorf("invalid discount kind: %q", d.Kind)
+	}
+
+	if amount > base {
+		amount = base
+	}
+	if amount < 0 {
+		amount = 0
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,168 @@
+package discount
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		kind    string
+		value   string
+		wantErr bool
+	}{
+		{kind: KindPercent, value: "10"},
+		{kind: KindPercent, value: "12.5"},
+		{kind: KindPercent, value: "100"},
+		{kind: KindPercent, value: "100.01", wantErr: true},
+		{kind: KindPercent, value: "0", wantErr: true},
+		{kind: KindPercent, value: "-5", wantErr: true},
+		{kind: KindFixed, value: "150000.00"},
+		{kind: KindFixed, value: "0", wantErr: true},
+		{kind: KindFixed, value: "abc", wantErr: true},
+		{kind: "bonus", value: "10", wantErr: true},
+		{kind: "", value: "10", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := Validate(tt.kind, tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%q, %q) error = %v, wantErr %v", tt.kind, tt.value, err, tt.wantErr)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name      string
+		base      int64
+		discounts []Discount
+		want      []Applied
+		wantErr   bool
+	}{
+		{
+			name: "no discounts",
+			base: 100000,
+		},
+		{
+			name: "stackable discounts add up",
+			base: 100000,
+			discounts: []Discount{
+				{ID: "a", Kind: KindPercent, Value: "10", Stackable: true},
+				{ID: "b", Kind: KindFixed, Value: "50", Stackable: true},
+			},
+			want: []Applied{{ID: "a", Amount: 10000}, {ID: "b", Amount: 5000}},
+		},
+		{
+			name: "larger exclusive discount wins",
+			base: 100000,
+			discounts: []Discount{
+				{ID: "a", Kind: KindPercent, Value: "10", Stackable: true},
+				{ID: "b", Kind: KindFixed, Value: "50", Stackable: true},
+				{ID: "c", Kind: KindPercent, Value: "20"},
+			},
+			want: []Applied{{ID: "c", Amount: 20000}},
+		},
+		{
+			name: "stackable discounts win a tie",
+			base: 100000,
+			discounts: []Discount{
+				{ID: "a", Kind: KindPercent, Value: "10", Stackable: true},
+				{ID: "b", Kind: KindFixed, Value: "50", Stackable: true},
+				{ID: "c", Kind: KindPercent, Value: "15"},
+			},
+			want: []Applied{{ID: "a", Amount: 10000}, {ID: "b", Amount: 5000}},
+		},
+		{
+			name: "best of the exclusive discounts",
+			base: 100000,
+			discounts: []Discount{
+				{ID: "a", Kind: KindPercent, Value: "20"},
+				{ID: "b", Kind: KindFixed, Value: "300"},
+				{ID: "c", Kind: KindPercent, Value: "25"},
+			},
+			want: []Applied{{ID: "b", Amount: 30000}},
+		},
+		{
+			name: "stacked total is capped at the base",
+			base: 100000,
+			discounts: []Discount{
+				{ID: "a", Kind: KindPercent, Value: "80", Stackable: true},
+				{ID: "b", Kind: KindFixed, Value: "300", Stackable: true},
+				{ID: "c", Kind: KindFixed, Value: "10", Stackable: true},
+			},
+			want: []Applied{{ID: "a", Amount: 80000}, {ID: "b", Amount: 20000}},
+		},
+		{
+			name: "fixed discount is capped at the base",
+			base: 100000,
+			discounts: []Discount{
+				{ID: "a", Kind: KindFixed, Value: "2000"},
+			},
+			want: []Applied{{ID: "a", Amount: 100000}},
+		},
+		{
+			name: "percent rounds half up to minor units",
+			base: 333,
+			discounts: []Discount{
+				{ID: "a", Kind: KindPercent, Value: "15", Stackable: true},
+				{ID: "b", Kind: KindPercent, Value: "10", Stackable: true},
+			},
+			want: []Applied{{ID: "a", Amount: 50}, {ID: "b", Amount: 33}},
+		},
+		{
+			name: "nothing off a zero base",
+			base: 0,
+			discounts: []Discount{
+				{ID: "a", Kind: KindPercent, Value: "10", Stackable: true},
+			},
+		},
+		{
+			name: "invalid value",
+			base: 100000,
+			discounts: []Discount{
+				{ID: "a", Kind: KindFixed, Value: "ten"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid kind",
+			base: 100000,
+			discounts: []Discount{
+				{ID: "a", Kind: "bonus", Value: "10"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := Apply(tt.base, tt.discounts)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if len(got) == 0 && len(tt.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Apply() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestTotal(t *testing.T) {
+	tests := []struct {
+		applied []Applied
+		want    int64
+	}{
+		{want: 0},
+		{applied: []Applied{{ID: "a", Amount: 10000}}, want: 10000},
+		{applied: []Applied{{ID: "a", Amount: 10000}, {ID: "b", Amount: 5050}}, want: 15050},
+	}
+
+	for _, tt := range tests {
+		if got := Total(tt.applied); got != tt.want {
+			t.Errorf("Total(%v) = %d, want %d", tt.applied, got, tt.want)
+		}
+	}
+}
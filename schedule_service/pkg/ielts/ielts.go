@@ -0,0 +1,108 @@
+package ielts
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	ReadingAcademic = "academic"
+	ReadingGeneral  = "general"
+
+	// MaxRaw is the number of questions in the Listening and Reading papers.
+	MaxRaw = 40
+)
+
+// threshold is the lowest raw score that earns a band.
+type threshold struct {
+	raw  int32
+	band float32
+}
+
+// The official conversion tables, highest band first. Raw scores below the
+// lowest published row earn band 2, and no correct answer earns 0.
+var (
+	listeningTable = []threshold{
+		{39, 9}, {37, 8.5}, {35, 8}, {32, 7.5}, {30, 7}, {26, 6.5}, {23, 6}, {18, 5.5},
+		{16, 5}, {13, 4.5}, {10, 4}, {8, 3.5}, {6, 3}, {4, 2.5}, {1, 2},
+	}
+	academicReadingTable = []threshold{
+		{39, 9}, {37, 8.5}, {35, 8}, {33, 7.5}, {30, 7}, {27, 6.5}, {23, 6}, {19, 5.5},
+		{15, 5}, {13, 4.5}, {10, 4}, {8, 3.5}, {6, 3}, {4, 2.5}, {1, 2},
+	}
+	generalReadingTable = []threshold{
+		{40, 9}, {39, 8.5}, {37, 8}, {36, 7.5}, {34, 7}, {32, 6.5}, {30, 6}, {27, 5.5},
+		{23, 5}, {19, 4.5}, {15, 4}, {12, 3.5}, {9, 3}, {6, 2.5}, {1, 2},
+	}
+)
+
+// ValidReadingType checks that a reading paper is academic or general
+// training.
+func ValidReadingType(readingType string) error {
+	if readingType != ReadingAcademic && readingType != ReadingGeneral {
+		return fmt.Errorf("readingType must be %s or %s", ReadingAcademic, ReadingGeneral)
+	}
+
+	return nil
+}
+
+// ListeningBand converts a Listening raw score to a band.
+func ListeningBand(raw int32) (float32, error) {
+	return convert(listeningTable, raw)
+}
+
+// ReadingBand converts a Reading raw score to a band on the table of the
+// paper taken.
+func ReadingBand(readingType string, raw int32) (float32, error) {
+	if err := ValidReadingType(readingType); err != nil {
+		return 0, err
+	}
+	if readingType == ReadingGeneral {
+		return convert(generalReadingTable, raw)
+	}
+
+	return convert(academicReadingTable, raw)
+}
+
+// CriteriaBand turns the four Writing or Speaking criterion scores, whole
+// bands from 0 to 9, into the band of the paper: their mean rounded down to
+// the half band.
+func CriteriaBand(criteria ...int32) (float32, error) {
+	if len(criteria) != 4 {
+		return 0, fmt.Errorf("expected 4 criterion scores, got %d", len(criteria))
+	}
+
+	var sum int32
+	for _, score := range criteria {
+		if score < 0 || score > 9 {
+			return 0, fmt.Errorf("invalid criterion score: %d", score)
+		}
+		sum += score
+	}
+
+	return float32(math.Floor(float64(sum)/4*2) / 2), nil
+}
+
+// OverallBand is the mean of the four paper bands rounded the IELTS way: a
+// mean ending in .25 goes up to the half band and one ending in .75 up to the
+// whole band; anything lower goes down.
+func OverallBand(listening, reading, writing, speaking float32) float32 {
+	mean := float64(listening+reading+writing+speaking) / 4
+	quarter := math.Floor(mean*4) / 4
+
+	return float32(math.Ceil(quarter*2) / 2)
+}
+
+func convert(table []threshold, raw int32) (float32, error) {
+	if raw < 0 || raw > MaxRaw {
+		return 0, fmt.Errorf("raw score must be between 0 and %d: %d", MaxRaw, raw)
+	}
+
+	for _, row := range table {
+		if raw >= row.raw {
+			return row.band, nil
+		}
+	}
+
+	return 0, nil
+}
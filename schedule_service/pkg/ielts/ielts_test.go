@@ -0,0 +1,129 @@
+package ielts
+
+import "testing"
+
+func TestListeningBand(t *testing.T) {
+	tests := []struct {
+		raw     int32
+		want    float32
+		wantErr bool
+	}{
+		{raw: 40, want: 9},
+		{raw: 39, want: 9},
+		{raw: 38, want: 8.5},
+		{raw: 32, want: 7.5},
+		{raw: 31, want: 7},
+		{raw: 26, want: 6.5},
+		{raw: 25, want: 6},
+		{raw: 16, want: 5},
+		{raw: 15, want: 4.5},
+		{raw: 1, want: 2},
+		{raw: 0, want: 0},
+		{raw: 41, wantErr: true},
+		{raw: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ListeningBand(tt.raw)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ListeningBand(%d) error = %v, wantErr %v", tt.raw, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ListeningBand(%d) = %v, want %v", tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestReadingBand(t *testing.T) {
+	tests := []struct {
+		readingType string
+		raw         int32
+		want        float32
+		wantErr     bool
+	}{
+		{readingType: ReadingAcademic, raw: 40, want: 9},
+		{readingType: ReadingAcademic, raw: 33, want: 7.5},
+		{readingType: ReadingAcademic, raw: 32, want: 7},
+		{readingType: ReadingAcademic, raw: 23, want: 6},
+		{readingType: ReadingAcademic, raw: 22, want: 5.5},
+		{readingType: ReadingAcademic, raw: 3, want: 2},
+		{readingType: ReadingAcademic, raw: 0, want: 0},
+		{readingType: ReadingGeneral, raw: 40, want: 9},
+		{readingType: ReadingGeneral, raw: 39, want: 8.5},
+		{readingType: ReadingGeneral, raw: 34, want: 7},
+		{readingType: ReadingGeneral, raw: 33, want: 6.5},
+		{readingType: ReadingGeneral, raw: 30, want: 6},
+		{readingType: ReadingGeneral, raw: 29, want: 5.5},
+		{readingType: ReadingGeneral, raw: 5, want: 2},
+		{readingType: ReadingGeneral, raw: 41, wantErr: true},
+		{readingType: "", raw: 30, wantErr: true},
+		{readingType: "Academic", raw: 30, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ReadingBand(tt.readingType, tt.raw)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ReadingBand(%q, %d) error = %v, wantErr %v", tt.readingType, tt.raw, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ReadingBand(%q, %d) = %v, want %v", tt.readingType, tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestCriteriaBand(t *testing.T) {
+	tests := []struct {
+		name     string
+		criteria []int32
+		want     float32
+		wantErr  bool
+	}{
+		{name: "whole band", criteria: []int32{7, 7, 7, 7}, want: 7},
+		{name: "half band", criteria: []int32{6, 6, 7, 7}, want: 6.5},
+		{name: "quarter rounds down", criteria: []int32{6, 6, 6, 7}, want: 6},
+		{name: "three quarters rounds down", criteria: []int32{6, 7, 7, 7}, want: 6.5},
+		{name: "zeros", criteria: []int32{0, 0, 0, 0}, want: 0},
+		{name: "nines", criteria: []int32{9, 9, 9, 9}, want: 9},
+		{name: "too few", criteria: []int32{7, 7, 7}, wantErr: true},
+		{name: "too many", criteria: []int32{7, 7, 7, 7, 7}, wantErr: true},
+		{name: "above nine", criteria: []int32{10, 7, 7, 7}, wantErr: true},
+		{name: "negative", criteria: []int32{-1, 7, 7, 7}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := CriteriaBand(tt.criteria...)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: CriteriaBand(%v) = %v, want %v", tt.name, tt.criteria, got, tt.want)
+		}
+	}
+}
+
+func TestOverallBand(t *testing.T) {
+	tests := []struct {
+		name                                  string
+		listening, reading, writing, speaking float32
+		want                                  float32
+	}{
+		{name: "exact whole band", listening: 7, reading: 7, writing: 7, speaking: 7, want: 7},
+		{name: "exact half band", listening: 6, reading: 7, writing: 6, speaking: 7, want: 6.5},
+		{name: ".25 goes up to the half band", listening: 6.5, reading: 6.5, writing: 6, speaking: 6, want: 6.5},
+		{name: ".75 goes up to the whole band", listening: 6.5, reading: 6.5, writing: 5, speaking: 5, want: 6},
+		{name: "below .25 goes down", listening: 7, reading: 7, writing: 7, speaking: 7.5, want: 7},
+		{name: "between .5 and .75 goes down", listening: 6.5, reading: 6.5, writing: 6.5, speaking: 7, want: 6.5},
+		{name: "between .75 and whole goes up", listening: 6.5, reading: 6.5, writing: 5.5, speaking: 5, want: 6},
+		{name: "zero", want: 0},
+	}
+
+	for _, tt := range tests {
+		got := OverallBand(tt.listening, tt.reading, tt.writing, tt.speaking)
+		if got != tt.want {
+			t.Errorf("%s: OverallBand(%v, %v, %v, %v) = %v, want %v", tt.name, tt.listening, tt.reading, tt.writing, tt.speaking, got, tt.want)
+		}
+	}
+}
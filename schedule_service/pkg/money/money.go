@@ -0,0 +1,89 @@
+package money
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Decimal describes a DECIMAL(Precision, 2) column. Amounts are exchanged as
+// decimal strings with at most two fractional digits and handled in code as
+// minor units (tiyin) to avoid float rounding. A zero Precision has no digit
+// limit; only the int64 range bounds it.
+type Decimal struct {
+	Precision int
+}
+
+var (
+	// Amount is the DECIMAL(10, 2) of tuition-sized payments.
+	Amount = Decimal{Precision: 10}
+	// Large is the DECIMAL(12, 2) of payroll and expenses, where salaries
+	// and rent outgrow tuition-sized amounts.
+	Large = Decimal{Precision: 12}
+	// Total is a SUM or other aggregate read back from the database, which
+	// outgrows any single row.
+	Total = Decimal{}
+)
+
+// Parse converts a decimal string such as "450000.50" to minor units.
+func (d Decimal) Parse(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+
+	whole, fraction, hasFraction := strings.Cut(strings.TrimPrefix(value, "-"), ".")
+	if !isDigits(whole) || hasFraction && (!isDigits(fraction) || len(fraction) > 2) {
+		return 0, fmt.Errorf("invalid amount: %q", value)
+	}
+	if d.Precision > 0 && len(whole) > d.Precision-2 {
+		return 0, fmt.Errorf("invalid amount: %q", value)
+	}
+
+	fraction = (fraction + "00")[:2]
+
+	units, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount: %q", value)
+	}
+	if strings.HasPrefix(value, "-") {
+		units = -units
+	}
+
+	return units, nil
+}
+
+// ParsePositive is Parse for amounts that must be greater than zero.
+func (d Decimal) ParsePositive(value string) (int64, error) {
+	units, err := d.Parse(value)
+	if err != nil {
+		return 0, err
+	}
+	if units <= 0 {
+		return 0, fmt.Errorf("amount must be positive: %q", value)
+	}
+
+	return units, nil
+}
+
+func isDigits(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Format converts minor units back to a decimal string with two fractional
+// digits.
+func Format(units int64) string {
+	sign := ""
+	if units < 0 {
+		sign = "-"
+		units = -units
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, units/100, units%100)
+}
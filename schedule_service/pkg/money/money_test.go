@@ -0,0 +1,75 @@
+package money
+
+import "testing"
+
+func TestDecimalParse(t *testing.T) {
+	tests := []struct {
+		parse   func(string) (int64, error)
+		name    string
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{parse: Amount.Parse, name: "Amount.Parse", value: "450000.50", want: 45000050},
+		{parse: Amount.Parse, name: "Amount.Parse", value: "1", want: 100},
+		{parse: Amount.Parse, name: "Amount.Parse", value: "1.5", want: 150},
+		{parse: Amount.Parse, name: "Amount.Parse", value: "0.05", want: 5},
+		{parse: Amount.Parse, name: "Amount.Parse", value: "-2.05", want: -205},
+		{parse: Amount.Parse, name: "Amount.Parse", value: " 3 ", want: 300},
+		{parse: Amount.Parse, name: "Amount.Parse", value: "99999999.99", want: 9999999999},
+		{parse: Amount.Parse, name: "Amount.Parse", value: "100000000", wantErr: true},
+		{parse: Amount.Parse, name: "Amount.Parse", value: "1.234", wantErr: true},
+		{parse: Amount.Parse, name: "Amount.Parse", value: "1,5", wantErr: true},
+		{parse: Amount.Parse, name: "Amount.Parse", value: ".5", wantErr: true},
+		{parse: Amount.Parse, name: "Amount.Parse", value: "abc", wantErr: true},
+		{parse: Amount.Parse, name: "Amount.Parse", value: "", wantErr: true},
+		{parse: Large.Parse, name: "Large.Parse", value: "9999999999.99", want: 999999999999},
+		{parse: Large.Parse, name: "Large.Parse", value: "-100000000", want: -10000000000},
+		{parse: Large.Parse, name: "Large.Parse", value: "10000000000", wantErr: true},
+		{parse: Total.Parse, name: "Total.Parse", value: "123456789012.34", want: 12345678901234},
+		{parse: Total.Parse, name: "Total.Parse", value: "-0.10", want: -10},
+		{parse: Total.Parse, name: "Total.Parse", value: "1.001", wantErr: true},
+		{parse: Total.Parse, name: "Total.Parse", value: "99999999999999999999", wantErr: true},
+		{parse: Decimal{Precision: 4}.Parse, name: "Decimal{4}.Parse", value: "99.99", want: 9999},
+		{parse: Decimal{Precision: 4}.Parse, name: "Decimal{4}.Parse", value: "100", wantErr: true},
+		{parse: Amount.Parse, name: "Amount.Parse", value: "-", wantErr: true},
+		{parse: Amount.Parse, name: "Amount.Parse", value: "1.", wantErr: true},
+		{parse: Amount.ParsePositive, name: "Amount.ParsePositive", value: "0.01", want: 1},
+		{parse: Amount.ParsePositive, name: "Amount.ParsePositive", value: "0", wantErr: true},
+		{parse: Amount.ParsePositive, name: "Amount.ParsePositive", value: "-1", wantErr: true},
+		{parse: Amount.ParsePositive, name: "Amount.ParsePositive", value: "100000000", wantErr: true},
+		{parse: Large.ParsePositive, name: "Large.ParsePositive", value: "100000000", want: 10000000000},
+		{parse: Large.ParsePositive, name: "Large.ParsePositive", value: "0.00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.parse(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s(%q) error = %v, wantErr %v", tt.name, tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s(%q) = %d, want %d", tt.name, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		units int64
+		want  string
+	}{
+		{units: 0, want: "0.00"},
+		{units: 5, want: "0.05"},
+		{units: 150, want: "1.50"},
+		{units: 45000050, want: "450000.50"},
+		{units: -205, want: "-2.05"},
+		{units: -5, want: "-0.05"},
+	}
+
+	for _, tt := range tests {
+		if got := Format(tt.units); got != tt.want {
+			t.Errorf("Format(%d) = %q, want %q", tt.units, got, tt.want)
+		}
+	}
+}
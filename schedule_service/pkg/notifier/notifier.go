@@ -0,0 +1,73 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+// NotifierI is the hook other parts of the service call when something
+// happened that people outside the system should hear about.
+type NotifierI interface {
+	Notify(ctx context.Context, event string, payload interface{})
+}
+
+type notifier struct {
+	url    string
+	log    logger.LoggerI
+	client *http.Client
+}
+
+// New returns a notifier that posts events as JSON to url. With an empty url
+// events are only logged.
+func New(url string, log logger.LoggerI) NotifierI {
+	return &notifier{
+		url:    url,
+		log:    log,
+		client: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+type message struct {
+	Event   string      `json:"event"`
+	Payload interface{} `json:"payload"`
+	SentAt  time.Time   `json:"sent_at"`
+}
+
+// Notify never fails the caller: delivery errors are logged.
+func (n *notifier) Notify(ctx context.Context, event string, payload interface{}) {
+	n.log.Info("---Notify--->>>", logger.String("event", event), logger.Any("payload", payload))
+
+	if n.url == "" {
+		return
+	}
+
+	body, err := json.Marshal(message{Event: event, Payload: payload, SentAt: time.Now()})
+	if err != nil {
+		n.log.Error("---Notify--->>>", logger.Error(err))
+		return
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		n.log.Error("---Notify--->>>", logger.Error(err))
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		n.log.Error("---Notify--->>>", logger.Error(err))
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		n.log.Error("---Notify--->>>", logger.Error(fmt.Errorf("webhook responded with %s", resp.Status)))
+	}
+}
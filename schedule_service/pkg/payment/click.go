@@ -0,0 +1,138 @@
+package payment
+
+import (
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"schedule_service/pkg/money"
+	"strconv"
+)
+
+// Click implements the Click SHOP-API: Click first calls with action 0
+// (prepare), then with action 1 (complete). A complete call with a negative
+// error means the payment failed on the Click side.
+type Click struct {
+	serviceID  string
+	merchantID string
+	secretKey  string
+}
+
+func NewClick(serviceID, merchantID, secretKey string) *Click {
+	return &Click{
+		serviceID:  serviceID,
+		merchantID: merchantID,
+		secretKey:  secretKey,
+	}
+}
+
+func (c *Click) Name() string {
+	return "click"
+}
+
+func (c *Click) CheckoutURL(order Order) string {
+	query := url.Values{}
+	query.Set("service_id", c.serviceID)
+	query.Set("merchant_id", c.merchantID)
+	query.Set("amount", money.Format(order.Amount))
+	query.Set("transaction_param", order.ID)
+	if order.ReturnURL != "" {
+		query.Set("return_url", order.ReturnURL)
+	}
+
+	return "https://my.click.uz/services/pay?" + query.Encode()
+}
+
+func (c *Click) Parse(payload map[string]string) (*Callback, error) {
+	action := payload["action"]
+	if action != "0" && action != "1" {
+		return nil, ErrInvalidCallback
+	}
+
+	signed := payload["click_trans_id"] + payload["service_id"] + c.secretKey + payload["merchant_trans_id"]
+	if action == "1" {
+		signed += payload["merchant_prepare_id"]
+	}
+	signed += payload["amount"] + action + payload["sign_time"]
+
+	sum := md5.Sum([]byte(signed))
+	if subtle.ConstantTimeCompare([]byte(hex.EncodeToString(sum[:])), []byte(payload["sign_string"])) != 1 {
+		return nil, ErrInvalidSignature
+	}
+
+	if payload["service_id"] != c.serviceID || payload["click_trans_id"] == "" || payload["merchant_trans_id"] == "" {
+		return nil, ErrInvalidCallback
+	}
+
+	amount, err := money.Amount.Parse(payload["amount"])
+	if err != nil {
+		return nil, ErrInvalidCallback
+	}
+
+	callback := &Callback{
+		OrderID:       payload["merchant_trans_id"],
+		TransactionID: payload["click_trans_id"],
+		Amount:        amount,
+		Stage:         StagePrepare,
+	}
+
+	if action == "1" {
+		callback.Stage = StageComplete
+		if code, _ := strconv.Atoi(payload["error"]); code < 0 {
+			callback.Stage = StageCancel
+		}
+	}
+
+	return callback, nil
+}
+
+// Reply answers in the Click format. The order id doubles as the prepare
+// and confirm ids.
+func (c *Click) Reply(payload map[string]string, callback *Callback, err error) []byte {
+	code, note := clickError(err)
+
+	var clickTransID interface{} = payload["click_trans_id"]
+	if id, err := strconv.ParseInt(payload["click_trans_id"], 10, 64); err == nil {
+		clickTransID = id
+	}
+
+	reply := map[string]interface{}{
+		"click_trans_id":    clickTransID,
+		"merchant_trans_id": payload["merchant_trans_id"],
+		"error":             code,
+		"error_note":        note,
+	}
+
+	if payload["action"] == "1" {
+		reply["merchant_confirm_id"] = payload["merchant_trans_id"]
+	} else {
+		reply["merchant_prepare_id"] = payload["merchant_trans_id"]
+	}
+
+	body, _ := json.Marshal(reply)
+	return body
+}
+
+func clickError(err error) (int, string) {
+	switch {
+	case err == nil:
+		return 0, "Success"
+	case errors.Is(err, ErrInvalidSignature):
+		return -1, "SIGN CHECK FAILED!"
+	case errors.Is(err, ErrAmountMismatch):
+		return -2, "Incorrect parameter amount"
+	case errors.Is(err, ErrAlreadyPaid):
+		return -4, "Already paid"
+	case errors.Is(err, ErrOrderNotFound):
+		return -5, "User does not exist"
+	case errors.Is(err, ErrInvalidCallback):
+		return -8, "Error in request from click"
+	case errors.Is(err, ErrCancelled):
+		return -9, "Transaction cancelled"
+	default:
+		return -7, fmt.Sprintf("Failed to update user: %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package payment
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestClickParse(t *testing.T) {
+	click := NewClick("77", "merchant", "secret")
+
+	prepare := map[string]string{
+		"click_trans_id":    "123",
+		"service_id":        "77",
+		"merchant_trans_id": "order-1",
+		"amount":            "45000.50",
+		"action":            "0",
+		"sign_time":         "2024-05-01 10:00:00",
+		"sign_string":       "dc3388329300788477dcca8e8a3362af",
+	}
+	complete := map[string]string{
+		"click_trans_id":      "123",
+		"service_id":          "77",
+		"merchant_trans_id":   "order-1",
+		"merchant_prepare_id": "order-1",
+		"amount":              "45000.50",
+		"action":              "1",
+		"error":               "0",
+		"sign_time":           "2024-05-01 10:01:00",
+		"sign_string":         "1c0f0acfe6c2ba5ac501a25454d255da",
+	}
+
+	with := func(payload map[string]string, key, value string) map[string]string {
+		changed := map[string]string{}
+		for k, v := range payload {
+			changed[k] = v
+		}
+		changed[key] = value
+		return changed
+	}
+
+	tests := []struct {
+		name    string
+		payload map[string]string
+		want    *Callback
+		wantErr error
+	}{
+		{
+			name:    "prepare",
+			payload: prepare,
+			want:    &Callback{OrderID: "order-1", TransactionID: "123", Amount: 4500050, Stage: StagePrepare},
+		},
+		{
+			name:    "complete",
+			payload: complete,
+			want:    &Callback{OrderID: "order-1", TransactionID: "123", Amount: 4500050, Stage: StageComplete},
+		},
+		{
+			name:    "failed on the Click side",
+			payload: with(complete, "error", "-5017"),
+			want:    &Callback{OrderID: "order-1", TransactionID: "123", Amount: 4500050, Stage: StageCancel},
+		},
+		{
+			name:    "wrong signature",
+			payload: with(prepare, "sign_string", "00000000000000000000000000000000"),
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:    "amount changed after signing",
+			payload: with(prepare, "amount", "1.00"),
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:    "prepare id left out of the complete signature",
+			payload: with(complete, "merchant_prepare_id", "order-2"),
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name: "signed for another service",
+			payload: with(with(prepare, "service_id", "78"),
+				"sign_string", "cb7499e0b5606a1b8156ac8c078b1b4c"),
+			wantErr: ErrInvalidCallback,
+		},
+		{
+			name: "invalid amount",
+			payload: with(with(prepare, "amount", "45000.505"),
+				"sign_string", "2ea7de65a6f5af45917f33514a017ff1"),
+			wantErr: ErrInvalidCallback,
+		},
+		{
+			name:    "unknown action",
+			payload: with(prepare, "action", "2"),
+			wantErr: ErrInvalidCallback,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := click.Parse(tt.payload)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Parse() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
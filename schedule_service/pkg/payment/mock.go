@@ -0,0 +1,85 @@
+package payment
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"schedule_service/pkg/money"
+	"strings"
+)
+
+// Mock is a local provider for testing the payment flow offline. Its
+// callbacks carry orderId, transactionId, amount and stage, signed with
+// HMAC-SHA256 over those fields joined by "|".
+type Mock struct {
+	secret      string
+	checkoutURL string
+}
+
+func NewMock(secret, checkoutURL string) *Mock {
+	return &Mock{
+		secret:      secret,
+		checkoutURL: strings.TrimRight(checkoutURL, "/"),
+	}
+}
+
+func (m *Mock) Name() string {
+	return "mock"
+}
+
+func (m *Mock) CheckoutURL(order Order) string {
+	return m.checkoutURL + "/" + order.ID
+}
+
+// Callback builds the signed payload the mock provider posts for an order.
+func (m *Mock) Callback(orderID, transactionID string, amount int64, stage string) map[string]string {
+	payload := map[string]string{
+		"orderId":       orderID,
+		"transactionId": transactionID,
+		"amount":        money.Format(amount),
+		"stage":         stage,
+	}
+	payload["signature"] = m.sign(payload)
+
+	return payload
+}
+
+func (m *Mock) Parse(payload map[string]string) (*Callback, error) {
+	if !hmac.Equal([]byte(payload["signature"]), []byte(m.sign(payload))) {
+		return nil, ErrInvalidSignature
+	}
+
+	stage := payload["stage"]
+	if stage != StagePrepare && stage != StageComplete && stage != StageCancel {
+		return nil, ErrInvalidCallback
+	}
+
+	amount, err := money.Amount.Parse(payload["amount"])
+	if err != nil || payload["orderId"] == "" || payload["transactionId"] == "" {
+		return nil, ErrInvalidCallback
+	}
+
+	return &Callback{
+		OrderID:       payload["orderId"],
+		TransactionID: payload["transactionId"],
+		Amount:        amount,
+		Stage:         stage,
+	}, nil
+}
+
+func (m *Mock) Reply(payload map[string]string, callback *Callback, err error) []byte {
+	reply := map[string]interface{}{"ok": err == nil}
+	if err != nil {
+		reply["error"] = err.Error()
+	}
+
+	body, _ := json.Marshal(reply)
+	return body
+}
+
+func (m *Mock) sign(payload map[string]string) string {
+	mac := hmac.New(sha256.New, []byte(m.secret))
+	mac.Write([]byte(strings.Join([]string{payload["orderId"], payload["transactionId"], payload["amount"], payload["stage"]}, "|")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
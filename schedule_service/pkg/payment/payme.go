@@ -0,0 +1,171 @@
+package payment
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Payme implements the Payme Merchant API, a JSON-RPC endpoint that Payme
+// calls with Basic authorization ("Paycom" and the merchant key). The nested
+// params arrive flattened with dotted keys, such as "params.account.order_id".
+// CheckPerformTransaction and CreateTransaction name the order and amount;
+// PerformTransaction and CancelTransaction only name the Payme transaction.
+// CheckTransaction and GetStatement are not supported: their state lives in
+// the online payment, which the reply cannot see, so reconcile with the
+// statement instead.
+type Payme struct {
+	merchantID  string
+	key         string
+	checkoutURL string
+}
+
+func NewPayme(merchantID, key, checkoutURL string) *Payme {
+	return &Payme{
+		merchantID:  merchantID,
+		key:         key,
+		checkoutURL: strings.TrimRight(checkoutURL, "/"),
+	}
+}
+
+func (p *Payme) Name() string {
+	return "payme"
+}
+
+// CheckoutURL encodes the merchant, order and amount in tiyin the way the
+// Payme checkout expects them.
+func (p *Payme) CheckoutURL(order Order) string {
+	params := fmt.Sprintf("m=%s;ac.order_id=%s;a=%d", p.merchantID, order.ID, order.Amount)
+	if order.ReturnURL != "" {
+		params += ";c=" + order.ReturnURL
+	}
+
+	return p.checkoutURL + "/" + base64.StdEncoding.EncodeToString([]byte(params))
+}
+
+// Authorize checks the Authorization header Payme signs its calls with.
+func (p *Payme) Authorize(header string) error {
+	expected := "Basic " + base64.StdEncoding.EncodeToString([]byte("Paycom:"+p.key))
+	if subtle.ConstantTimeCompare([]byte(header), []byte(expected)) != 1 {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func (p *Payme) Parse(payload map[string]string) (*Callback, error) {
+	callback := &Callback{TransactionID: payload["params.id"]}
+
+	switch payload["method"] {
+	case "CheckPerformTransaction":
+		callback.Stage = StagePrepare
+		callback.TransactionID = ""
+	case "CreateTransaction":
+		callback.Stage = StagePrepare
+		if err := p.needTransaction(callback); err != nil {
+			return nil, err
+		}
+	case "PerformTransaction":
+		callback.Stage = StageComplete
+		return callback, p.needTransaction(callback)
+	case "CancelTransaction":
+		callback.Stage = StageCancel
+		return callback, p.needTransaction(callback)
+	default:
+		return nil, ErrInvalidCallback
+	}
+
+	amount, err := strconv.ParseInt(payload["params.amount"], 10, 64)
+	if err != nil || amount <= 0 || payload["params.account.order_id"] == "" {
+		return nil, ErrInvalidCallback
+	}
+
+	callback.OrderID = payload["params.account.order_id"]
+	callback.Amount = amount
+
+	return callback, nil
+}
+
+func (p *Payme) needTransaction(callback *Callback) error {
+	if callback.TransactionID == "" {
+		return ErrInvalidCallback
+	}
+
+	return nil
+}
+
+// Reply answers in the JSON-RPC format of the Merchant API. Times are in
+// milliseconds; a repeated cancel of a cancelled payment succeeds.
+func (p *Payme) Reply(payload map[string]string, callback *Callback, err error) []byte {
+	method := payload["method"]
+	if method == "CancelTransaction" && errors.Is(err, ErrCancelled) {
+		err = nil
+	}
+
+	reply := map[string]interface{}{"id": paymeRequestID(payload["id"])}
+
+	if err != nil {
+		code, message := paymeError(method, err)
+		reply["error"] = map[string]interface{}{
+			"code":    code,
+			"message": map[string]string{"ru": message, "uz": message, "en": message},
+		}
+	} else {
+		now := time.Now().UnixMilli()
+		transaction := payload["params.id"]
+
+		switch method {
+		case "CheckPerformTransaction":
+			reply["result"] = map[string]interface{}{"allow": true}
+		case "CreateTransaction":
+			created, _ := strconv.ParseInt(payload["params.time"], 10, 64)
+			reply["result"] = map[string]interface{}{"create_time": created, "transaction": transaction, "state": 1}
+		case "PerformTransaction":
+			reply["result"] = map[string]interface{}{"perform_time": now, "transaction": transaction, "state": 2}
+		case "CancelTransaction":
+			reply["result"] = map[string]interface{}{"cancel_time": now, "transaction": transaction, "state": -1}
+		}
+	}
+
+	body, _ := json.Marshal(reply)
+	return body
+}
+
+// paymeRequestID echoes the JSON-RPC id as a number when it is one.
+func paymeRequestID(id string) interface{} {
+	if number, err := strconv.ParseInt(id, 10, 64); err == nil {
+		return number
+	}
+
+	return id
+}
+
+func paymeError(method string, err error) (int, string) {
+	byTransaction := method == "PerformTransaction" || method == "CancelTransaction"
+
+	switch {
+	case errors.Is(err, ErrInvalidSignature):
+		return -32504, "Insufficient privileges"
+	case errors.Is(err, ErrAmountMismatch):
+		return -31001, "Incorrect amount"
+	case errors.Is(err, ErrOrderNotFound) && byTransaction:
+		return -31003, "Transaction not found"
+	case errors.Is(err, ErrOrderNotFound):
+		return -31050, "Order not found"
+	case errors.Is(err, ErrAlreadyPaid) && method == "CancelTransaction":
+		return -31007, "Order is paid, the transaction cannot be cancelled"
+	case errors.Is(err, ErrAlreadyPaid), errors.Is(err, ErrCancelled):
+		return -31008, "Unable to perform operation"
+	case errors.Is(err, ErrInvalidCallback) && method != "CheckPerformTransaction" && method != "CreateTransaction" && !byTransaction:
+		return -32601, "Method not found"
+	case errors.Is(err, ErrInvalidCallback):
+		return -32600, "Invalid request"
+	default:
+		return -32400, fmt.Sprintf("System error: %v", err)
+	}
+}
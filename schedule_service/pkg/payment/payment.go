@@ -0,0 +1,59 @@
+package payment
+
+import "errors"
+
+// Stages a provider callback can report.
+const (
+	// StagePrepare asks whether an order can still be paid.
+	StagePrepare = "prepare"
+	// StageComplete reports that the money was captured.
+	StageComplete = "complete"
+	// StageCancel reports that the payment failed or was cancelled.
+	StageCancel = "cancel"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid callback signature")
+	ErrInvalidCallback  = errors.New("invalid callback")
+	ErrOrderNotFound    = errors.New("online payment not found")
+	ErrAmountMismatch   = errors.New("amount does not match the online payment")
+	ErrAlreadyPaid      = errors.New("online payment is already paid")
+	ErrCancelled        = errors.New("online payment is cancelled")
+)
+
+// Order is an online payment a student is about to make. Amount is in minor
+// units.
+type Order struct {
+	ID        string
+	Amount    int64
+	ReturnURL string
+}
+
+// Callback is a verified provider callback. Amount is in minor units. A
+// callback without an OrderID names only the provider transaction, and
+// refers to the order and amount checked when that transaction was created.
+type Callback struct {
+	OrderID       string
+	TransactionID string
+	Amount        int64
+	Stage         string
+}
+
+// Provider is an online payment provider. Callbacks arrive as flat
+// key-value payloads, the form fields or JSON keys the provider posts.
+type Provider interface {
+	Name() string
+	// CheckoutURL is the page where the student pays the order.
+	CheckoutURL(order Order) string
+	// Parse verifies the signature of a callback and reads it.
+	Parse(payload map[string]string) (*Callback, error)
+	// Reply is the response body the provider expects for a callback that
+	// was handled with err.
+	Reply(payload map[string]string, callback *Callback, err error) []byte
+}
+
+// Authorizer is implemented by providers that authenticate callbacks by the
+// Authorization header instead of a signature in the payload.
+type Authorizer interface {
+	Authorize(header string) error
+}
@@ -0,0 +1,293 @@
+package timetable
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// step is the granularity, in minutes, of candidate lesson start times.
+const step = 30
+
+// maxSteps bounds the backtracking search before falling back to a greedy
+// placement, so a branch with many groups still gets an answer quickly.
+const maxSteps = 200000
+
+// Window is a weekly time range. Weekday is ISO (1 = Monday), Start and End
+// are minutes from midnight.
+type Window struct {
+	Weekday int
+	Start   int
+	End     int
+}
+
+// Group is a group that needs lessonsPerWeek lessons of Duration minutes.
+type Group struct {
+	ID               string
+	TeacherID        string
+	SupportTeacherID string
+	LessonsPerWeek   int
+	Duration         int
+}
+
+// Lesson is a placed weekly lesson.
+type Lesson struct {
+	GroupID          string
+	TeacherID        string
+	SupportTeacherID string
+	Window
+	// SameBranch marks existing lessons that take a room in the branch being
+	// planned. Lessons from other branches only block their teachers.
+	SameBranch bool
+}
+
+type Input struct {
+	Groups []Group
+	// Availability maps a teacher or support teacher id to the weekly
+	// windows they can teach in.
+	Availability map[string][]Window
+	// Busy are lessons that are already fixed and must not be moved.
+	Busy []Lesson
+	// Rooms is the number of lessons that can run at the same time in the
+	// branch. Zero means unlimited.
+	Rooms int
+}
+
+type Result struct {
+	Lessons []Lesson
+	// Unscheduled maps a group id to the reason it could not be placed.
+	Unscheduled map[string]string
+}
+
+type unit struct {
+	group      Group
+	candidates []Window
+}
+
+type solver struct {
+	in     Input
+	placed []Lesson
+	steps  int
+}
+
+// Solve proposes a conflict-free weekly timetable. Lessons of the same group
+// are put on different days, no teacher teaches two lessons at once, every
+// lesson lies inside its teachers' availability and at most Rooms lessons of
+// the branch overlap.
+func Solve(in Input) Result {
+	res := Result{Unscheduled: map[string]string{}}
+
+	var units []unit
+	for _, g := range in.Groups {
+		candidates, reason := candidatesFor(g, in.Availability)
+		if reason != "" {
+			res.Unscheduled[g.ID] = reason
+			continue
+		}
+		units = append(units, unit{group: g, candidates: candidates})
+	}
+
+	// Most constrained groups first.
+	sort.SliceStable(units, func(i, j int) bool {
+		return len(units[i].candidates) < len(units[j].candidates)
+	})
+
+	s := &solver{in: in}
+	if s.search(units, 0, 0, -1) {
+		res.Lessons = s.placed
+		return res
+	}
+
+	// The full search failed or ran out of budget: place groups one by one
+	// and report the ones that do not fit.
+	s.placed = nil
+	for _, u := range units {
+		before := len(s.placed)
+		s.steps = 0
+		if !s.search([]unit{u}, 0, 0, -1) {
+			s.placed = s.placed[:before]
+			res.Unscheduled[u.group.ID] = "no conflict-free slot"
+		}
+	}
+	res.Lessons = s.placed
+
+	return res
+}
+
+// search places lesson number k of units[i]. Lessons of one group are placed
+// in increasing candidate order to avoid trying the same set twice.
+func (s *solver) search(units []unit, i, k, from int) bool {
+	if i == len(units) {
+		return true
+	}
+
+	u := units[i]
+	if k == u.group.LessonsPerWeek {
+		return s.search(units, i+1, 0, -1)
+	}
+
+	for c := from + 1; c < len(u.candidates); c++ {
+		s.steps++
+		if s.steps > maxSteps {
+			return false
+		}
+
+		lesson := Lesson{
+			GroupID:          u.group.ID,
+			TeacherID:        u.group.TeacherID,
+			SupportTeacherID: u.group.SupportTeacherID,
+			Window:           u.candidates[c],
+			SameBranch:       true,
+		}
+		if !s.fits(lesson) {
+			continue
+		}
+
+		s.placed = append(s.placed, lesson)
+		if s.search(units, i, k+1, c) {
+			return true
+		}
+		s.placed = s.placed[:len(s.placed)-1]
+	}
+
+	return false
+}
+
+func (s *solver) fits(l Lesson) bool {
+	rooms := 0
+	check := func(o Lesson) bool {
+		if o.GroupID == l.GroupID && o.Weekday == l.Weekday {
+			return false
+		}
+		if !overlaps(o.Window, l.Window) {
+			return true
+		}
+		if sharesTeacher(o, l) {
+			return false
+		}
+		if o.SameBranch {
+			rooms++
+		}
+		return true
+	}
+
+	for _, o := range s.in.Busy {
+		if !check(o) {
+			return false
+		}
+	}
+	for _, o := range s.placed {
+		if !check(o) {
+			return false
+		}
+	}
+
+	// Counting every overlapping lesson is stricter than the true peak, but
+	// keeps the check cheap and never overbooks rooms.
+	return s.in.Rooms <= 0 || rooms < s.in.Rooms
+}
+
+func candidatesFor(g Group, availability map[string][]Window) ([]Window, string) {
+	if g.LessonsPerWeek <= 0 || g.Duration <= 0 {
+		return nil, "group has no lesson requirement"
+	}
+	if g.TeacherID == "" {
+		return nil, "group has no teacher"
+	}
+
+	teacher := availability[g.TeacherID]
+	if len(teacher) == 0 {
+		return nil, "teacher has no availability"
+	}
+
+	var support []Window
+	if g.SupportTeacherID != "" {
+		support = availability[g.SupportTeacherID]
+		if len(support) == 0 {
+			return nil, "support teacher has no availability"
+		}
+	}
+
+	var candidates []Window
+	for _, w := range teacher {
+		for start := roundUp(w.Start); start+g.Duration <= w.End; start += step {
+			slot := Window{Weekday: w.Weekday, Start: start, End: start + g.Duration}
+			if g.SupportTeacherID != "" && !covered(slot, support) {
+				continue
+			}
+			candidates = append(candidates, slot)
+		}
+	}
+
+	days := map[int]bool{}
+	for _, c := range candidates {
+		days[c.Weekday] = true
+	}
+	if len(days) < g.LessonsPerWeek {
+		return nil, fmt.Sprintf("teachers are available on %d days, %d lessons per week required", len(days), g.LessonsPerWeek)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Weekday != candidates[j].Weekday {
+			return candidates[i].Weekday < candidates[j].Weekday
+		}
+		return candidates[i].Start < candidates[j].Start
+	})
+
+	return candidates, ""
+}
+
+func covered(slot Window, windows []Window) bool {
+	for _, w := range windows {
+		if w.Weekday == slot.Weekday && w.Start <= slot.Start && slot.End <= w.End {
+			return true
+		}
+	}
+	return false
+}
+
+func overlaps(a, b Window) bool {
+	return a.Weekday == b.Weekday && a.Start < b.End && b.Start < a.End
+}
+
+func sharesTeacher(a, b Lesson) bool {
+	for _, x := range []string{a.TeacherID, a.SupportTeacherID} {
+		if x == "" {
+			continue
+		}
+		if x == b.TeacherID || x == b.SupportTeacherID {
+			return true
+		}
+	}
+	return false
+}
+
+func roundUp(minutes int) int {
+	if minutes%step == 0 {
+		return minutes
+	}
+	return minutes + step - minutes%step
+}
+
+// ParseClock converts "15:04" or "15:04:05" to minutes from midnight.
+func ParseClock(value string) (int, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 {
+		return 0, fmt.Errorf("invalid time: %s", value)
+	}
+
+	var hours, minutes int
+	if _, err := fmt.Sscanf(parts[0]+" "+parts[1], "%d %d", &hours, &minutes); err != nil {
+		return 0, fmt.Errorf("invalid time: %s", value)
+	}
+	if hours < 0 || hours > 24 || minutes < 0 || minutes > 59 {
+		return 0, fmt.Errorf("invalid time: %s", value)
+	}
+
+	return hours*60 + minutes, nil
+}
+
+// FormatClock converts minutes from midnight to "15:04".
+func FormatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
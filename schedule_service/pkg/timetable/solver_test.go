@@ -0,0 +1,259 @@
+package timetable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSolve(t *testing.T) {
+	monday := func(start, end string) Window {
+		s, _ := ParseClock(start)
+		e, _ := ParseClock(end)
+		return Window{Weekday: 1, Start: s, End: e}
+	}
+	on := func(weekday int, w Window) Window {
+		w.Weekday = weekday
+		return w
+	}
+
+	tests := []struct {
+		name string
+		in   Input
+		// lessons is the number of lessons placed per group.
+		lessons     map[string]int
+		unscheduled map[string]string
+		// starts pins the start of a group's first lesson, where only one
+		// start fits.
+		starts map[string]string
+	}{
+		{
+			name: "no lesson requirement",
+			in: Input{
+				Groups:       []Group{{ID: "g1", TeacherID: "t1", Duration: 90}},
+				Availability: map[string][]Window{"t1": {monday("09:00", "12:00")}},
+			},
+			unscheduled: map[string]string{"g1": "group has no lesson requirement"},
+		},
+		{
+			name: "no teacher",
+			in: Input{
+				Groups: []Group{{ID: "g1", LessonsPerWeek: 1, Duration: 90}},
+			},
+			unscheduled: map[string]string{"g1": "group has no teacher"},
+		},
+		{
+			name: "teacher without availability",
+			in: Input{
+				Groups: []Group{{ID: "g1", TeacherID: "t1", LessonsPerWeek: 1, Duration: 90}},
+			},
+			unscheduled: map[string]string{"g1": "teacher has no availability"},
+		},
+		{
+			name: "support teacher without availability",
+			in: Input{
+				Groups:       []Group{{ID: "g1", TeacherID: "t1", SupportTeacherID: "s1", LessonsPerWeek: 1, Duration: 90}},
+				Availability: map[string][]Window{"t1": {monday("09:00", "12:00")}},
+			},
+			unscheduled: map[string]string{"g1": "support teacher has no availability"},
+		},
+		{
+			name: "too few days for the lessons",
+			in: Input{
+				Groups:       []Group{{ID: "g1", TeacherID: "t1", LessonsPerWeek: 2, Duration: 90}},
+				Availability: map[string][]Window{"t1": {monday("09:00", "18:00")}},
+			},
+			unscheduled: map[string]string{"g1": "teachers are available on 1 days, 2 lessons per week required"},
+		},
+		{
+			name: "lessons of a group on different days",
+			in: Input{
+				Groups: []Group{{ID: "g1", TeacherID: "t1", LessonsPerWeek: 3, Duration: 90}},
+				Availability: map[string][]Window{"t1": {
+					monday("09:00", "18:00"), on(3, monday("09:00", "18:00")), on(5, monday("09:00", "18:00")),
+				}},
+			},
+			lessons: map[string]int{"g1": 3},
+		},
+		{
+			name: "one teacher, two groups",
+			in: Input{
+				Groups: []Group{
+					{ID: "g1", TeacherID: "t1", LessonsPerWeek: 1, Duration: 90},
+					{ID: "g2", TeacherID: "t1", LessonsPerWeek: 1, Duration: 90},
+				},
+				Availability: map[string][]Window{"t1": {monday("09:00", "12:00")}},
+			},
+			lessons: map[string]int{"g1": 1, "g2": 1},
+		},
+		{
+			name: "start rounded up to the step",
+			in: Input{
+				Groups:       []Group{{ID: "g1", TeacherID: "t1", LessonsPerWeek: 1, Duration: 60}},
+				Availability: map[string][]Window{"t1": {monday("09:10", "10:30")}},
+			},
+			lessons: map[string]int{"g1": 1},
+			starts:  map[string]string{"g1": "09:30"},
+		},
+		{
+			name: "support teacher narrows the slot",
+			in: Input{
+				Groups: []Group{{ID: "g1", TeacherID: "t1", SupportTeacherID: "s1", LessonsPerWeek: 1, Duration: 90}},
+				Availability: map[string][]Window{
+					"t1": {monday("09:00", "12:00")},
+					"s1": {monday("10:30", "12:00")},
+				},
+			},
+			lessons: map[string]int{"g1": 1},
+			starts:  map[string]string{"g1": "10:30"},
+		},
+		{
+			name: "busy teacher in another branch",
+			in: Input{
+				Groups:       []Group{{ID: "g1", TeacherID: "t1", LessonsPerWeek: 1, Duration: 90}},
+				Availability: map[string][]Window{"t1": {monday("09:00", "10:30")}},
+				Busy:         []Lesson{{GroupID: "other", TeacherID: "t1", Window: monday("10:00", "11:30")}},
+			},
+			unscheduled: map[string]string{"g1": "no conflict-free slot"},
+		},
+		{
+			name: "busy lesson of another teacher takes the only room",
+			in: Input{
+				Groups:       []Group{{ID: "g1", TeacherID: "t1", LessonsPerWeek: 1, Duration: 90}},
+				Availability: map[string][]Window{"t1": {monday("09:00", "12:00")}},
+				Busy:         []Lesson{{GroupID: "other", TeacherID: "t2", Window: monday("09:00", "10:30"), SameBranch: true}},
+				Rooms:        1,
+			},
+			lessons: map[string]int{"g1": 1},
+			starts:  map[string]string{"g1": "10:30"},
+		},
+		{
+			name: "more groups than rooms",
+			in: Input{
+				Groups: []Group{
+					{ID: "g1", TeacherID: "t1", LessonsPerWeek: 1, Duration: 90},
+					{ID: "g2", TeacherID: "t2", LessonsPerWeek: 1, Duration: 90},
+				},
+				Availability: map[string][]Window{
+					"t1": {monday("09:00", "10:30")},
+					"t2": {monday("09:00", "10:30")},
+				},
+				Rooms: 1,
+			},
+			lessons:     map[string]int{"g1": 1},
+			unscheduled: map[string]string{"g2": "no conflict-free slot"},
+		},
+	}
+
+	for _, tt := range tests {
+		res := Solve(tt.in)
+
+		lessons := map[string]int{}
+		starts := map[string]string{}
+		for _, l := range res.Lessons {
+			if lessons[l.GroupID] == 0 {
+				starts[l.GroupID] = FormatClock(l.Start)
+			}
+			lessons[l.GroupID]++
+		}
+
+		if len(lessons) > 0 || len(tt.lessons) > 0 {
+			if !reflect.DeepEqual(lessons, tt.lessons) {
+				t.Errorf("%s: lessons = %v, want %v", tt.name, lessons, tt.lessons)
+			}
+		}
+		if len(res.Unscheduled) > 0 || len(tt.unscheduled) > 0 {
+			if !reflect.DeepEqual(res.Unscheduled, tt.unscheduled) {
+				t.Errorf("%s: unscheduled = %v, want %v", tt.name, res.Unscheduled, tt.unscheduled)
+			}
+		}
+		for group, want := range tt.starts {
+			if starts[group] != want {
+				t.Errorf("%s: %s starts at %s, want %s", tt.name, group, starts[group], want)
+			}
+		}
+
+		checkTimetable(t, tt.name, tt.in, res.Lessons)
+	}
+}
+
+// checkTimetable asserts the constraints Solve promises for every placed
+// lesson.
+func checkTimetable(t *testing.T, name string, in Input, placed []Lesson) {
+	t.Helper()
+
+	for i, l := range placed {
+		if !covered(l.Window, in.Availability[l.TeacherID]) {
+			t.Errorf("%s: %s at %v is outside the teacher's availability", name, l.GroupID, l.Window)
+		}
+		if l.SupportTeacherID != "" && !covered(l.Window, in.Availability[l.SupportTeacherID]) {
+			t.Errorf("%s: %s at %v is outside the support teacher's availability", name, l.GroupID, l.Window)
+		}
+
+		rooms := 1
+		others := append(append([]Lesson{}, in.Busy...), placed[i+1:]...)
+		for _, o := range others {
+			if o.GroupID == l.GroupID && o.Weekday == l.Weekday {
+				t.Errorf("%s: %s has two lessons on day %d", name, l.GroupID, l.Weekday)
+			}
+			if !overlaps(o.Window, l.Window) {
+				continue
+			}
+			if sharesTeacher(o, l) {
+				t.Errorf("%s: %s and %s share a teacher at the same time", name, l.GroupID, o.GroupID)
+			}
+			if o.SameBranch {
+				rooms++
+			}
+		}
+		if in.Rooms > 0 && rooms > in.Rooms {
+			t.Errorf("%s: %d lessons overlap %s with %d rooms", name, rooms, l.GroupID, in.Rooms)
+		}
+	}
+}
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{value: "15:04", want: 904},
+		{value: "08:00:00", want: 480},
+		{value: "00:00", want: 0},
+		{value: "24:00", want: 1440},
+		{value: "7", wantErr: true},
+		{value: "25:00", wantErr: true},
+		{value: "10:60", wantErr: true},
+		{value: "ab:cd", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseClock(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseClock(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseClock(%q) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestFormatClock(t *testing.T) {
+	tests := []struct {
+		minutes int
+		want    string
+	}{
+		{minutes: 0, want: "00:00"},
+		{minutes: 480, want: "08:00"},
+		{minutes: 904, want: "15:04"},
+		{minutes: 1440, want: "24:00"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatClock(tt.minutes); got != tt.want {
+			t.Errorf("FormatClock(%d) = %q, want %q", tt.minutes, got, tt.want)
+		}
+	}
+}
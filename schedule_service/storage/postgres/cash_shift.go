@@ -0,0 +1,463 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/pkg/money"
+	"schedule_service/storage"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const cashShiftColumns = `
+            cs.id,
+            cs.administrationId::text,
+            a.fullname,
+            cs.branchId::text,
+            cs.openingFloat::text,
+            cs.status,
+            cs.mismatch,
+            cs.note,
+            cs.closeNote,
+            cs.openedAt::text,
+            cs.closedAt::text,
+            cs.approvedBy::text,
+            cs.approvedAt::text,
+            cs.approvalNote,
+            (SELECT COUNT(*) FROM "student_payment" sp WHERE sp.shiftId = cs.id)`
+
+type cashShiftRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewCashShiftRepo(db *pgxpool.Pool) storage.CashShiftRepoI {
+	return &cashShiftRepo{
+		db: db,
+	}
+}
+
+// queryer is implemented by both the pool and a transaction.
+type queryer interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+// openCashShiftOf returns the open shift of an administrator, keeping it open
+// until the transaction ends. Entries without an administrator, such as
+// online payments, belong to no shift.
+func openCashShiftOf(ctx context.Context, tx pgx.Tx, administrationId string) (string, error) {
+	if administrationId == "" {
+		return "", nil
+	}
+
+	var id string
+
+	err := tx.QueryRow(ctx, `
+        SELECT id
+        FROM "cash_shift"
+        WHERE administrationId = $1 AND status = 'open'
+        FOR SHARE`, administrationId).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", errors.New("open a cash shift before recording payments")
+		}
+		log.Println("error while getting open cash shift", err)
+		return "", err
+	}
+
+	return id, nil
+}
+
+// Open implements storage.CashShiftRepoI. An administrator has at most one
+// open shift, in the branch they work at.
+func (c *cashShiftRepo) Open(ctx context.Context, req *schedule_service.OpenCashShift) (*schedule_service.CashShift, error) {
+	id := uuid.NewString()
+
+	result, err := c.db.Exec(ctx, `
+        INSERT INTO "cash_shift" (
+            id,
+            administrationId,
+            branchId,
+            openingFloat,
+            note
+        )
+        SELECT $1, a.id, a.branchId, $2, NULLIF($3, '')
+        FROM "administration" a
+        WHERE a.id = $4 AND a.deleted_at = 0
+          AND NOT EXISTS (SELECT 1 FROM "cash_shift" WHERE administrationId = a.id AND status = 'open')
+        `, id, req.OpeningFloat, req.Note, req.AdministrationId)
+
+	if err != nil {
+		log.Println("error while opening cash shift in storage", err)
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("administrator not found or already has an open cash shift")
+	}
+
+	return c.GetByID(ctx, &schedule_service.CashShiftPrimaryKey{Id: id})
+}
+
+// Close implements storage.CashShiftRepoI. It writes the Z-report: per
+// payment method what the shift took, plus the opening float for cash,
+// against what was counted. Methods that were not counted count as zero.
+func (c *cashShiftRepo) Close(ctx context.Context, req *schedule_service.CloseCashShift) (*schedule_service.CashShift, error) {
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting cash shift close transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		administrationId string
+		openingFloat     string
+		status           string
+	)
+
+	err = tx.QueryRow(ctx, `
+        SELECT administrationId::text, openingFloat::text, status
+        FROM "cash_shift"
+        WHERE id::text = $1
+        FOR UPDATE`, req.Id).Scan(&administrationId, &openingFloat, &status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("cash shift not found")
+		}
+		log.Println("error while locking cash shift", err)
+		return nil, err
+	}
+
+	if status != "open" {
+		return nil, errors.New("cash shift is already closed")
+	}
+
+	if req.AdministrationId != "" && req.AdministrationId != administrationId {
+		return nil, errors.New("cash shift belongs to another administrator")
+	}
+
+	expected, err := cashShiftExpected(ctx, tx, req.Id, openingFloat)
+	if err != nil {
+		return nil, err
+	}
+
+	counted := map[string]int64{}
+	for _, count := range req.Counted {
+		amount, err := money.Total.Parse(count.Amount)
+		if err != nil {
+			return nil, err
+		}
+		counted[count.PaymentMethod] += amount
+	}
+
+	mismatch := false
+	for _, method := range cashShiftMethods(expected, counted) {
+		difference := counted[method] - expected[method]
+		if difference != 0 {
+			mismatch = true
+		}
+
+		_, err = tx.Exec(ctx, `
+            INSERT INTO "cash_shift_total" (
+                shiftId,
+                paymentMethod,
+                expected,
+                counted,
+                difference
+            ) VALUES (
+                $1, $2, $3, $4, $5
+            )`, req.Id, method, money.Format(expected[method]), money.Format(counted[method]), money.Format(difference))
+		if err != nil {
+			log.Println("error while writing cash shift totals", err)
+			return nil, err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE "cash_shift" SET
+            status = 'closed',
+            mismatch = $1,
+            closeNote = NULLIF($2, ''),
+            closedAt = NOW(),
+            updated_at = NOW()
+        WHERE id = $3`, mismatch, req.Note, req.Id)
+	if err != nil {
+		log.Println("error while closing cash shift", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing cash shift close", err)
+		return nil, err
+	}
+
+	return c.GetByID(ctx, &schedule_service.CashShiftPrimaryKey{Id: req.Id})
+}
+
+// Approve implements storage.CashShiftRepoI. A shift with a mismatch can
+// only be approved with a note explaining it.
+func (c *cashShiftRepo) Approve(ctx context.Context, req *schedule_service.ApproveCashShift) (*schedule_service.CashShift, error) {
+	var (
+		status   string
+		mismatch bool
+	)
+
+	err := c.db.QueryRow(ctx, `
+        SELECT status, mismatch
+        FROM "cash_shift"
+        WHERE id::text = $1`, req.Id).Scan(&status, &mismatch)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("cash shift not found")
+		}
+		log.Println("error while getting cash shift", err)
+		return nil, err
+	}
+
+	switch {
+	case status == "open":
+		return nil, errors.New("cash shift must be closed before it is approved")
+	case status == "approved":
+		return nil, errors.New("cash shift is already approved")
+	case mismatch && req.Note == "":
+		return nil, errors.New("a note is required to approve a cash shift with a mismatch")
+	}
+
+	_, err = c.db.Exec(ctx, `
+        UPDATE "cash_shift" SET
+            status = 'approved',
+            approvedBy = $1,
+            approvedAt = NOW(),
+            approvalNote = NULLIF($2, ''),
+            updated_at = NOW()
+        WHERE id = $3 AND status = 'closed'`, req.ApprovedBy, req.Note, req.Id)
+	if err != nil {
+		log.Println("error while approving cash shift", err)
+		return nil, err
+	}
+
+	return c.GetByID(ctx, &schedule_service.CashShiftPrimaryKey{Id: req.Id})
+}
+
+// GetByID implements storage.CashShiftRepoI. An open shift shows what it is
+// expected to hold so far.
+func (c *cashShiftRepo) GetByID(ctx context.Context, req *schedule_service.CashShiftPrimaryKey) (*schedule_service.CashShift, error) {
+	shift, err := scanCashShift(c.db.QueryRow(ctx, `
+        SELECT `+cashShiftColumns+`
+        FROM "cash_shift" cs
+        LEFT JOIN "administration" a ON a.id = cs.administrationId
+        WHERE cs.id::text = $1`, req.Id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("cash shift not found")
+		}
+		log.Println("error while getting cash shift by id", err)
+		return nil, err
+	}
+
+	if err = c.loadTotals(ctx, shift); err != nil {
+		return nil, err
+	}
+
+	return shift, nil
+}
+
+// GetCurrent implements storage.CashShiftRepoI.
+func (c *cashShiftRepo) GetCurrent(ctx context.Context, req *schedule_service.CurrentCashShiftRequest) (*schedule_service.CashShift, error) {
+	var id string
+
+	err := c.db.QueryRow(ctx, `
+        SELECT id
+        FROM "cash_shift"
+        WHERE administrationId::text = $1 AND status = 'open'`, req.AdministrationId).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("no open cash shift")
+		}
+		log.Println("error while getting current cash shift", err)
+		return nil, err
+	}
+
+	return c.GetByID(ctx, &schedule_service.CashShiftPrimaryKey{Id: id})
+}
+
+// GetList implements storage.CashShiftRepoI. Newest shifts come first;
+// totals are left to GetByID.
+func (c *cashShiftRepo) GetList(ctx context.Context, req *schedule_service.GetListCashShiftRequest) (*schedule_service.GetListCashShiftResponse, error) {
+	resp := &schedule_service.GetListCashShiftResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := c.db.Query(ctx, `
+        SELECT `+cashShiftColumns+`
+        FROM "cash_shift" cs
+        LEFT JOIN "administration" a ON a.id = cs.administrationId
+        WHERE ($1 = '' OR cs.administrationId::text = $1)
+          AND ($2 = '' OR cs.branchId::text = $2)
+          AND ($3 = '' OR cs.status = $3)
+          AND (NOT $4 OR cs.mismatch)
+        ORDER BY cs.openedAt DESC
+    `+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.AdministrationId, req.BranchId, req.Status, req.MismatchOnly)
+	if err != nil {
+		log.Println("error while getting all cash shifts:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		shift, err := scanCashShift(rows)
+		if err != nil {
+			log.Println("error while scanning cash shifts:", err)
+			return nil, err
+		}
+
+		resp.Shifts = append(resp.Shifts, shift)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+func (c *cashShiftRepo) loadTotals(ctx context.Context, shift *schedule_service.CashShift) error {
+	if shift.Status == "open" {
+		expected, err := cashShiftExpected(ctx, c.db, shift.Id, shift.OpeningFloat)
+		if err != nil {
+			return err
+		}
+
+		for _, method := range cashShiftMethods(expected, nil) {
+			shift.Totals = append(shift.Totals, &schedule_service.CashShiftTotal{
+				PaymentMethod: method,
+				Expected:      money.Format(expected[method]),
+			})
+		}
+
+		return nil
+	}
+
+	rows, err := c.db.Query(ctx, `
+        SELECT paymentMethod, expected::text, counted::text, difference::text, difference <> 0
+        FROM "cash_shift_total"
+        WHERE shiftId = $1
+        ORDER BY paymentMethod`, shift.Id)
+	if err != nil {
+		log.Println("error while getting cash shift totals:", err)
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var total schedule_service.CashShiftTotal
+		if err := rows.Scan(&total.PaymentMethod, &total.Expected, &total.Counted, &total.Difference, &total.Mismatch); err != nil {
+			log.Println("error while scanning cash shift totals:", err)
+			return err
+		}
+
+		shift.Totals = append(shift.Totals, &total)
+	}
+
+	return rows.Err()
+}
+
+// cashShiftExpected sums the entries of a shift by payment method, in minor
+// units. Cash also includes the opening float. A busy shift can take in more
+// than any single payment, so the sums are parsed without the per-row limit.
+func cashShiftExpected(ctx context.Context, q queryer, shiftId, openingFloat string) (map[string]int64, error) {
+	float, err := money.Amount.Parse(openingFloat)
+	if err != nil {
+		return nil, err
+	}
+
+	expected := map[string]int64{"cash": float}
+
+	rows, err := q.Query(ctx, `
+        SELECT paymentMethod, SUM(paidSum)::text
+        FROM "student_payment"
+        WHERE shiftId::text = $1
+        GROUP BY paymentMethod`, shiftId)
+	if err != nil {
+		log.Println("error while summing cash shift payments:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var method, sum string
+		if err := rows.Scan(&method, &sum); err != nil {
+			log.Println("error while scanning cash shift payments:", err)
+			return nil, err
+		}
+
+		amount, err := money.Total.Parse(sum)
+		if err != nil {
+			return nil, err
+		}
+		expected[method] += amount
+	}
+
+	return expected, rows.Err()
+}
+
+// cashShiftMethods lists the payment methods of either map in order.
+func cashShiftMethods(expected, counted map[string]int64) []string {
+	var methods []string
+	for method := range expected {
+		methods = append(methods, method)
+	}
+	for method := range counted {
+		if _, ok := expected[method]; !ok {
+			methods = append(methods, method)
+		}
+	}
+
+	sort.Strings(methods)
+	return methods
+}
+
+func scanCashShift(row pgx.Row) (*schedule_service.CashShift, error) {
+	var (
+		shift              schedule_service.CashShift
+		administrationName sql.NullString
+		branchId           sql.NullString
+		note               sql.NullString
+		closeNote          sql.NullString
+		openedAt           sql.NullString
+		closedAt           sql.NullString
+		approvedBy         sql.NullString
+		approvedAt         sql.NullString
+		approvalNote       sql.NullString
+	)
+
+	err := row.Scan(&shift.Id, &shift.AdministrationId, &administrationName, &branchId, &shift.OpeningFloat, &shift.Status, &shift.Mismatch,
+		&note, &closeNote, &openedAt, &closedAt, &approvedBy, &approvedAt, &approvalNote, &shift.PaymentCount)
+	if err != nil {
+		return nil, err
+	}
+
+	shift.AdministrationName = administrationName.String
+	shift.BranchId = branchId.String
+	shift.Note = note.String
+	shift.CloseNote = closeNote.String
+	shift.OpenedAt = openedAt.String
+	shift.ClosedAt = closedAt.String
+	shift.ApprovedBy = approvedBy.String
+	shift.ApprovedAt = approvedAt.String
+	shift.ApprovalNote = approvalNote.String
+
+	return &shift, nil
+}
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"schedule_service/genproto/schedule_service"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+func newTestAdministration(t *testing.T, db *pgxpool.Pool, branchId string) string {
+	t.Helper()
+
+	id := uuid.NewString()
+	mustExec(t, db, `
+        INSERT INTO "administration" (id, login, fullname, phone, password, branchId)
+        VALUES ($1, $1, 'test administrator', $2, 'secret', $3)
+    `, id, uniquePhone(), branchId)
+
+	return id
+}
+
+func TestCashShiftClose(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	shifts := NewCashShiftRepo(db)
+	payments := NewStudentPaymentRepo(db)
+
+	branchId := newTestBranch(t, db)
+	administrationId := newTestAdministration(t, db, branchId)
+	groupId := newTestGroup(t, db, branchId, "", 0)
+	studentId := newTestStudent(t, db, branchId)
+
+	pay := func(sum, method string) error {
+		_, err := payments.Create(ctx, &schedule_service.CreateStudentPayment{
+			StudentId:        studentId,
+			GroupId:          groupId,
+			AdministrationId: administrationId,
+			PaidSum:          sum,
+			PaymentMethod:    method,
+		})
+		return err
+	}
+
+	if err := pay("100", "cash"); err == nil {
+		t.Fatal("recorded a payment without an open shift")
+	}
+
+	shift, err := shifts.Open(ctx, &schedule_service.OpenCashShift{AdministrationId: administrationId, OpeningFloat: "50"})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	if _, err := shifts.Open(ctx, &schedule_service.OpenCashShift{AdministrationId: administrationId, OpeningFloat: "0"}); err == nil {
+		t.Fatal("opened a second shift")
+	}
+
+	if err := pay("100", "cash"); err != nil {
+		t.Fatalf("cash payment: %v", err)
+	}
+	if err := pay("20", "card"); err != nil {
+		t.Fatalf("card payment: %v", err)
+	}
+
+	if _, err := shifts.Approve(ctx, &schedule_service.ApproveCashShift{Id: shift.Id, Note: "early"}); err == nil {
+		t.Fatal("approved an open shift")
+	}
+
+	closed, err := shifts.Close(ctx, &schedule_service.CloseCashShift{
+		Id:               shift.Id,
+		AdministrationId: administrationId,
+		Counted: []*schedule_service.CashCount{
+			{PaymentMethod: "cash", Amount: "150"},
+			{PaymentMethod: "card", Amount: "15"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if closed.Status != "closed" || !closed.Mismatch {
+		t.Fatalf("closed shift = %s, mismatch %v; want closed with a mismatch", closed.Status, closed.Mismatch)
+	}
+
+	want := map[string]string{"cash": "0.00", "card": "-5.00"}
+	for _, total := range closed.Totals {
+		if total.Difference != want[total.PaymentMethod] {
+			t.Errorf("%s difference = %s, want %s", total.PaymentMethod, total.Difference, want[total.PaymentMethod])
+		}
+		delete(want, total.PaymentMethod)
+	}
+	if len(want) != 0 {
+		t.Errorf("missing totals for %v", want)
+	}
+
+	if err := pay("10", "cash"); err == nil {
+		t.Error("recorded a payment after the shift was closed")
+	}
+	if _, err := shifts.Close(ctx, &schedule_service.CloseCashShift{Id: shift.Id}); err == nil {
+		t.Error("closed a shift twice")
+	}
+
+	if _, err := shifts.Approve(ctx, &schedule_service.ApproveCashShift{Id: shift.Id, ApprovedBy: administrationId}); err == nil {
+		t.Error("approved a mismatch without a note")
+	}
+	approved, err := shifts.Approve(ctx, &schedule_service.ApproveCashShift{Id: shift.Id, ApprovedBy: administrationId, Note: "card terminal fee"})
+	if err != nil {
+		t.Fatalf("approve: %v", err)
+	}
+	if approved.Status != "approved" {
+		t.Errorf("status = %s, want approved", approved.Status)
+	}
+}
@@ -0,0 +1,262 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/pkg/money"
+	"schedule_service/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// commissionEntries selects the commission each ledger entry of the month
+// starting on $2 earns the teacher and the support teacher who taught its
+// group when the entry was recorded. The
+// most specific rule wins: one for the staff member and the group type, then
+// one for the staff member, then one for the group type. Reversals and refunds
+// are negative, so they claw the commission back at the rate of the payment
+// they correct.
+const commissionEntries = `
+            SELECT c.staffType,
+                c.staffId,
+                sp.groupId,
+                sp.entryType,
+                sp.paidSum,
+                ROUND(sp.paidSum * r.rate / 100, 2) AS commission
+            FROM "student_payment" sp
+            JOIN "group" g ON g.id = sp.groupId
+            LEFT JOIN "student_payment" orig ON orig.id = sp.reversalOf
+            CROSS JOIN LATERAL (
+                VALUES ('teacher', sp.teacherId), ('support_teacher', sp.supportTeacherId)
+            ) c (staffType, staffId)
+            JOIN LATERAL (
+                SELECT cr.rate
+                FROM "commission_rule" cr
+                WHERE cr.deleted_at = 0
+                  AND cr.staffType = c.staffType
+                  AND (cr.staffId = c.staffId OR cr.staffId IS NULL)
+                  AND (cr.groupType = g.type OR cr.groupType IS NULL)
+                  AND cr.validFrom <= COALESCE(orig.created_at, sp.created_at)::date
+                ORDER BY cr.staffId IS NOT NULL DESC, cr.groupType IS NOT NULL DESC, cr.validFrom DESC
+                LIMIT 1
+            ) r ON true
+            WHERE c.staffId IS NOT NULL
+              AND sp.created_at >= $2::date
+              AND sp.created_at < $2::date + INTERVAL '1 month'`
+
+const commissionRuleColumns = `
+            cr.id,
+            cr.staffType,
+            cr.staffId::text,
+            st.fullname,
+            cr.groupType,
+            cr.rate::text,
+            cr.validFrom::text,
+            cr.created_at::text`
+
+type commissionRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewCommissionRepo(db *pgxpool.Pool) storage.CommissionRepoI {
+	return &commissionRepo{
+		db: db,
+	}
+}
+
+// CreateRule implements storage.CommissionRepoI. A rule applies from the
+// month of validFrom until the next rule for the same staff member and group
+// type.
+func (cm *commissionRepo) CreateRule(ctx context.Context, req *schedule_service.CreateCommissionRule) (*schedule_service.CommissionRule, error) {
+	id := uuid.NewString()
+
+	result, err := cm.db.Exec(ctx, `
+        INSERT INTO "commission_rule" (
+            id,
+            staffType,
+            staffId,
+            groupType,
+            rate,
+            validFrom
+        )
+        SELECT $1, $2, st.id, NULLIF($4, ''), $5,
+            COALESCE(date_trunc('month', NULLIF($6, '')::date)::date, date_trunc('month', NOW())::date)
+        FROM (SELECT 1) one
+        LEFT JOIN (`+payrollStaff+`
+        ) st ON st.staffType = $2 AND st.id::text = $3 AND st.deleted_at = 0
+        WHERE $3 = '' OR st.id IS NOT NULL
+        `, id, req.StaffType, req.StaffId, req.GroupType, req.Rate, req.ValidFrom)
+
+	if err != nil {
+		log.Println("error while creating commission rule in storage", err)
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("staff member not found")
+	}
+
+	rule, err := scanCommissionRule(cm.db.QueryRow(ctx, `
+        SELECT `+commissionRuleColumns+`
+        FROM "commission_rule" cr
+        LEFT JOIN (`+payrollStaff+`
+        ) st ON st.staffType = cr.staffType AND st.id = cr.staffId
+        WHERE cr.id = $1`, id))
+	if err != nil {
+		log.Println("error while getting commission rule by id", err)
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// GetListRule implements storage.CommissionRepoI.
+func (cm *commissionRepo) GetListRule(ctx context.Context, req *schedule_service.GetListCommissionRuleRequest) (*schedule_service.GetListCommissionRuleResponse, error) {
+	resp := &schedule_service.GetListCommissionRuleResponse{}
+
+	rows, err := cm.db.Query(ctx, `
+        SELECT `+commissionRuleColumns+`
+        FROM "commission_rule" cr
+        LEFT JOIN (`+payrollStaff+`
+        ) st ON st.staffType = cr.staffType AND st.id = cr.staffId
+        WHERE cr.deleted_at = 0
+          AND ($1 = '' OR cr.staffType = $1)
+          AND ($2 = '' OR cr.staffId::text = $2)
+          AND ($3 = '' OR cr.groupType = $3)
+        ORDER BY cr.staffType, st.fullname NULLS FIRST, cr.groupType NULLS FIRST, cr.validFrom DESC`, req.StaffType, req.StaffId, req.GroupType)
+	if err != nil {
+		log.Println("error while getting all commission rules:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		rule, err := scanCommissionRule(rows)
+		if err != nil {
+			log.Println("error while scanning commission rules:", err)
+			return nil, err
+		}
+
+		resp.Rules = append(resp.Rules, rule)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// DeleteRule implements storage.CommissionRepoI. Locked payroll runs keep
+// the commissions they paid.
+func (cm *commissionRepo) DeleteRule(ctx context.Context, req *schedule_service.CommissionRulePrimaryKey) error {
+	_, err := cm.db.Exec(ctx, `
+        UPDATE "commission_rule" SET
+            deleted_at = 1,
+            updated_at = NOW()
+        WHERE id = $1
+    `, req.Id)
+
+	if err != nil {
+		log.Println("error while deleting commission rule")
+		return err
+	}
+
+	return nil
+}
+
+// GetReport implements storage.CommissionRepoI. Commissions are reported per
+// staff member and group for the month the payments were recorded in.
+func (cm *commissionRepo) GetReport(ctx context.Context, req *schedule_service.CommissionReportRequest) (*schedule_service.CommissionReport, error) {
+	resp := &schedule_service.CommissionReport{Period: req.Period[:7]}
+
+	rows, err := cm.db.Query(ctx, `
+        SELECT
+            ce.staffType,
+            ce.staffId::text,
+            COALESCE(st.fullname, ''),
+            ce.groupId::text,
+            COALESCE(g.name, ''),
+            COUNT(*) FILTER (WHERE ce.entryType = 'payment'),
+            COALESCE(SUM(ce.paidSum) FILTER (WHERE ce.paidSum > 0), 0)::text,
+            COALESCE(-SUM(ce.paidSum) FILTER (WHERE ce.paidSum < 0), 0)::text,
+            COALESCE(SUM(ce.commission) FILTER (WHERE ce.commission > 0), 0)::text,
+            COALESCE(-SUM(ce.commission) FILTER (WHERE ce.commission < 0), 0)::text,
+            SUM(ce.commission)::text
+        FROM (`+commissionEntries+`
+        ) ce
+        LEFT JOIN (`+payrollStaff+`
+        ) st ON st.staffType = ce.staffType AND st.id = ce.staffId
+        LEFT JOIN "group" g ON g.id = ce.groupId
+        WHERE ($1 = '' OR ce.staffType = $1)
+          AND ($3 = '' OR ce.staffId::text = $3)
+        GROUP BY 1, 2, 3, 4, 5
+        ORDER BY 1, 3, 5`, req.StaffType, req.Period, req.StaffId)
+	if err != nil {
+		log.Println("error while getting commission report:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var commission, clawback, net int64
+
+	for rows.Next() {
+		var row schedule_service.CommissionRow
+		err := rows.Scan(&row.StaffType, &row.StaffId, &row.StaffName, &row.GroupId, &row.GroupName, &row.PaymentCount, &row.Collected, &row.Refunded,
+			&row.Commission, &row.Clawback, &row.Net)
+		if err != nil {
+			log.Println("error while scanning commission report:", err)
+			return nil, err
+		}
+
+		if err = addAmounts([]*int64{&commission, &clawback, &net}, row.Commission, row.Clawback, row.Net); err != nil {
+			return nil, err
+		}
+
+		resp.Rows = append(resp.Rows, &row)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.TotalCommission = money.Format(commission)
+	resp.TotalClawback = money.Format(clawback)
+	resp.TotalNet = money.Format(net)
+
+	return resp, nil
+}
+
+func scanCommissionRule(row pgx.Row) (*schedule_service.CommissionRule, error) {
+	var (
+		rule       schedule_service.CommissionRule
+		staffId    sql.NullString
+		staffName  sql.NullString
+		groupType  sql.NullString
+		created_at sql.NullString
+	)
+
+	err := row.Scan(&rule.Id, &rule.StaffType, &staffId, &staffName, &groupType, &rule.Rate, &rule.ValidFrom, &created_at)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.StaffId = staffId.String
+	rule.StaffName = staffName.String
+	rule.GroupType = groupType.String
+	rule.CreatedAt = created_at.String
+
+	return &rule, nil
+}
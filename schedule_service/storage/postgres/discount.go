@@ -0,0 +1,594 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/pkg/discount"
+	"schedule_service/pkg/money"
+	"schedule_service/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const discountColumns = `
+            d.id,
+            d.studentId::text,
+            d.groupId::text,
+            d.category,
+            d.kind,
+            d.value::text,
+            d.stackable,
+            d.validFrom::text,
+            d.validTo::text,
+            d.promoCodeId::text,
+            d.reason,
+            d.created_at::text`
+
+const promoCodeColumns = `
+            id,
+            code,
+            branchId::text,
+            kind,
+            value::text,
+            stackable,
+            months,
+            maxUses,
+            usedCount,
+            validFrom::text,
+            validTo::text,
+            created_at::text`
+
+type discountRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewDiscountRepo(db *pgxpool.Pool) storage.DiscountRepoI {
+	return &discountRepo{
+		db: db,
+	}
+}
+
+// issuedInvoice is an invoice created by GenerateInvoices that still has to
+// get its discounts.
+type issuedInvoice struct {
+	id         string
+	studentId  string
+	groupId    string
+	baseAmount string
+}
+
+// Create implements storage.DiscountRepoI. A discount without validFrom
+// starts with the current month; one without validTo never ends.
+func (d *discountRepo) Create(ctx context.Context, req *schedule_service.CreateDiscount) (*schedule_service.Discount, error) {
+	id := uuid.NewString()
+
+	_, err := d.db.Exec(ctx, `
+        INSERT INTO "discount" (
+            id,
+            studentId,
+            groupId,
+            category,
+            kind,
+            value,
+            stackable,
+            validFrom,
+            validTo,
+            reason
+        ) VALUES (
+            $1, $2, NULLIF($3, '')::uuid, $4, $5, $6, $7,
+            COALESCE(NULLIF($8, '')::date, date_trunc('month', NOW())::date),
+            NULLIF($9, '')::date,
+            NULLIF($10, '')
+        )`, id, req.StudentId, req.GroupId, req.Category, req.Kind, req.Value, req.Stackable, req.ValidFrom, req.ValidTo, req.Reason)
+	if err != nil {
+		log.Println("error while creating discount in storage", err)
+		return nil, err
+	}
+
+	return getDiscount(ctx, d.db, id)
+}
+
+// GetList implements storage.DiscountRepoI. activeOn keeps the discounts in
+// force on that date.
+func (d *discountRepo) GetList(ctx context.Context, req *schedule_service.GetListDiscountRequest) (*schedule_service.GetListDiscountResponse, error) {
+	resp := &schedule_service.GetListDiscountResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := d.db.Query(ctx, `
+        SELECT `+discountColumns+`
+        FROM "discount" d
+        WHERE d.deleted_at = 0
+          AND ($1 = '' OR d.studentId::text = $1)
+          AND ($2 = '' OR d.groupId::text = $2)
+          AND ($3 = '' OR d.category = $3)
+          AND ($4 = '' OR (d.validFrom <= $4::date AND (d.validTo IS NULL OR d.validTo >= $4::date)))
+        ORDER BY d.created_at DESC
+    `+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.StudentId, req.GroupId, req.Category, req.ActiveOn)
+	if err != nil {
+		log.Println("error while getting all discounts:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		item, err := scanDiscount(rows)
+		if err != nil {
+			log.Println("error while scanning discounts:", err)
+			return nil, err
+		}
+
+		resp.Discounts = append(resp.Discounts, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// Delete implements storage.DiscountRepoI. Invoices already issued keep the
+// discount they got.
+func (d *discountRepo) Delete(ctx context.Context, req *schedule_service.DiscountPrimaryKey) error {
+	_, err := d.db.Exec(ctx, `
+        UPDATE "discount" SET
+            deleted_at = 1,
+            updated_at = NOW()
+        WHERE id = $1
+    `, req.Id)
+
+	if err != nil {
+		log.Println("error while deleting discount")
+		return err
+	}
+
+	return nil
+}
+
+// CreatePromo implements storage.DiscountRepoI. Codes are unique regardless
+// of case.
+func (d *discountRepo) CreatePromo(ctx context.Context, req *schedule_service.CreatePromoCode) (*schedule_service.PromoCode, error) {
+	id := uuid.NewString()
+
+	result, err := d.db.Exec(ctx, `
+        INSERT INTO "promo_code" (
+            id,
+            code,
+            branchId,
+            kind,
+            value,
+            stackable,
+            months,
+            maxUses,
+            validFrom,
+            validTo
+        )
+        SELECT $1, $2, NULLIF($3, '')::uuid, $4, $5, $6, $7, $8,
+            COALESCE(NULLIF($9, '')::date, NOW()::date),
+            NULLIF($10, '')::date
+        WHERE NOT EXISTS (
+            SELECT 1 FROM "promo_code" WHERE UPPER(code) = UPPER($2) AND deleted_at = 0
+        )`, id, req.Code, req.BranchId, req.Kind, req.Value, req.Stackable, req.Months, req.MaxUses, req.ValidFrom, req.ValidTo)
+	if err != nil {
+		log.Println("error while creating promo code in storage", err)
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, fmt.Errorf("promo code %s already exists", req.Code)
+	}
+
+	promo, err := scanPromoCode(d.db.QueryRow(ctx, `
+        SELECT `+promoCodeColumns+`
+        FROM "promo_code"
+        WHERE id = $1`, id))
+	if err != nil {
+		log.Println("error while getting promo code after creating", err)
+		return nil, err
+	}
+
+	return promo, nil
+}
+
+// GetListPromo implements storage.DiscountRepoI.
+func (d *discountRepo) GetListPromo(ctx context.Context, req *schedule_service.GetListPromoCodeRequest) (*schedule_service.GetListPromoCodeResponse, error) {
+	resp := &schedule_service.GetListPromoCodeResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := d.db.Query(ctx, `
+        SELECT `+promoCodeColumns+`
+        FROM "promo_code"
+        WHERE deleted_at = 0
+          AND ($1 = '' OR branchId::text = $1)
+          AND ($2 = '' OR code ILIKE '%' || $2 || '%')
+        ORDER BY created_at DESC
+    `+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.BranchId, req.Search)
+	if err != nil {
+		log.Println("error while getting all promo codes:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		promo, err := scanPromoCode(rows)
+		if err != nil {
+			log.Println("error while scanning promo codes:", err)
+			return nil, err
+		}
+
+		resp.PromoCodes = append(resp.PromoCodes, promo)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// DeletePromo implements storage.DiscountRepoI. Discounts already redeemed
+// with the code stay in force.
+func (d *discountRepo) DeletePromo(ctx context.Context, req *schedule_service.PromoCodePrimaryKey) error {
+	_, err := d.db.Exec(ctx, `
+        UPDATE "promo_code" SET
+            deleted_at = 1,
+            updated_at = NOW()
+        WHERE id = $1
+    `, req.Id)
+
+	if err != nil {
+		log.Println("error while deleting promo code")
+		return err
+	}
+
+	return nil
+}
+
+// RedeemPromo implements storage.DiscountRepoI. A student can redeem a code
+// once, while it is valid and has uses left. Codes of a branch only work for
+// students of that branch. The discount runs for the code's number of months
+// starting with the current one.
+func (d *discountRepo) RedeemPromo(ctx context.Context, req *schedule_service.RedeemPromoCodeRequest) (*schedule_service.Discount, error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting promo code transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		promoId   string
+		branchId  sql.NullString
+		maxUses   int32
+		usedCount int32
+		valid     bool
+		redeemed  bool
+		eligible  bool
+	)
+
+	err = tx.QueryRow(ctx, `
+        SELECT p.id,
+            p.branchId::text,
+            p.maxUses,
+            p.usedCount,
+            p.validFrom <= NOW()::date AND (p.validTo IS NULL OR p.validTo >= NOW()::date),
+            EXISTS (SELECT 1 FROM "discount" d WHERE d.promoCodeId = p.id AND d.studentId::text = $2),
+            EXISTS (
+                SELECT 1
+                FROM "group_student" gs
+                JOIN "group" g ON g.id = gs.groupId AND g.deleted_at = 0
+                WHERE gs.studentId::text = $2
+                  AND gs.deleted_at = 0
+                  AND (p.branchId IS NULL OR g.branchId = p.branchId)
+                  AND ($3 = '' OR g.id::text = $3)
+            )
+        FROM "promo_code" p
+        WHERE UPPER(p.code) = UPPER(TRIM($1)) AND p.deleted_at = 0
+        FOR UPDATE OF p`, req.Code, req.StudentId, req.GroupId).Scan(&promoId, &branchId, &maxUses, &usedCount, &valid, &redeemed, &eligible)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("promo code not found")
+		}
+		log.Println("error while getting promo code", err)
+		return nil, err
+	}
+
+	switch {
+	case !valid:
+		return nil, errors.New("promo code is not valid today")
+	case maxUses > 0 && usedCount >= maxUses:
+		return nil, errors.New("promo code has been used up")
+	case redeemed:
+		return nil, errors.New("promo code is already redeemed by this student")
+	case !eligible && (branchId.Valid || req.GroupId != ""):
+		return nil, errors.New("promo code does not apply to this student")
+	}
+
+	id := uuid.NewString()
+
+	_, err = tx.Exec(ctx, `
+        INSERT INTO "discount" (
+            id,
+            studentId,
+            groupId,
+            category,
+            kind,
+            value,
+            stackable,
+            validFrom,
+            validTo,
+            promoCodeId,
+            reason
+        )
+        SELECT $1, $2, NULLIF($3, '')::uuid, 'promo', p.kind, p.value, p.stackable,
+            date_trunc('month', NOW())::date,
+            (date_trunc('month', NOW()) + make_interval(months => p.months) - interval '1 day')::date,
+            p.id,
+            'promo code ' || p.code
+        FROM "promo_code" p
+        WHERE p.id = $4`, id, req.StudentId, req.GroupId, promoId)
+	if err != nil {
+		log.Println("error while redeeming promo code in storage", err)
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE "promo_code" SET
+            usedCount = usedCount + 1,
+            updated_at = NOW()
+        WHERE id = $1`, promoId)
+	if err != nil {
+		log.Println("error while counting promo code use", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing promo code", err)
+		return nil, err
+	}
+
+	return getDiscount(ctx, d.db, id)
+}
+
+// GetReport implements storage.DiscountRepoI. Discounts are attributed to the
+// branch of the invoiced group and to the invoice period.
+func (d *discountRepo) GetReport(ctx context.Context, req *schedule_service.DiscountReportRequest) (*schedule_service.DiscountReport, error) {
+	resp := &schedule_service.DiscountReport{}
+
+	rows, err := d.db.Query(ctx, `
+        SELECT g.branchId::text,
+            i.period::text,
+            d.category,
+            COUNT(DISTINCT i.id),
+            COUNT(DISTINCT i.studentId),
+            SUM(idc.amount)::text
+        FROM "invoice_discount" idc
+        JOIN "invoice" i ON i.id = idc.invoiceId
+        JOIN "group" g ON g.id = i.groupId
+        JOIN "discount" d ON d.id = idc.discountId
+        WHERE ($1 = '' OR g.branchId::text = $1)
+          AND ($2 = '' OR i.period >= $2::date)
+          AND ($3 = '' OR i.period <= $3::date)
+        GROUP BY g.branchId, i.period, d.category
+        ORDER BY i.period, g.branchId, d.category`, req.BranchId, req.FromPeriod, req.ToPeriod)
+	if err != nil {
+		log.Println("error while getting discount report", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var total int64
+
+	for rows.Next() {
+		var (
+			row      schedule_service.DiscountReportRow
+			branchId sql.NullString
+		)
+
+		if err = rows.Scan(&branchId, &row.Period, &row.Category, &row.Invoices, &row.Students, &row.Total); err != nil {
+			log.Println("error while scanning discount report", err)
+			return nil, err
+		}
+		row.BranchId = branchId.String
+
+		amount, err := money.Total.Parse(row.Total)
+		if err != nil {
+			return nil, err
+		}
+		total += amount
+
+		resp.Rows = append(resp.Rows, &row)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Total = money.Format(total)
+
+	return resp, nil
+}
+
+// applyInvoiceDiscounts reduces freshly issued invoices of a period by the
+// discounts of their students in force during that month and records which
+// discount covered what.
+func applyInvoiceDiscounts(ctx context.Context, tx pgx.Tx, period string, invoices []issuedInvoice) error {
+	if len(invoices) == 0 {
+		return nil
+	}
+
+	studentIds := make([]string, 0, len(invoices))
+	for _, invoice := range invoices {
+		studentIds = append(studentIds, invoice.studentId)
+	}
+
+	rows, err := tx.Query(ctx, `
+        SELECT d.id, d.studentId::text, d.groupId::text, d.kind, d.value::text, d.stackable
+        FROM "discount" d
+        WHERE d.deleted_at = 0
+          AND d.studentId::text = ANY($1::text[])
+          AND d.validFrom < $2::date + interval '1 month'
+          AND (d.validTo IS NULL OR d.validTo >= $2::date)
+        ORDER BY d.created_at`, studentIds, period)
+	if err != nil {
+		log.Println("error while getting discounts of invoices", err)
+		return err
+	}
+	defer rows.Close()
+
+	type studentDiscount struct {
+		discount.Discount
+		groupId sql.NullString
+	}
+
+	byStudent := map[string][]studentDiscount{}
+	for rows.Next() {
+		var (
+			sd        studentDiscount
+			studentId string
+		)
+
+		if err = rows.Scan(&sd.ID, &studentId, &sd.groupId, &sd.Kind, &sd.Value, &sd.Stackable); err != nil {
+			log.Println("error while scanning discounts of invoices", err)
+			return err
+		}
+
+		byStudent[studentId] = append(byStudent[studentId], sd)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return err
+	}
+	rows.Close()
+
+	for _, invoice := range invoices {
+		var candidates []discount.Discount
+		for _, sd := range byStudent[invoice.studentId] {
+			if !sd.groupId.Valid || sd.groupId.String == invoice.groupId {
+				candidates = append(candidates, sd.Discount)
+			}
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+
+		base, err := money.Amount.Parse(invoice.baseAmount)
+		if err != nil {
+			return err
+		}
+
+		applied, err := discount.Apply(base, candidates)
+		if err != nil {
+			return err
+		}
+
+		total := discount.Total(applied)
+		if total == 0 {
+			continue
+		}
+
+		_, err = tx.Exec(ctx, `
+            UPDATE "invoice" SET
+                discountAmount = $1,
+                amount = baseAmount - $1
+            WHERE id = $2`, money.Format(total), invoice.id)
+		if err != nil {
+			log.Println("error while discounting invoice", err)
+			return err
+		}
+
+		for _, a := range applied {
+			_, err = tx.Exec(ctx, `
+                INSERT INTO "invoice_discount" (invoiceId, discountId, amount)
+                VALUES ($1, $2, $3)`, invoice.id, a.ID, money.Format(a.Amount))
+			if err != nil {
+				log.Println("error while recording invoice discount", err)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func getDiscount(ctx context.Context, db *pgxpool.Pool, id string) (*schedule_service.Discount, error) {
+	item, err := scanDiscount(db.QueryRow(ctx, `
+        SELECT `+discountColumns+`
+        FROM "discount" d
+        WHERE d.id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("discount not found")
+		}
+		log.Println("error while getting discount by id", err)
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func scanDiscount(row pgx.Row) (*schedule_service.Discount, error) {
+	var (
+		item        schedule_service.Discount
+		groupId     sql.NullString
+		validTo     sql.NullString
+		promoCodeId sql.NullString
+		reason      sql.NullString
+		created_at  sql.NullString
+	)
+
+	err := row.Scan(&item.Id, &item.StudentId, &groupId, &item.Category, &item.Kind, &item.Value,
+		&item.Stackable, &item.ValidFrom, &validTo, &promoCodeId, &reason, &created_at)
+	if err != nil {
+		return nil, err
+	}
+
+	item.GroupId = groupId.String
+	item.ValidTo = validTo.String
+	item.PromoCodeId = promoCodeId.String
+	item.Reason = reason.String
+	item.CreatedAt = created_at.String
+
+	return &item, nil
+}
+
+func scanPromoCode(row pgx.Row) (*schedule_service.PromoCode, error) {
+	var (
+		promo      schedule_service.PromoCode
+		branchId   sql.NullString
+		validTo    sql.NullString
+		created_at sql.NullString
+	)
+
+	err := row.Scan(&promo.Id, &promo.Code, &branchId, &promo.Kind, &promo.Value, &promo.Stackable, &promo.Months,
+		&promo.MaxUses, &promo.UsedCount, &promo.ValidFrom, &validTo, &created_at)
+	if err != nil {
+		return nil, err
+	}
+
+	promo.BranchId = branchId.String
+	promo.ValidTo = validTo.String
+	promo.CreatedAt = created_at.String
+
+	return &promo, nil
+}
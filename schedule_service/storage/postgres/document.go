@@ -0,0 +1,410 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const contractTemplateColumns = `
+            id,
+            branchId::text,
+            name,
+            body,
+            created_at::text,
+            updated_at::text`
+
+type documentRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewDocumentRepo(db *pgxpool.Pool) storage.DocumentRepoI {
+	return &documentRepo{
+		db: db,
+	}
+}
+
+// issueReceipt gives a payment the next receipt number of its branch, the
+// branch of its group or else of its student. Payments without a branch get
+// no receipt.
+func issueReceipt(ctx context.Context, tx pgx.Tx, paymentId string) error {
+	var branchId sql.NullString
+
+	err := tx.QueryRow(ctx, `
+        SELECT COALESCE(g.branchId, s.branchId)::text
+        FROM "student_payment" sp
+        LEFT JOIN "group" g ON g.id = sp.groupId
+        LEFT JOIN "student" s ON s.id = sp.studentId
+        WHERE sp.id = $1`, paymentId).Scan(&branchId)
+	if err != nil {
+		log.Println("error while getting branch of student payment", err)
+		return err
+	}
+
+	if !branchId.Valid {
+		return nil
+	}
+
+	var number int64
+
+	err = tx.QueryRow(ctx, `
+        INSERT INTO "receipt_sequence" (branchId, lastNumber)
+        VALUES ($1, 1)
+        ON CONFLICT (branchId) DO UPDATE SET lastNumber = "receipt_sequence".lastNumber + 1
+        RETURNING lastNumber`, branchId.String).Scan(&number)
+	if err != nil {
+		log.Println("error while getting next receipt number", err)
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+        INSERT INTO "payment_receipt" (paymentId, branchId, number)
+        VALUES ($1, $2, $3)`, paymentId, branchId.String, number)
+	if err != nil {
+		log.Println("error while issuing payment receipt", err)
+		return err
+	}
+
+	return nil
+}
+
+// GetReceipt implements storage.DocumentRepoI. Receipts are only issued for
+// payments, not for their reversals or refunds.
+func (d *documentRepo) GetReceipt(ctx context.Context, req *schedule_service.PaymentReceiptRequest) (*schedule_service.PaymentReceipt, error) {
+	var (
+		receipt            schedule_service.PaymentReceipt
+		entryType          string
+		number             sql.NullInt64
+		branchId           sql.NullString
+		branchName         sql.NullString
+		branchAddress      sql.NullString
+		branchPhone        sql.NullString
+		studentName        sql.NullString
+		studentPhone       sql.NullString
+		groupName          sql.NullString
+		administrationName sql.NullString
+		created_at         sql.NullString
+	)
+
+	err := d.db.QueryRow(ctx, `
+        SELECT
+            sp.id,
+            sp.entryType,
+            r.number,
+            r.branchId::text,
+            b.name,
+            b.address,
+            b.phone,
+            sp.studentId::text,
+            st.fullname,
+            st.phone,
+            g.name,
+            a.fullname,
+            sp.paidSum::text,
+            sp.created_at::text
+        FROM "student_payment" sp
+        LEFT JOIN "payment_receipt" r ON r.paymentId = sp.id
+        LEFT JOIN "branch" b ON b.id = r.branchId
+        LEFT JOIN "student" st ON st.id = sp.studentId
+        LEFT JOIN "group" g ON g.id = sp.groupId
+        LEFT JOIN "administration" a ON a.id = sp.administrationId
+        WHERE sp.id = $1`, req.PaymentId).Scan(
+		&receipt.PaymentId,
+		&entryType,
+		&number,
+		&branchId,
+		&branchName,
+		&branchAddress,
+		&branchPhone,
+		&receipt.StudentId,
+		&studentName,
+		&studentPhone,
+		&groupName,
+		&administrationName,
+		&receipt.PaidSum,
+		&created_at,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("student payment not found")
+		}
+		log.Println("error while getting payment receipt", err)
+		return nil, err
+	}
+
+	if entryType != "payment" {
+		return nil, fmt.Errorf("receipts are only issued for payments, not for a %s", entryType)
+	}
+
+	if !number.Valid {
+		return nil, errors.New("payment has no branch to number its receipt")
+	}
+
+	receipt.Number = number.Int64
+	receipt.BranchId = branchId.String
+	receipt.BranchName = branchName.String
+	receipt.BranchAddress = branchAddress.String
+	receipt.BranchPhone = branchPhone.String
+	receipt.StudentName = studentName.String
+	receipt.StudentPhone = studentPhone.String
+	receipt.GroupName = groupName.String
+	receipt.AdministrationName = administrationName.String
+	receipt.CreatedAt = created_at.String
+
+	return &receipt, nil
+}
+
+// CreateTemplate implements storage.DocumentRepoI. Templates without a
+// branch are shared by all branches.
+func (d *documentRepo) CreateTemplate(ctx context.Context, req *schedule_service.CreateContractTemplate) (*schedule_service.ContractTemplate, error) {
+	id := uuid.NewString()
+
+	_, err := d.db.Exec(ctx, `
+        INSERT INTO "contract_template" (
+            id,
+            branchId,
+            name,
+            body
+        ) VALUES (
+            $1, NULLIF($2, '')::uuid, $3, $4
+        )`, id, req.BranchId, req.Name, req.Body)
+
+	if err != nil {
+		log.Println("error while creating contract template in storage", err)
+		return nil, err
+	}
+
+	return d.GetTemplate(ctx, &schedule_service.ContractTemplatePrimaryKey{Id: id})
+}
+
+// GetTemplate implements storage.DocumentRepoI.
+func (d *documentRepo) GetTemplate(ctx context.Context, req *schedule_service.ContractTemplatePrimaryKey) (*schedule_service.ContractTemplate, error) {
+	template, err := scanContractTemplate(d.db.QueryRow(ctx, `
+        SELECT `+contractTemplateColumns+`
+        FROM "contract_template"
+        WHERE id = $1 AND deleted_at = 0`, req.Id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("contract template not found")
+		}
+		log.Println("error while getting contract template by id", err)
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// GetListTemplate implements storage.DocumentRepoI. Filtering by branch
+// also returns the shared templates.
+func (d *documentRepo) GetListTemplate(ctx context.Context, req *schedule_service.GetListContractTemplateRequest) (*schedule_service.GetListContractTemplateResponse, error) {
+	resp := &schedule_service.GetListContractTemplateResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := d.db.Query(ctx, `
+        SELECT `+contractTemplateColumns+`
+        FROM "contract_template"
+        WHERE deleted_at = 0
+          AND ($1 = '' OR branchId::text = $1 OR branchId IS NULL)
+        ORDER BY name, created_at
+    `+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.BranchId)
+	if err != nil {
+		log.Println("error while getting all contract templates:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		template, err := scanContractTemplate(rows)
+		if err != nil {
+			log.Println("error while scanning contract templates:", err)
+			return nil, err
+		}
+
+		resp.Templates = append(resp.Templates, template)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// UpdateTemplate implements storage.DocumentRepoI.
+func (d *documentRepo) UpdateTemplate(ctx context.Context, req *schedule_service.UpdateContractTemplate) (*schedule_service.ContractTemplate, error) {
+	_, err := d.db.Exec(ctx, `
+        UPDATE "contract_template" SET
+            name = $1,
+            body = $2,
+            updated_at = NOW()
+        WHERE id = $3 AND deleted_at = 0`, req.Name, req.Body, req.Id)
+
+	if err != nil {
+		log.Println("error while updating contract template in storage", err)
+		return nil, err
+	}
+
+	return d.GetTemplate(ctx, &schedule_service.ContractTemplatePrimaryKey{Id: req.Id})
+}
+
+// DeleteTemplate implements storage.DocumentRepoI.
+func (d *documentRepo) DeleteTemplate(ctx context.Context, req *schedule_service.ContractTemplatePrimaryKey) error {
+	_, err := d.db.Exec(ctx, `
+        UPDATE "contract_template" SET
+            deleted_at = 1,
+            updated_at = NOW()
+        WHERE id = $1
+    `, req.Id)
+
+	if err != nil {
+		log.Println("error while deleting contract template")
+		return err
+	}
+
+	return nil
+}
+
+// GetContractData implements storage.DocumentRepoI. Without a template id the
+// newest template of the student's branch is used, then the newest shared
+// one. The returned values are keyed by contract field, plus the branchId the
+// contract is made with; the monthly fee is the current tuition plan of the
+// group.
+func (d *documentRepo) GetContractData(ctx context.Context, req *schedule_service.RenderContractRequest) (*schedule_service.ContractTemplate, map[string]string, error) {
+	var (
+		template *schedule_service.ContractTemplate
+		err      error
+	)
+
+	if req.TemplateId != "" {
+		template, err = d.GetTemplate(ctx, &schedule_service.ContractTemplatePrimaryKey{Id: req.TemplateId})
+	} else {
+		template, err = scanContractTemplate(d.db.QueryRow(ctx, `
+            SELECT `+contractTemplateColumns+`
+            FROM "contract_template"
+            WHERE deleted_at = 0
+              AND (branchId IS NULL OR branchId = (SELECT branchId FROM "student" WHERE id = $1))
+            ORDER BY branchId IS NULL, updated_at DESC
+            LIMIT 1`, req.StudentId))
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = errors.New("no contract template found")
+		}
+	}
+	if err != nil {
+		log.Println("error while getting contract template", err)
+		return nil, nil, err
+	}
+
+	var (
+		groupId       sql.NullString
+		branchId      sql.NullString
+		studentName   sql.NullString
+		studentPhone  sql.NullString
+		branchName    sql.NullString
+		branchAddress sql.NullString
+		branchPhone   sql.NullString
+		groupName     sql.NullString
+		groupType     sql.NullString
+		monthlyFee    sql.NullString
+		date          string
+	)
+
+	err = d.db.QueryRow(ctx, `
+        SELECT
+            g.id::text,
+            COALESCE(g.branchId, st.branchId)::text,
+            st.fullname,
+            st.phone,
+            b.name,
+            b.address,
+            b.phone,
+            g.name,
+            g.type,
+            fee.monthlyFee::text,
+            CURRENT_DATE::text
+        FROM "student" st
+        LEFT JOIN "group" g ON g.id = NULLIF($2, '')::uuid AND g.deleted_at = 0
+        LEFT JOIN "branch" b ON b.id = COALESCE(g.branchId, st.branchId)
+        LEFT JOIN LATERAL (
+            SELECT tp.monthlyFee
+            FROM "tuition_plan" tp
+            WHERE tp.branchId = g.branchId
+              AND tp.groupType = g.type
+              AND tp.deleted_at = 0
+              AND tp.validFrom <= CURRENT_DATE
+            ORDER BY tp.validFrom DESC
+            LIMIT 1
+        ) fee ON TRUE
+        WHERE st.id = $1 AND st.deleted_at = 0`, req.StudentId, req.GroupId).Scan(
+		&groupId,
+		&branchId,
+		&studentName,
+		&studentPhone,
+		&branchName,
+		&branchAddress,
+		&branchPhone,
+		&groupName,
+		&groupType,
+		&monthlyFee,
+		&date,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, errors.New("student not found")
+		}
+		log.Println("error while getting contract data", err)
+		return nil, nil, err
+	}
+
+	if req.GroupId != "" && !groupId.Valid {
+		return nil, nil, errors.New("group not found")
+	}
+
+	values := map[string]string{
+		"branchId":      branchId.String,
+		"studentName":   studentName.String,
+		"studentPhone":  studentPhone.String,
+		"branchName":    branchName.String,
+		"branchAddress": branchAddress.String,
+		"branchPhone":   branchPhone.String,
+		"groupName":     groupName.String,
+		"groupType":     groupType.String,
+		"monthlyFee":    monthlyFee.String,
+		"date":          date,
+	}
+
+	return template, values, nil
+}
+
+func scanContractTemplate(row pgx.Row) (*schedule_service.ContractTemplate, error) {
+	var (
+		template   schedule_service.ContractTemplate
+		branchId   sql.NullString
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+
+	err := row.Scan(&template.Id, &branchId, &template.Name, &template.Body, &created_at, &updated_at)
+	if err != nil {
+		return nil, err
+	}
+
+	template.BranchId = branchId.String
+	template.CreatedAt = created_at.String
+	template.UpdatedAt = updated_at.String
+
+	return &template, nil
+}
@@ -12,6 +12,19 @@ import (
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
+// eventCutoffHours selects the registration cutoff of the event aliased as ev,
+// falling back to its branch's setting when the event has none.
+const eventCutoffHours = `COALESCE(ev.cutoffHours, (SELECT bs.registrationCutoffHours FROM "branch_setting" bs WHERE bs.branchId = ev.branchId), 3)`
+
+// eventTimezone selects the timezone of the branch of the event aliased as ev.
+const eventTimezone = `COALESCE((SELECT bs.timezone FROM "branch_setting" bs WHERE bs.branchId = ev.branchId), 'Asia/Tashkent')`
+
+// eventRegistrationCounts selects the registered and waitlisted counts of
+// the event aliased as ev.
+const eventRegistrationCounts = `
+            (SELECT COUNT(*)::int FROM "event_student" es WHERE es.eventId = ev.id AND es.deleted_at = 0 AND es.status = 'registered'),
+            (SELECT COUNT(*)::int FROM "event_student" es WHERE es.eventId = ev.id AND es.deleted_at = 0 AND es.status = 'waitlisted')`
+
 type eventRepo struct {
 	db *pgxpool.Pool
 }
@@ -22,27 +35,50 @@ func NewEventRepo(db *pgxpool.Pool) storage.EventRepoI {
 	}
 }
 
-// Create implements storage.EventRepoI.
+// Create implements storage.EventRepoI. An event held in a room without a
+// capacity of its own takes the room's capacity.
 func (e *eventRepo) Create(ctx context.Context, req *schedule_service.CreateEvent) (*schedule_service.GetEvent, error) {
 	id := uuid.NewString()
 
-	_, err := e.db.Exec(ctx, `
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting event transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
         INSERT INTO "event" (
             id,
             assignStudent,
             topic,
             startTime,
             date,
-            branchId
+            branchId,
+            capacity,
+            cutoffHours,
+            endTime,
+            roomId
         ) VALUES (
-            $1, $2, $3, $4, $5, $6
-        )`, id, req.AssignStudent, req.Topic, req.StartTime, req.Date, req.BranchId)
+            $1, $2, $3, $4, $5, $6,
+            COALESCE(NULLIF($7, 0), (SELECT capacity FROM "room" WHERE id::text = NULLIF($10, ''))),
+            NULLIF($8, 0), NULLIF($9, '')::time, NULLIF($10, '')::uuid
+        )`, id, req.AssignStudent, req.Topic, req.StartTime, req.Date, req.BranchId, req.Capacity, req.CutoffHours, req.EndTime, req.RoomId)
 
 	if err != nil {
 		log.Println("error while creating event in storage", err)
 		return nil, err
 	}
 
+	if err = checkRoomBooking(ctx, tx, "event", id); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing event", err)
+		return nil, err
+	}
+
 	event, err := e.GetByID(ctx, &schedule_service.EventPrimaryKey{Id: id})
 	if err != nil {
 		log.Println("error while getting event by id after creating", err)
@@ -57,7 +93,10 @@ func (e *eventRepo) GetByID(ctx context.Context, req *schedule_service.EventPrim
 
 	var (
 		startTime  sql.NullString
+		endTime    sql.NullString
 		date       sql.NullString
+		capacity   sql.NullInt32
+		roomId     sql.NullString
 		created_at sql.NullString
 		updated_at sql.NullString
 	)
@@ -69,10 +108,15 @@ func (e *eventRepo) GetByID(ctx context.Context, req *schedule_service.EventPrim
             startTime,
             date,
             branchId,
+            capacity,
+            `+eventCutoffHours+`,
+            `+eventRegistrationCounts+`,
+            endTime,
+            roomId,
             created_at,
             updated_at
-            FROM "event"
-        WHERE id=$1`, req.Id).Scan(&resp.Id, &resp.AssignStudent, &resp.Topic, &startTime, &date, &resp.BranchId, &created_at, &updated_at)
+            FROM "event" ev
+        WHERE id=$1`, req.Id).Scan(&resp.Id, &resp.AssignStudent, &resp.Topic, &startTime, &date, &resp.BranchId, &capacity, &resp.CutoffHours, &resp.RegisteredCount, &resp.WaitlistedCount, &endTime, &roomId, &created_at, &updated_at)
 
 	if err != nil {
 		log.Println("error while getting event by id", err)
@@ -80,7 +124,10 @@ func (e *eventRepo) GetByID(ctx context.Context, req *schedule_service.EventPrim
 	}
 
 	resp.StartTime = startTime.String
+	resp.EndTime = endTime.String
 	resp.Date = date.String
+	resp.Capacity = capacity.Int32
+	resp.RoomId = roomId.String
 	resp.CreatedAt = created_at.String
 	resp.UpdatedAt = updated_at.String
 
@@ -94,6 +141,9 @@ func (e *eventRepo) GetList(ctx context.Context, req *schedule_service.GetListEv
 		filter     string
 		date       sql.NullString
 		startTime  sql.NullString
+		endTime    sql.NullString
+		capacity   sql.NullInt32
+		roomId     sql.NullString
 		created_at sql.NullString
 		updated_at sql.NullString
 	)
@@ -113,9 +163,14 @@ func (e *eventRepo) GetList(ctx context.Context, req *schedule_service.GetListEv
             startTime,
             date,
             branchId,
+            capacity,
+            `+eventCutoffHours+`,
+            `+eventRegistrationCounts+`,
+            endTime,
+            roomId,
             created_at,
             updated_at
-        FROM "event" WHERE deleted_at=0
+        FROM "event" ev WHERE deleted_at=0
     `+filter)
 
 	if err != nil {
@@ -130,7 +185,7 @@ func (e *eventRepo) GetList(ctx context.Context, req *schedule_service.GetListEv
 	for rows.Next() {
 		var event schedule_service.Event
 		count++
-		err = rows.Scan(&event.Id, &event.AssignStudent, &event.Topic, &startTime, &date, &event.BranchId, &created_at, &updated_at)
+		err = rows.Scan(&event.Id, &event.AssignStudent, &event.Topic, &startTime, &date, &event.BranchId, &capacity, &event.CutoffHours, &event.RegisteredCount, &event.WaitlistedCount, &endTime, &roomId, &created_at, &updated_at)
 
 		if err != nil {
 			log.Println("error while scanning events:", err)
@@ -139,6 +194,9 @@ func (e *eventRepo) GetList(ctx context.Context, req *schedule_service.GetListEv
 
 		event.Date = date.String
 		event.StartTime = startTime.String
+		event.EndTime = endTime.String
+		event.Capacity = capacity.Int32
+		event.RoomId = roomId.String
 		event.CreatedAt = created_at.String
 		event.UpdatedAt = updated_at.String
 
@@ -155,24 +213,49 @@ func (e *eventRepo) GetList(ctx context.Context, req *schedule_service.GetListEv
 	return resp, nil
 }
 
-// Update implements storage.EventRepoI.
+// Update implements storage.EventRepoI. Raising the capacity moves
+// waitlisted students into the free seats, and an event held in a room must
+// fit in it.
 func (e *eventRepo) Update(ctx context.Context, req *schedule_service.UpdateEvent) (*schedule_service.GetEvent, error) {
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting event update transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
 
-	_, err := e.db.Exec(ctx, `
+	_, err = tx.Exec(ctx, `
         UPDATE "event" SET
             assignStudent=$1,
             topic=$2,
             startTime=$3,
             date=$4,
             branchId=$5,
+            capacity=COALESCE(NULLIF($6, 0), (SELECT capacity FROM "room" WHERE id::text = NULLIF($9, ''))),
+            cutoffHours=NULLIF($7, 0),
+            endTime=NULLIF($8, '')::time,
+            roomId=NULLIF($9, '')::uuid,
             updated_at = NOW()
-        WHERE id = $6`, req.AssignStudent, req.Topic, req.StartTime, req.Date, req.BranchId, req.Id)
+        WHERE id = $10`, req.AssignStudent, req.Topic, req.StartTime, req.Date, req.BranchId, req.Capacity, req.CutoffHours, req.EndTime, req.RoomId, req.Id)
 
 	if err != nil {
 		log.Println("error while updating event in storage", err)
 		return nil, err
 	}
 
+	if err = checkRoomBooking(ctx, tx, "event", req.Id); err != nil {
+		return nil, err
+	}
+
+	if err = promoteEventWaitlist(ctx, tx, req.Id); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing event update", err)
+		return nil, err
+	}
+
 	event, err := e.GetByID(ctx, &schedule_service.EventPrimaryKey{Id: req.Id})
 	if err != nil {
 		log.Println("error while getting updated event by id", err)
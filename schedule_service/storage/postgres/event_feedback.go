@@ -0,0 +1,318 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// eventRatingColumns selects the rating of the event aliased as ev from the
+// feedback aliased as f. The query must group by ev.id.
+const eventRatingColumns = `
+            ev.id,
+            ev.topic,
+            ev.date::text,
+            ev.branchId::text,
+            COALESCE(AVG(f.rating), 0)::float8,
+            COUNT(f.id),
+            ARRAY[
+                COUNT(f.id) FILTER (WHERE f.rating = 1),
+                COUNT(f.id) FILTER (WHERE f.rating = 2),
+                COUNT(f.id) FILTER (WHERE f.rating = 3),
+                COUNT(f.id) FILTER (WHERE f.rating = 4),
+                COUNT(f.id) FILTER (WHERE f.rating = 5)
+            ]::int[]`
+
+type eventFeedbackRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewEventFeedbackRepo(db *pgxpool.Pool) storage.EventFeedbackRepoI {
+	return &eventFeedbackRepo{
+		db: db,
+	}
+}
+
+// Create implements storage.EventFeedbackRepoI. Feedback is accepted once per
+// registration, from registered students only and after the event started.
+func (e *eventFeedbackRepo) Create(ctx context.Context, req *schedule_service.CreateEventFeedback) (*schedule_service.EventFeedback, error) {
+	if req.Rating < 1 || req.Rating > 5 {
+		return nil, errors.New("rating must be between 1 and 5")
+	}
+
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting event feedback transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		eventId, studentId, status string
+		started                    sql.NullBool
+		exists                     bool
+	)
+
+	err = tx.QueryRow(ctx, `
+        SELECT es.eventId,
+            es.studentId,
+            es.status,
+            NOW() >= ((ev.date + ev.startTime) AT TIME ZONE `+eventTimezone+`),
+            EXISTS (SELECT 1 FROM "event_feedback" f WHERE f.eventStudentId = es.id)
+        FROM "event_student" es
+        JOIN "event" ev ON ev.id = es.eventId
+        WHERE es.id = $1 AND es.deleted_at = 0
+        FOR UPDATE OF es`, req.EventStudentId).Scan(&eventId, &studentId, &status, &started, &exists)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("event registration not found")
+		}
+		log.Println("error while getting event registration for feedback", err)
+		return nil, err
+	}
+
+	if req.StudentId != "" && req.StudentId != studentId {
+		return nil, errors.New("event registration belongs to another student")
+	}
+	if status != "registered" {
+		return nil, fmt.Errorf("cannot leave feedback on a %s registration", status)
+	}
+	if !started.Bool {
+		return nil, errors.New("feedback opens once the event starts")
+	}
+	if exists {
+		return nil, errors.New("feedback for this event is already left")
+	}
+
+	id := uuid.NewString()
+
+	resp := &schedule_service.EventFeedback{}
+	var (
+		comment    sql.NullString
+		created_at sql.NullString
+	)
+
+	err = tx.QueryRow(ctx, `
+        INSERT INTO "event_feedback" (
+            id,
+            eventStudentId,
+            eventId,
+            studentId,
+            rating,
+            comment
+        ) VALUES (
+            $1, $2, $3, $4, $5, NULLIF($6, '')
+        )
+        RETURNING id, eventStudentId, eventId, studentId, rating, comment, created_at::text`,
+		id, req.EventStudentId, eventId, studentId, req.Rating, req.Comment,
+	).Scan(&resp.Id, &resp.EventStudentId, &resp.EventId, &resp.StudentId, &resp.Rating, &comment, &created_at)
+	if err != nil {
+		log.Println("error while creating event feedback in storage", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing event feedback", err)
+		return nil, err
+	}
+
+	resp.Comment = comment.String
+	resp.CreatedAt = created_at.String
+
+	return resp, nil
+}
+
+// GetList implements storage.EventFeedbackRepoI.
+func (e *eventFeedbackRepo) GetList(ctx context.Context, req *schedule_service.GetListEventFeedbackRequest) (*schedule_service.GetListEventFeedbackResponse, error) {
+	resp := &schedule_service.GetListEventFeedbackResponse{}
+	var (
+		comment    sql.NullString
+		created_at sql.NullString
+	)
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := e.db.Query(ctx, `
+        SELECT
+            id,
+            eventStudentId,
+            eventId,
+            studentId,
+            rating,
+            comment,
+            created_at::text
+        FROM "event_feedback"
+        WHERE eventId = $1
+        ORDER BY created_at DESC
+    `+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.EventId)
+
+	if err != nil {
+		log.Println("error while getting all event feedbacks:", err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		var feedback schedule_service.EventFeedback
+		count++
+		err = rows.Scan(&feedback.Id, &feedback.EventStudentId, &feedback.EventId, &feedback.StudentId, &feedback.Rating, &comment, &created_at)
+
+		if err != nil {
+			log.Println("error while scanning event feedbacks:", err)
+			return nil, err
+		}
+		feedback.Comment = comment.String
+		feedback.CreatedAt = created_at.String
+
+		resp.Feedbacks = append(resp.Feedbacks, &feedback)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// GetEventRating implements storage.EventFeedbackRepoI.
+func (e *eventFeedbackRepo) GetEventRating(ctx context.Context, req *schedule_service.EventRatingRequest) (*schedule_service.EventRating, error) {
+	rating, err := scanEventRating(e.db.QueryRow(ctx, `
+        SELECT `+eventRatingColumns+`
+        FROM "event" ev
+        LEFT JOIN "event_feedback" f ON f.eventId = ev.id
+        WHERE ev.id = $1 AND ev.deleted_at = 0
+        GROUP BY ev.id`, req.EventId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("event not found")
+		}
+		log.Println("error while getting event rating", err)
+		return nil, err
+	}
+
+	return rating, nil
+}
+
+// GetTopicRatings implements storage.EventFeedbackRepoI. Topics are compared
+// case-insensitively.
+func (e *eventFeedbackRepo) GetTopicRatings(ctx context.Context, req *schedule_service.TopicRatingRequest) (*schedule_service.TopicRatingResponse, error) {
+	resp := &schedule_service.TopicRatingResponse{}
+
+	rows, err := e.db.Query(ctx, `
+        SELECT MIN(ev.topic),
+            AVG(f.rating)::float8,
+            COUNT(f.id),
+            COUNT(DISTINCT ev.id)
+        FROM "event_feedback" f
+        JOIN "event" ev ON ev.id = f.eventId
+        WHERE ev.deleted_at = 0
+          AND ($1 = '' OR ev.branchId::text = $1)
+          AND ($2 = '' OR ev.topic ILIKE '%' || $2 || '%')
+        GROUP BY LOWER(TRIM(ev.topic))
+        ORDER BY AVG(f.rating) DESC, COUNT(f.id) DESC`, req.BranchId, req.Search)
+	if err != nil {
+		log.Println("error while getting topic ratings", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			topic schedule_service.TopicRating
+			name  sql.NullString
+		)
+
+		if err = rows.Scan(&name, &topic.AverageRating, &topic.Responses, &topic.Events); err != nil {
+			log.Println("error while scanning topic rating", err)
+			return nil, err
+		}
+		topic.Topic = name.String
+
+		resp.Topics = append(resp.Topics, &topic)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetLowRatedEvents implements storage.EventFeedbackRepoI. The lowest rated
+// events come first.
+func (e *eventFeedbackRepo) GetLowRatedEvents(ctx context.Context, req *schedule_service.LowRatedEventsRequest) (*schedule_service.LowRatedEventsResponse, error) {
+	resp := &schedule_service.LowRatedEventsResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := e.db.Query(ctx, `
+        SELECT `+eventRatingColumns+`
+        FROM "event" ev
+        JOIN "event_feedback" f ON f.eventId = ev.id
+        WHERE ev.deleted_at = 0
+          AND ($1 = '' OR ev.branchId::text = $1)
+        GROUP BY ev.id
+        HAVING AVG(f.rating) <= $2 AND COUNT(f.id) >= $3
+        ORDER BY AVG(f.rating), COUNT(f.id) DESC
+    `+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.BranchId, req.MaxRating, req.MinResponses)
+	if err != nil {
+		log.Println("error while getting low rated events", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		rating, err := scanEventRating(rows)
+		if err != nil {
+			log.Println("error while scanning low rated event", err)
+			return nil, err
+		}
+
+		resp.Events = append(resp.Events, rating)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+func scanEventRating(row pgx.Row) (*schedule_service.EventRating, error) {
+	var (
+		rating   schedule_service.EventRating
+		topic    sql.NullString
+		date     sql.NullString
+		branchId sql.NullString
+	)
+
+	err := row.Scan(&rating.EventId, &topic, &date, &branchId, &rating.AverageRating, &rating.Responses, &rating.Distribution)
+	if err != nil {
+		return nil, err
+	}
+
+	rating.Topic = topic.String
+	rating.Date = date.String
+	rating.BranchId = branchId.String
+
+	return &rating, nil
+}
@@ -3,12 +3,14 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	es "schedule_service/genproto/schedule_service"
 	"schedule_service/storage"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -22,24 +24,77 @@ func NewEventStudentRepo(db *pgxpool.Pool) storage.EventStudentRepoI {
 	}
 }
 
-// Create implements storage.EventStudentRepoI.
+// Create implements storage.EventStudentRepoI. The event row is locked so
+// concurrent registrations cannot overbook it; once the capacity is reached
+// the student is put on the waitlist instead.
 func (e *eventStudentRepo) Create(ctx context.Context, req *es.CreateEventStudent) (*es.GetEventStudent, error) {
 	id := uuid.NewString()
 
-	_, err := e.db.Exec(ctx, `
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting event registration transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	capacity, err := lockEventRegistration(ctx, tx, req.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkStudentHold(ctx, tx, req.StudentId); err != nil {
+		return nil, err
+	}
+
+	var exists bool
+	err = tx.QueryRow(ctx, `
+        SELECT EXISTS (
+            SELECT 1 FROM "event_student"
+            WHERE eventId = $1 AND studentId = $2 AND deleted_at = 0 AND status <> 'cancelled'
+        )`, req.EventId, req.StudentId).Scan(&exists)
+	if err != nil {
+		log.Println("error while checking event registration", err)
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New("student is already registered for this event")
+	}
+
+	status := "registered"
+	if capacity.Valid {
+		var registered int32
+		err = tx.QueryRow(ctx, `
+            SELECT COUNT(*) FROM "event_student"
+            WHERE eventId = $1 AND deleted_at = 0 AND status = 'registered'`, req.EventId).Scan(&registered)
+		if err != nil {
+			log.Println("error while counting event registrations", err)
+			return nil, err
+		}
+		if registered >= capacity.Int32 {
+			status = "waitlisted"
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
         INSERT INTO "event_student" (
             id,
             eventId,
-            studentId
+            studentId,
+            status
         ) VALUES (
-            $1, $2, $3
-        )`, id, req.EventId, req.StudentId)
+            $1, $2, $3, $4
+        )`, id, req.EventId, req.StudentId, status)
 
 	if err != nil {
 		log.Println("error while creating event student in storage", err)
 		return nil, err
 	}
 
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing event registration", err)
+		return nil, err
+	}
+
 	eventStudent, err := e.GetByID(ctx, &es.EventStudentPrimaryKey{Id: id})
 	if err != nil {
 		log.Println("error while getting event student by id after creating", err)
@@ -48,29 +103,164 @@ func (e *eventStudentRepo) Create(ctx context.Context, req *es.CreateEventStuden
 	return eventStudent, nil
 }
 
+// Cancel implements storage.EventStudentRepoI. A registration can be
+// cancelled until the event's cutoff; the freed seat goes to the earliest
+// waitlisted student.
+func (e *eventStudentRepo) Cancel(ctx context.Context, req *es.CancelEventStudent) (*es.GetEventStudent, error) {
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting event cancellation transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var eventId string
+	err = tx.QueryRow(ctx, `
+        SELECT eventId FROM "event_student"
+        WHERE id = $1 AND deleted_at = 0`, req.Id).Scan(&eventId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("event registration not found")
+		}
+		log.Println("error while getting event registration", err)
+		return nil, err
+	}
+
+	if _, err = lockEventRegistration(ctx, tx, eventId); err != nil {
+		return nil, err
+	}
+
+	var studentId, status string
+	err = tx.QueryRow(ctx, `
+        SELECT studentId, status FROM "event_student"
+        WHERE id = $1
+        FOR UPDATE`, req.Id).Scan(&studentId, &status)
+	if err != nil {
+		log.Println("error while locking event registration", err)
+		return nil, err
+	}
+
+	if req.StudentId != "" && req.StudentId != studentId {
+		return nil, errors.New("event registration belongs to another student")
+	}
+	if status == "cancelled" {
+		return nil, errors.New("event registration is already cancelled")
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE "event_student" SET
+            status = 'cancelled',
+            updated_at = NOW()
+        WHERE id = $1`, req.Id)
+	if err != nil {
+		log.Println("error while cancelling event registration", err)
+		return nil, err
+	}
+
+	if err = promoteEventWaitlist(ctx, tx, eventId); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing event cancellation", err)
+		return nil, err
+	}
+
+	return e.GetByID(ctx, &es.EventStudentPrimaryKey{Id: req.Id})
+}
+
+// lockEventRegistration locks the event row and fails once registration is
+// closed, i.e. the cutoff before the event starts in its branch's timezone.
+func lockEventRegistration(ctx context.Context, tx pgx.Tx, eventId string) (sql.NullInt32, error) {
+	var (
+		capacity sql.NullInt32
+		closed   sql.NullBool
+	)
+
+	err := tx.QueryRow(ctx, `
+        SELECT ev.capacity,
+            NOW() >= ((ev.date + ev.startTime) AT TIME ZONE `+eventTimezone+`) - `+eventCutoffHours+` * INTERVAL '1 hour'
+        FROM "event" ev
+        WHERE ev.id = $1 AND ev.deleted_at = 0
+        FOR UPDATE`, eventId).Scan(&capacity, &closed)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return capacity, errors.New("event not found")
+		}
+		log.Println("error while locking event", err)
+		return capacity, err
+	}
+
+	if !closed.Valid {
+		return capacity, errors.New("event has no date or start time")
+	}
+	if closed.Bool {
+		return capacity, errors.New("registration for this event is closed")
+	}
+
+	return capacity, nil
+}
+
+// promoteEventWaitlist moves waitlisted students, oldest first, into the free
+// seats of the event, skipping students on hold. The caller must hold the
+// event row lock.
+func promoteEventWaitlist(ctx context.Context, tx pgx.Tx, eventId string) error {
+	_, err := tx.Exec(ctx, `
+        UPDATE "event_student" SET
+            status = 'registered',
+            updated_at = NOW()
+        WHERE id IN (
+            SELECT w.id FROM "event_student" w
+            WHERE w.eventId = $1 AND w.deleted_at = 0 AND w.status = 'waitlisted'
+              AND NOT EXISTS (SELECT 1 FROM "student_hold" h WHERE h.studentId = w.studentId AND h.clearedAt IS NULL)
+            ORDER BY w.created_at, w.id
+            LIMIT (
+                SELECT CASE WHEN ev.capacity IS NULL THEN NULL
+                    ELSE GREATEST(ev.capacity - (
+                        SELECT COUNT(*) FROM "event_student" r
+                        WHERE r.eventId = ev.id AND r.deleted_at = 0 AND r.status = 'registered'
+                    ), 0) END
+                FROM "event" ev WHERE ev.id = $1
+            )
+        )`, eventId)
+	if err != nil {
+		log.Println("error while promoting event waitlist", err)
+		return err
+	}
+
+	return nil
+}
+
 // GetByID implements storage.EventStudentRepoI.
 func (e *eventStudentRepo) GetByID(ctx context.Context, req *es.EventStudentPrimaryKey) (*es.GetEventStudent, error) {
 	resp := &es.GetEventStudent{}
 
 	var (
-		created_at sql.NullString
-		updated_at sql.NullString
+		checkedInAt sql.NullString
+		checkedInBy sql.NullString
+		created_at  sql.NullString
+		updated_at  sql.NullString
 	)
 
 	err := e.db.QueryRow(ctx, `
             SELECT id,
             eventId,
             studentId,
+            status,
+            checkedInAt::text,
+            checkedInBy::text,
             created_at,
             updated_at
             FROM "event_student"
-        WHERE id=$1`, req.Id).Scan(&resp.Id, &resp.EventId, &resp.StudentId, &created_at, &updated_at)
+        WHERE id=$1`, req.Id).Scan(&resp.Id, &resp.EventId, &resp.StudentId, &resp.Status, &checkedInAt, &checkedInBy, &created_at, &updated_at)
 
 	if err != nil {
 		log.Println("error while getting event student by id", err)
 		return nil, err
 	}
 
+	resp.CheckedInAt = checkedInAt.String
+	resp.CheckedInBy = checkedInBy.String
 	resp.CreatedAt = created_at.String
 	resp.UpdatedAt = updated_at.String
 
@@ -81,9 +271,11 @@ func (e *eventStudentRepo) GetByID(ctx context.Context, req *es.EventStudentPrim
 func (e *eventStudentRepo) GetList(ctx context.Context, req *es.GetListEventStudentRequest) (*es.GetListEventStudentResponse, error) {
 	resp := &es.GetListEventStudentResponse{}
 	var (
-		filter     string
-		created_at sql.NullString
-		updated_at sql.NullString
+		filter      string
+		checkedInAt sql.NullString
+		checkedInBy sql.NullString
+		created_at  sql.NullString
+		updated_at  sql.NullString
 	)
 	offset := (req.Page - 1) * req.Limit
 
@@ -98,8 +290,12 @@ func (e *eventStudentRepo) GetList(ctx context.Context, req *es.GetListEventStud
             id,
             eventId,
             studentId,
+            status,
+            checkedInAt::text,
+            checkedInBy::text,
             created_at,
-            updated_at
+            updated_at,
+            deleted_at
         FROM "event_student" WHERE deleted_at=0
     `+filter)
 
@@ -115,12 +311,14 @@ func (e *eventStudentRepo) GetList(ctx context.Context, req *es.GetListEventStud
 	for rows.Next() {
 		var eventStudent es.EventStudent
 		count++
-		err = rows.Scan(&eventStudent.Id, &eventStudent.EventId, &eventStudent.StudentId, &created_at, &updated_at, &eventStudent.DeletedAt)
+		err = rows.Scan(&eventStudent.Id, &eventStudent.EventId, &eventStudent.StudentId, &eventStudent.Status, &checkedInAt, &checkedInBy, &created_at, &updated_at, &eventStudent.DeletedAt)
 
 		if err != nil {
 			log.Println("error while scanning event students:", err)
 			return nil, err
 		}
+		eventStudent.CheckedInAt = checkedInAt.String
+		eventStudent.CheckedInBy = checkedInBy.String
 		eventStudent.CreatedAt = created_at.String
 		eventStudent.UpdatedAt = updated_at.String
 
@@ -137,33 +335,34 @@ func (e *eventStudentRepo) GetList(ctx context.Context, req *es.GetListEventStud
 	return resp, nil
 }
 
-// Update implements storage.EventStudentRepoI.
-func (e *eventStudentRepo) Update(ctx context.Context, req *es.UpdateEventStudent) (*es.GetEventStudent, error) {
+// Delete implements storage.EventStudentRepoI. Unlike Cancel it ignores the
+// cutoff, but still hands the freed seat to the waitlist.
+func (e *eventStudentRepo) Delete(ctx context.Context, req *es.EventStudentPrimaryKey) error {
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting event student delete transaction", err)
+		return err
+	}
+	defer tx.Rollback(ctx)
 
-	_, err := e.db.Exec(ctx, `
-        UPDATE "event_student" SET
-            eventId=$1,
-            studentId=$2,
-            updated_at = NOW()
-        WHERE id = $3`, req.EventId, req.StudentId, req.Id)
+	var eventId string
+	err = tx.QueryRow(ctx, `
+        SELECT eventId FROM "event_student" WHERE id = $1
+    `, req.Id).Scan(&eventId)
 
 	if err != nil {
-		log.Println("error while updating event student in storage", err)
-		return nil, err
+		log.Println("error while getting event student", err)
+		return err
 	}
 
-	eventStudent, err := e.GetByID(ctx, &es.EventStudentPrimaryKey{Id: req.Id})
+	// The event is locked before its registrations, as Create and Cancel do.
+	_, err = tx.Exec(ctx, `SELECT 1 FROM "event" WHERE id = $1 FOR UPDATE`, eventId)
 	if err != nil {
-		log.Println("error while getting updated event student by id", err)
-		return nil, err
+		log.Println("error while locking event", err)
+		return err
 	}
 
-	return eventStudent, nil
-}
-
-// Delete implements storage.EventStudentRepoI.
-func (e *eventStudentRepo) Delete(ctx context.Context, req *es.EventStudentPrimaryKey) error {
-	_, err := e.db.Exec(ctx, `
+	_, err = tx.Exec(ctx, `
         UPDATE "event_student" SET 
             deleted_at = 1
         WHERE id = $1
@@ -174,19 +373,40 @@ func (e *eventStudentRepo) Delete(ctx context.Context, req *es.EventStudentPrima
 		return err
 	}
 
-	return nil
+	if err = promoteEventWaitlist(ctx, tx, eventId); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
+// GetStudentWithEventsByID implements storage.EventStudentRepoI. Cancelled
+// registrations are left out.
 func (r *eventStudentRepo) GetStudentWithEventsByID(ctx context.Context, req *es.EventStudentPrimaryKey) (*es.GetStudentWithEventsResponse, error) {
-	var studentResp es.GetStudentWithEventsResponse
+	var (
+		studentResp es.GetStudentWithEventsResponse
+		name        sql.NullString
+	)
+
+	err := r.db.QueryRow(ctx, `
+        SELECT id, fullname, phone
+        FROM "student"
+        WHERE id = $1 AND deleted_at = 0
+    `, req.Id).Scan(&studentResp.Id, &name, &studentResp.Phone)
+	if err != nil {
+		log.Println("error while getting student with events:", err)
+		return nil, err
+	}
+	studentResp.Name = name.String
 
 	rows, err := r.db.Query(ctx, `
-        SELECT s.id, s.name, s.email, s.phone,
-               es.id as event_student_id, es.eventId, e.assignStudent, e.topic, e.startTime, e.date, e.branchId, e.created_at as event_created_at, e.updated_at as event_updated_at
-        FROM student s
-        LEFT JOIN event_student es ON s.id = es.studentId
-        LEFT JOIN event e ON es.eventId = e.id
-        WHERE s.id = $1
+        SELECT es.id, es.eventId, es.status, es.checkedInAt::text,
+               e.assignStudent, e.topic, e.startTime::text, e.date::text, e.branchId::text,
+               es.created_at::text, es.updated_at::text
+        FROM "event_student" es
+        JOIN "event" e ON e.id = es.eventId
+        WHERE es.studentId = $1 AND es.deleted_at = 0 AND es.status <> 'cancelled'
+        ORDER BY e.date DESC, e.startTime DESC
     `, req.Id)
 	if err != nil {
 		log.Println("error while getting student with events:", err)
@@ -195,33 +415,36 @@ func (r *eventStudentRepo) GetStudentWithEventsByID(ctx context.Context, req *es
 	defer rows.Close()
 
 	for rows.Next() {
-		var studentID, name, phone string
-		var eventStudentID, eventID, assignStudent, topic, startTime, date, branchID, eventCreatedAt, eventUpdatedAt sql.NullString
+		var eventStudentID, eventID, status string
+		var checkedInAt, assignStudent, topic, startTime, date, branchID, createdAt, updatedAt sql.NullString
 
-		err := rows.Scan(&studentID, &name, &phone,
-			&eventStudentID, &eventID, &assignStudent, &topic, &startTime, &date, &branchID, &eventCreatedAt, &eventUpdatedAt)
+		err := rows.Scan(&eventStudentID, &eventID, &status, &checkedInAt,
+			&assignStudent, &topic, &startTime, &date, &branchID, &createdAt, &updatedAt)
 		if err != nil {
 			log.Println("error while scanning student with events row:", err)
 			return nil, err
 		}
 
-		if studentResp.Id == "" {
-			studentResp.Id = studentID
-			studentResp.Name = name
-			studentResp.Phone = phone
+		if status == "registered" {
+			studentResp.RegisteredCount++
+		}
+		if checkedInAt.Valid {
+			studentResp.AttendedCount++
 		}
 
 		eventResponse := &es.EventStudentResponse{
-			Id:            eventID.String,
-			EventId:       eventID.String,
-			StudentId:     studentID,
-			CreatedAt:     eventCreatedAt.String,
-			UpdatedAt:     eventUpdatedAt.String,
+			Id:            eventStudentID,
+			EventId:       eventID,
+			StudentId:     req.Id,
+			CreatedAt:     createdAt.String,
+			UpdatedAt:     updatedAt.String,
 			AssignStudent: assignStudent.String,
 			Topic:         topic.String,
 			StartTime:     startTime.String,
 			Date:          date.String,
 			BranchId:      branchID.String,
+			Status:        status,
+			CheckedInAt:   checkedInAt.String,
 		}
 
 		studentResp.Events = append(studentResp.Events, eventResponse)
@@ -234,3 +457,137 @@ func (r *eventStudentRepo) GetStudentWithEventsByID(ctx context.Context, req *es
 
 	return &studentResp, nil
 }
+
+// CheckIn implements storage.EventStudentRepoI. When eventStudentId is empty
+// the registration is looked up by req.EventId and req.StudentId.
+func (e *eventStudentRepo) CheckIn(ctx context.Context, eventStudentId string, req *es.CheckInEventStudent) (*es.GetEventStudent, error) {
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting event check-in transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		id, eventId, status string
+		checkedInAt         sql.NullString
+	)
+
+	query := `
+        SELECT id, eventId, status, checkedInAt::text
+        FROM "event_student"
+        WHERE id = $1 AND deleted_at = 0
+        FOR UPDATE`
+	args := []interface{}{eventStudentId}
+	if eventStudentId == "" {
+		if req.EventId == "" || req.StudentId == "" {
+			return nil, errors.New("check-in code or event and student ids are required")
+		}
+		query = `
+            SELECT id, eventId, status, checkedInAt::text
+            FROM "event_student"
+            WHERE eventId = $1 AND studentId = $2 AND deleted_at = 0 AND status <> 'cancelled'
+            FOR UPDATE`
+		args = []interface{}{req.EventId, req.StudentId}
+	}
+
+	err = tx.QueryRow(ctx, query, args...).Scan(&id, &eventId, &status, &checkedInAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("event registration not found")
+		}
+		log.Println("error while getting event registration for check-in", err)
+		return nil, err
+	}
+
+	if req.EventId != "" && req.EventId != eventId {
+		return nil, errors.New("check-in code belongs to another event")
+	}
+	if status != "registered" {
+		return nil, fmt.Errorf("cannot check in a %s registration", status)
+	}
+	if checkedInAt.Valid {
+		return nil, errors.New("student is already checked in")
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE "event_student" SET
+            checkedInAt = NOW(),
+            checkedInBy = NULLIF($2, '')::uuid,
+            updated_at = NOW()
+        WHERE id = $1`, id, req.CheckedInBy)
+	if err != nil {
+		log.Println("error while checking in event student", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing event check-in", err)
+		return nil, err
+	}
+
+	return e.GetByID(ctx, &es.EventStudentPrimaryKey{Id: id})
+}
+
+// GetAttendance implements storage.EventStudentRepoI.
+func (e *eventStudentRepo) GetAttendance(ctx context.Context, req *es.EventAttendanceRequest) (*es.EventAttendance, error) {
+	resp := &es.EventAttendance{EventId: req.EventId}
+
+	var capacity sql.NullInt32
+	err := e.db.QueryRow(ctx, `
+        SELECT ev.capacity,
+            COUNT(es.id) FILTER (WHERE es.status = 'registered'),
+            COUNT(es.id) FILTER (WHERE es.status = 'waitlisted'),
+            COUNT(es.id) FILTER (WHERE es.status = 'cancelled'),
+            COUNT(es.id) FILTER (WHERE es.status = 'registered' AND es.checkedInAt IS NOT NULL)
+        FROM "event" ev
+        LEFT JOIN "event_student" es ON es.eventId = ev.id AND es.deleted_at = 0
+        WHERE ev.id = $1 AND ev.deleted_at = 0
+        GROUP BY ev.id`, req.EventId).Scan(&capacity, &resp.Registered, &resp.Waitlisted, &resp.Cancelled, &resp.Attended)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("event not found")
+		}
+		log.Println("error while getting event attendance", err)
+		return nil, err
+	}
+	resp.Capacity = capacity.Int32
+	resp.NoShow = resp.Registered - resp.Attended
+
+	rows, err := e.db.Query(ctx, `
+        SELECT id, eventId, studentId, status, checkedInAt::text, checkedInBy::text, created_at::text, updated_at::text
+        FROM "event_student"
+        WHERE eventId = $1 AND deleted_at = 0 AND status = 'registered'
+        ORDER BY created_at`, req.EventId)
+	if err != nil {
+		log.Println("error while getting event attendees", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			eventStudent                                   es.EventStudent
+			checkedInAt, checkedInBy, createdAt, updatedAt sql.NullString
+		)
+
+		err = rows.Scan(&eventStudent.Id, &eventStudent.EventId, &eventStudent.StudentId, &eventStudent.Status, &checkedInAt, &checkedInBy, &createdAt, &updatedAt)
+		if err != nil {
+			log.Println("error while scanning event attendee", err)
+			return nil, err
+		}
+		eventStudent.CheckedInAt = checkedInAt.String
+		eventStudent.CheckedInBy = checkedInBy.String
+		eventStudent.CreatedAt = createdAt.String
+		eventStudent.UpdatedAt = updatedAt.String
+
+		resp.EventStudents = append(resp.EventStudents, &eventStudent)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"schedule_service/genproto/schedule_service"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// newTestEvent creates an event daysAhead days from today at noon with a
+// three hour registration cutoff; a zero capacity leaves it unlimited.
+func newTestEvent(t *testing.T, db *pgxpool.Pool, branchId string, daysAhead int, capacity int32) string {
+	t.Helper()
+
+	id := uuid.NewString()
+	mustExec(t, db, `
+        INSERT INTO "event" (id, topic, date, startTime, branchId, capacity, cutoffHours)
+        VALUES ($1, 'test event', CURRENT_DATE + $2::int, '12:00', $3, NULLIF($4, 0), 3)
+    `, id, daysAhead, branchId, capacity)
+
+	return id
+}
+
+func TestEventRegistrationCutoff(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	registrations := NewEventStudentRepo(db)
+
+	branchId := newTestBranch(t, db)
+	studentId := newTestStudent(t, db, branchId)
+
+	past := newTestEvent(t, db, branchId, -1, 0)
+	if _, err := registrations.Create(ctx, &schedule_service.CreateEventStudent{EventId: past, StudentId: studentId}); err == nil {
+		t.Fatal("registered for an event after its cutoff")
+	}
+
+	upcoming := newTestEvent(t, db, branchId, 7, 0)
+	registration, err := registrations.Create(ctx, &schedule_service.CreateEventStudent{EventId: upcoming, StudentId: studentId})
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	if registration.Status != "registered" {
+		t.Errorf("status = %q, want registered", registration.Status)
+	}
+
+	// Moving the event inside the cutoff closes it for cancellations too.
+	mustExec(t, db, `UPDATE "event" SET date = CURRENT_DATE - 1 WHERE id = $1`, upcoming)
+	if _, err := registrations.Cancel(ctx, &schedule_service.CancelEventStudent{Id: registration.Id, StudentId: studentId}); err == nil {
+		t.Fatal("cancelled a registration after the cutoff")
+	}
+}
+
+func TestEventRegistrationWaitlist(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	registrations := NewEventStudentRepo(db)
+
+	branchId := newTestBranch(t, db)
+	first := newTestStudent(t, db, branchId)
+	second := newTestStudent(t, db, branchId)
+	eventId := newTestEvent(t, db, branchId, 7, 1)
+
+	seated, err := registrations.Create(ctx, &schedule_service.CreateEventStudent{EventId: eventId, StudentId: first})
+	if err != nil {
+		t.Fatalf("register first: %v", err)
+	}
+
+	waiting, err := registrations.Create(ctx, &schedule_service.CreateEventStudent{EventId: eventId, StudentId: second})
+	if err != nil {
+		t.Fatalf("register second: %v", err)
+	}
+	if waiting.Status != "waitlisted" {
+		t.Fatalf("status = %q, want waitlisted", waiting.Status)
+	}
+
+	if _, err := registrations.Cancel(ctx, &schedule_service.CancelEventStudent{Id: seated.Id, StudentId: second}); err == nil {
+		t.Fatal("cancelled another student's registration")
+	}
+
+	if _, err := registrations.Cancel(ctx, &schedule_service.CancelEventStudent{Id: seated.Id, StudentId: first}); err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+
+	promoted, err := registrations.GetByID(ctx, &schedule_service.EventStudentPrimaryKey{Id: waiting.Id})
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if promoted.Status != "registered" {
+		t.Errorf("status after a seat was freed = %q, want registered", promoted.Status)
+	}
+}
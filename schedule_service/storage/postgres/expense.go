@@ -0,0 +1,510 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/pkg/money"
+	"schedule_service/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const expenseColumns = `
+            e.id,
+            e.branchId::text,
+            COALESCE(b.name, ''),
+            e.categoryId::text,
+            COALESCE(c.name, ''),
+            e.amount::text,
+            e.spentOn::text,
+            e.description,
+            e.status,
+            e.createdBy::text,
+            e.reviewedBy::text,
+            e.reviewedAt::text,
+            e.reviewNote,
+            e.created_at::text,
+            e.updated_at::text`
+
+const expenseFrom = `
+        FROM "expense" e
+        LEFT JOIN "branch" b ON b.id = e.branchId
+        LEFT JOIN "expense_category" c ON c.id = e.categoryId`
+
+const expenseAttachmentColumns = `
+            id,
+            expenseId::text,
+            fileName,
+            contentType,
+            size,
+            createdBy::text,
+            created_at::text`
+
+type expenseRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewExpenseRepo(db *pgxpool.Pool) storage.ExpenseRepoI {
+	return &expenseRepo{
+		db: db,
+	}
+}
+
+// CreateCategory implements storage.ExpenseRepoI.
+func (e *expenseRepo) CreateCategory(ctx context.Context, req *schedule_service.CreateExpenseCategory) (*schedule_service.ExpenseCategory, error) {
+	id := uuid.NewString()
+
+	result, err := e.db.Exec(ctx, `
+        INSERT INTO "expense_category" (
+            id,
+            name
+        ) VALUES (
+            $1, $2
+        )
+        ON CONFLICT DO NOTHING`, id, req.Name)
+
+	if err != nil {
+		log.Println("error while creating expense category in storage", err)
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("expense category already exists")
+	}
+
+	var (
+		category   schedule_service.ExpenseCategory
+		created_at sql.NullString
+	)
+
+	err = e.db.QueryRow(ctx, `
+        SELECT id, name, created_at::text
+        FROM "expense_category"
+        WHERE id = $1`, id).Scan(&category.Id, &category.Name, &created_at)
+	if err != nil {
+		log.Println("error while getting expense category by id", err)
+		return nil, err
+	}
+
+	category.CreatedAt = created_at.String
+
+	return &category, nil
+}
+
+// GetListCategory implements storage.ExpenseRepoI.
+func (e *expenseRepo) GetListCategory(ctx context.Context, req *schedule_service.GetListExpenseCategoryRequest) (*schedule_service.GetListExpenseCategoryResponse, error) {
+	resp := &schedule_service.GetListExpenseCategoryResponse{}
+
+	rows, err := e.db.Query(ctx, `
+        SELECT id, name, created_at::text
+        FROM "expense_category"
+        WHERE deleted_at = 0
+        ORDER BY name`)
+	if err != nil {
+		log.Println("error while getting all expense categories:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		var (
+			category   schedule_service.ExpenseCategory
+			created_at sql.NullString
+		)
+		count++
+		if err := rows.Scan(&category.Id, &category.Name, &created_at); err != nil {
+			log.Println("error while scanning expense categories:", err)
+			return nil, err
+		}
+		category.CreatedAt = created_at.String
+
+		resp.Categories = append(resp.Categories, &category)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// DeleteCategory implements storage.ExpenseRepoI. Expenses keep their
+// category.
+func (e *expenseRepo) DeleteCategory(ctx context.Context, req *schedule_service.ExpenseCategoryPrimaryKey) error {
+	_, err := e.db.Exec(ctx, `
+        UPDATE "expense_category" SET
+            deleted_at = 1,
+            updated_at = NOW()
+        WHERE id = $1
+    `, req.Id)
+
+	if err != nil {
+		log.Println("error while deleting expense category")
+		return err
+	}
+
+	return nil
+}
+
+// Create implements storage.ExpenseRepoI. Administrators record expenses
+// of the branch they work at, whatever branch is asked for.
+func (e *expenseRepo) Create(ctx context.Context, req *schedule_service.CreateExpense) (*schedule_service.Expense, error) {
+	id := uuid.NewString()
+
+	result, err := e.db.Exec(ctx, `
+        INSERT INTO "expense" (
+            id,
+            branchId,
+            categoryId,
+            amount,
+            spentOn,
+            description,
+            createdBy
+        )
+        SELECT $1,
+            COALESCE((SELECT a.branchId FROM "administration" a WHERE a.id::text = $7), NULLIF($2, '')::uuid),
+            c.id,
+            $4,
+            COALESCE(NULLIF($5, '')::date, CURRENT_DATE),
+            NULLIF($6, ''),
+            NULLIF($7, '')::uuid
+        FROM "expense_category" c
+        WHERE c.id::text = $3 AND c.deleted_at = 0
+        `, id, req.BranchId, req.CategoryId, req.Amount, req.SpentOn, req.Description, req.CreatedBy)
+
+	if err != nil {
+		log.Println("error while creating expense in storage", err)
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("expense category not found")
+	}
+
+	return e.GetByID(ctx, &schedule_service.ExpensePrimaryKey{Id: id})
+}
+
+// GetByID implements storage.ExpenseRepoI.
+func (e *expenseRepo) GetByID(ctx context.Context, req *schedule_service.ExpensePrimaryKey) (*schedule_service.Expense, error) {
+	expense, err := scanExpense(e.db.QueryRow(ctx, `
+        SELECT `+expenseColumns+expenseFrom+`
+        WHERE e.id::text = $1 AND e.deleted_at = 0`, req.Id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("expense not found")
+		}
+		log.Println("error while getting expense by id", err)
+		return nil, err
+	}
+
+	rows, err := e.db.Query(ctx, `
+        SELECT `+expenseAttachmentColumns+`
+        FROM "expense_attachment"
+        WHERE expenseId = $1
+        ORDER BY created_at`, expense.Id)
+	if err != nil {
+		log.Println("error while getting expense attachments:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		attachment, err := scanExpenseAttachment(rows)
+		if err != nil {
+			log.Println("error while scanning expense attachments:", err)
+			return nil, err
+		}
+
+		expense.Attachments = append(expense.Attachments, attachment)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	return expense, nil
+}
+
+// GetList implements storage.ExpenseRepoI. The total is the sum of the
+// expenses on the page.
+func (e *expenseRepo) GetList(ctx context.Context, req *schedule_service.GetListExpenseRequest) (*schedule_service.GetListExpenseResponse, error) {
+	resp := &schedule_service.GetListExpenseResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := e.db.Query(ctx, `
+        SELECT `+expenseColumns+expenseFrom+`
+        WHERE e.deleted_at = 0
+          AND ($1 = '' OR e.branchId::text = $1)
+          AND ($2 = '' OR e.categoryId::text = $2)
+          AND ($3 = '' OR e.status = $3)
+          AND ($4 = '' OR e.spentOn >= NULLIF($4, '')::date)
+          AND ($5 = '' OR e.spentOn <= NULLIF($5, '')::date)
+          AND ($6 = '' OR e.createdBy::text = $6)
+        ORDER BY e.spentOn DESC, e.created_at DESC
+    `+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.BranchId, req.CategoryId, req.Status, req.FromDate, req.ToDate, req.CreatedBy)
+	if err != nil {
+		log.Println("error while getting all expenses:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		count int64
+		total int64
+	)
+
+	for rows.Next() {
+		count++
+		expense, err := scanExpense(rows)
+		if err != nil {
+			log.Println("error while scanning expenses:", err)
+			return nil, err
+		}
+
+		amount, err := money.Large.Parse(expense.Amount)
+		if err != nil {
+			return nil, err
+		}
+		total += amount
+
+		resp.Expenses = append(resp.Expenses, expense)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+	resp.Total = money.Format(total)
+
+	return resp, nil
+}
+
+// Update implements storage.ExpenseRepoI. Only pending expenses change.
+func (e *expenseRepo) Update(ctx context.Context, req *schedule_service.UpdateExpense) (*schedule_service.Expense, error) {
+	result, err := e.db.Exec(ctx, `
+        UPDATE "expense" SET
+            categoryId = COALESCE((SELECT id FROM "expense_category" WHERE id::text = $1 AND deleted_at = 0), categoryId),
+            amount = $2,
+            spentOn = COALESCE(NULLIF($3, '')::date, spentOn),
+            description = NULLIF($4, ''),
+            updated_at = NOW()
+        WHERE id::text = $5 AND deleted_at = 0 AND status = 'pending'`, req.CategoryId, req.Amount, req.SpentOn, req.Description, req.Id)
+
+	if err != nil {
+		log.Println("error while updating expense in storage", err)
+		return nil, err
+	}
+
+	expense, err := e.GetByID(ctx, &schedule_service.ExpensePrimaryKey{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("only pending expenses can be changed")
+	}
+
+	return expense, nil
+}
+
+// Delete implements storage.ExpenseRepoI. Only pending expenses can be
+// deleted.
+func (e *expenseRepo) Delete(ctx context.Context, req *schedule_service.ExpensePrimaryKey) error {
+	result, err := e.db.Exec(ctx, `
+        UPDATE "expense" SET
+            deleted_at = 1,
+            updated_at = NOW()
+        WHERE id::text = $1 AND deleted_at = 0 AND status = 'pending'
+    `, req.Id)
+
+	if err != nil {
+		log.Println("error while deleting expense")
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return errors.New("expense not found or already reviewed")
+	}
+
+	return nil
+}
+
+// Review implements storage.ExpenseRepoI. A pending expense is approved or
+// rejected once; only approved expenses count as costs.
+func (e *expenseRepo) Review(ctx context.Context, req *schedule_service.ReviewExpense) (*schedule_service.Expense, error) {
+	result, err := e.db.Exec(ctx, `
+        UPDATE "expense" SET
+            status = $1,
+            reviewedBy = NULLIF($2, '')::uuid,
+            reviewedAt = NOW(),
+            reviewNote = NULLIF($3, ''),
+            updated_at = NOW()
+        WHERE id::text = $4 AND deleted_at = 0 AND status = 'pending'`, req.Status, req.ReviewedBy, req.Note, req.Id)
+
+	if err != nil {
+		log.Println("error while reviewing expense", err)
+		return nil, err
+	}
+
+	expense, err := e.GetByID(ctx, &schedule_service.ExpensePrimaryKey{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("expense is already reviewed")
+	}
+
+	return expense, nil
+}
+
+// AddAttachment implements storage.ExpenseRepoI. Files can be attached while
+// the expense is pending.
+func (e *expenseRepo) AddAttachment(ctx context.Context, req *schedule_service.CreateExpenseAttachment) (*schedule_service.ExpenseAttachment, error) {
+	id := uuid.NewString()
+
+	result, err := e.db.Exec(ctx, `
+        INSERT INTO "expense_attachment" (
+            id,
+            expenseId,
+            fileName,
+            contentType,
+            size,
+            content,
+            createdBy
+        )
+        SELECT $1, e.id, $3, $4, $5, $6, NULLIF($7, '')::uuid
+        FROM "expense" e
+        WHERE e.id::text = $2 AND e.deleted_at = 0 AND e.status = 'pending'
+        `, id, req.ExpenseId, req.FileName, req.ContentType, len(req.Content), req.Content, req.CreatedBy)
+
+	if err != nil {
+		log.Println("error while adding expense attachment in storage", err)
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("expense not found or already reviewed")
+	}
+
+	attachment, err := scanExpenseAttachment(e.db.QueryRow(ctx, `
+        SELECT `+expenseAttachmentColumns+`
+        FROM "expense_attachment"
+        WHERE id = $1`, id))
+	if err != nil {
+		log.Println("error while getting expense attachment by id", err)
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// GetAttachment implements storage.ExpenseRepoI.
+func (e *expenseRepo) GetAttachment(ctx context.Context, req *schedule_service.ExpenseAttachmentPrimaryKey) (*schedule_service.ExpenseAttachmentFile, error) {
+	var (
+		resp       = &schedule_service.ExpenseAttachmentFile{Attachment: &schedule_service.ExpenseAttachment{}}
+		createdBy  sql.NullString
+		created_at sql.NullString
+	)
+
+	err := e.db.QueryRow(ctx, `
+        SELECT a.id, a.expenseId::text, a.fileName, a.contentType, a.size, a.createdBy::text, a.created_at::text, a.content
+        FROM "expense_attachment" a
+        JOIN "expense" e ON e.id = a.expenseId AND e.deleted_at = 0
+        WHERE a.id::text = $1`, req.Id).Scan(&resp.Attachment.Id, &resp.Attachment.ExpenseId, &resp.Attachment.FileName, &resp.Attachment.ContentType,
+		&resp.Attachment.Size, &createdBy, &created_at, &resp.Content)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("expense attachment not found")
+		}
+		log.Println("error while getting expense attachment", err)
+		return nil, err
+	}
+
+	resp.Attachment.CreatedBy = createdBy.String
+	resp.Attachment.CreatedAt = created_at.String
+
+	return resp, nil
+}
+
+// DeleteAttachment implements storage.ExpenseRepoI. Files stay with an
+// expense once it is reviewed.
+func (e *expenseRepo) DeleteAttachment(ctx context.Context, req *schedule_service.ExpenseAttachmentPrimaryKey) error {
+	result, err := e.db.Exec(ctx, `
+        DELETE FROM "expense_attachment" a
+        USING "expense" e
+        WHERE a.id::text = $1 AND e.id = a.expenseId AND e.status = 'pending'
+    `, req.Id)
+
+	if err != nil {
+		log.Println("error while deleting expense attachment")
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return errors.New("expense attachment not found or expense already reviewed")
+	}
+
+	return nil
+}
+
+func scanExpense(row pgx.Row) (*schedule_service.Expense, error) {
+	var (
+		expense     schedule_service.Expense
+		description sql.NullString
+		createdBy   sql.NullString
+		reviewedBy  sql.NullString
+		reviewedAt  sql.NullString
+		reviewNote  sql.NullString
+		created_at  sql.NullString
+		updated_at  sql.NullString
+	)
+
+	err := row.Scan(&expense.Id, &expense.BranchId, &expense.BranchName, &expense.CategoryId, &expense.CategoryName, &expense.Amount, &expense.SpentOn,
+		&description, &expense.Status, &createdBy, &reviewedBy, &reviewedAt, &reviewNote, &created_at, &updated_at)
+	if err != nil {
+		return nil, err
+	}
+
+	expense.Description = description.String
+	expense.CreatedBy = createdBy.String
+	expense.ReviewedBy = reviewedBy.String
+	expense.ReviewedAt = reviewedAt.String
+	expense.ReviewNote = reviewNote.String
+	expense.CreatedAt = created_at.String
+	expense.UpdatedAt = updated_at.String
+
+	return &expense, nil
+}
+
+func scanExpenseAttachment(row pgx.Row) (*schedule_service.ExpenseAttachment, error) {
+	var (
+		attachment schedule_service.ExpenseAttachment
+		createdBy  sql.NullString
+		created_at sql.NullString
+	)
+
+	err := row.Scan(&attachment.Id, &attachment.ExpenseId, &attachment.FileName, &attachment.ContentType, &attachment.Size, &createdBy, &created_at)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment.CreatedBy = createdBy.String
+	attachment.CreatedAt = created_at.String
+
+	return &attachment, nil
+}
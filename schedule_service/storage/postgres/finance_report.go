@@ -0,0 +1,433 @@
+package postgres
+
+import (
+	"context"
+	"log"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/pkg/money"
+	"schedule_service/storage"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// ledgerInRange selects the ledger entries recorded between $1 and $2, both
+// dates and inclusive, with their branch: the branch of the group, or else of
+// the student, with its name, and the administrator who recorded them. $3 optionally
+// filters the branch.
+const ledgerInRange = `
+        FROM "student_payment" sp
+        LEFT JOIN "group" g ON g.id = sp.groupId
+        LEFT JOIN "student" s ON s.id = sp.studentId
+        LEFT JOIN "branch" b ON b.id = COALESCE(g.branchId, s.branchId)
+        LEFT JOIN "administration" a ON a.id = sp.administrationId
+        WHERE sp.created_at >= $1::date
+          AND sp.created_at < $2::date + 1
+          AND ($3 = '' OR COALESCE(g.branchId, s.branchId)::text = $3)`
+
+// debtAgingBuckets are the aging buckets in report order.
+var debtAgingBuckets = []string{"current", "1-30", "31-60", "61-90", "90+"}
+
+type financeReportRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewFinanceReportRepo(db *pgxpool.Pool) storage.FinanceReportRepoI {
+	return &financeReportRepo{
+		db: db,
+	}
+}
+
+// GetRevenue implements storage.FinanceReportRepoI. Revenue is the ledger
+// per branch, month and group type: payments, minus reversals and refunds as
+// corrections, in the month they were recorded.
+func (f *financeReportRepo) GetRevenue(ctx context.Context, req *schedule_service.FinanceReportRequest) (*schedule_service.RevenueReport, error) {
+	resp := &schedule_service.RevenueReport{FromDate: req.FromDate, ToDate: req.ToDate}
+
+	rows, err := f.db.Query(ctx, `
+        SELECT
+            COALESCE(COALESCE(g.branchId, s.branchId)::text, ''),
+            COALESCE(b.name, ''),
+            to_char(sp.created_at, 'YYYY-MM'),
+            COALESCE(g.type, ''),
+            COALESCE(SUM(sp.paidSum) FILTER (WHERE sp.entryType = 'payment'), 0)::text,
+            COALESCE(SUM(sp.paidSum) FILTER (WHERE sp.entryType <> 'payment'), 0)::text,
+            SUM(sp.paidSum)::text,
+            COUNT(*) FILTER (WHERE sp.entryType = 'payment')
+        `+ledgerInRange+`
+        GROUP BY 1, 2, 3, 4
+        ORDER BY 3, 2, 4`, req.FromDate, req.ToDate, req.BranchId)
+	if err != nil {
+		log.Println("error while getting revenue report:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var payments, corrections, net int64
+
+	for rows.Next() {
+		var row schedule_service.RevenueRow
+		err := rows.Scan(&row.BranchId, &row.BranchName, &row.Month, &row.GroupType, &row.Payments, &row.Corrections, &row.Net, &row.PaymentCount)
+		if err != nil {
+			log.Println("error while scanning revenue report:", err)
+			return nil, err
+		}
+
+		if err = addAmounts([]*int64{&payments, &corrections, &net}, row.Payments, row.Corrections, row.Net); err != nil {
+			return nil, err
+		}
+
+		resp.Rows = append(resp.Rows, &row)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.TotalPayments = money.Format(payments)
+	resp.TotalCorrections = money.Format(corrections)
+	resp.TotalNet = money.Format(net)
+
+	return resp, nil
+}
+
+// GetCollections implements storage.FinanceReportRepoI. Entries recorded
+// without an administrator, such as online payments, are grouped under an
+// empty administrationId.
+func (f *financeReportRepo) GetCollections(ctx context.Context, req *schedule_service.FinanceReportRequest) (*schedule_service.CollectionsReport, error) {
+	resp := &schedule_service.CollectionsReport{FromDate: req.FromDate, ToDate: req.ToDate}
+
+	rows, err := f.db.Query(ctx, `
+        SELECT
+            COALESCE(sp.administrationId::text, ''),
+            COALESCE(a.fullname, ''),
+            sp.paymentMethod,
+            COALESCE(SUM(sp.paidSum) FILTER (WHERE sp.entryType = 'payment'), 0)::text,
+            COALESCE(SUM(sp.paidSum) FILTER (WHERE sp.entryType <> 'payment'), 0)::text,
+            SUM(sp.paidSum)::text,
+            COUNT(*) FILTER (WHERE sp.entryType = 'payment')
+        `+ledgerInRange+`
+        GROUP BY 1, 2, 3
+        ORDER BY 2, 1, 3`, req.FromDate, req.ToDate, req.BranchId)
+	if err != nil {
+		log.Println("error while getting collections report:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var net int64
+
+	for rows.Next() {
+		var row schedule_service.CollectionRow
+		err := rows.Scan(&row.AdministrationId, &row.AdministrationName, &row.PaymentMethod, &row.Payments, &row.Corrections, &row.Net, &row.PaymentCount)
+		if err != nil {
+			log.Println("error while scanning collections report:", err)
+			return nil, err
+		}
+
+		if err = addAmounts([]*int64{&net}, row.Net); err != nil {
+			return nil, err
+		}
+
+		resp.Rows = append(resp.Rows, &row)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.TotalNet = money.Format(net)
+
+	return resp, nil
+}
+
+// GetDebtAging implements storage.FinanceReportRepoI. Outstanding invoices
+// are bucketed by days past their due date as of today; invoices not due yet
+// are current.
+func (f *financeReportRepo) GetDebtAging(ctx context.Context, req *schedule_service.DebtAgingRequest) (*schedule_service.DebtAgingReport, error) {
+	resp := &schedule_service.DebtAgingReport{}
+
+	rows, err := f.db.Query(ctx, `
+        SELECT
+            CASE
+                WHEN o.daysLate <= 0 THEN 'current'
+                WHEN o.daysLate <= 30 THEN '1-30'
+                WHEN o.daysLate <= 60 THEN '31-60'
+                WHEN o.daysLate <= 90 THEN '61-90'
+                ELSE '90+'
+            END,
+            COUNT(DISTINCT o.studentId),
+            COUNT(*),
+            SUM(o.amount - o.paid)::text
+        FROM (`+outstandingInvoices+`
+        ) o
+        LEFT JOIN "group" g ON g.id = o.groupId
+        WHERE ($1 = '' OR g.branchId::text = $1)
+        GROUP BY 1`, req.BranchId)
+	if err != nil {
+		log.Println("error while getting debt aging report:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	buckets := map[string]*schedule_service.DebtAgingBucket{}
+
+	for rows.Next() {
+		var bucket schedule_service.DebtAgingBucket
+		if err := rows.Scan(&bucket.Bucket, &bucket.StudentCount, &bucket.InvoiceCount, &bucket.Amount); err != nil {
+			log.Println("error while scanning debt aging report:", err)
+			return nil, err
+		}
+
+		buckets[bucket.Bucket] = &bucket
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	var total int64
+
+	for _, name := range debtAgingBuckets {
+		bucket, ok := buckets[name]
+		if !ok {
+			bucket = &schedule_service.DebtAgingBucket{Bucket: name, Amount: money.Format(0)}
+		}
+
+		if err = addAmounts([]*int64{&total}, bucket.Amount); err != nil {
+			return nil, err
+		}
+
+		resp.Buckets = append(resp.Buckets, bucket)
+	}
+
+	resp.Total = money.Format(total)
+
+	if err = f.db.QueryRow(ctx, `SELECT CURRENT_DATE::text`).Scan(&resp.AsOf); err != nil {
+		log.Println("error while getting report date:", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetMonthComparison implements storage.FinanceReportRepoI. Every month of
+// the range is listed, with what was invoiced for it and what was collected
+// in it, compared with the month before. The change percent is empty when
+// nothing was collected the month before.
+func (f *financeReportRepo) GetMonthComparison(ctx context.Context, req *schedule_service.FinanceReportRequest) (*schedule_service.MonthComparisonReport, error) {
+	resp := &schedule_service.MonthComparisonReport{FromDate: req.FromDate, ToDate: req.ToDate}
+
+	rows, err := f.db.Query(ctx, `
+        WITH months AS (
+            SELECT generate_series(date_trunc('month', $1::date), date_trunc('month', $2::date), INTERVAL '1 month')::date AS month
+        ),
+        collected AS (
+            SELECT date_trunc('month', sp.created_at)::date AS month, SUM(sp.paidSum) AS total
+            FROM "student_payment" sp
+            LEFT JOIN "group" g ON g.id = sp.groupId
+            LEFT JOIN "student" s ON s.id = sp.studentId
+            WHERE sp.created_at >= date_trunc('month', $1::date) - INTERVAL '1 month'
+              AND sp.created_at < date_trunc('month', $2::date) + INTERVAL '1 month'
+              AND ($3 = '' OR COALESCE(g.branchId, s.branchId)::text = $3)
+            GROUP BY 1
+        ),
+        invoiced AS (
+            SELECT i.period AS month, SUM(i.amount) AS amount, SUM(i.discountAmount) AS discount
+            FROM "invoice" i
+            LEFT JOIN "group" g ON g.id = i.groupId
+            WHERE i.period >= date_trunc('month', $1::date)
+              AND i.period <= $2::date
+              AND ($3 = '' OR g.branchId::text = $3)
+            GROUP BY 1
+        )
+        SELECT
+            to_char(m.month, 'YYYY-MM'),
+            COALESCE(inv.amount, 0)::text,
+            COALESCE(inv.discount, 0)::text,
+            COALESCE(c.total, 0)::text,
+            COALESCE(prev.total, 0)::text,
+            (COALESCE(c.total, 0) - COALESCE(prev.total, 0))::text,
+            CASE WHEN COALESCE(prev.total, 0) = 0 THEN ''
+                ELSE ROUND((COALESCE(c.total, 0) - prev.total) * 100 / prev.total, 1)::text
+            END,
+            CASE WHEN COALESCE(inv.amount, 0) = 0 THEN ''
+                ELSE ROUND(COALESCE(c.total, 0) * 100 / inv.amount, 1)::text
+            END
+        FROM months m
+        LEFT JOIN invoiced inv ON inv.month = m.month
+        LEFT JOIN collected c ON c.month = m.month
+        LEFT JOIN collected prev ON prev.month = (m.month - INTERVAL '1 month')::date
+        ORDER BY m.month`, req.FromDate, req.ToDate, req.BranchId)
+	if err != nil {
+		log.Println("error while getting month comparison report:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row schedule_service.MonthComparisonRow
+		err := rows.Scan(&row.Month, &row.Invoiced, &row.Discounts, &row.Collected, &row.PreviousCollected, &row.Change, &row.ChangePercent, &row.CollectionRate)
+		if err != nil {
+			log.Println("error while scanning month comparison report:", err)
+			return nil, err
+		}
+
+		resp.Rows = append(resp.Rows, &row)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// addAmounts adds each decimal amount to the total at the same position. The
+// amounts are SUMs, so they are not held to the per-row digit limit.
+func addAmounts(totals []*int64, amounts ...string) error {
+	for i, amount := range amounts {
+		value, err := money.Total.Parse(amount)
+		if err != nil {
+			return err
+		}
+		*totals[i] += value
+	}
+	return nil
+}
+
+// GetProfitAndLoss implements storage.FinanceReportRepoI. Per branch, the
+// income is the net ledger of the range, the payroll cost is what locked runs
+// of the months the range touches pay the branch's staff, and the expenses
+// are the approved expenses spent in the range.
+func (f *financeReportRepo) GetProfitAndLoss(ctx context.Context, req *schedule_service.FinanceReportRequest) (*schedule_service.ProfitAndLossReport, error) {
+	resp := &schedule_service.ProfitAndLossReport{FromDate: req.FromDate, ToDate: req.ToDate}
+
+	rows, err := f.db.Query(ctx, `
+        WITH income AS (
+            SELECT COALESCE(g.branchId, s.branchId) AS branchId, SUM(sp.paidSum) AS amount
+            FROM "student_payment" sp
+            LEFT JOIN "group" g ON g.id = sp.groupId
+            LEFT JOIN "student" s ON s.id = sp.studentId
+            WHERE sp.created_at >= $1::date
+              AND sp.created_at < $2::date + 1
+            GROUP BY 1
+        ),
+        payroll AS (
+            SELECT pl.branchId, SUM(pl.total) AS amount
+            FROM "payroll_line" pl
+            JOIN "payroll_run" pr ON pr.id = pl.runId
+            WHERE pr.status = 'locked'
+              AND pr.period >= date_trunc('month', $1::date)
+              AND pr.period <= $2::date
+            GROUP BY 1
+        ),
+        expenses AS (
+            SELECT branchId, SUM(amount) AS amount
+            FROM "expense"
+            WHERE status = 'approved' AND deleted_at = 0
+              AND spentOn >= $1::date
+              AND spentOn <= $2::date
+            GROUP BY 1
+        ),
+        branches AS (
+            SELECT branchId FROM income
+            UNION
+            SELECT branchId FROM payroll
+            UNION
+            SELECT branchId FROM expenses
+        )
+        SELECT
+            COALESCE(br.branchId::text, ''),
+            COALESCE(b.name, ''),
+            COALESCE(i.amount, 0)::text,
+            COALESCE(p.amount, 0)::text,
+            COALESCE(e.amount, 0)::text,
+            (COALESCE(i.amount, 0) - COALESCE(p.amount, 0) - COALESCE(e.amount, 0))::text,
+            CASE WHEN COALESCE(i.amount, 0) <= 0 THEN ''
+                ELSE ROUND((i.amount - COALESCE(p.amount, 0) - COALESCE(e.amount, 0)) * 100 / i.amount, 1)::text
+            END
+        FROM branches br
+        LEFT JOIN "branch" b ON b.id = br.branchId
+        LEFT JOIN income i ON i.branchId IS NOT DISTINCT FROM br.branchId
+        LEFT JOIN payroll p ON p.branchId IS NOT DISTINCT FROM br.branchId
+        LEFT JOIN expenses e ON e.branchId IS NOT DISTINCT FROM br.branchId
+        WHERE ($3 = '' OR br.branchId::text = $3)
+        ORDER BY b.name`, req.FromDate, req.ToDate, req.BranchId)
+	if err != nil {
+		log.Println("error while getting profit and loss report:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var income, payroll, expenses, profit int64
+
+	branches := map[string]*schedule_service.BranchProfit{}
+
+	for rows.Next() {
+		var branch schedule_service.BranchProfit
+		err := rows.Scan(&branch.BranchId, &branch.BranchName, &branch.Income, &branch.Payroll, &branch.Expenses, &branch.Profit, &branch.Margin)
+		if err != nil {
+			log.Println("error while scanning profit and loss report:", err)
+			return nil, err
+		}
+
+		if err = addAmounts([]*int64{&income, &payroll, &expenses, &profit}, branch.Income, branch.Payroll, branch.Expenses, branch.Profit); err != nil {
+			return nil, err
+		}
+
+		branches[branch.BranchId] = &branch
+		resp.Branches = append(resp.Branches, &branch)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	rows, err = f.db.Query(ctx, `
+        SELECT e.branchId::text, c.id::text, c.name, SUM(e.amount)::text
+        FROM "expense" e
+        JOIN "expense_category" c ON c.id = e.categoryId
+        WHERE e.status = 'approved' AND e.deleted_at = 0
+          AND e.spentOn >= $1::date
+          AND e.spentOn <= $2::date
+          AND ($3 = '' OR e.branchId::text = $3)
+        GROUP BY 1, 2, 3
+        ORDER BY 3`, req.FromDate, req.ToDate, req.BranchId)
+	if err != nil {
+		log.Println("error while getting expenses by category:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			branchId string
+			category schedule_service.CategoryAmount
+		)
+		if err := rows.Scan(&branchId, &category.CategoryId, &category.CategoryName, &category.Amount); err != nil {
+			log.Println("error while scanning expenses by category:", err)
+			return nil, err
+		}
+
+		if branch, ok := branches[branchId]; ok {
+			branch.ExpensesByCategory = append(branch.ExpensesByCategory, &category)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.TotalIncome = money.Format(income)
+	resp.TotalPayroll = money.Format(payroll)
+	resp.TotalExpenses = money.Format(expenses)
+	resp.TotalProfit = money.Format(profit)
+
+	return resp, nil
+}
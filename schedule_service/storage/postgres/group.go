@@ -3,15 +3,21 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"schedule_service/genproto/schedule_service"
 	"schedule_service/storage"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
+// groupStudentsCount selects the number of active members of the group
+// aliased as g.
+const groupStudentsCount = `(SELECT COUNT(*) FROM "group_student" gs WHERE gs.groupId = g.id AND gs.deleted_at = 0)::int`
+
 type groupRepo struct {
 	db *pgxpool.Pool
 }
@@ -32,10 +38,11 @@ func (g *groupRepo) Create(ctx context.Context, req *schedule_service.CreateGrou
             teacherId,
             supportTeacherId,
             branchId,
-            type
+            type,
+            capacity
         ) VALUES (
-            $1, $2, $3, $4, $5
-        )`, id, req.TeacherId, req.SuppportTeacherId, req.BranchId, req.Type)
+            $1, $2, $3, $4, $5, NULLIF($6, 0)
+        )`, id, req.TeacherId, req.SuppportTeacherId, req.BranchId, req.Type, req.Capacity)
 
 	if err != nil {
 		log.Println("error while creating group in storage", err)
@@ -55,6 +62,7 @@ func (g *groupRepo) GetByID(ctx context.Context, req *schedule_service.GroupPrim
 	resp := &schedule_service.GetGroup{}
 
 	var (
+		capacity   sql.NullInt32
 		created_at sql.NullString
 		updated_at sql.NullString
 	)
@@ -65,16 +73,19 @@ func (g *groupRepo) GetByID(ctx context.Context, req *schedule_service.GroupPrim
             supportTeacherId,
             branchId,
             type,
+            capacity,
+            `+groupStudentsCount+`,
             created_at,
             updated_at
-            FROM "group"
-        WHERE id=$1`, req.Id).Scan(&resp.Id, &resp.TeacherId, &resp.SuppportTeacherId, &resp.BranchId, &resp.Type, &created_at, &updated_at)
+            FROM "group" g
+        WHERE id=$1`, req.Id).Scan(&resp.Id, &resp.TeacherId, &resp.SuppportTeacherId, &resp.BranchId, &resp.Type, &capacity, &resp.StudentsCount, &created_at, &updated_at)
 
 	if err != nil {
 		log.Println("error while getting group by id", err)
 		return nil, err
 	}
 
+	resp.Capacity = capacity.Int32
 	resp.CreatedAt = created_at.String
 	resp.UpdatedAt = updated_at.String
 
@@ -86,6 +97,7 @@ func (g *groupRepo) GetList(ctx context.Context, req *schedule_service.GetListGr
 	resp := &schedule_service.GetListGroupResponse{}
 	var (
 		filter     string
+		capacity   sql.NullInt32
 		created_at sql.NullString
 		updated_at sql.NullString
 	)
@@ -95,6 +107,9 @@ func (g *groupRepo) GetList(ctx context.Context, req *schedule_service.GetListGr
 		filter = fmt.Sprintf(` AND (type ILIKE '%%%v%%')`, req.Search)
 	}
 
+	// Names are matched exactly; they are how students refer to groups.
+	filter += ` AND ($1 = '' OR branchId::text = $1) AND ($2 = '' OR name = $2)`
+
 	filter += fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit)
 
 	rows, err := g.db.Query(ctx, `
@@ -104,10 +119,12 @@ func (g *groupRepo) GetList(ctx context.Context, req *schedule_service.GetListGr
             supportTeacherId,
             branchId,
             type,
+            capacity,
+            `+groupStudentsCount+`,
             created_at,
             updated_at
-        FROM "group" WHERE deleted_at=0
-    `+filter)
+        FROM "group" g WHERE deleted_at=0
+    `+filter, req.BranchId, req.Name)
 
 	if err != nil {
 		log.Println("error while getting all groups:", err)
@@ -121,12 +138,13 @@ func (g *groupRepo) GetList(ctx context.Context, req *schedule_service.GetListGr
 	for rows.Next() {
 		var group schedule_service.Group
 		count++
-		err = rows.Scan(&group.Id, &group.TeacherId, &group.SuppportTeacherId, &group.BranchId, &group.Type, &created_at, &updated_at)
+		err = rows.Scan(&group.Id, &group.TeacherId, &group.SuppportTeacherId, &group.BranchId, &group.Type, &capacity, &group.StudentsCount, &created_at, &updated_at)
 
 		if err != nil {
 			log.Println("error while scanning groups:", err)
 			return nil, err
 		}
+		group.Capacity = capacity.Int32
 		group.CreatedAt = created_at.String
 		group.UpdatedAt = updated_at.String
 
@@ -143,23 +161,103 @@ func (g *groupRepo) GetList(ctx context.Context, req *schedule_service.GetListGr
 	return resp, nil
 }
 
-// Update implements storage.GroupRepoI.
+// Update implements storage.GroupRepoI. The capacity cannot go below the
+// number of members. A new teacher takes over the lessons that have not been
+// delivered yet. When the group needs more seats or moves to another branch,
+// the rooms of its upcoming lessons are checked again.
 func (g *groupRepo) Update(ctx context.Context, req *schedule_service.UpdateGroup) (*schedule_service.GetGroup, error) {
+	tx, err := g.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
 
-	_, err := g.db.Exec(ctx, `
+	capacity, members, err := lockGroupSeats(ctx, tx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Capacity > 0 && req.Capacity < members {
+		return nil, fmt.Errorf("group has %d students, capacity cannot be %d", members, req.Capacity)
+	}
+
+	var branchId string
+	err = tx.QueryRow(ctx, `
+        SELECT COALESCE(branchId::text, '') FROM "group" WHERE id = $1
+    `, req.Id).Scan(&branchId)
+	if err != nil {
+		log.Println("error while getting group branch", err)
+		return nil, err
+	}
+
+	oldSeats, newSeats := members, members
+	if capacity.Valid {
+		oldSeats = capacity.Int32
+	}
+	if req.Capacity > 0 {
+		newSeats = req.Capacity
+	}
+
+	_, err = tx.Exec(ctx, `
         UPDATE "group" SET
             teacherId=$1,
             supportTeacherId=$2,
             branchId=$3,
             type=$4,
+            capacity=NULLIF($5, 0),
             updated_at = NOW()
-        WHERE id = $5`, req.TeacherId, req.SuppportTeacherId, req.BranchId, req.Type, req.Id)
+        WHERE id = $6`, req.TeacherId, req.SuppportTeacherId, req.BranchId, req.Type, req.Capacity, req.Id)
 
 	if err != nil {
 		log.Println("error while updating group in storage", err)
 		return nil, err
 	}
 
+	// Lessons still to come go to the new teachers; delivered ones stay
+	// with whoever taught them.
+	_, err = tx.Exec(ctx, `
+        UPDATE "schedule" sc SET
+            teacherId = g.teacherId,
+            supportTeacherId = g.supportTeacherId,
+            updated_at = NOW()
+        FROM "journal" j
+        JOIN "group" g ON g.id = j.groupId
+        WHERE j.id = sc.journalId
+          AND g.id = $1
+          AND sc.deleted_at = 0
+          AND NOT `+lessonDelivered, req.Id)
+	if err != nil {
+		log.Println("error while moving lessons to the new teachers", err)
+		return nil, err
+	}
+
+	if newSeats > oldSeats || branchId != req.BranchId {
+		lessons, err := tx.Query(ctx, `
+            SELECT s.id::text, s.date::text, TRUE
+            FROM "schedule" s
+            JOIN "journal" j ON j.id = s.journalId
+            WHERE j.groupId = $1
+              AND j.deleted_at = 0
+              AND s.deleted_at = 0
+              AND s.roomId IS NOT NULL
+              AND s.date >= CURRENT_DATE
+        `, req.Id)
+		if err != nil {
+			log.Println("error while getting group lessons", err)
+			return nil, err
+		}
+
+		if _, err = checkLessonRooms(ctx, tx, lessons); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing group update", err)
+		return nil, err
+	}
+
 	group, err := g.GetByID(ctx, &schedule_service.GroupPrimaryKey{Id: req.Id})
 	if err != nil {
 		log.Println("error while getting updated group by id", err)
@@ -188,15 +286,17 @@ func (g *groupRepo) Delete(ctx context.Context, req *schedule_service.GroupPrima
 func (r *groupRepo) GetByIDTeacher(ctx context.Context, req *schedule_service.TeacherID) (*schedule_service.GetGroup, error) {
 	var resp schedule_service.GetGroup
 	var created_at, updated_at sql.NullString
+	var capacity sql.NullInt32
 
 	err := r.db.QueryRow(ctx, `
-        SELECT id, teacherId, supportTeacherId, branchId, type, created_at, updated_at
-        FROM "group"
+        SELECT id, teacherId, supportTeacherId, branchId, type, capacity, `+groupStudentsCount+`, created_at, updated_at
+        FROM "group" g
         WHERE teacherId = $1
     `, req.Id).Scan(
 		&resp.Id, &resp.TeacherId, &resp.SuppportTeacherId, &resp.BranchId, &resp.Type,
-		&created_at, &updated_at,
+		&capacity, &resp.StudentsCount, &created_at, &updated_at,
 	)
+	resp.Capacity = capacity.Int32
 	resp.CreatedAt = created_at.String
 	resp.UpdatedAt = updated_at.String
 	if err != nil {
@@ -205,3 +305,409 @@ func (r *groupRepo) GetByIDTeacher(ctx context.Context, req *schedule_service.Te
 	}
 	return &resp, nil
 }
+
+// groupLevels lists group types in the order students progress through them.
+var groupLevels = []string{"beginner", "elementary", "intermediate", "ielts"}
+
+func nextGroupLevel(level string) (string, error) {
+	for i, l := range groupLevels {
+		if l != level {
+			continue
+		}
+		if i == len(groupLevels)-1 {
+			return "", fmt.Errorf("group is already at the last level: %s", level)
+		}
+		return groupLevels[i+1], nil
+	}
+
+	return "", fmt.Errorf("unknown group level: %s", level)
+}
+
+// PromoteGroup closes the group's open journal and moves the students who
+// meet the attendance and score criteria to the next level. Students who do
+// not meet them are listed as repeating and move to the repeat group, which
+// mode "move" requires; with mode "new" they may stay in the finished group.
+func (g *groupRepo) PromoteGroup(ctx context.Context, req *schedule_service.PromoteGroupRequest) (*schedule_service.PromoteGroupResponse, error) {
+	mode := req.Mode
+	if mode == "" {
+		mode = "move"
+	}
+	if mode != "move" && mode != "new" {
+		return nil, fmt.Errorf("invalid promotion mode: %s", mode)
+	}
+
+	tx, err := g.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting promotion transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var level, status sql.NullString
+	err = tx.QueryRow(ctx, `
+        SELECT type, status
+        FROM "group"
+        WHERE id = $1 AND deleted_at = 0
+        FOR UPDATE
+    `, req.GroupId).Scan(&level, &status)
+	if err != nil {
+		log.Println("error while getting group for promotion", err)
+		return nil, err
+	}
+
+	if status.String == "finished" {
+		return nil, errors.New("group is already finished")
+	}
+
+	toLevel, err := nextGroupLevel(level.String)
+	if err != nil {
+		return nil, err
+	}
+
+	var journalId string
+	err = tx.QueryRow(ctx, `
+        SELECT id
+        FROM "journal"
+        WHERE groupId = $1 AND deleted_at = 0 AND closed_at IS NULL
+        ORDER BY fromDate DESC NULLS LAST, created_at DESC
+        LIMIT 1
+    `, req.GroupId).Scan(&journalId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("group has no open journal")
+		}
+		log.Println("error while getting open journal for promotion", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, `
+        WITH lessons AS (
+            SELECT id FROM "schedule" WHERE journalId = $2 AND deleted_at = 0
+        )
+        SELECT
+            gs.studentId,
+            COALESCE((
+                SELECT 100.0 * COUNT(*) FILTER (WHERE la.isPresent) / NULLIF((SELECT COUNT(*) FROM lessons), 0)
+                FROM "lesson_attendance" la
+                WHERE la.studentId = gs.studentId AND la.scheduleId IN (SELECT id FROM lessons)
+            ), 0)::float8 AS attendance,
+            COALESCE((
+                SELECT AVG(st.score)
+                FROM "student_task" st
+                JOIN "task" t ON t.id = st.taskId
+                WHERE st.studentId = gs.studentId AND st.deleted_at = 0
+                  AND t.deleted_at = 0 AND t.scheduleId IN (SELECT id FROM lessons)
+            ), 0)::float8 AS average_score
+        FROM "group_student" gs
+        WHERE gs.groupId = $1 AND gs.deleted_at = 0
+        ORDER BY gs.created_at
+    `, req.GroupId, journalId)
+	if err != nil {
+		log.Println("error while computing promotion criteria", err)
+		return nil, err
+	}
+
+	var students []*schedule_service.PromotionStudent
+	for rows.Next() {
+		var (
+			student      schedule_service.PromotionStudent
+			attendance   float64
+			averageScore float64
+		)
+		if err = rows.Scan(&student.StudentId, &attendance, &averageScore); err != nil {
+			rows.Close()
+			log.Println("error while scanning promotion criteria", err)
+			return nil, err
+		}
+
+		student.Attendance = float32(attendance)
+		student.AverageScore = float32(averageScore)
+		student.Outcome = "repeat"
+		if attendance >= float64(req.MinAttendance) && averageScore >= float64(req.MinAverageScore) {
+			student.Outcome = "promoted"
+		}
+
+		students = append(students, &student)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	repeaters := 0
+	for _, student := range students {
+		if student.Outcome == "repeat" {
+			repeaters++
+		}
+	}
+
+	// Repeating students stay on their level: they move to the repeat group
+	// when one is given. Moving the group up would otherwise leave them in
+	// no group at all.
+	if req.RepeatGroupId != "" {
+		if err = lockRepeatGroup(ctx, tx, req, level.String, repeaters); err != nil {
+			return nil, err
+		}
+	} else if mode == "move" && repeaters > 0 {
+		return nil, fmt.Errorf("%d students repeat the level, a repeatGroupId is required", repeaters)
+	}
+
+	toGroupId := req.GroupId
+	if mode == "new" {
+		toGroupId = uuid.NewString()
+
+		_, err = tx.Exec(ctx, `
+            INSERT INTO "group" (id, name, teacherId, supportTeacherId, branchId, type)
+            SELECT $1, name, teacherId, supportTeacherId, branchId, $2
+            FROM "group" WHERE id = $3
+        `, toGroupId, toLevel, req.GroupId)
+		if err != nil {
+			log.Println("error while creating next level group", err)
+			return nil, err
+		}
+
+		_, err = tx.Exec(ctx, `
+            UPDATE "group" SET
+                status = 'finished',
+                updated_at = NOW()
+            WHERE id = $1
+        `, req.GroupId)
+		if err != nil {
+			log.Println("error while finishing group", err)
+			return nil, err
+		}
+	} else {
+		_, err = tx.Exec(ctx, `
+            UPDATE "group" SET
+                type = $1,
+                updated_at = NOW()
+            WHERE id = $2
+        `, toLevel, req.GroupId)
+		if err != nil {
+			log.Println("error while moving group to the next level", err)
+			return nil, err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE "journal" SET
+            closed_at = NOW(),
+            toDate = COALESCE(toDate, CURRENT_DATE),
+            updated_at = NOW()
+        WHERE id = $1
+    `, journalId)
+	if err != nil {
+		log.Println("error while closing journal", err)
+		return nil, err
+	}
+
+	id := uuid.NewString()
+	_, err = tx.Exec(ctx, `
+        INSERT INTO "group_promotion" (
+            id,
+            fromGroupId,
+            toGroupId,
+            journalId,
+            fromLevel,
+            toLevel,
+            mode,
+            minAttendance,
+            minAverageScore,
+            repeatGroupId
+        ) VALUES (
+            $1, $2, $3, $4, $5, $6, $7, $8, $9, NULLIF($10, '')::uuid
+        )`, id, req.GroupId, toGroupId, journalId, level.String, toLevel, mode, req.MinAttendance, req.MinAverageScore, req.RepeatGroupId)
+	if err != nil {
+		log.Println("error while creating group promotion", err)
+		return nil, err
+	}
+
+	for _, student := range students {
+		_, err = tx.Exec(ctx, `
+            INSERT INTO "group_promotion_student" (
+                id,
+                promotionId,
+                studentId,
+                attendance,
+                averageScore,
+                outcome
+            ) VALUES (
+                $1, $2, $3, $4, $5, $6
+            )`, uuid.NewString(), id, student.StudentId, student.Attendance, student.AverageScore, student.Outcome)
+		if err != nil {
+			log.Println("error while recording promotion outcome", err)
+			return nil, err
+		}
+
+		switch {
+		case mode == "new" && student.Outcome == "promoted":
+			_, err = tx.Exec(ctx, `
+                INSERT INTO "group_student" (id, groupId, studentId)
+                VALUES ($1, $2, $3)
+            `, uuid.NewString(), toGroupId, student.StudentId)
+		case req.RepeatGroupId != "" && student.Outcome == "repeat":
+			err = moveRepeater(ctx, tx, req.GroupId, req.RepeatGroupId, student.StudentId)
+		}
+		if err != nil {
+			log.Println("error while updating group students after promotion", err)
+			return nil, err
+		}
+
+		if err = syncStudentGroupName(ctx, tx, student.StudentId); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing group promotion", err)
+		return nil, err
+	}
+
+	return g.GetPromotion(ctx, &schedule_service.PromotionPrimaryKey{Id: id})
+}
+
+// lockRepeatGroup checks that the repeat group runs the level being repeated
+// in the same branch and has a seat for every repeating student.
+func lockRepeatGroup(ctx context.Context, tx pgx.Tx, req *schedule_service.PromoteGroupRequest, level string, repeaters int) error {
+	if req.RepeatGroupId == req.GroupId {
+		return errors.New("repeatGroupId must be another group")
+	}
+
+	capacity, members, err := lockGroupSeats(ctx, tx, req.RepeatGroupId)
+	if err != nil {
+		return err
+	}
+
+	var sameBranch, sameLevel, finished bool
+	err = tx.QueryRow(ctx, `
+        SELECT r.branchId IS NOT DISTINCT FROM g.branchId,
+            r.type IS NOT DISTINCT FROM $3,
+            r.status = 'finished'
+        FROM "group" r, "group" g
+        WHERE r.id = $1 AND g.id = $2
+    `, req.RepeatGroupId, req.GroupId, level).Scan(&sameBranch, &sameLevel, &finished)
+	if err != nil {
+		log.Println("error while checking repeat group", err)
+		return err
+	}
+
+	switch {
+	case finished:
+		return errors.New("repeat group is already finished")
+	case !sameBranch:
+		return errors.New("repeat group is in another branch")
+	case !sameLevel:
+		return fmt.Errorf("repeat group is not on the %s level", level)
+	case capacity.Valid && members+int32(repeaters) > capacity.Int32:
+		return fmt.Errorf("repeat group has %d free seats, %d students repeat", capacity.Int32-members, repeaters)
+	}
+
+	return nil
+}
+
+// moveRepeater moves a repeating student from the promoted group to the
+// repeat group, dropping any place they held on its waitlist.
+func moveRepeater(ctx context.Context, tx pgx.Tx, fromGroupId, repeatGroupId, studentId string) error {
+	_, err := tx.Exec(ctx, `
+        UPDATE "group_student" SET
+            deleted_at = 1,
+            updated_at = NOW()
+        WHERE groupId = $1 AND studentId = $2 AND deleted_at = 0
+    `, fromGroupId, studentId)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+        INSERT INTO "group_student" (id, groupId, studentId)
+        VALUES ($1, $2, $3)
+        ON CONFLICT DO NOTHING
+    `, uuid.NewString(), repeatGroupId, studentId)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE "group_waitlist" SET
+            status = 'cancelled',
+            updated_at = NOW()
+        WHERE groupId = $1 AND studentId = $2 AND status = 'waiting'
+    `, repeatGroupId, studentId)
+
+	return err
+}
+
+// GetPromotion returns a recorded promotion with its per-student outcomes.
+func (g *groupRepo) GetPromotion(ctx context.Context, req *schedule_service.PromotionPrimaryKey) (*schedule_service.PromoteGroupResponse, error) {
+	resp := &schedule_service.PromoteGroupResponse{}
+
+	var (
+		minAttendance   float64
+		minAverageScore float64
+		created_at      sql.NullString
+	)
+
+	err := g.db.QueryRow(ctx, `
+        SELECT id,
+            fromGroupId,
+            toGroupId,
+            journalId,
+            fromLevel,
+            toLevel,
+            mode,
+            minAttendance,
+            minAverageScore,
+            COALESCE(repeatGroupId::text, ''),
+            created_at
+        FROM "group_promotion"
+        WHERE id = $1
+    `, req.Id).Scan(&resp.Id, &resp.FromGroupId, &resp.ToGroupId, &resp.JournalId, &resp.FromLevel, &resp.ToLevel, &resp.Mode, &minAttendance, &minAverageScore, &resp.RepeatGroupId, &created_at)
+	if err != nil {
+		log.Println("error while getting group promotion by id", err)
+		return nil, err
+	}
+
+	resp.MinAttendance = float32(minAttendance)
+	resp.MinAverageScore = float32(minAverageScore)
+	resp.CreatedAt = created_at.String
+
+	rows, err := g.db.Query(ctx, `
+        SELECT studentId, attendance, averageScore, outcome
+        FROM "group_promotion_student"
+        WHERE promotionId = $1
+        ORDER BY created_at
+    `, req.Id)
+	if err != nil {
+		log.Println("error while getting group promotion students", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			student      schedule_service.PromotionStudent
+			attendance   float64
+			averageScore float64
+		)
+		if err = rows.Scan(&student.StudentId, &attendance, &averageScore, &student.Outcome); err != nil {
+			log.Println("error while scanning group promotion students", err)
+			return nil, err
+		}
+		student.Attendance = float32(attendance)
+		student.AverageScore = float32(averageScore)
+
+		if student.Outcome == "promoted" {
+			resp.Promoted = append(resp.Promoted, &student)
+		} else {
+			resp.Repeat = append(resp.Repeat, &student)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
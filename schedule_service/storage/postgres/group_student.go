@@ -0,0 +1,552 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type groupStudentRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewGroupStudentRepo(db *pgxpool.Pool) storage.GroupStudentRepoI {
+	return &groupStudentRepo{
+		db: db,
+	}
+}
+
+// Create implements storage.GroupStudentRepoI. A full group rejects new
+// students; they have to join the waitlist instead.
+func (g *groupStudentRepo) Create(ctx context.Context, req *schedule_service.CreateGroupStudent) (*schedule_service.GetGroupStudent, error) {
+	tx, err := g.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting group student transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	capacity, members, err := lockGroupSeats(ctx, tx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var exists bool
+	err = tx.QueryRow(ctx, `
+        SELECT EXISTS (
+            SELECT 1 FROM "group_student"
+            WHERE groupId = $1 AND studentId = $2 AND deleted_at = 0
+        )`, req.GroupId, req.StudentId).Scan(&exists)
+	if err != nil {
+		log.Println("error while checking group student", err)
+		return nil, err
+	}
+
+	if exists {
+		return nil, errors.New("student is already in this group")
+	}
+
+	if capacity.Valid && members >= capacity.Int32 {
+		return nil, errors.New("group is full, add the student to the waitlist")
+	}
+
+	id := uuid.NewString()
+
+	_, err = tx.Exec(ctx, `
+        INSERT INTO "group_student" (
+            id,
+            groupId,
+            studentId
+        ) VALUES (
+            $1, $2, $3
+        )`, id, req.GroupId, req.StudentId)
+
+	if err != nil {
+		log.Println("error while creating group student in storage", err)
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE "group_waitlist" SET
+            status = 'cancelled',
+            updated_at = NOW()
+        WHERE groupId = $1 AND studentId = $2 AND status = 'waiting'
+    `, req.GroupId, req.StudentId)
+	if err != nil {
+		log.Println("error while cancelling waitlist entry", err)
+		return nil, err
+	}
+
+	if err = syncStudentGroupName(ctx, tx, req.StudentId); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing group student", err)
+		return nil, err
+	}
+
+	groupStudent, err := g.GetByID(ctx, &schedule_service.GroupStudentPrimaryKey{Id: id})
+	if err != nil {
+		log.Println("error while getting group student by id after creating", err)
+		return nil, err
+	}
+	return groupStudent, nil
+}
+
+// syncStudentGroupName sets the student's groupName to the group they joined
+// last, or clears it, so the name user_service shows follows membership.
+func syncStudentGroupName(ctx context.Context, tx pgx.Tx, studentId string) error {
+	_, err := tx.Exec(ctx, `
+        UPDATE "student" s SET
+            groupName = (
+                SELECT g.name
+                FROM "group_student" gs
+                JOIN "group" g ON g.id = gs.groupId
+                WHERE gs.studentId = s.id AND gs.deleted_at = 0
+                ORDER BY gs.created_at DESC
+                LIMIT 1
+            ),
+            updated_at = NOW()
+        WHERE s.id = $1
+    `, studentId)
+	if err != nil {
+		log.Println("error while syncing student group name", err)
+		return err
+	}
+
+	return nil
+}
+
+// lockGroupSeats locks the group row so concurrent enrolments cannot both
+// take the last seat, and returns its capacity and current member count.
+func lockGroupSeats(ctx context.Context, tx pgx.Tx, groupId string) (sql.NullInt32, int32, error) {
+	var (
+		capacity sql.NullInt32
+		members  int32
+	)
+
+	err := tx.QueryRow(ctx, `
+        SELECT capacity FROM "group" WHERE id = $1 AND deleted_at = 0 FOR UPDATE
+    `, groupId).Scan(&capacity)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return capacity, 0, errors.New("group not found")
+		}
+		log.Println("error while locking group", err)
+		return capacity, 0, err
+	}
+
+	err = tx.QueryRow(ctx, `
+        SELECT COUNT(*)::int FROM "group_student" WHERE groupId = $1 AND deleted_at = 0
+    `, groupId).Scan(&members)
+	if err != nil {
+		log.Println("error while counting group students", err)
+		return capacity, 0, err
+	}
+
+	return capacity, members, nil
+}
+
+// GetByID implements storage.GroupStudentRepoI.
+func (g *groupStudentRepo) GetByID(ctx context.Context, req *schedule_service.GroupStudentPrimaryKey) (*schedule_service.GetGroupStudent, error) {
+	resp := &schedule_service.GetGroupStudent{}
+
+	var (
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+
+	err := g.db.QueryRow(ctx, `
+            SELECT id,
+            groupId,
+            studentId,
+            created_at,
+            updated_at,
+            deleted_at
+            FROM "group_student"
+        WHERE id=$1`, req.Id).Scan(&resp.Id, &resp.GroupId, &resp.StudentId, &created_at, &updated_at, &resp.DeletedAt)
+
+	if err != nil {
+		log.Println("error while getting group student by id", err)
+		return nil, err
+	}
+
+	resp.CreatedAt = created_at.String
+	resp.UpdatedAt = updated_at.String
+
+	return resp, nil
+}
+
+// GetList implements storage.GroupStudentRepoI.
+func (g *groupStudentRepo) GetList(ctx context.Context, req *schedule_service.GetListGroupStudentRequest) (*schedule_service.GetListGroupStudentResponse, error) {
+	resp := &schedule_service.GetListGroupStudentResponse{}
+	var (
+		filter     string
+		args       []interface{}
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+	offset := (req.Page - 1) * req.Limit
+
+	if req.GroupId != "" {
+		args = append(args, req.GroupId)
+		filter = ` AND groupId = $1`
+	}
+
+	if req.Search != "" {
+		filter += fmt.Sprintf(` AND (groupId::text ILIKE '%%%v%%' OR studentId::text ILIKE '%%%v%%')`, req.Search, req.Search)
+	}
+
+	filter += fmt.Sprintf(" ORDER BY created_at OFFSET %v LIMIT %v", offset, req.Limit)
+
+	rows, err := g.db.Query(ctx, `
+        SELECT
+            id,
+            groupId,
+            studentId,
+            created_at,
+            updated_at
+        FROM "group_student" WHERE deleted_at=0
+    `+filter, args...)
+
+	if err != nil {
+		log.Println("error while getting all group students:", err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		var groupStudent schedule_service.GroupStudent
+		count++
+		err = rows.Scan(&groupStudent.Id, &groupStudent.GroupId, &groupStudent.StudentId, &created_at, &updated_at)
+
+		if err != nil {
+			log.Println("error while scanning group students:", err)
+			return nil, err
+		}
+		groupStudent.CreatedAt = created_at.String
+		groupStudent.UpdatedAt = updated_at.String
+
+		resp.GroupStudents = append(resp.GroupStudents, &groupStudent)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// Delete implements storage.GroupStudentRepoI.
+func (g *groupStudentRepo) Delete(ctx context.Context, req *schedule_service.GroupStudentPrimaryKey) error {
+	var studentId string
+
+	tx, err := g.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting group student transaction", err)
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, `
+        UPDATE "group_student" SET
+            deleted_at = 1,
+            updated_at = NOW()
+        WHERE id = $1
+        RETURNING studentId
+    `, req.Id).Scan(&studentId)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		log.Println("error while deleting group student")
+		return err
+	}
+
+	if err = syncStudentGroupName(ctx, tx, studentId); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// JoinWaitlist puts a student at the end of the group's waitlist. Only full
+// groups have a waitlist.
+func (g *groupStudentRepo) JoinWaitlist(ctx context.Context, req *schedule_service.CreateGroupStudent) (*schedule_service.WaitlistEntry, error) {
+	tx, err := g.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting waitlist transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	capacity, members, err := lockGroupSeats(ctx, tx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !capacity.Valid || members < capacity.Int32 {
+		return nil, errors.New("group has free seats, add the student directly")
+	}
+
+	var exists bool
+	err = tx.QueryRow(ctx, `
+        SELECT EXISTS (
+            SELECT 1 FROM "group_student"
+            WHERE groupId = $1 AND studentId = $2 AND deleted_at = 0
+        ) OR EXISTS (
+            SELECT 1 FROM "group_waitlist"
+            WHERE groupId = $1 AND studentId = $2 AND status = 'waiting'
+        )`, req.GroupId, req.StudentId).Scan(&exists)
+	if err != nil {
+		log.Println("error while checking waitlist", err)
+		return nil, err
+	}
+
+	if exists {
+		return nil, errors.New("student is already in this group or its waitlist")
+	}
+
+	id := uuid.NewString()
+	_, err = tx.Exec(ctx, `
+        INSERT INTO "group_waitlist" (
+            id,
+            groupId,
+            studentId
+        ) VALUES (
+            $1, $2, $3
+        )`, id, req.GroupId, req.StudentId)
+	if err != nil {
+		log.Println("error while joining waitlist", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing waitlist", err)
+		return nil, err
+	}
+
+	return g.getWaitlistEntry(ctx, id)
+}
+
+// GetWaitlist returns the students still waiting for a seat, first come
+// first.
+func (g *groupStudentRepo) GetWaitlist(ctx context.Context, req *schedule_service.GetWaitlistRequest) (*schedule_service.GetWaitlistResponse, error) {
+	resp := &schedule_service.GetWaitlistResponse{}
+
+	rows, err := g.db.Query(ctx, `
+        SELECT `+waitlistColumns+`
+        FROM "group_waitlist" w
+        WHERE w.groupId = $1 AND w.status = 'waiting'
+        ORDER BY w.created_at
+    `, req.GroupId)
+	if err != nil {
+		log.Println("error while getting waitlist:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			log.Println("error while scanning waitlist:", err)
+			return nil, err
+		}
+		count++
+
+		resp.Entries = append(resp.Entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// LeaveWaitlist implements storage.GroupStudentRepoI.
+func (g *groupStudentRepo) LeaveWaitlist(ctx context.Context, req *schedule_service.WaitlistPrimaryKey) error {
+	_, err := g.db.Exec(ctx, `
+        UPDATE "group_waitlist" SET
+            status = 'cancelled',
+            updated_at = NOW()
+        WHERE id = $1 AND status = 'waiting'
+    `, req.Id)
+	if err != nil {
+		log.Println("error while leaving waitlist")
+		return err
+	}
+
+	return nil
+}
+
+// PromoteFromWaitlist fills the free seats of a group with waitlisted
+// students in the order they joined and returns the promoted entries.
+func (g *groupStudentRepo) PromoteFromWaitlist(ctx context.Context, groupId string) ([]*schedule_service.WaitlistEntry, error) {
+	tx, err := g.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting waitlist promotion transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	capacity, members, err := lockGroupSeats(ctx, tx, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a capacity every waiting student fits.
+	limit := "ALL"
+	if capacity.Valid {
+		if members >= capacity.Int32 {
+			return nil, nil
+		}
+		limit = fmt.Sprint(capacity.Int32 - members)
+	}
+
+	rows, err := tx.Query(ctx, `
+        SELECT id, studentId
+        FROM "group_waitlist"
+        WHERE groupId = $1 AND status = 'waiting'
+        ORDER BY created_at
+        LIMIT `+limit, groupId)
+	if err != nil {
+		log.Println("error while getting waitlist for promotion", err)
+		return nil, err
+	}
+
+	type waiting struct{ id, studentId string }
+	var next []waiting
+	for rows.Next() {
+		var w waiting
+		if err = rows.Scan(&w.id, &w.studentId); err != nil {
+			rows.Close()
+			log.Println("error while scanning waitlist for promotion", err)
+			return nil, err
+		}
+		next = append(next, w)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	var ids []string
+	for _, w := range next {
+		groupStudentId := uuid.NewString()
+
+		_, err = tx.Exec(ctx, `
+            INSERT INTO "group_student" (id, groupId, studentId)
+            VALUES ($1, $2, $3)
+        `, groupStudentId, groupId, w.studentId)
+		if err != nil {
+			log.Println("error while adding waitlisted student to group", err)
+			return nil, err
+		}
+
+		if err = syncStudentGroupName(ctx, tx, w.studentId); err != nil {
+			return nil, err
+		}
+
+		_, err = tx.Exec(ctx, `
+            UPDATE "group_waitlist" SET
+                status = 'promoted',
+                groupStudentId = $1,
+                promoted_at = NOW(),
+                updated_at = NOW()
+            WHERE id = $2
+        `, groupStudentId, w.id)
+		if err != nil {
+			log.Println("error while recording waitlist promotion", err)
+			return nil, err
+		}
+
+		ids = append(ids, w.id)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing waitlist promotion", err)
+		return nil, err
+	}
+
+	var promoted []*schedule_service.WaitlistEntry
+	for _, id := range ids {
+		entry, err := g.getWaitlistEntry(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		promoted = append(promoted, entry)
+	}
+
+	return promoted, nil
+}
+
+// waitlistColumns selects a waitlist entry aliased as w. The position is
+// only meaningful for waiting entries.
+const waitlistColumns = `
+            w.id,
+            w.groupId,
+            w.studentId,
+            CASE WHEN w.status = 'waiting' THEN
+                (SELECT COUNT(*)::int FROM "group_waitlist" o
+                    WHERE o.groupId = w.groupId AND o.status = 'waiting' AND o.created_at <= w.created_at)
+            ELSE 0 END,
+            w.status,
+            w.groupStudentId,
+            w.promoted_at,
+            w.created_at`
+
+func scanWaitlistEntry(row pgx.Row) (*schedule_service.WaitlistEntry, error) {
+	var (
+		entry          schedule_service.WaitlistEntry
+		groupStudentId sql.NullString
+		promoted_at    sql.NullString
+		created_at     sql.NullString
+	)
+
+	err := row.Scan(&entry.Id, &entry.GroupId, &entry.StudentId, &entry.Position, &entry.Status, &groupStudentId, &promoted_at, &created_at)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.GroupStudentId = groupStudentId.String
+	entry.PromotedAt = promoted_at.String
+	entry.CreatedAt = created_at.String
+
+	return &entry, nil
+}
+
+func (g *groupStudentRepo) getWaitlistEntry(ctx context.Context, id string) (*schedule_service.WaitlistEntry, error) {
+	entry, err := scanWaitlistEntry(g.db.QueryRow(ctx, `
+        SELECT `+waitlistColumns+`
+        FROM "group_waitlist" w
+        WHERE w.id = $1
+    `, id))
+	if err != nil {
+		log.Println("error while getting waitlist entry by id", err)
+		return nil, err
+	}
+
+	return entry, nil
+}
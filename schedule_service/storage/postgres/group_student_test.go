@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"schedule_service/genproto/schedule_service"
+	"testing"
+)
+
+func TestGroupCapacityAndWaitlist(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	groups := NewGroupRepo(db)
+	members := NewGroupStudentRepo(db)
+
+	branchId := newTestBranch(t, db)
+	groupId := newTestGroup(t, db, branchId, "", 2)
+	first := newTestStudent(t, db, branchId)
+	second := newTestStudent(t, db, branchId)
+	third := newTestStudent(t, db, branchId)
+
+	if _, err := members.JoinWaitlist(ctx, &schedule_service.CreateGroupStudent{GroupId: groupId, StudentId: first}); err == nil {
+		t.Fatal("joined the waitlist of a group with free seats")
+	}
+
+	for _, studentId := range []string{first, second} {
+		if _, err := members.Create(ctx, &schedule_service.CreateGroupStudent{GroupId: groupId, StudentId: studentId}); err != nil {
+			t.Fatalf("add student: %v", err)
+		}
+	}
+
+	if _, err := members.Create(ctx, &schedule_service.CreateGroupStudent{GroupId: groupId, StudentId: first}); err == nil {
+		t.Fatal("added the same student twice")
+	}
+	if _, err := members.Create(ctx, &schedule_service.CreateGroupStudent{GroupId: groupId, StudentId: third}); err == nil {
+		t.Fatal("added a student to a full group")
+	}
+
+	entry, err := members.JoinWaitlist(ctx, &schedule_service.CreateGroupStudent{GroupId: groupId, StudentId: third})
+	if err != nil {
+		t.Fatalf("join waitlist: %v", err)
+	}
+	if entry.Position != 1 {
+		t.Errorf("waitlist position = %d, want 1", entry.Position)
+	}
+
+	update := &schedule_service.UpdateGroup{
+		Id:                groupId,
+		TeacherId:         newTestTeacher(t, db, branchId),
+		SuppportTeacherId: newTestSupportTeacher(t, db, branchId),
+		BranchId:          branchId,
+		Type:              "beginner",
+		Capacity:          1,
+	}
+	if _, err := groups.Update(ctx, update); err == nil {
+		t.Fatal("lowered the capacity below the number of students")
+	}
+
+	promoted, err := members.PromoteFromWaitlist(ctx, groupId)
+	if err != nil {
+		t.Fatalf("promote from a full group: %v", err)
+	}
+	if len(promoted) != 0 {
+		t.Fatalf("promoted %d students into a full group", len(promoted))
+	}
+
+	update.Capacity = 3
+	if _, err := groups.Update(ctx, update); err != nil {
+		t.Fatalf("raise capacity: %v", err)
+	}
+
+	promoted, err = members.PromoteFromWaitlist(ctx, groupId)
+	if err != nil {
+		t.Fatalf("promote: %v", err)
+	}
+	if len(promoted) != 1 || promoted[0].StudentId != third || promoted[0].Status != "promoted" {
+		t.Fatalf("promoted = %v, want the waitlisted student", promoted)
+	}
+
+	group, err := groups.GetByID(ctx, &schedule_service.GroupPrimaryKey{Id: groupId})
+	if err != nil {
+		t.Fatalf("get group: %v", err)
+	}
+	if group.StudentsCount != 3 {
+		t.Errorf("students = %d, want 3", group.StudentsCount)
+	}
+}
@@ -0,0 +1,400 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// mockTestColumns selects a mock test with the number of results and the
+// average bands. Queries using it group by mt.id and g.name.
+const mockTestColumns = `
+            mt.id,
+            mt.groupId::text,
+            COALESCE(g.name, ''),
+            mt.title,
+            mt.testDate::text,
+            mt.readingType,
+            COALESCE(mt.createdBy::text, ''),
+            COALESCE(mt.created_at::text, ''),
+            COUNT(r.id),
+            COALESCE(ROUND(AVG(r.listeningBand), 2), 0)::real,
+            COALESCE(ROUND(AVG(r.readingBand), 2), 0)::real,
+            COALESCE(ROUND(AVG(r.writingBand), 2), 0)::real,
+            COALESCE(ROUND(AVG(r.speakingBand), 2), 0)::real,
+            COALESCE(ROUND(AVG(r.overall), 2), 0)::real`
+
+const mockTestResultColumns = `
+            r.id,
+            r.mockTestId::text,
+            mt.title,
+            mt.testDate::text,
+            r.studentId::text,
+            COALESCE(s.fullname, ''),
+            r.listeningRaw,
+            r.readingRaw,
+            r.writingTaskResponse::int,
+            r.writingCoherence::int,
+            r.writingLexical::int,
+            r.writingGrammar::int,
+            r.speakingFluency::int,
+            r.speakingLexical::int,
+            r.speakingGrammar::int,
+            r.speakingPronunciation::int,
+            r.listeningBand::real,
+            r.readingBand::real,
+            r.writingBand::real,
+            r.speakingBand::real,
+            r.overall::real`
+
+type mockTestRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewMockTestRepo(db *pgxpool.Pool) storage.MockTestRepoI {
+	return &mockTestRepo{
+		db: db,
+	}
+}
+
+// Create implements storage.MockTestRepoI. Mock tests are only run for ielts
+// groups.
+func (m *mockTestRepo) Create(ctx context.Context, req *schedule_service.CreateMockTest) (*schedule_service.MockTest, error) {
+	id := uuid.NewString()
+
+	result, err := m.db.Exec(ctx, `
+        INSERT INTO "mock_test" (
+            id,
+            groupId,
+            title,
+            testDate,
+            readingType,
+            createdBy
+        )
+        SELECT $1, g.id, $3, $4, $5, NULLIF($6, '')::uuid
+        FROM "group" g
+        WHERE g.id::text = $2 AND g.deleted_at = 0 AND g.type = 'ielts'
+        `, id, req.GroupId, req.Title, req.TestDate, req.ReadingType, req.CreatedBy)
+
+	if err != nil {
+		log.Println("error while creating mock test in storage", err)
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("group not found or not an ielts group")
+	}
+
+	return m.GetByID(ctx, &schedule_service.MockTestPrimaryKey{Id: id})
+}
+
+// GetByID implements storage.MockTestRepoI. The results are listed by
+// student name.
+func (m *mockTestRepo) GetByID(ctx context.Context, req *schedule_service.MockTestPrimaryKey) (*schedule_service.MockTest, error) {
+	test, err := scanMockTest(m.db.QueryRow(ctx, `
+        SELECT `+mockTestColumns+`
+        FROM "mock_test" mt
+        LEFT JOIN "group" g ON g.id = mt.groupId
+        LEFT JOIN "mock_test_result" r ON r.mockTestId = mt.id
+        WHERE mt.id::text = $1 AND mt.deleted_at = 0
+        GROUP BY mt.id, g.name`, req.Id))
+	if err != nil {
+		log.Println("error while getting mock test by id", err)
+		return nil, err
+	}
+
+	test.Results, err = m.results(ctx, `r.mockTestId::text = $1 ORDER BY s.fullname`, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return test, nil
+}
+
+// GetList implements storage.MockTestRepoI. Tests are listed newest first,
+// without their results.
+func (m *mockTestRepo) GetList(ctx context.Context, req *schedule_service.GetListMockTestRequest) (*schedule_service.GetListMockTestResponse, error) {
+	resp := &schedule_service.GetListMockTestResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	var err error
+	resp.MockTests, err = m.tests(ctx, req.GroupId, req.FromDate, req.ToDate, fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Count = int64(len(resp.MockTests))
+
+	return resp, nil
+}
+
+// Delete implements storage.MockTestRepoI.
+func (m *mockTestRepo) Delete(ctx context.Context, req *schedule_service.MockTestPrimaryKey) error {
+	result, err := m.db.Exec(ctx, `
+        UPDATE "mock_test" SET
+            deleted_at = 1,
+            updated_at = NOW()
+        WHERE id::text = $1 AND deleted_at = 0
+    `, req.Id)
+
+	if err != nil {
+		log.Println("error while deleting mock test")
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return errors.New("mock test not found")
+	}
+
+	return nil
+}
+
+// SaveResult implements storage.MockTestRepoI. The bands come worked out; a
+// student has one result per test, which is replaced when saved again.
+func (m *mockTestRepo) SaveResult(ctx context.Context, req *schedule_service.MockTestResult) (*schedule_service.MockTestResult, error) {
+	result, err := m.db.Exec(ctx, `
+        INSERT INTO "mock_test_result" (
+            id,
+            mockTestId,
+            studentId,
+            listeningRaw,
+            listeningBand,
+            readingRaw,
+            readingBand,
+            writingTaskResponse,
+            writingCoherence,
+            writingLexical,
+            writingGrammar,
+            writingBand,
+            speakingFluency,
+            speakingLexical,
+            speakingGrammar,
+            speakingPronunciation,
+            speakingBand,
+            overall
+        )
+        SELECT $1, mt.id, gs.studentId, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
+        FROM "mock_test" mt
+        JOIN "group_student" gs ON gs.groupId = mt.groupId AND gs.deleted_at = 0
+        WHERE mt.id::text = $2 AND mt.deleted_at = 0 AND gs.studentId::text = $3
+        LIMIT 1
+        ON CONFLICT (mockTestId, studentId) DO UPDATE SET
+            listeningRaw = EXCLUDED.listeningRaw,
+            listeningBand = EXCLUDED.listeningBand,
+            readingRaw = EXCLUDED.readingRaw,
+            readingBand = EXCLUDED.readingBand,
+            writingTaskResponse = EXCLUDED.writingTaskResponse,
+            writingCoherence = EXCLUDED.writingCoherence,
+            writingLexical = EXCLUDED.writingLexical,
+            writingGrammar = EXCLUDED.writingGrammar,
+            writingBand = EXCLUDED.writingBand,
+            speakingFluency = EXCLUDED.speakingFluency,
+            speakingLexical = EXCLUDED.speakingLexical,
+            speakingGrammar = EXCLUDED.speakingGrammar,
+            speakingPronunciation = EXCLUDED.speakingPronunciation,
+            speakingBand = EXCLUDED.speakingBand,
+            overall = EXCLUDED.overall,
+            updated_at = NOW()
+        `, uuid.NewString(), req.MockTestId, req.StudentId, req.ListeningRaw, req.Bands.Listening, req.ReadingRaw, req.Bands.Reading,
+		req.WritingTaskResponse, req.WritingCoherence, req.WritingLexical, req.WritingGrammar, req.Bands.Writing,
+		req.SpeakingFluency, req.SpeakingLexical, req.SpeakingGrammar, req.SpeakingPronunciation, req.Bands.Speaking, req.Bands.Overall)
+
+	if err != nil {
+		log.Println("error while saving mock test result in storage", err)
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("mock test not found or student not in its group")
+	}
+
+	results, err := m.results(ctx, `r.mockTestId::text = $1 AND r.studentId::text = $2`, req.MockTestId, req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, pgx.ErrNoRows
+	}
+
+	return results[0], nil
+}
+
+// DeleteResult implements storage.MockTestRepoI.
+func (m *mockTestRepo) DeleteResult(ctx context.Context, req *schedule_service.MockTestResultPrimaryKey) error {
+	_, err := m.db.Exec(ctx, `DELETE FROM "mock_test_result" WHERE id::text = $1`, req.Id)
+
+	if err != nil {
+		log.Println("error while deleting mock test result")
+		return err
+	}
+
+	return nil
+}
+
+// GetStudentProgress implements storage.MockTestRepoI. Results are listed
+// oldest first; the change is between the first and the latest overall band.
+func (m *mockTestRepo) GetStudentProgress(ctx context.Context, req *schedule_service.StudentMockProgressRequest) (*schedule_service.StudentMockProgress, error) {
+	resp := &schedule_service.StudentMockProgress{StudentId: req.StudentId}
+
+	err := m.db.QueryRow(ctx, `SELECT COALESCE(fullname, '') FROM "student" WHERE id::text = $1`, req.StudentId).Scan(&resp.StudentName)
+	if err != nil {
+		log.Println("error while getting student of mock progress", err)
+		return nil, err
+	}
+
+	resp.Results, err = m.results(ctx, `r.studentId::text = $1
+          AND ($2 = '' OR mt.testDate >= $2::date)
+          AND ($3 = '' OR mt.testDate <= $3::date)
+        ORDER BY mt.testDate`, req.StudentId, req.FromDate, req.ToDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Results) > 0 {
+		first, latest := resp.Results[0], resp.Results[len(resp.Results)-1]
+		resp.Latest = latest.Bands
+		resp.Best = first.Bands
+		for _, result := range resp.Results {
+			if result.Bands.Overall > resp.Best.Overall {
+				resp.Best = result.Bands
+			}
+		}
+		resp.OverallChange = latest.Bands.Overall - first.Bands.Overall
+	}
+
+	return resp, nil
+}
+
+// GetGroupAverages implements storage.MockTestRepoI. Tests are listed oldest
+// first, and the group average covers every result in the range.
+func (m *mockTestRepo) GetGroupAverages(ctx context.Context, req *schedule_service.GroupMockAveragesRequest) (*schedule_service.GroupMockAverages, error) {
+	resp := &schedule_service.GroupMockAverages{GroupId: req.GroupId, Average: &schedule_service.MockBands{}}
+
+	err := m.db.QueryRow(ctx, `
+        SELECT
+            g.name,
+            COALESCE(ROUND(AVG(r.listeningBand), 2), 0)::real,
+            COALESCE(ROUND(AVG(r.readingBand), 2), 0)::real,
+            COALESCE(ROUND(AVG(r.writingBand), 2), 0)::real,
+            COALESCE(ROUND(AVG(r.speakingBand), 2), 0)::real,
+            COALESCE(ROUND(AVG(r.overall), 2), 0)::real
+        FROM "group" g
+        LEFT JOIN "mock_test" mt ON mt.groupId = g.id AND mt.deleted_at = 0
+          AND ($2 = '' OR mt.testDate >= $2::date)
+          AND ($3 = '' OR mt.testDate <= $3::date)
+        LEFT JOIN "mock_test_result" r ON r.mockTestId = mt.id
+        WHERE g.id::text = $1
+        GROUP BY g.id`, req.GroupId, req.FromDate, req.ToDate).Scan(&resp.GroupName, &resp.Average.Listening, &resp.Average.Reading,
+		&resp.Average.Writing, &resp.Average.Speaking, &resp.Average.Overall)
+	if err != nil {
+		log.Println("error while getting group mock averages", err)
+		return nil, err
+	}
+
+	tests, err := m.tests(ctx, req.GroupId, req.FromDate, req.ToDate, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(tests) - 1; i >= 0; i-- {
+		resp.Tests = append(resp.Tests, tests[i])
+	}
+
+	return resp, nil
+}
+
+// tests lists mock tests newest first.
+func (m *mockTestRepo) tests(ctx context.Context, groupId, fromDate, toDate, paging string) ([]*schedule_service.MockTest, error) {
+	rows, err := m.db.Query(ctx, `
+        SELECT `+mockTestColumns+`
+        FROM "mock_test" mt
+        LEFT JOIN "group" g ON g.id = mt.groupId
+        LEFT JOIN "mock_test_result" r ON r.mockTestId = mt.id
+        WHERE mt.deleted_at = 0
+          AND ($1 = '' OR mt.groupId::text = $1)
+          AND ($2 = '' OR mt.testDate >= $2::date)
+          AND ($3 = '' OR mt.testDate <= $3::date)
+        GROUP BY mt.id, g.name
+        ORDER BY mt.testDate DESC, g.name`+paging, groupId, fromDate, toDate)
+	if err != nil {
+		log.Println("error while getting all mock tests:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tests []*schedule_service.MockTest
+
+	for rows.Next() {
+		test, err := scanMockTest(rows)
+		if err != nil {
+			log.Println("error while scanning mock tests:", err)
+			return nil, err
+		}
+
+		tests = append(tests, test)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	return tests, nil
+}
+
+// results lists the results of live mock tests matching a condition.
+func (m *mockTestRepo) results(ctx context.Context, condition string, args ...interface{}) ([]*schedule_service.MockTestResult, error) {
+	rows, err := m.db.Query(ctx, `
+        SELECT `+mockTestResultColumns+`
+        FROM "mock_test_result" r
+        JOIN "mock_test" mt ON mt.id = r.mockTestId AND mt.deleted_at = 0
+        LEFT JOIN "student" s ON s.id = r.studentId
+        WHERE `+condition, args...)
+	if err != nil {
+		log.Println("error while getting mock test results:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*schedule_service.MockTestResult
+
+	for rows.Next() {
+		result := schedule_service.MockTestResult{Bands: &schedule_service.MockBands{}}
+		err := rows.Scan(&result.Id, &result.MockTestId, &result.Title, &result.TestDate, &result.StudentId, &result.StudentName,
+			&result.ListeningRaw, &result.ReadingRaw, &result.WritingTaskResponse, &result.WritingCoherence, &result.WritingLexical, &result.WritingGrammar,
+			&result.SpeakingFluency, &result.SpeakingLexical, &result.SpeakingGrammar, &result.SpeakingPronunciation,
+			&result.Bands.Listening, &result.Bands.Reading, &result.Bands.Writing, &result.Bands.Speaking, &result.Bands.Overall)
+		if err != nil {
+			log.Println("error while scanning mock test results:", err)
+			return nil, err
+		}
+
+		results = append(results, &result)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func scanMockTest(row pgx.Row) (*schedule_service.MockTest, error) {
+	test := schedule_service.MockTest{Average: &schedule_service.MockBands{}}
+
+	err := row.Scan(&test.Id, &test.GroupId, &test.GroupName, &test.Title, &test.TestDate, &test.ReadingType, &test.CreatedBy, &test.CreatedAt,
+		&test.ResultCount, &test.Average.Listening, &test.Average.Reading, &test.Average.Writing, &test.Average.Speaking, &test.Average.Overall)
+	if err != nil {
+		return nil, err
+	}
+
+	return &test, nil
+}
@@ -3,12 +3,14 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"schedule_service/genproto/schedule_service"
 	"schedule_service/storage"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -224,3 +226,254 @@ func (r *journalRepo) GetByGroupID(ctx context.Context, req *schedule_service.Jo
 	}
 	return &resp, nil
 }
+
+// GetDueForRollover returns the latest open journal of every active group
+// whose toDate has already passed.
+func (j *journalRepo) GetDueForRollover(ctx context.Context) ([]*schedule_service.Journal, error) {
+	rows, err := j.db.Query(ctx, `
+        SELECT
+            j.id,
+            j.fromDate::text,
+            j.toDate::text,
+            j.groupId,
+            j.studentsCount
+        FROM "journal" j
+        JOIN "group" g ON g.id = j.groupId
+        WHERE j.deleted_at = 0
+          AND j.closed_at IS NULL
+          AND j.toDate < CURRENT_DATE
+          AND g.deleted_at = 0
+          AND g.status = 'active'
+          AND NOT EXISTS (
+              SELECT 1 FROM "journal" n
+              WHERE n.groupId = j.groupId AND n.deleted_at = 0 AND n.fromDate > j.fromDate
+          )
+        ORDER BY j.toDate
+    `)
+	if err != nil {
+		log.Println("error while getting journals due for rollover:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var journals []*schedule_service.Journal
+	for rows.Next() {
+		var (
+			journal  schedule_service.Journal
+			fromDate sql.NullString
+			toDate   sql.NullString
+		)
+		if err = rows.Scan(&journal.Id, &fromDate, &toDate, &journal.GroupId, &journal.StudentsCount); err != nil {
+			log.Println("error while scanning journals due for rollover:", err)
+			return nil, err
+		}
+		journal.FromDate = fromDate.String
+		journal.ToDate = toDate.String
+
+		journals = append(journals, &journal)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	return journals, nil
+}
+
+// Rollover closes the given journal and opens the next month's journal for
+// the same group. The student count is taken from the group's current
+// members and the weekly lesson pattern of the old journal, rooms included,
+// is repeated over the new period. A lesson whose room is taken fails the
+// rollover.
+func (j *journalRepo) Rollover(ctx context.Context, req *schedule_service.JournalPrimaryKey) (*schedule_service.GetJournal, error) {
+	tx, err := j.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting rollover transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		groupId string
+		toDate  sql.NullString
+	)
+	err = tx.QueryRow(ctx, `
+        SELECT groupId, toDate::text
+        FROM "journal"
+        WHERE id = $1 AND deleted_at = 0 AND closed_at IS NULL
+        FOR UPDATE
+    `, req.Id).Scan(&groupId, &toDate)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("journal is already closed or deleted")
+		}
+		log.Println("error while locking journal for rollover", err)
+		return nil, err
+	}
+
+	if !toDate.Valid {
+		return nil, errors.New("journal has no end date")
+	}
+
+	id := uuid.NewString()
+	_, err = tx.Exec(ctx, `
+        INSERT INTO "journal" (
+            id,
+            fromDate,
+            toDate,
+            groupId,
+            studentsCount
+        ) VALUES (
+            $1,
+            $2::date + 1,
+            ($2::date + 1 + INTERVAL '1 month' - INTERVAL '1 day')::date,
+            $3,
+            (SELECT COUNT(*) FROM "group_student" WHERE groupId = $3 AND deleted_at = 0)
+        )`, id, toDate.String, groupId)
+	if err != nil {
+		log.Println("error while creating next journal", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, `
+        INSERT INTO "schedule" (id, journalId, date, startTime, endTime, lesson, roomId, teacherId, supportTeacherId)
+        SELECT uuid_generate_v4(), n.id, d::date, p.startTime, p.endTime, p.lesson, p.roomId, g.teacherId, g.supportTeacherId
+        FROM "journal" n
+        JOIN "group" g ON g.id = n.groupId
+        CROSS JOIN LATERAL generate_series(n.fromDate, n.toDate, INTERVAL '1 day') d
+        JOIN (
+            SELECT DISTINCT ON (EXTRACT(ISODOW FROM date), startTime)
+                EXTRACT(ISODOW FROM date) AS dow,
+                startTime,
+                endTime,
+                lesson,
+                roomId
+            FROM "schedule"
+            WHERE journalId = $2 AND deleted_at = 0 AND date IS NOT NULL
+            ORDER BY EXTRACT(ISODOW FROM date), startTime, date DESC
+        ) p ON p.dow = EXTRACT(ISODOW FROM d)
+        WHERE n.id = $1
+        RETURNING id::text, date::text, roomId IS NOT NULL
+    `, id, req.Id)
+	if err != nil {
+		log.Println("error while copying lesson pattern", err)
+		return nil, err
+	}
+
+	if _, err = checkLessonRooms(ctx, tx, rows); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE "journal" SET
+            closed_at = NOW(),
+            updated_at = NOW()
+        WHERE id = $1
+    `, req.Id)
+	if err != nil {
+		log.Println("error while closing rolled over journal", err)
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM "journal_rollover_failure" WHERE journalId = $1`, req.Id)
+	if err != nil {
+		log.Println("error while clearing rollover failure", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing rollover", err)
+		return nil, err
+	}
+
+	return j.GetByID(ctx, &schedule_service.JournalPrimaryKey{Id: id})
+}
+
+// SaveRolloverFailure records why a journal could not be rolled over. A
+// journal that keeps failing has its attempt counter increased.
+func (j *journalRepo) SaveRolloverFailure(ctx context.Context, req *schedule_service.RolloverFailure) error {
+	_, err := j.db.Exec(ctx, `
+        INSERT INTO "journal_rollover_failure" (
+            id,
+            journalId,
+            groupId,
+            error
+        ) VALUES (
+            $1, $2, $3, $4
+        )
+        ON CONFLICT (journalId) DO UPDATE SET
+            error = EXCLUDED.error,
+            attempts = "journal_rollover_failure".attempts + 1,
+            updated_at = NOW()
+    `, uuid.NewString(), req.JournalId, req.GroupId, req.Error)
+	if err != nil {
+		log.Println("error while saving rollover failure", err)
+		return err
+	}
+
+	return nil
+}
+
+// GetRolloverReport lists groups whose journal could not be rolled over.
+func (j *journalRepo) GetRolloverReport(ctx context.Context, req *schedule_service.GetRolloverReportRequest) (*schedule_service.GetRolloverReportResponse, error) {
+	resp := &schedule_service.GetRolloverReportResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := j.db.Query(ctx, fmt.Sprintf(`
+        SELECT
+            f.journalId,
+            f.groupId,
+            g.name,
+            j.toDate::text,
+            f.error,
+            f.attempts,
+            f.created_at,
+            f.updated_at
+        FROM "journal_rollover_failure" f
+        JOIN "journal" j ON j.id = f.journalId
+        JOIN "group" g ON g.id = f.groupId
+        ORDER BY f.updated_at DESC
+        OFFSET %v LIMIT %v
+    `, offset, req.Limit))
+	if err != nil {
+		log.Println("error while getting rollover report:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+	for rows.Next() {
+		var (
+			failure    schedule_service.RolloverFailure
+			groupName  sql.NullString
+			toDate     sql.NullString
+			errMsg     sql.NullString
+			created_at sql.NullString
+			updated_at sql.NullString
+		)
+		count++
+		err = rows.Scan(&failure.JournalId, &failure.GroupId, &groupName, &toDate, &errMsg, &failure.Attempts, &created_at, &updated_at)
+		if err != nil {
+			log.Println("error while scanning rollover report:", err)
+			return nil, err
+		}
+
+		failure.GroupName = groupName.String
+		failure.ToDate = toDate.String
+		failure.Error = errMsg.String
+		failure.CreatedAt = created_at.String
+		failure.UpdatedAt = updated_at.String
+
+		resp.Failures = append(resp.Failures, &failure)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
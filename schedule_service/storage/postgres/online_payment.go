@@ -0,0 +1,417 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/pkg/money"
+	"schedule_service/pkg/payment"
+	"schedule_service/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const onlinePaymentColumns = `
+            id,
+            studentId::text,
+            groupId::text,
+            amount::text,
+            provider,
+            status,
+            transactionId,
+            studentPaymentId::text,
+            paidAt::text,
+            created_at::text,
+            updated_at::text,
+            returnUrl`
+
+type onlinePaymentRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewOnlinePaymentRepo(db *pgxpool.Pool) storage.OnlinePaymentRepoI {
+	return &onlinePaymentRepo{
+		db: db,
+	}
+}
+
+// Create implements storage.OnlinePaymentRepoI.
+func (o *onlinePaymentRepo) Create(ctx context.Context, req *schedule_service.CreateOnlinePayment) (*schedule_service.OnlinePayment, error) {
+	id := uuid.NewString()
+
+	_, err := o.db.Exec(ctx, `
+        INSERT INTO "online_payment" (
+            id,
+            studentId,
+            groupId,
+            amount,
+            provider,
+            returnUrl
+        ) VALUES (
+            $1, $2, $3, $4, $5, NULLIF($6, '')
+        )`, id, req.StudentId, req.GroupId, req.Amount, req.Provider, req.ReturnUrl)
+
+	if err != nil {
+		log.Println("error while creating online payment in storage", err)
+		return nil, err
+	}
+
+	return o.GetByID(ctx, &schedule_service.OnlinePaymentPrimaryKey{Id: id})
+}
+
+// GetByID implements storage.OnlinePaymentRepoI.
+func (o *onlinePaymentRepo) GetByID(ctx context.Context, req *schedule_service.OnlinePaymentPrimaryKey) (*schedule_service.OnlinePayment, error) {
+	onlinePayment, err := scanOnlinePayment(o.db.QueryRow(ctx, `
+        SELECT `+onlinePaymentColumns+`
+        FROM "online_payment"
+        WHERE id::text = $1`, req.Id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, payment.ErrOrderNotFound
+		}
+		log.Println("error while getting online payment by id", err)
+		return nil, err
+	}
+
+	return onlinePayment, nil
+}
+
+// GetList implements storage.OnlinePaymentRepoI. Newest payments come first.
+func (o *onlinePaymentRepo) GetList(ctx context.Context, req *schedule_service.GetListOnlinePaymentRequest) (*schedule_service.GetListOnlinePaymentResponse, error) {
+	resp := &schedule_service.GetListOnlinePaymentResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := o.db.Query(ctx, `
+        SELECT `+onlinePaymentColumns+`
+        FROM "online_payment"
+        WHERE ($1 = '' OR studentId::text = $1)
+          AND ($2 = '' OR provider = $2)
+          AND ($3 = '' OR status = $3)
+        ORDER BY created_at DESC
+    `+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.StudentId, req.Provider, req.Status)
+	if err != nil {
+		log.Println("error while getting all online payments:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		onlinePayment, err := scanOnlinePayment(rows)
+		if err != nil {
+			log.Println("error while scanning online payments:", err)
+			return nil, err
+		}
+
+		resp.OnlinePayments = append(resp.OnlinePayments, onlinePayment)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// Capture implements storage.OnlinePaymentRepoI. A completed callback records
+// the payment in the student ledger once: repeating it with the same
+// transaction returns the payment as it is, and captured reports whether
+// this call recorded it. Callbacks naming only the transaction are matched
+// to their payment by it.
+func (o *onlinePaymentRepo) Capture(ctx context.Context, provider string, callback *payment.Callback) (*schedule_service.OnlinePayment, bool, error) {
+	tx, err := o.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting online payment capture transaction", err)
+		return nil, false, err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		id            string
+		studentId     string
+		groupId       string
+		amount        string
+		status        string
+		transactionId sql.NullString
+	)
+
+	err = tx.QueryRow(ctx, `
+        SELECT id, studentId::text, groupId::text, amount::text, status, transactionId
+        FROM "online_payment"
+        WHERE provider = $2
+          AND CASE WHEN $1 = '' THEN transactionId = $3 ELSE id::text = $1 END
+        FOR UPDATE`, callback.OrderID, provider, callback.TransactionID).Scan(&id, &studentId, &groupId, &amount, &status, &transactionId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, false, payment.ErrOrderNotFound
+		}
+		log.Println("error while locking online payment", err)
+		return nil, false, err
+	}
+
+	if callback.OrderID == "" {
+		callback.OrderID = id
+	} else {
+		expected, err := money.Amount.Parse(amount)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if callback.Amount != expected {
+			return nil, false, payment.ErrAmountMismatch
+		}
+	}
+
+	captured := false
+
+	switch {
+	case status == "paid" && callback.Stage == payment.StageComplete && transactionId.String == callback.TransactionID:
+	case status == "paid":
+		return nil, false, payment.ErrAlreadyPaid
+	case status == "cancelled":
+		return nil, false, payment.ErrCancelled
+	case callback.Stage == payment.StagePrepare:
+		_, err = tx.Exec(ctx, `
+            UPDATE "online_payment" SET
+                transactionId = COALESCE(NULLIF($1, ''), transactionId),
+                updated_at = NOW()
+            WHERE id = $2`, callback.TransactionID, id)
+	case callback.Stage == payment.StageCancel:
+		_, err = tx.Exec(ctx, `
+            UPDATE "online_payment" SET
+                status = 'cancelled',
+                transactionId = $1,
+                updated_at = NOW()
+            WHERE id = $2`, callback.TransactionID, id)
+	default:
+		studentPaymentId := uuid.NewString()
+
+		if err = insertStudentPayment(ctx, tx, studentPaymentId, studentId, groupId, amount, "", "online"); err != nil {
+			return nil, false, err
+		}
+
+		_, err = tx.Exec(ctx, `
+            UPDATE "online_payment" SET
+                status = 'paid',
+                transactionId = $1,
+                studentPaymentId = $2,
+                paidAt = NOW(),
+                updated_at = NOW()
+            WHERE id = $3`, callback.TransactionID, studentPaymentId, id)
+		captured = true
+	}
+	if err != nil {
+		log.Println("error while updating online payment", err)
+		return nil, false, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing online payment capture", err)
+		return nil, false, err
+	}
+
+	onlinePayment, err := o.GetByID(ctx, &schedule_service.OnlinePaymentPrimaryKey{Id: id})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return onlinePayment, captured, nil
+}
+
+// LogCallback implements storage.OnlinePaymentRepoI. Every callback is kept,
+// including the ones that failed verification.
+func (o *onlinePaymentRepo) LogCallback(ctx context.Context, provider string, payload map[string]string, callback *payment.Callback, callbackErr error) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	var orderId, stage, errText string
+	if callback != nil {
+		orderId = callback.OrderID
+		stage = callback.Stage
+	}
+	if callbackErr != nil {
+		errText = callbackErr.Error()
+	}
+
+	_, err = o.db.Exec(ctx, `
+        INSERT INTO "online_payment_callback" (
+            id,
+            provider,
+            onlinePaymentId,
+            stage,
+            payload,
+            error
+        ) VALUES (
+            $1, $2, (SELECT id FROM "online_payment" WHERE id::text = $3), NULLIF($4, ''), $5, NULLIF($6, '')
+        )`, uuid.NewString(), provider, orderId, stage, string(body), errText)
+
+	if err != nil {
+		log.Println("error while logging online payment callback", err)
+		return err
+	}
+
+	return nil
+}
+
+// Reconcile implements storage.OnlinePaymentRepoI. It compares the payments
+// of a provider created between fromDate and toDate with the transactions
+// in the provider's statement for the same days, and reports payments still
+// pending after a day.
+func (o *onlinePaymentRepo) Reconcile(ctx context.Context, req *schedule_service.ReconcileOnlinePaymentRequest) (*schedule_service.OnlinePaymentReconciliation, error) {
+	resp := &schedule_service.OnlinePaymentReconciliation{}
+
+	rows, err := o.db.Query(ctx, `
+        SELECT
+            id,
+            COALESCE(transactionId, ''),
+            amount::text,
+            status,
+            created_at < NOW() - INTERVAL '1 day'
+        FROM "online_payment"
+        WHERE provider = $1
+          AND created_at >= $2::date
+          AND created_at < $3::date + 1
+        ORDER BY created_at`, req.Provider, req.FromDate, req.ToDate)
+	if err != nil {
+		log.Println("error while getting online payments to reconcile:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	type local struct {
+		id            string
+		transactionId string
+		amount        string
+		status        string
+		stale         bool
+		seen          bool
+	}
+
+	var (
+		locals          []*local
+		byId            = map[string]*local{}
+		byTransactionId = map[string]*local{}
+	)
+
+	for rows.Next() {
+		item := &local{}
+		if err := rows.Scan(&item.id, &item.transactionId, &item.amount, &item.status, &item.stale); err != nil {
+			log.Println("error while scanning online payments to reconcile:", err)
+			return nil, err
+		}
+
+		locals = append(locals, item)
+		byId[item.id] = item
+		if item.transactionId != "" {
+			byTransactionId[item.transactionId] = item
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	for _, transaction := range req.Transactions {
+		item, ok := byTransactionId[transaction.TransactionId]
+		if !ok {
+			item, ok = byId[transaction.OrderId]
+		}
+
+		if !ok || item.status != "paid" {
+			issue := &schedule_service.ReconciliationIssue{
+				Kind:          "missing_locally",
+				TransactionId: transaction.TransactionId,
+				Expected:      "paid",
+			}
+			if ok {
+				item.seen = true
+				issue.OnlinePaymentId = item.id
+				issue.Actual = item.status
+			}
+			resp.Issues = append(resp.Issues, issue)
+			continue
+		}
+		item.seen = true
+
+		expected, err := money.Amount.Parse(item.amount)
+		if err != nil {
+			return nil, err
+		}
+
+		actual, err := money.Amount.Parse(transaction.Amount)
+		if err != nil || actual != expected {
+			resp.Issues = append(resp.Issues, &schedule_service.ReconciliationIssue{
+				Kind:            "amount_mismatch",
+				OnlinePaymentId: item.id,
+				TransactionId:   transaction.TransactionId,
+				Expected:        item.amount,
+				Actual:          transaction.Amount,
+			})
+			continue
+		}
+
+		resp.Matched++
+	}
+
+	for _, item := range locals {
+		switch {
+		case item.status == "paid" && !item.seen:
+			resp.Issues = append(resp.Issues, &schedule_service.ReconciliationIssue{
+				Kind:            "missing_at_provider",
+				OnlinePaymentId: item.id,
+				TransactionId:   item.transactionId,
+				Expected:        item.amount,
+			})
+		case item.status == "pending" && item.stale && !item.seen:
+			resp.Issues = append(resp.Issues, &schedule_service.ReconciliationIssue{
+				Kind:            "stale_pending",
+				OnlinePaymentId: item.id,
+				TransactionId:   item.transactionId,
+				Actual:          item.status,
+			})
+		}
+	}
+
+	return resp, nil
+}
+
+func scanOnlinePayment(row pgx.Row) (*schedule_service.OnlinePayment, error) {
+	var (
+		onlinePayment    schedule_service.OnlinePayment
+		transactionId    sql.NullString
+		studentPaymentId sql.NullString
+		paidAt           sql.NullString
+		created_at       sql.NullString
+		updated_at       sql.NullString
+		returnUrl        sql.NullString
+	)
+
+	err := row.Scan(&onlinePayment.Id, &onlinePayment.StudentId, &onlinePayment.GroupId, &onlinePayment.Amount, &onlinePayment.Provider,
+		&onlinePayment.Status, &transactionId, &studentPaymentId, &paidAt, &created_at, &updated_at, &returnUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	onlinePayment.TransactionId = transactionId.String
+	onlinePayment.StudentPaymentId = studentPaymentId.String
+	onlinePayment.PaidAt = paidAt.String
+	onlinePayment.CreatedAt = created_at.String
+	onlinePayment.UpdatedAt = updated_at.String
+	onlinePayment.ReturnUrl = returnUrl.String
+
+	return &onlinePayment, nil
+}
@@ -0,0 +1,451 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/storage"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// outstandingInvoices selects the invoices that the payments of the student
+// for the group do not cover, with the part still owed as amount - paid.
+// Payments go to the oldest invoices first.
+const outstandingInvoices = `
+        SELECT due.studentId,
+            due.groupId,
+            due.period,
+            due.amount,
+            due.amount - LEAST(due.amount, due.cumulative - due.paid) AS paid,
+            due.dueDate,
+            CURRENT_DATE - due.dueDate AS daysLate
+        FROM (
+            SELECT i.studentId,
+                i.groupId,
+                i.period,
+                i.amount,
+                i.dueDate,
+                SUM(i.amount) OVER (PARTITION BY i.studentId, i.groupId ORDER BY i.period) AS cumulative,
+                COALESCE((
+                    SELECT SUM(sp.paidSum)
+                    FROM "student_payment" sp
+                    WHERE sp.studentId = i.studentId AND sp.groupId = i.groupId
+                ), 0) AS paid
+            FROM "invoice" i
+        ) due
+        WHERE due.amount > 0
+          AND due.cumulative - due.paid > 0`
+
+// overdueInvoices selects the outstanding invoices whose due date has passed.
+const overdueInvoices = outstandingInvoices + `
+          AND due.dueDate < CURRENT_DATE`
+
+// overdueColumns selects an overdue row aliased as po.
+const overdueColumns = `
+            po.id,
+            po.studentId::text,
+            s.fullname,
+            po.groupId::text,
+            g.name,
+            g.branchId::text,
+            po.period::text,
+            po.amountDue::text,
+            po.paid::text,
+            (po.amountDue - po.paid)::text,
+            po.dueDate::text,
+            po.daysLate,
+            po.remindedAt::text,
+            po.resolvedAt::text,
+            EXISTS (SELECT 1 FROM "student_hold" h WHERE h.studentId = po.studentId AND h.clearedAt IS NULL)`
+
+const studentHoldColumns = `
+            id,
+            studentId::text,
+            reason,
+            createdBy::text,
+            created_at::text,
+            clearedAt::text,
+            clearedBy::text,
+            clearNote`
+
+type overdueRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewOverdueRepo(db *pgxpool.Pool) storage.OverdueRepoI {
+	return &overdueRepo{
+		db: db,
+	}
+}
+
+// queryRower is implemented by both the pool and a transaction.
+type queryRower interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// checkStudentHold rejects students who are on hold.
+func checkStudentHold(ctx context.Context, q queryRower, studentId string) error {
+	var reason string
+
+	err := q.QueryRow(ctx, `
+        SELECT reason
+        FROM "student_hold"
+        WHERE studentId::text = $1 AND clearedAt IS NULL`, studentId).Scan(&reason)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		log.Println("error while checking student hold", err)
+		return err
+	}
+
+	return fmt.Errorf("student is on hold until a manager clears it: %s", reason)
+}
+
+// Refresh implements storage.OverdueRepoI. It records every overdue invoice
+// with its current days late and resolves the ones that got paid. It returns
+// how many are open and how many were resolved.
+func (o *overdueRepo) Refresh(ctx context.Context) (int64, int64, error) {
+	tx, err := o.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting overdue refresh transaction", err)
+		return 0, 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	open, err := tx.Exec(ctx, `
+        INSERT INTO "payment_overdue" (
+            studentId,
+            groupId,
+            period,
+            amountDue,
+            paid,
+            dueDate,
+            daysLate
+        )
+        SELECT studentId, groupId, period, amount, paid, dueDate, daysLate
+        FROM (`+overdueInvoices+`
+        ) o
+        ON CONFLICT (studentId, groupId, period) DO UPDATE SET
+            amountDue = EXCLUDED.amountDue,
+            paid = EXCLUDED.paid,
+            dueDate = EXCLUDED.dueDate,
+            daysLate = EXCLUDED.daysLate,
+            resolvedAt = NULL,
+            updated_at = NOW()`)
+	if err != nil {
+		log.Println("error while recording overdue invoices", err)
+		return 0, 0, err
+	}
+
+	resolved, err := tx.Exec(ctx, `
+        UPDATE "payment_overdue" po SET
+            resolvedAt = NOW(),
+            updated_at = NOW()
+        WHERE po.resolvedAt IS NULL
+          AND NOT EXISTS (
+              SELECT 1
+              FROM (`+overdueInvoices+`
+              ) o
+              WHERE o.studentId = po.studentId AND o.groupId = po.groupId AND o.period = po.period
+          )`)
+	if err != nil {
+		log.Println("error while resolving paid overdue invoices", err)
+		return 0, 0, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing overdue refresh", err)
+		return 0, 0, err
+	}
+
+	return open.RowsAffected(), resolved.RowsAffected(), nil
+}
+
+// GetList implements storage.OverdueRepoI. The latest overdue come first.
+func (o *overdueRepo) GetList(ctx context.Context, req *schedule_service.GetListOverdueRequest) (*schedule_service.GetListOverdueResponse, error) {
+	resp := &schedule_service.GetListOverdueResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := o.db.Query(ctx, `
+        SELECT `+overdueColumns+`
+        FROM "payment_overdue" po
+        JOIN "student" s ON s.id = po.studentId
+        JOIN "group" g ON g.id = po.groupId
+        WHERE ($1 OR po.resolvedAt IS NULL)
+          AND ($2 = '' OR g.branchId::text = $2)
+          AND ($3 = '' OR po.studentId::text = $3)
+          AND po.daysLate >= $4
+        ORDER BY po.daysLate DESC, po.period
+    `+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.IncludeResolved, req.BranchId, req.StudentId, req.MinDaysLate)
+	if err != nil {
+		log.Println("error while getting all overdue payments:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		overdue, err := scanOverdue(rows)
+		if err != nil {
+			log.Println("error while scanning overdue payments:", err)
+			return nil, err
+		}
+
+		resp.Overdues = append(resp.Overdues, overdue)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// GetDueForReminder implements storage.OverdueRepoI. An open overdue is
+// reminded of once and then again every remindEvery.
+func (o *overdueRepo) GetDueForReminder(ctx context.Context, remindEvery time.Duration) ([]*schedule_service.Overdue, error) {
+	rows, err := o.db.Query(ctx, `
+        SELECT `+overdueColumns+`
+        FROM "payment_overdue" po
+        JOIN "student" s ON s.id = po.studentId
+        JOIN "group" g ON g.id = po.groupId
+        WHERE po.resolvedAt IS NULL
+          AND (po.remindedAt IS NULL OR po.remindedAt <= NOW() - make_interval(secs => $1))
+        ORDER BY po.period`, remindEvery.Seconds())
+	if err != nil {
+		log.Println("error while getting overdue payments to remind", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var overdues []*schedule_service.Overdue
+	for rows.Next() {
+		overdue, err := scanOverdue(rows)
+		if err != nil {
+			log.Println("error while scanning overdue payments to remind", err)
+			return nil, err
+		}
+
+		overdues = append(overdues, overdue)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	return overdues, nil
+}
+
+// MarkReminded implements storage.OverdueRepoI.
+func (o *overdueRepo) MarkReminded(ctx context.Context, id string) error {
+	_, err := o.db.Exec(ctx, `
+        UPDATE "payment_overdue" SET
+            remindedAt = NOW()
+        WHERE id = $1`, id)
+	if err != nil {
+		log.Println("error while marking overdue payment reminded", err)
+		return err
+	}
+
+	return nil
+}
+
+// HoldOverdue implements storage.OverdueRepoI. Students with an open overdue
+// at least afterDays late are put on hold, unless a manager already cleared
+// a hold of theirs since that overdue was recorded.
+func (o *overdueRepo) HoldOverdue(ctx context.Context, afterDays int32) ([]*schedule_service.StudentHold, error) {
+	rows, err := o.db.Query(ctx, `
+        INSERT INTO "student_hold" (studentId, reason)
+        SELECT po.studentId, 'overdue payment for ' || to_char(MIN(po.period), 'YYYY-MM')
+        FROM "payment_overdue" po
+        WHERE po.resolvedAt IS NULL
+          AND po.daysLate >= $1
+          AND NOT EXISTS (
+              SELECT 1 FROM "student_hold" h
+              WHERE h.studentId = po.studentId
+                AND (h.clearedAt IS NULL OR h.clearedAt >= po.created_at)
+          )
+        GROUP BY po.studentId
+        ON CONFLICT DO NOTHING
+        RETURNING `+studentHoldColumns, afterDays)
+	if err != nil {
+		log.Println("error while putting overdue students on hold", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var holds []*schedule_service.StudentHold
+	for rows.Next() {
+		hold, err := scanStudentHold(rows)
+		if err != nil {
+			log.Println("error while scanning student holds", err)
+			return nil, err
+		}
+
+		holds = append(holds, hold)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	return holds, nil
+}
+
+// CreateHold implements storage.OverdueRepoI.
+func (o *overdueRepo) CreateHold(ctx context.Context, req *schedule_service.CreateStudentHold) (*schedule_service.StudentHold, error) {
+	hold, err := scanStudentHold(o.db.QueryRow(ctx, `
+        INSERT INTO "student_hold" (studentId, reason, createdBy)
+        VALUES ($1, $2, NULLIF($3, '')::uuid)
+        ON CONFLICT DO NOTHING
+        RETURNING `+studentHoldColumns, req.StudentId, req.Reason, req.CreatedBy))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("student is already on hold")
+		}
+		log.Println("error while creating student hold", err)
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+// ClearHold implements storage.OverdueRepoI.
+func (o *overdueRepo) ClearHold(ctx context.Context, req *schedule_service.ClearStudentHold) (*schedule_service.StudentHold, error) {
+	hold, err := scanStudentHold(o.db.QueryRow(ctx, `
+        UPDATE "student_hold" SET
+            clearedAt = NOW(),
+            clearedBy = NULLIF($2, '')::uuid,
+            clearNote = NULLIF($3, '')
+        WHERE studentId::text = $1 AND clearedAt IS NULL
+        RETURNING `+studentHoldColumns, req.StudentId, req.ClearedBy, req.Note))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("student is not on hold")
+		}
+		log.Println("error while clearing student hold", err)
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+// GetListHold implements storage.OverdueRepoI.
+func (o *overdueRepo) GetListHold(ctx context.Context, req *schedule_service.GetListStudentHoldRequest) (*schedule_service.GetListStudentHoldResponse, error) {
+	resp := &schedule_service.GetListStudentHoldResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := o.db.Query(ctx, `
+        SELECT `+studentHoldColumns+`
+        FROM "student_hold"
+        WHERE ($1 = '' OR studentId::text = $1)
+          AND (NOT $2 OR clearedAt IS NULL)
+        ORDER BY created_at DESC
+    `+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.StudentId, req.ActiveOnly)
+	if err != nil {
+		log.Println("error while getting all student holds:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		hold, err := scanStudentHold(rows)
+		if err != nil {
+			log.Println("error while scanning student holds:", err)
+			return nil, err
+		}
+
+		resp.Holds = append(resp.Holds, hold)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// GetHold implements storage.OverdueRepoI.
+func (o *overdueRepo) GetHold(ctx context.Context, req *schedule_service.StudentHoldRequest) (*schedule_service.StudentHoldStatus, error) {
+	hold, err := scanStudentHold(o.db.QueryRow(ctx, `
+        SELECT `+studentHoldColumns+`
+        FROM "student_hold"
+        WHERE studentId::text = $1 AND clearedAt IS NULL`, req.StudentId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &schedule_service.StudentHoldStatus{}, nil
+		}
+		log.Println("error while getting student hold", err)
+		return nil, err
+	}
+
+	return &schedule_service.StudentHoldStatus{OnHold: true, Hold: hold}, nil
+}
+
+func scanOverdue(row pgx.Row) (*schedule_service.Overdue, error) {
+	var (
+		overdue     schedule_service.Overdue
+		studentName sql.NullString
+		branchId    sql.NullString
+		remindedAt  sql.NullString
+		resolvedAt  sql.NullString
+	)
+
+	err := row.Scan(&overdue.Id, &overdue.StudentId, &studentName, &overdue.GroupId, &overdue.GroupName, &branchId,
+		&overdue.Period, &overdue.AmountDue, &overdue.Paid, &overdue.Outstanding, &overdue.DueDate, &overdue.DaysLate,
+		&remindedAt, &resolvedAt, &overdue.OnHold)
+	if err != nil {
+		return nil, err
+	}
+
+	overdue.StudentName = studentName.String
+	overdue.BranchId = branchId.String
+	overdue.RemindedAt = remindedAt.String
+	overdue.ResolvedAt = resolvedAt.String
+
+	return &overdue, nil
+}
+
+func scanStudentHold(row pgx.Row) (*schedule_service.StudentHold, error) {
+	var (
+		hold       schedule_service.StudentHold
+		createdBy  sql.NullString
+		created_at sql.NullString
+		clearedAt  sql.NullString
+		clearedBy  sql.NullString
+		clearNote  sql.NullString
+	)
+
+	err := row.Scan(&hold.Id, &hold.StudentId, &hold.Reason, &createdBy, &created_at, &clearedAt, &clearedBy, &clearNote)
+	if err != nil {
+		return nil, err
+	}
+
+	hold.CreatedBy = createdBy.String
+	hold.CreatedAt = created_at.String
+	hold.ClearedAt = clearedAt.String
+	hold.ClearedBy = clearedBy.String
+	hold.ClearNote = clearNote.String
+
+	return &hold, nil
+}
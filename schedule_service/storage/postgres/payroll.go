@@ -0,0 +1,760 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/pkg/money"
+	"schedule_service/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// payrollStaff lists everyone who can be on the payroll, by staff type.
+const payrollStaff = `
+            SELECT 'teacher' AS staffType, id, fullname, branchId, salary, deleted_at FROM "teacher"
+            UNION ALL
+            SELECT 'support_teacher', id, fullname, branchId, salary, deleted_at FROM "support_teacher"
+            UNION ALL
+            SELECT 'administration', id, fullname, branchId, salary, deleted_at FROM "administration"
+            UNION ALL
+            SELECT 'manager', id, fullname, branchId, salary, deleted_at FROM "manager"`
+
+// payrollLines computes the payroll lines of run $1 for the month starting
+// on $2. A lesson counts once it has ended or its attendance was taken: it
+// pays the teacher it was delivered by, or the substitute who took it, and
+// its support teacher. Every student
+// present at a lesson counts for the per-student rate. Commissions on the
+// tuition collected in the month are added, net of clawbacks. Staff without a
+// pay rule for the month get their salary as the monthly fee.
+const payrollLines = `
+        WITH delivered AS (
+            SELECT COALESCE(sc.substituteTeacherId, sc.teacherId) AS teacherId,
+                sc.supportTeacherId,
+                (SELECT COUNT(*) FROM "lesson_attendance" la WHERE la.scheduleId = sc.id AND la.isPresent) AS present
+            FROM "schedule" sc
+            JOIN "journal" j ON j.id = sc.journalId
+            JOIN "group" g ON g.id = j.groupId
+            WHERE sc.deleted_at = 0
+              AND sc.date >= $2::date
+              AND sc.date < $2::date + INTERVAL '1 month'
+              AND ` + lessonDelivered + `
+        ),
+        lessons AS (
+            SELECT d.staffType, d.staffId, COUNT(*) AS lessonCount, SUM(d.present) AS studentCount
+            FROM (
+                SELECT 'teacher' AS staffType, teacherId AS staffId, present FROM delivered
+                UNION ALL
+                SELECT 'support_teacher', supportTeacherId, present FROM delivered
+            ) d
+            WHERE d.staffId IS NOT NULL
+            GROUP BY 1, 2
+        ),
+        adjustments AS (
+            SELECT staffType,
+                staffId,
+                COALESCE(SUM(amount) FILTER (WHERE kind = 'bonus'), 0) AS bonuses,
+                COALESCE(SUM(amount) FILTER (WHERE kind = 'penalty'), 0) AS penalties
+            FROM "payroll_adjustment"
+            WHERE period = $2::date AND deleted_at = 0
+            GROUP BY 1, 2
+        ),
+        commissions AS (
+            SELECT staffType, staffId, SUM(commission) AS commission
+            FROM (` + commissionEntries + `
+            ) ce
+            GROUP BY 1, 2
+        )
+        INSERT INTO "payroll_line" (
+            runId,
+            staffType,
+            staffId,
+            staffName,
+            branchId,
+            ruleId,
+            monthlyFee,
+            lessonCount,
+            perLesson,
+            lessonAmount,
+            studentCount,
+            perStudent,
+            studentAmount,
+            bonuses,
+            penalties,
+            commission,
+            total
+        )
+        SELECT $1, x.*, x.monthlyFee + x.lessonAmount + x.studentAmount + x.bonuses - x.penalties + x.commission
+        FROM (
+            SELECT st.staffType,
+                st.id,
+                COALESCE(st.fullname, ''),
+                st.branchId,
+                r.id,
+                COALESCE(r.monthlyFee, st.salary, 0) AS monthlyFee,
+                COALESCE(l.lessonCount, 0),
+                COALESCE(r.perLesson, 0),
+                COALESCE(l.lessonCount, 0) * COALESCE(r.perLesson, 0) AS lessonAmount,
+                COALESCE(l.studentCount, 0),
+                COALESCE(r.perStudent, 0),
+                COALESCE(l.studentCount, 0) * COALESCE(r.perStudent, 0) AS studentAmount,
+                COALESCE(a.bonuses, 0) AS bonuses,
+                COALESCE(a.penalties, 0) AS penalties,
+                COALESCE(cm.commission, 0) AS commission
+            FROM (` + payrollStaff + `
+            ) st
+            LEFT JOIN LATERAL (
+                SELECT pr.id, pr.monthlyFee, pr.perLesson, pr.perStudent
+                FROM "pay_rule" pr
+                WHERE pr.staffType = st.staffType
+                  AND pr.staffId = st.id
+                  AND pr.deleted_at = 0
+                  AND pr.validFrom <= $2::date
+                ORDER BY pr.validFrom DESC
+                LIMIT 1
+            ) r ON true
+            LEFT JOIN lessons l ON l.staffType = st.staffType AND l.staffId = st.id
+            LEFT JOIN adjustments a ON a.staffType = st.staffType AND a.staffId = st.id
+            LEFT JOIN commissions cm ON cm.staffType = st.staffType AND cm.staffId = st.id
+            WHERE st.deleted_at = 0 OR l.staffId IS NOT NULL OR a.staffId IS NOT NULL OR cm.staffId IS NOT NULL
+        ) x`
+
+const payRuleColumns = `
+            pr.id,
+            pr.staffType,
+            pr.staffId::text,
+            COALESCE(st.fullname, ''),
+            pr.monthlyFee::text,
+            pr.perLesson::text,
+            pr.perStudent::text,
+            pr.validFrom::text,
+            pr.created_at::text`
+
+const payrollAdjustmentColumns = `
+            pa.id,
+            pa.staffType,
+            pa.staffId::text,
+            COALESCE(st.fullname, ''),
+            to_char(pa.period, 'YYYY-MM'),
+            pa.kind,
+            pa.amount::text,
+            pa.reason,
+            pa.createdBy::text,
+            pa.created_at::text`
+
+const payrollRunColumns = `
+            r.id,
+            to_char(r.period, 'YYYY-MM'),
+            r.status,
+            r.createdBy::text,
+            r.created_at::text,
+            r.lockedBy::text,
+            r.lockedAt::text,
+            (SELECT COUNT(*) FROM "payroll_line" pl WHERE pl.runId = r.id),
+            (SELECT COALESCE(SUM(pl.total), 0) FROM "payroll_line" pl WHERE pl.runId = r.id)::text`
+
+const payrollLineColumns = `
+            staffType,
+            staffId::text,
+            staffName,
+            branchId::text,
+            ruleId::text,
+            monthlyFee::text,
+            lessonCount,
+            perLesson::text,
+            lessonAmount::text,
+            studentCount,
+            perStudent::text,
+            studentAmount::text,
+            bonuses::text,
+            penalties::text,
+            commission::text,
+            total::text`
+
+type payrollRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewPayrollRepo(db *pgxpool.Pool) storage.PayrollRepoI {
+	return &payrollRepo{
+		db: db,
+	}
+}
+
+// CreateRule implements storage.PayrollRepoI. A rule applies from the month
+// of validFrom until the next rule of the same staff member.
+func (p *payrollRepo) CreateRule(ctx context.Context, req *schedule_service.CreatePayRule) (*schedule_service.PayRule, error) {
+	id := uuid.NewString()
+
+	result, err := p.db.Exec(ctx, `
+        INSERT INTO "pay_rule" (
+            id,
+            staffType,
+            staffId,
+            monthlyFee,
+            perLesson,
+            perStudent,
+            validFrom
+        )
+        SELECT $1, st.staffType, st.id, $4, $5, $6,
+            COALESCE(date_trunc('month', NULLIF($7, '')::date)::date, date_trunc('month', NOW())::date)
+        FROM (`+payrollStaff+`
+        ) st
+        WHERE st.staffType = $2 AND st.id::text = $3 AND st.deleted_at = 0
+        `, id, req.StaffType, req.StaffId, req.MonthlyFee, req.PerLesson, req.PerStudent, req.ValidFrom)
+
+	if err != nil {
+		log.Println("error while creating pay rule in storage", err)
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("staff member not found")
+	}
+
+	rule, err := scanPayRule(p.db.QueryRow(ctx, `
+        SELECT `+payRuleColumns+`
+        FROM "pay_rule" pr
+        LEFT JOIN (`+payrollStaff+`
+        ) st ON st.staffType = pr.staffType AND st.id = pr.staffId
+        WHERE pr.id = $1`, id))
+	if err != nil {
+		log.Println("error while getting pay rule by id", err)
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// GetListRule implements storage.PayrollRepoI.
+func (p *payrollRepo) GetListRule(ctx context.Context, req *schedule_service.GetListPayRuleRequest) (*schedule_service.GetListPayRuleResponse, error) {
+	resp := &schedule_service.GetListPayRuleResponse{}
+
+	rows, err := p.db.Query(ctx, `
+        SELECT `+payRuleColumns+`
+        FROM "pay_rule" pr
+        LEFT JOIN (`+payrollStaff+`
+        ) st ON st.staffType = pr.staffType AND st.id = pr.staffId
+        WHERE pr.deleted_at = 0
+          AND ($1 = '' OR pr.staffType = $1)
+          AND ($2 = '' OR pr.staffId::text = $2)
+        ORDER BY pr.staffType, st.fullname, pr.validFrom DESC`, req.StaffType, req.StaffId)
+	if err != nil {
+		log.Println("error while getting all pay rules:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		rule, err := scanPayRule(rows)
+		if err != nil {
+			log.Println("error while scanning pay rules:", err)
+			return nil, err
+		}
+
+		resp.Rules = append(resp.Rules, rule)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// DeleteRule implements storage.PayrollRepoI. Runs already computed keep
+// their amounts.
+func (p *payrollRepo) DeleteRule(ctx context.Context, req *schedule_service.PayRulePrimaryKey) error {
+	_, err := p.db.Exec(ctx, `
+        UPDATE "pay_rule" SET
+            deleted_at = 1,
+            updated_at = NOW()
+        WHERE id = $1
+    `, req.Id)
+
+	if err != nil {
+		log.Println("error while deleting pay rule")
+		return err
+	}
+
+	return nil
+}
+
+// CreateAdjustment implements storage.PayrollRepoI. Bonuses and penalties
+// cannot be added to a month whose payroll is locked.
+func (p *payrollRepo) CreateAdjustment(ctx context.Context, req *schedule_service.CreatePayrollAdjustment) (*schedule_service.PayrollAdjustment, error) {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting payroll adjustment transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err = payrollPeriodOpen(ctx, tx, req.Period); err != nil {
+		return nil, err
+	}
+
+	id := uuid.NewString()
+
+	result, err := tx.Exec(ctx, `
+        INSERT INTO "payroll_adjustment" (
+            id,
+            staffType,
+            staffId,
+            period,
+            kind,
+            amount,
+            reason,
+            createdBy
+        )
+        SELECT $1, st.staffType, st.id, $4::date, $5, $6, $7, NULLIF($8, '')::uuid
+        FROM (`+payrollStaff+`
+        ) st
+        WHERE st.staffType = $2 AND st.id::text = $3
+        `, id, req.StaffType, req.StaffId, req.Period, req.Kind, req.Amount, req.Reason, req.CreatedBy)
+
+	if err != nil {
+		log.Println("error while creating payroll adjustment in storage", err)
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("staff member not found")
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing payroll adjustment", err)
+		return nil, err
+	}
+
+	adjustment, err := scanPayrollAdjustment(p.db.QueryRow(ctx, `
+        SELECT `+payrollAdjustmentColumns+`
+        FROM "payroll_adjustment" pa
+        LEFT JOIN (`+payrollStaff+`
+        ) st ON st.staffType = pa.staffType AND st.id = pa.staffId
+        WHERE pa.id = $1`, id))
+	if err != nil {
+		log.Println("error while getting payroll adjustment by id", err)
+		return nil, err
+	}
+
+	return adjustment, nil
+}
+
+// GetListAdjustment implements storage.PayrollRepoI.
+func (p *payrollRepo) GetListAdjustment(ctx context.Context, req *schedule_service.GetListPayrollAdjustmentRequest) (*schedule_service.GetListPayrollAdjustmentResponse, error) {
+	resp := &schedule_service.GetListPayrollAdjustmentResponse{}
+
+	rows, err := p.db.Query(ctx, `
+        SELECT `+payrollAdjustmentColumns+`
+        FROM "payroll_adjustment" pa
+        LEFT JOIN (`+payrollStaff+`
+        ) st ON st.staffType = pa.staffType AND st.id = pa.staffId
+        WHERE pa.deleted_at = 0
+          AND ($1 = '' OR pa.period = NULLIF($1, '')::date)
+          AND ($2 = '' OR pa.staffType = $2)
+          AND ($3 = '' OR pa.staffId::text = $3)
+        ORDER BY pa.period DESC, pa.created_at DESC`, req.Period, req.StaffType, req.StaffId)
+	if err != nil {
+		log.Println("error while getting all payroll adjustments:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		adjustment, err := scanPayrollAdjustment(rows)
+		if err != nil {
+			log.Println("error while scanning payroll adjustments:", err)
+			return nil, err
+		}
+
+		resp.Adjustments = append(resp.Adjustments, adjustment)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// DeleteAdjustment implements storage.PayrollRepoI.
+func (p *payrollRepo) DeleteAdjustment(ctx context.Context, req *schedule_service.PayrollAdjustmentPrimaryKey) error {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting payroll adjustment transaction", err)
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var period string
+
+	err = tx.QueryRow(ctx, `
+        SELECT period::text
+        FROM "payroll_adjustment"
+        WHERE id::text = $1 AND deleted_at = 0`, req.Id).Scan(&period)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errors.New("payroll adjustment not found")
+		}
+		log.Println("error while getting payroll adjustment", err)
+		return err
+	}
+
+	if err = payrollPeriodOpen(ctx, tx, period); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE "payroll_adjustment" SET
+            deleted_at = 1
+        WHERE id = $1`, req.Id)
+	if err != nil {
+		log.Println("error while deleting payroll adjustment")
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing payroll adjustment delete", err)
+		return err
+	}
+
+	return nil
+}
+
+// CreateRun implements storage.PayrollRepoI. There is one run per month.
+func (p *payrollRepo) CreateRun(ctx context.Context, req *schedule_service.CreatePayrollRun) (*schedule_service.PayrollRun, error) {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting payroll run transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	id := uuid.NewString()
+
+	result, err := tx.Exec(ctx, `
+        INSERT INTO "payroll_run" (
+            id,
+            period,
+            createdBy
+        ) VALUES (
+            $1, $2, NULLIF($3, '')::uuid
+        )
+        ON CONFLICT (period) DO NOTHING`, id, req.Period, req.CreatedBy)
+	if err != nil {
+		log.Println("error while creating payroll run in storage", err)
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("a payroll run for this month already exists")
+	}
+
+	if _, err = tx.Exec(ctx, payrollLines, id, req.Period); err != nil {
+		log.Println("error while computing payroll lines", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing payroll run", err)
+		return nil, err
+	}
+
+	return p.GetRun(ctx, &schedule_service.GetPayrollRunRequest{Id: id})
+}
+
+// RecalculateRun implements storage.PayrollRepoI. The lines of a draft run
+// are computed again from the current lessons, rules and adjustments.
+func (p *payrollRepo) RecalculateRun(ctx context.Context, req *schedule_service.PayrollRunPrimaryKey) (*schedule_service.PayrollRun, error) {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting payroll run transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var period, status string
+
+	err = tx.QueryRow(ctx, `
+        SELECT period::text, status
+        FROM "payroll_run"
+        WHERE id::text = $1
+        FOR UPDATE`, req.Id).Scan(&period, &status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("payroll run not found")
+		}
+		log.Println("error while locking payroll run", err)
+		return nil, err
+	}
+
+	if status == "locked" {
+		return nil, errors.New("payroll run is locked")
+	}
+
+	if _, err = tx.Exec(ctx, `DELETE FROM "payroll_line" WHERE runId = $1`, req.Id); err != nil {
+		log.Println("error while clearing payroll lines", err)
+		return nil, err
+	}
+
+	if _, err = tx.Exec(ctx, payrollLines, req.Id, period); err != nil {
+		log.Println("error while computing payroll lines", err)
+		return nil, err
+	}
+
+	if _, err = tx.Exec(ctx, `UPDATE "payroll_run" SET updated_at = NOW() WHERE id = $1`, req.Id); err != nil {
+		log.Println("error while updating payroll run", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing payroll run", err)
+		return nil, err
+	}
+
+	return p.GetRun(ctx, &schedule_service.GetPayrollRunRequest{Id: req.Id})
+}
+
+// LockRun implements storage.PayrollRepoI. A locked run no longer changes.
+func (p *payrollRepo) LockRun(ctx context.Context, req *schedule_service.LockPayrollRun) (*schedule_service.PayrollRun, error) {
+	result, err := p.db.Exec(ctx, `
+        UPDATE "payroll_run" SET
+            status = 'locked',
+            lockedBy = NULLIF($1, '')::uuid,
+            lockedAt = NOW(),
+            updated_at = NOW()
+        WHERE id::text = $2 AND status = 'draft'`, req.LockedBy, req.Id)
+	if err != nil {
+		log.Println("error while locking payroll run", err)
+		return nil, err
+	}
+
+	run, err := p.GetRun(ctx, &schedule_service.GetPayrollRunRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("payroll run is already locked")
+	}
+
+	return run, nil
+}
+
+// GetRun implements storage.PayrollRepoI. When a staff member is given only
+// their line is returned, and the run totals are theirs.
+func (p *payrollRepo) GetRun(ctx context.Context, req *schedule_service.GetPayrollRunRequest) (*schedule_service.PayrollRun, error) {
+	run, err := scanPayrollRun(p.db.QueryRow(ctx, `
+        SELECT `+payrollRunColumns+`
+        FROM "payroll_run" r
+        WHERE r.id::text = $1`, req.Id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("payroll run not found")
+		}
+		log.Println("error while getting payroll run by id", err)
+		return nil, err
+	}
+
+	rows, err := p.db.Query(ctx, `
+        SELECT `+payrollLineColumns+`
+        FROM "payroll_line"
+        WHERE runId = $1
+          AND ($2 = '' OR staffType = $2)
+          AND ($3 = '' OR staffId::text = $3)
+        ORDER BY staffType, staffName`, run.Id, req.StaffType, req.StaffId)
+	if err != nil {
+		log.Println("error while getting payroll lines:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var total int64
+
+	for rows.Next() {
+		line, err := scanPayrollLine(rows)
+		if err != nil {
+			log.Println("error while scanning payroll lines:", err)
+			return nil, err
+		}
+
+		amount, err := money.Large.Parse(line.Total)
+		if err != nil {
+			return nil, err
+		}
+		total += amount
+
+		run.Lines = append(run.Lines, line)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	if req.StaffType != "" || req.StaffId != "" {
+		run.StaffCount = int64(len(run.Lines))
+		run.Total = money.Format(total)
+	}
+
+	return run, nil
+}
+
+// GetListRun implements storage.PayrollRepoI.
+func (p *payrollRepo) GetListRun(ctx context.Context, req *schedule_service.GetListPayrollRunRequest) (*schedule_service.GetListPayrollRunResponse, error) {
+	resp := &schedule_service.GetListPayrollRunResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := p.db.Query(ctx, `
+        SELECT `+payrollRunColumns+`
+        FROM "payroll_run" r
+        WHERE ($1 = '' OR r.status = $1)
+        ORDER BY r.period DESC
+    `+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.Status)
+	if err != nil {
+		log.Println("error while getting all payroll runs:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		run, err := scanPayrollRun(rows)
+		if err != nil {
+			log.Println("error while scanning payroll runs:", err)
+			return nil, err
+		}
+
+		resp.Runs = append(resp.Runs, run)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// payrollPeriodOpen fails when the payroll of the month is locked. It holds
+// the run, if any, until the transaction ends so it cannot be locked meanwhile.
+func payrollPeriodOpen(ctx context.Context, tx pgx.Tx, period string) error {
+	var status string
+
+	err := tx.QueryRow(ctx, `
+        SELECT status
+        FROM "payroll_run"
+        WHERE period = $1::date
+        FOR SHARE`, period).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		log.Println("error while checking payroll run", err)
+		return err
+	}
+
+	if status == "locked" {
+		return errors.New("payroll for this month is locked")
+	}
+
+	return nil
+}
+
+func scanPayRule(row pgx.Row) (*schedule_service.PayRule, error) {
+	var (
+		rule       schedule_service.PayRule
+		created_at sql.NullString
+	)
+
+	err := row.Scan(&rule.Id, &rule.StaffType, &rule.StaffId, &rule.StaffName, &rule.MonthlyFee, &rule.PerLesson, &rule.PerStudent, &rule.ValidFrom, &created_at)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.CreatedAt = created_at.String
+
+	return &rule, nil
+}
+
+func scanPayrollAdjustment(row pgx.Row) (*schedule_service.PayrollAdjustment, error) {
+	var (
+		adjustment schedule_service.PayrollAdjustment
+		createdBy  sql.NullString
+		created_at sql.NullString
+	)
+
+	err := row.Scan(&adjustment.Id, &adjustment.StaffType, &adjustment.StaffId, &adjustment.StaffName, &adjustment.Period, &adjustment.Kind, &adjustment.Amount,
+		&adjustment.Reason, &createdBy, &created_at)
+	if err != nil {
+		return nil, err
+	}
+
+	adjustment.CreatedBy = createdBy.String
+	adjustment.CreatedAt = created_at.String
+
+	return &adjustment, nil
+}
+
+func scanPayrollRun(row pgx.Row) (*schedule_service.PayrollRun, error) {
+	var (
+		run        schedule_service.PayrollRun
+		createdBy  sql.NullString
+		created_at sql.NullString
+		lockedBy   sql.NullString
+		lockedAt   sql.NullString
+	)
+
+	err := row.Scan(&run.Id, &run.Period, &run.Status, &createdBy, &created_at, &lockedBy, &lockedAt, &run.StaffCount, &run.Total)
+	if err != nil {
+		return nil, err
+	}
+
+	run.CreatedBy = createdBy.String
+	run.CreatedAt = created_at.String
+	run.LockedBy = lockedBy.String
+	run.LockedAt = lockedAt.String
+
+	return &run, nil
+}
+
+func scanPayrollLine(row pgx.Row) (*schedule_service.PayrollLine, error) {
+	var (
+		line     schedule_service.PayrollLine
+		branchId sql.NullString
+		ruleId   sql.NullString
+	)
+
+	err := row.Scan(&line.StaffType, &line.StaffId, &line.StaffName, &branchId, &ruleId, &line.MonthlyFee, &line.LessonCount, &line.PerLesson, &line.LessonAmount,
+		&line.StudentCount, &line.PerStudent, &line.StudentAmount, &line.Bonuses, &line.Penalties, &line.Commission, &line.Total)
+	if err != nil {
+		return nil, err
+	}
+
+	line.BranchId = branchId.String
+	line.RuleId = ruleId.String
+
+	return &line, nil
+}
@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"math/rand"
+	"schedule_service/genproto/schedule_service"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// newTestJournal creates a journal of the group running from fromDate to
+// toDate.
+func newTestJournal(t *testing.T, db *pgxpool.Pool, groupId, fromDate, toDate string) string {
+	t.Helper()
+
+	id := uuid.NewString()
+	mustExec(t, db, `
+        INSERT INTO "journal" (id, fromDate, toDate, groupId, studentsCount)
+        VALUES ($1, $2, $3, $4, 0)
+    `, id, fromDate, toDate, groupId)
+
+	return id
+}
+
+func TestPayrollLinesFollowTheTeacherOfEachLesson(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	payroll := NewPayrollRepo(db)
+	groups := NewGroupRepo(db)
+	lessons := NewScheduleRepo(db)
+
+	// Runs are unique per month, so the test takes a month of its own long
+	// before any real payroll.
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	period := time.Date(1900+rng.Intn(100), time.Month(1+rng.Intn(12)), 1, 0, 0, 0, 0, time.UTC)
+	periodDate := period.Format("2006-01-02")
+	mustExec(t, db, `DELETE FROM "payroll_run" WHERE period = $1`, periodDate)
+
+	branchId := newTestBranch(t, db)
+	before := newTestTeacher(t, db, branchId)
+	after := newTestTeacher(t, db, branchId)
+	support := newTestSupportTeacher(t, db, branchId)
+	groupId := newTestGroup(t, db, branchId, before, 0)
+	studentId := newTestStudent(t, db, branchId)
+	journalId := newTestJournal(t, db, groupId, periodDate, period.AddDate(0, 1, -1).Format("2006-01-02"))
+
+	for _, teacherId := range []string{before, after} {
+		_, err := payroll.CreateRule(ctx, &schedule_service.CreatePayRule{
+			StaffType:  "teacher",
+			StaffId:    teacherId,
+			MonthlyFee: "0",
+			PerLesson:  "10",
+			PerStudent: "1",
+			ValidFrom:  periodDate,
+		})
+		if err != nil {
+			t.Fatalf("create rule: %v", err)
+		}
+	}
+
+	for day := 1; day <= 2; day++ {
+		lesson, err := lessons.Create(ctx, &schedule_service.CreateSchedule{
+			JournalId: journalId,
+			Date:      period.AddDate(0, 0, day).Format("2006-01-02"),
+			StartTime: "10:00",
+			EndTime:   "11:30",
+		})
+		if err != nil {
+			t.Fatalf("create lesson: %v", err)
+		}
+		mustExec(t, db, `INSERT INTO "lesson_attendance" (scheduleId, studentId) VALUES ($1, $2)`, lesson.Id, studentId)
+	}
+
+	// A lesson that has not ended and has no attendance is not delivered.
+	tomorrow := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+	upcoming, err := lessons.Create(ctx, &schedule_service.CreateSchedule{
+		JournalId: newTestJournal(t, db, groupId, tomorrow, tomorrow),
+		Date:      tomorrow,
+		StartTime: "10:00",
+		EndTime:   "11:30",
+	})
+	if err != nil {
+		t.Fatalf("create upcoming lesson: %v", err)
+	}
+
+	_, err = groups.Update(ctx, &schedule_service.UpdateGroup{
+		Id:                groupId,
+		TeacherId:         after,
+		SuppportTeacherId: support,
+		BranchId:          branchId,
+		Type:              "beginner",
+	})
+	if err != nil {
+		t.Fatalf("change teacher: %v", err)
+	}
+
+	run, err := payroll.CreateRun(ctx, &schedule_service.CreatePayrollRun{Period: periodDate})
+	if err != nil {
+		t.Fatalf("create run: %v", err)
+	}
+
+	lines := map[string]*schedule_service.PayrollLine{}
+	for _, line := range run.Lines {
+		lines[line.StaffId] = line
+	}
+
+	if line := lines[before]; line == nil || line.LessonCount != 2 || line.StudentCount != 2 || line.Total != "22.00" {
+		t.Errorf("previous teacher line = %v, want 2 lessons, 2 students and 22.00", line)
+	}
+	if line := lines[after]; line != nil && line.LessonCount != 0 {
+		t.Errorf("new teacher was paid for %d lessons taught before the change", line.LessonCount)
+	}
+	if line := lines[support]; line != nil && line.LessonCount != 0 {
+		t.Errorf("new support teacher was paid for %d lessons taught before the change", line.LessonCount)
+	}
+
+	count := func() int32 {
+		t.Helper()
+
+		resp, err := lessons.GetTeacherLessonCount(ctx, &schedule_service.TeacherLessonCountRequest{
+			TeacherId: after,
+			FromDate:  tomorrow,
+			ToDate:    tomorrow,
+		})
+		if err != nil {
+			t.Fatalf("count lessons: %v", err)
+		}
+		return resp.Lessons
+	}
+
+	if got := count(); got != 0 {
+		t.Errorf("lessons of the new teacher before the lesson ended = %d, want 0", got)
+	}
+
+	mustExec(t, db, `INSERT INTO "lesson_attendance" (scheduleId, studentId) VALUES ($1, $2)`, upcoming.Id, studentId)
+	if got := count(); got != 1 {
+		t.Errorf("lessons of the new teacher once attendance was taken = %d, want 1", got)
+	}
+}
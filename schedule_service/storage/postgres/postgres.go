@@ -16,11 +16,26 @@ type Store struct {
 	event_student  storage.EventStudentRepoI
 	event          storage.EventRepoI
 	group          storage.GroupRepoI
+	groupStudent   storage.GroupStudentRepoI
 	journal        storage.JournalRepoI
 	schedule       storage.ScheduleRepoI
 	studentTask    storage.StudentTaskRepoI
 	task           storage.TaskRepoI
 	studentPayment storage.StudentPaymentRepoI
+	timetable      storage.TimetableRepoI
+	eventFeedback  storage.EventFeedbackRepoI
+	tuition        storage.TuitionRepoI
+	discount       storage.DiscountRepoI
+	overdue        storage.OverdueRepoI
+	document       storage.DocumentRepoI
+	onlinePayment  storage.OnlinePaymentRepoI
+	cashShift      storage.CashShiftRepoI
+	financeReport  storage.FinanceReportRepoI
+	payroll        storage.PayrollRepoI
+	expense        storage.ExpenseRepoI
+	commission     storage.CommissionRepoI
+	mockTest       storage.MockTestRepoI
+	room           storage.RoomRepoI
 }
 
 func NewPostgres(ctx context.Context, cfg config.Config) (storage.StorageI, error) {
@@ -87,6 +102,15 @@ func (s *Store) Group() storage.GroupRepoI {
 	return s.group
 }
 
+// GroupStudent implements storage.StorageI.
+func (s *Store) GroupStudent() storage.GroupStudentRepoI {
+	if s.groupStudent == nil {
+		s.groupStudent = NewGroupStudentRepo(s.db)
+	}
+
+	return s.groupStudent
+}
+
 // Journal implements storage.StorageI.
 func (s *Store) Journal() storage.JournalRepoI {
 	if s.journal == nil {
@@ -131,3 +155,129 @@ func (s *Store) StudentPayment() storage.StudentPaymentRepoI {
 
 	return s.studentPayment
 }
+
+// Timetable implements storage.StorageI.
+func (s *Store) Timetable() storage.TimetableRepoI {
+	if s.timetable == nil {
+		s.timetable = NewTimetableRepo(s.db)
+	}
+
+	return s.timetable
+}
+
+// EventFeedback implements storage.StorageI.
+func (s *Store) EventFeedback() storage.EventFeedbackRepoI {
+	if s.eventFeedback == nil {
+		s.eventFeedback = NewEventFeedbackRepo(s.db)
+	}
+
+	return s.eventFeedback
+}
+
+// Tuition implements storage.StorageI.
+func (s *Store) Tuition() storage.TuitionRepoI {
+	if s.tuition == nil {
+		s.tuition = NewTuitionRepo(s.db)
+	}
+
+	return s.tuition
+}
+
+// Discount implements storage.StorageI.
+func (s *Store) Discount() storage.DiscountRepoI {
+	if s.discount == nil {
+		s.discount = NewDiscountRepo(s.db)
+	}
+
+	return s.discount
+}
+
+// Overdue implements storage.StorageI.
+func (s *Store) Overdue() storage.OverdueRepoI {
+	if s.overdue == nil {
+		s.overdue = NewOverdueRepo(s.db)
+	}
+
+	return s.overdue
+}
+
+// Document implements storage.StorageI.
+func (s *Store) Document() storage.DocumentRepoI {
+	if s.document == nil {
+		s.document = NewDocumentRepo(s.db)
+	}
+
+	return s.document
+}
+
+// OnlinePayment implements storage.StorageI.
+func (s *Store) OnlinePayment() storage.OnlinePaymentRepoI {
+	if s.onlinePayment == nil {
+		s.onlinePayment = NewOnlinePaymentRepo(s.db)
+	}
+
+	return s.onlinePayment
+}
+
+// CashShift implements storage.StorageI.
+func (s *Store) CashShift() storage.CashShiftRepoI {
+	if s.cashShift == nil {
+		s.cashShift = NewCashShiftRepo(s.db)
+	}
+
+	return s.cashShift
+}
+
+// FinanceReport implements storage.StorageI.
+func (s *Store) FinanceReport() storage.FinanceReportRepoI {
+	if s.financeReport == nil {
+		s.financeReport = NewFinanceReportRepo(s.db)
+	}
+
+	return s.financeReport
+}
+
+// Payroll implements storage.StorageI.
+func (s *Store) Payroll() storage.PayrollRepoI {
+	if s.payroll == nil {
+		s.payroll = NewPayrollRepo(s.db)
+	}
+
+	return s.payroll
+}
+
+// Expense implements storage.StorageI.
+func (s *Store) Expense() storage.ExpenseRepoI {
+	if s.expense == nil {
+		s.expense = NewExpenseRepo(s.db)
+	}
+
+	return s.expense
+}
+
+// Commission implements storage.StorageI.
+func (s *Store) Commission() storage.CommissionRepoI {
+	if s.commission == nil {
+		s.commission = NewCommissionRepo(s.db)
+	}
+
+	return s.commission
+}
+
+// MockTest implements storage.StorageI.
+func (s *Store) MockTest() storage.MockTestRepoI {
+	if s.mockTest == nil {
+		s.mockTest = NewMockTestRepo(s.db)
+	}
+
+	return s.mockTest
+}
+
+// Room implements storage.StorageI.
+func (s *Store) Room() storage.RoomRepoI {
+	if s.room == nil {
+		s.room = NewRoomRepo(s.db)
+	}
+
+	return s.room
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// testDB connects to the database in POSTGRES_TEST_URL, which must have all
+// migrations applied. Tests that need a database are skipped without it.
+func testDB(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	url := os.Getenv("POSTGRES_TEST_URL")
+	if url == "" {
+		t.Skip("POSTGRES_TEST_URL is not set")
+	}
+
+	db, err := pgxpool.Connect(context.Background(), url)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(db.Close)
+
+	return db
+}
+
+// mustExec runs a fixture statement.
+func mustExec(t *testing.T, db *pgxpool.Pool, sql string, args ...interface{}) {
+	t.Helper()
+
+	if _, err := db.Exec(context.Background(), sql, args...); err != nil {
+		t.Fatalf("fixture: %v", err)
+	}
+}
+
+// uniquePhone returns a phone number no other fixture uses.
+func uniquePhone() string {
+	return "+" + uuid.NewString()[:18]
+}
+
+func newTestBranch(t *testing.T, db *pgxpool.Pool) string {
+	t.Helper()
+
+	id := uuid.NewString()
+	mustExec(t, db, `INSERT INTO "branch" (id, name, phone) VALUES ($1, 'test branch', $2)`, id, uniquePhone())
+
+	return id
+}
+
+func newTestTeacher(t *testing.T, db *pgxpool.Pool, branchId string) string {
+	t.Helper()
+
+	id := uuid.NewString()
+	mustExec(t, db, `
+        INSERT INTO "teacher" (id, login, fullname, phone, password, branchId)
+        VALUES ($1, $1, 'test teacher', $2, 'secret', $3)
+    `, id, uniquePhone(), branchId)
+
+	return id
+}
+
+func newTestSupportTeacher(t *testing.T, db *pgxpool.Pool, branchId string) string {
+	t.Helper()
+
+	id := uuid.NewString()
+	mustExec(t, db, `
+        INSERT INTO "support_teacher" (id, login, fullname, phone, password, branchId)
+        VALUES ($1, $1, 'test support teacher', $2, 'secret', $3)
+    `, id, uniquePhone(), branchId)
+
+	return id
+}
+
+// newTestGroup creates an active group; a zero capacity leaves it unlimited.
+func newTestGroup(t *testing.T, db *pgxpool.Pool, branchId, teacherId string, capacity int32) string {
+	t.Helper()
+
+	id := uuid.NewString()
+	mustExec(t, db, `
+        INSERT INTO "group" (id, name, teacherId, branchId, type, capacity)
+        VALUES ($1, $1, NULLIF($2, '')::uuid, $3, 'beginner', NULLIF($4, 0))
+    `, id, teacherId, branchId, capacity)
+
+	return id
+}
+
+func newTestStudent(t *testing.T, db *pgxpool.Pool, branchId string) string {
+	t.Helper()
+
+	id := uuid.NewString()
+	mustExec(t, db, `
+        INSERT INTO "student" (id, login, fullname, phone, password, branchId)
+        VALUES ($1, $1, 'test student', $2, 'secret', $3)
+    `, id, uniquePhone(), branchId)
+
+	return id
+}
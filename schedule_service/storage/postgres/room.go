@@ -0,0 +1,433 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/storage"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const roomColumns = `
+            r.id,
+            r.branchId::text,
+            r.name,
+            r.capacity,
+            to_char(r.openTime, 'HH24:MI'),
+            to_char(r.closeTime, 'HH24:MI'),
+            COALESCE(r.created_at::text, ''),
+            COALESCE(r.updated_at::text, '')`
+
+// roomBookings lists the lessons and events that have a room, with the seats
+// they need: the group's capacity, or its member count when it has none, and
+// the event's capacity, or its registrations when it has none.
+const roomBookings = `
+            SELECT 'lesson' AS kind, s.id, s.roomId, s.date, s.startTime, s.endTime,
+                COALESCE(g.capacity, ` + groupStudentsCount + `) AS seats, g.branchId
+            FROM "schedule" s
+            JOIN "journal" j ON j.id = s.journalId
+            JOIN "group" g ON g.id = j.groupId
+            WHERE s.deleted_at = 0 AND s.roomId IS NOT NULL
+            UNION ALL
+            SELECT 'event', ev.id, ev.roomId, ev.date, ev.startTime, ev.endTime,
+                COALESCE(ev.capacity, (
+                    SELECT COUNT(*) FROM "event_student" es
+                    WHERE es.eventId = ev.id AND es.deleted_at = 0 AND es.status = 'registered'
+                )::int), ev.branchId
+            FROM "event" ev
+            WHERE ev.deleted_at = 0 AND ev.roomId IS NOT NULL`
+
+type roomRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewRoomRepo(db *pgxpool.Pool) storage.RoomRepoI {
+	return &roomRepo{
+		db: db,
+	}
+}
+
+// Create implements storage.RoomRepoI. Rooms are open from 08:00 to 21:00
+// unless told otherwise.
+func (r *roomRepo) Create(ctx context.Context, req *schedule_service.CreateRoom) (*schedule_service.Room, error) {
+	id := uuid.NewString()
+
+	_, err := r.db.Exec(ctx, `
+        INSERT INTO "room" (
+            id,
+            branchId,
+            name,
+            capacity,
+            openTime,
+            closeTime
+        ) VALUES (
+            $1, $2, $3, $4, COALESCE(NULLIF($5, '')::time, '08:00'), COALESCE(NULLIF($6, '')::time, '21:00')
+        )`, id, req.BranchId, req.Name, req.Capacity, req.OpenTime, req.CloseTime)
+
+	if err != nil {
+		log.Println("error while creating room in storage", err)
+		return nil, err
+	}
+
+	return r.GetByID(ctx, &schedule_service.RoomPrimaryKey{Id: id})
+}
+
+// GetByID implements storage.RoomRepoI.
+func (r *roomRepo) GetByID(ctx context.Context, req *schedule_service.RoomPrimaryKey) (*schedule_service.Room, error) {
+	resp, err := scanRoom(r.db.QueryRow(ctx, `
+        SELECT `+roomColumns+`
+        FROM "room" r
+        WHERE r.id::text = $1 AND r.deleted_at = 0`, req.Id))
+
+	if err != nil {
+		log.Println("error while getting room by id", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetList implements storage.RoomRepoI.
+func (r *roomRepo) GetList(ctx context.Context, req *schedule_service.GetListRoomRequest) (*schedule_service.GetListRoomResponse, error) {
+	resp := &schedule_service.GetListRoomResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := r.db.Query(ctx, `
+        SELECT `+roomColumns+`
+        FROM "room" r
+        WHERE r.deleted_at = 0
+          AND ($1 = '' OR r.branchId::text = $1)
+          AND ($2 = '' OR r.name ILIKE '%' || $2 || '%')
+        ORDER BY r.name`+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.BranchId, req.Search)
+
+	if err != nil {
+		log.Println("error while getting all rooms:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		room, err := scanRoom(rows)
+		if err != nil {
+			log.Println("error while scanning rooms:", err)
+			return nil, err
+		}
+
+		resp.Rooms = append(resp.Rooms, room)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// Update implements storage.RoomRepoI. The room's upcoming lessons and
+// events must still fit its seats and opening hours.
+func (r *roomRepo) Update(ctx context.Context, req *schedule_service.UpdateRoom) (*schedule_service.Room, error) {
+	var misfit bool
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+        UPDATE "room" SET
+            name = $1,
+            capacity = $2,
+            openTime = COALESCE(NULLIF($3, '')::time, openTime),
+            closeTime = COALESCE(NULLIF($4, '')::time, closeTime),
+            updated_at = NOW()
+        WHERE id::text = $5 AND deleted_at = 0`, req.Name, req.Capacity, req.OpenTime, req.CloseTime, req.Id)
+
+	if err != nil {
+		log.Println("error while updating room in storage", err)
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("room not found")
+	}
+
+	err = tx.QueryRow(ctx, `
+        SELECT EXISTS (
+            SELECT 1
+            FROM (`+roomBookings+`
+            ) b
+            JOIN "room" r ON r.id = b.roomId
+            WHERE r.id::text = $1
+              AND b.date >= CURRENT_DATE
+              AND (b.seats > r.capacity OR b.startTime < r.openTime OR b.endTime > r.closeTime)
+        )`, req.Id).Scan(&misfit)
+
+	if err != nil {
+		log.Println("error while checking room bookings", err)
+		return nil, err
+	}
+
+	if misfit {
+		return nil, errors.New("room has upcoming lessons or events that would no longer fit")
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing room", err)
+		return nil, err
+	}
+
+	return r.GetByID(ctx, &schedule_service.RoomPrimaryKey{Id: req.Id})
+}
+
+// Delete implements storage.RoomRepoI. A room with upcoming lessons or
+// events cannot be deleted.
+func (r *roomRepo) Delete(ctx context.Context, req *schedule_service.RoomPrimaryKey) error {
+	result, err := r.db.Exec(ctx, `
+        UPDATE "room" SET
+            deleted_at = 1,
+            updated_at = NOW()
+        WHERE id::text = $1 AND deleted_at = 0
+          AND NOT EXISTS (
+            SELECT 1 FROM (`+roomBookings+`
+            ) b
+            WHERE b.roomId = "room".id AND b.date >= CURRENT_DATE
+          )`, req.Id)
+
+	if err != nil {
+		log.Println("error while deleting room", err)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return errors.New("room not found or has upcoming lessons or events")
+	}
+
+	return nil
+}
+
+// GetUtilization implements storage.RoomRepoI. A room's week is its opening
+// hours on all seven days; utilization is the share of it booked and seat
+// fill the share of seats the bookings need.
+func (r *roomRepo) GetUtilization(ctx context.Context, req *schedule_service.RoomUtilizationRequest) (*schedule_service.RoomUtilizationReport, error) {
+	weekStart, err := time.Parse("2006-01-02", req.WeekStart)
+	if err != nil {
+		return nil, fmt.Errorf("invalid weekStart: %s", req.WeekStart)
+	}
+
+	resp := &schedule_service.RoomUtilizationReport{
+		BranchId:  req.BranchId,
+		WeekStart: req.WeekStart,
+		WeekEnd:   weekStart.AddDate(0, 0, 6).Format("2006-01-02"),
+	}
+
+	rows, err := r.db.Query(ctx, `
+        SELECT
+            r.id,
+            r.name,
+            r.capacity,
+            (EXTRACT(EPOCH FROM (r.closeTime - r.openTime)) / 60 * 7)::int,
+            COUNT(b.id) FILTER (WHERE b.kind = 'lesson')::int,
+            COUNT(b.id) FILTER (WHERE b.kind = 'event')::int,
+            COALESCE(SUM(EXTRACT(EPOCH FROM (b.endTime - b.startTime)) / 60), 0)::int,
+            COALESCE(SUM(b.seats), 0)::int
+        FROM "room" r
+        LEFT JOIN (`+roomBookings+`
+        ) b ON b.roomId = r.id AND b.date BETWEEN $2::date AND $3::date AND b.endTime IS NOT NULL
+        WHERE r.branchId::text = $1 AND r.deleted_at = 0
+        GROUP BY r.id, r.name, r.capacity, r.openTime, r.closeTime
+        ORDER BY r.name`, req.BranchId, resp.WeekStart, resp.WeekEnd)
+
+	if err != nil {
+		log.Println("error while getting room utilization:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			room  schedule_service.RoomUtilization
+			seats int32
+		)
+		err = rows.Scan(&room.RoomId, &room.Name, &room.Capacity, &room.OpenMinutes, &room.Lessons, &room.Events, &room.BookedMinutes, &seats)
+		if err != nil {
+			log.Println("error while scanning room utilization:", err)
+			return nil, err
+		}
+
+		room.Utilization = percentOf(room.BookedMinutes, room.OpenMinutes)
+		room.SeatFill = percentOf(seats, room.Capacity*(room.Lessons+room.Events))
+		resp.Rooms = append(resp.Rooms, &room)
+
+		resp.BookedMinutes += room.BookedMinutes
+		resp.OpenMinutes += room.OpenMinutes
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Utilization = percentOf(resp.BookedMinutes, resp.OpenMinutes)
+
+	return resp, nil
+}
+
+// checkRoomBooking checks the lesson or event just written in tx against its
+// room: the room must be in the same branch, seat everyone, be open for the
+// whole booking and not be booked by anything else at the same time. The
+// room row is locked so that two bookings cannot race for the same slot.
+func checkRoomBooking(ctx context.Context, tx pgx.Tx, kind, id string) error {
+	var (
+		roomId, date, start, end, branchId string
+		seats                              int32
+		name, roomBranchId, opens, closes  string
+		capacity                           int32
+	)
+
+	err := tx.QueryRow(ctx, `
+        SELECT b.roomId::text, b.date::text, b.startTime::text, COALESCE(b.endTime::text, ''), b.seats, COALESCE(b.branchId::text, '')
+        FROM (`+roomBookings+`
+        ) b
+        WHERE b.kind = $1 AND b.id::text = $2`, kind, id).Scan(&roomId, &date, &start, &end, &seats, &branchId)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		log.Println("error while getting room booking", err)
+		return err
+	}
+
+	if end == "" {
+		return fmt.Errorf("%s in a room needs an endTime", kind)
+	}
+	if end <= start {
+		return errors.New("endTime must be after startTime")
+	}
+
+	err = tx.QueryRow(ctx, `
+        SELECT name, branchId::text, capacity, openTime::text, closeTime::text
+        FROM "room"
+        WHERE id::text = $1 AND deleted_at = 0
+        FOR UPDATE`, roomId).Scan(&name, &roomBranchId, &capacity, &opens, &closes)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return errors.New("room not found")
+	}
+	if err != nil {
+		log.Println("error while locking room", err)
+		return err
+	}
+
+	if roomBranchId != branchId {
+		return fmt.Errorf("room %s is in another branch", name)
+	}
+	if seats > capacity {
+		return fmt.Errorf("room %s has %d seats but %d are needed", name, capacity, seats)
+	}
+	if start < opens || end > closes {
+		return fmt.Errorf("room %s is open from %s to %s", name, opens[:5], closes[:5])
+	}
+
+	var otherKind, otherStart, otherEnd string
+	err = tx.QueryRow(ctx, `
+        SELECT o.kind, o.startTime::text, o.endTime::text
+        FROM (`+roomBookings+`
+        ) o
+        WHERE o.roomId::text = $1
+          AND o.date = $2::date
+          AND o.startTime < $4::time
+          AND o.endTime > $3::time
+          AND NOT (o.kind = $5 AND o.id::text = $6)
+        ORDER BY o.startTime
+        LIMIT 1`, roomId, date, start, end, kind, id).Scan(&otherKind, &otherStart, &otherEnd)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		log.Println("error while checking room bookings", err)
+		return err
+	}
+
+	return fmt.Errorf("room %s is already booked from %s to %s by another %s", name, otherStart[:5], otherEnd[:5], otherKind)
+}
+
+// checkLessonRooms checks the room bookings of lessons inserted in tx. rows
+// lists the id, date and whether a room was given of each lesson; the number
+// of lessons is returned.
+func checkLessonRooms(ctx context.Context, tx pgx.Tx, rows pgx.Rows) (int, error) {
+	type lesson struct {
+		id, date string
+	}
+
+	var (
+		count  int
+		booked []lesson
+	)
+	for rows.Next() {
+		var (
+			l       lesson
+			hasRoom bool
+		)
+		if err := rows.Scan(&l.id, &l.date, &hasRoom); err != nil {
+			rows.Close()
+			log.Println("error while scanning inserted lessons", err)
+			return 0, err
+		}
+
+		count++
+		if hasRoom {
+			booked = append(booked, l)
+		}
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		log.Println("error while inserting lessons", err)
+		return 0, err
+	}
+
+	for _, l := range booked {
+		if err := checkRoomBooking(ctx, tx, "lesson", l.id); err != nil {
+			return 0, fmt.Errorf("lesson on %s: %w", l.date, err)
+		}
+	}
+
+	return count, nil
+}
+
+// percentOf is part as a percentage of whole, to two decimals.
+func percentOf(part, whole int32) float32 {
+	if whole == 0 {
+		return 0
+	}
+
+	return float32(math.Round(float64(part)*10000/float64(whole)) / 100)
+}
+
+func scanRoom(row pgx.Row) (*schedule_service.Room, error) {
+	var room schedule_service.Room
+
+	err := row.Scan(&room.Id, &room.BranchId, &room.Name, &room.Capacity, &room.OpenTime, &room.CloseTime, &room.CreatedAt, &room.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &room, nil
+}
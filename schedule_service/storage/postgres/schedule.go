@@ -3,15 +3,26 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"schedule_service/genproto/schedule_service"
 	"schedule_service/storage"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
+// lessonDelivered is true once the lesson aliased as sc of the group aliased
+// as g has ended in its branch's timezone, or once attendance was taken.
+const lessonDelivered = `(
+                ((sc.date + COALESCE(sc.endTime, '23:59')) AT TIME ZONE COALESCE(
+                    (SELECT bs.timezone FROM "branch_setting" bs WHERE bs.branchId = g.branchId), 'Asia/Tashkent'
+                )) <= NOW()
+                OR EXISTS (SELECT 1 FROM "lesson_attendance" la WHERE la.scheduleId = sc.id)
+            )`
+
 type scheduleRepo struct {
 	db *pgxpool.Pool
 }
@@ -22,27 +33,53 @@ func NewScheduleRepo(db *pgxpool.Pool) storage.ScheduleRepoI {
 	}
 }
 
-// Create implements storage.ScheduleRepoI.
+// Create implements storage.ScheduleRepoI. A lesson given a room must fit
+// in it.
 func (s *scheduleRepo) Create(ctx context.Context, req *schedule_service.CreateSchedule) (*schedule_service.GetSchedule, error) {
 	id := uuid.NewString()
 
-	_, err := s.db.Exec(ctx, `
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting schedule transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
         INSERT INTO "schedule" (
             id,
             journalId,
             date,
             startTime,
             endTime,
-            lesson
-        ) VALUES (
-            $1, $2, $3, $4, $5, $6
-        )`, id, req.JournalId, req.Date, req.StartTime, req.EndTime, req.Lesson)
+            lesson,
+            roomId,
+            teacherId,
+            supportTeacherId
+        )
+        SELECT $1, $2, $3::date, $4::time, $5::time, $6, NULLIF($7, '')::uuid, g.teacherId, g.supportTeacherId
+        FROM "journal" j
+        LEFT JOIN "group" g ON g.id = j.groupId
+        WHERE j.id = $2`, id, req.JournalId, req.Date, req.StartTime, req.EndTime, req.Lesson, req.RoomId)
 
 	if err != nil {
 		log.Println("error while creating schedule in storage", err)
 		return nil, err
 	}
 
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("journal not found")
+	}
+
+	if err = checkRoomBooking(ctx, tx, "lesson", id); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing schedule", err)
+		return nil, err
+	}
+
 	schedule, err := s.GetByID(ctx, &schedule_service.SchedulePrimaryKey{Id: id})
 	if err != nil {
 		log.Println("error while getting schedule by id after creating", err)
@@ -56,8 +93,10 @@ func (s *scheduleRepo) GetByID(ctx context.Context, req *schedule_service.Schedu
 	resp := &schedule_service.GetSchedule{}
 
 	var (
-		created_at sql.NullString
-		updated_at sql.NullString
+		substituteTeacherId sql.NullString
+		roomId              sql.NullString
+		created_at          sql.NullString
+		updated_at          sql.NullString
 	)
 
 	err := s.db.QueryRow(ctx, `
@@ -67,16 +106,20 @@ func (s *scheduleRepo) GetByID(ctx context.Context, req *schedule_service.Schedu
             startTime,
             endTime,
             lesson,
+            substituteTeacherId,
+            roomId,
             created_at,
             updated_at
             FROM "schedule"
-        WHERE id=$1`, req.Id).Scan(&resp.Id, &resp.JournalId, &resp.Date, &resp.StartTime, &resp.EndTime, &resp.Lesson, &created_at, &updated_at)
+        WHERE id=$1`, req.Id).Scan(&resp.Id, &resp.JournalId, &resp.Date, &resp.StartTime, &resp.EndTime, &resp.Lesson, &substituteTeacherId, &roomId, &created_at, &updated_at)
 
 	if err != nil {
 		log.Println("error while getting schedule by id", err)
 		return nil, err
 	}
 
+	resp.SubstituteTeacherId = substituteTeacherId.String
+	resp.RoomId = roomId.String
 	resp.CreatedAt = created_at.String
 	resp.UpdatedAt = updated_at.String
 
@@ -87,9 +130,11 @@ func (s *scheduleRepo) GetByID(ctx context.Context, req *schedule_service.Schedu
 func (s *scheduleRepo) GetList(ctx context.Context, req *schedule_service.GetListScheduleRequest) (*schedule_service.GetListScheduleResponse, error) {
 	resp := &schedule_service.GetListScheduleResponse{}
 	var (
-		filter     string
-		created_at sql.NullString
-		updated_at sql.NullString
+		filter              string
+		substituteTeacherId sql.NullString
+		roomId              sql.NullString
+		created_at          sql.NullString
+		updated_at          sql.NullString
 	)
 	offset := (req.Page - 1) * req.Limit
 
@@ -107,10 +152,17 @@ func (s *scheduleRepo) GetList(ctx context.Context, req *schedule_service.GetLis
             startTime,
             endTime,
             lesson,
+            substituteTeacherId,
+            roomId,
             created_at,
             updated_at
         FROM "schedule" WHERE deleted_at=0
-    `+filter)
+          AND ($1 = '' OR journalId IN (
+            SELECT j.id FROM "journal" j
+            JOIN "group_student" gs ON gs.groupId = j.groupId AND gs.deleted_at = 0
+            WHERE gs.studentId::text = $1
+          ))
+    `+filter, req.StudentId)
 
 	if err != nil {
 		log.Println("error while getting all schedules:", err)
@@ -124,12 +176,14 @@ func (s *scheduleRepo) GetList(ctx context.Context, req *schedule_service.GetLis
 	for rows.Next() {
 		var schedule schedule_service.Schedule
 		count++
-		err = rows.Scan(&schedule.Id, &schedule.JournalId, &schedule.Date, &schedule.StartTime, &schedule.EndTime, &schedule.Lesson, &created_at, &updated_at)
+		err = rows.Scan(&schedule.Id, &schedule.JournalId, &schedule.Date, &schedule.StartTime, &schedule.EndTime, &schedule.Lesson, &substituteTeacherId, &roomId, &created_at, &updated_at)
 
 		if err != nil {
 			log.Println("error while scanning schedules:", err)
 			return nil, err
 		}
+		schedule.SubstituteTeacherId = substituteTeacherId.String
+		schedule.RoomId = roomId.String
 		schedule.CreatedAt = created_at.String
 		schedule.UpdatedAt = updated_at.String
 
@@ -146,24 +200,41 @@ func (s *scheduleRepo) GetList(ctx context.Context, req *schedule_service.GetLis
 	return resp, nil
 }
 
-// Update implements storage.ScheduleRepoI.
+// Update implements storage.ScheduleRepoI. A lesson given a room must fit
+// in it.
 func (s *scheduleRepo) Update(ctx context.Context, req *schedule_service.UpdateSchedule) (*schedule_service.GetSchedule, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting schedule transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
 
-	_, err := s.db.Exec(ctx, `
+	_, err = tx.Exec(ctx, `
         UPDATE "schedule" SET
             journalId=$1,
             date=$2,
             startTime=$3,
             endTime=$4,
             lesson=$5,
+            roomId=NULLIF($6, '')::uuid,
             updated_at = NOW()
-        WHERE id = $6`, req.JournalId, req.Date, req.StartTime, req.EndTime, req.Lesson, req.Id)
+        WHERE id = $7`, req.JournalId, req.Date, req.StartTime, req.EndTime, req.Lesson, req.RoomId, req.Id)
 
 	if err != nil {
 		log.Println("error while updating schedule in storage", err)
 		return nil, err
 	}
 
+	if err = checkRoomBooking(ctx, tx, "lesson", req.Id); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing schedule", err)
+		return nil, err
+	}
+
 	schedule, err := s.GetByID(ctx, &schedule_service.SchedulePrimaryKey{Id: req.Id})
 	if err != nil {
 		log.Println("error while getting updated schedule by id", err)
@@ -193,23 +264,29 @@ func (s *scheduleRepo) Delete(ctx context.Context, req *schedule_service.Schedul
 func (s *scheduleRepo) GetScheduleForWeek(ctx context.Context, teacherId string, weekStartDate, weekEndDate string) (*schedule_service.GetListScheduleResponse, error) {
 	resp := &schedule_service.GetListScheduleResponse{}
 	var (
-		createdAt sql.NullString
-		updatedAt sql.NullString
+		substituteTeacherId sql.NullString
+		roomId              sql.NullString
+		createdAt           sql.NullString
+		updatedAt           sql.NullString
 	)
 
 	query := `
-		SELECT id,
-			   journalId,
-			   date,
-			   startTime,
-			   endTime,
-			   lesson,
-			   created_at,
-			   updated_at
-		FROM "schedule"
-		WHERE date BETWEEN $1 AND $2
-		AND (teacherId = $3 OR supportTeacherId = $3)
-		AND deleted_at = 0
+		SELECT s.id,
+			   s.journalId,
+			   s.date,
+			   s.startTime,
+			   s.endTime,
+			   s.lesson,
+			   s.substituteTeacherId,
+			   s.roomId,
+			   s.created_at,
+			   s.updated_at
+		FROM "schedule" s
+		JOIN "journal" j ON j.id = s.journalId
+		JOIN "group" g ON g.id = j.groupId
+		WHERE s.date BETWEEN $1 AND $2
+		AND ($3 = '' OR COALESCE(s.substituteTeacherId, g.teacherId)::text = $3 OR g.supportTeacherId::text = $3)
+		AND s.deleted_at = 0
 	`
 	rows, err := s.db.Query(ctx, query, weekStartDate, weekEndDate, teacherId)
 	if err != nil {
@@ -222,11 +299,13 @@ func (s *scheduleRepo) GetScheduleForWeek(ctx context.Context, teacherId string,
 	for rows.Next() {
 		var schedule schedule_service.Schedule
 		count++
-		err = rows.Scan(&schedule.Id, &schedule.JournalId, &schedule.Date, &schedule.StartTime, &schedule.EndTime, &schedule.Lesson, &createdAt, &updatedAt)
+		err = rows.Scan(&schedule.Id, &schedule.JournalId, &schedule.Date, &schedule.StartTime, &schedule.EndTime, &schedule.Lesson, &substituteTeacherId, &roomId, &createdAt, &updatedAt)
 		if err != nil {
 			log.Printf("Error while scanning schedules: %v", err)
 			return nil, err
 		}
+		schedule.SubstituteTeacherId = substituteTeacherId.String
+		schedule.RoomId = roomId.String
 		schedule.CreatedAt = createdAt.String
 		schedule.UpdatedAt = updatedAt.String
 		resp.Schedules = append(resp.Schedules, &schedule)
@@ -246,23 +325,29 @@ func (s *scheduleRepo) GetScheduleForWeek(ctx context.Context, teacherId string,
 func (s *scheduleRepo) GetScheduleForMonth(ctx context.Context, teacherId string, monthStartDate, monthEndDate string) (*schedule_service.GetListScheduleResponse, error) {
 	resp := &schedule_service.GetListScheduleResponse{}
 	var (
-		createdAt sql.NullString
-		updatedAt sql.NullString
+		substituteTeacherId sql.NullString
+		roomId              sql.NullString
+		createdAt           sql.NullString
+		updatedAt           sql.NullString
 	)
 
 	query := `
-		SELECT id,
-			   journalId,
-			   date,
-			   startTime,
-			   endTime,
-			   lesson,
-			   created_at,
-			   updated_at
-		FROM "schedule"
-		WHERE date BETWEEN $1 AND $2
-		AND (teacherId = $3 OR supportTeacherId = $3)
-		AND deleted_at=0
+		SELECT s.id,
+			   s.journalId,
+			   s.date,
+			   s.startTime,
+			   s.endTime,
+			   s.lesson,
+			   s.substituteTeacherId,
+			   s.roomId,
+			   s.created_at,
+			   s.updated_at
+		FROM "schedule" s
+		JOIN "journal" j ON j.id = s.journalId
+		JOIN "group" g ON g.id = j.groupId
+		WHERE s.date BETWEEN $1 AND $2
+		AND ($3 = '' OR COALESCE(s.substituteTeacherId, g.teacherId)::text = $3 OR g.supportTeacherId::text = $3)
+		AND s.deleted_at = 0
 	`
 	rows, err := s.db.Query(ctx, query, monthStartDate, monthEndDate, teacherId)
 	if err != nil {
@@ -275,11 +360,13 @@ func (s *scheduleRepo) GetScheduleForMonth(ctx context.Context, teacherId string
 	for rows.Next() {
 		var schedule schedule_service.Schedule
 		count++
-		err = rows.Scan(&schedule.Id, &schedule.JournalId, &schedule.Date, &schedule.StartTime, &schedule.EndTime, &schedule.Lesson, &createdAt, &updatedAt)
+		err = rows.Scan(&schedule.Id, &schedule.JournalId, &schedule.Date, &schedule.StartTime, &schedule.EndTime, &schedule.Lesson, &substituteTeacherId, &roomId, &createdAt, &updatedAt)
 		if err != nil {
 			log.Printf("Error while scanning schedules: %v", err)
 			return nil, err
 		}
+		schedule.SubstituteTeacherId = substituteTeacherId.String
+		schedule.RoomId = roomId.String
 		schedule.CreatedAt = createdAt.String
 		schedule.UpdatedAt = updatedAt.String
 		resp.Schedules = append(resp.Schedules, &schedule)
@@ -294,3 +381,196 @@ func (s *scheduleRepo) GetScheduleForMonth(ctx context.Context, teacherId string
 
 	return resp, nil
 }
+
+// MarkAttendance records whether a student was present at a lesson. Marking
+// the same student twice overwrites the previous mark.
+func (s *scheduleRepo) MarkAttendance(ctx context.Context, req *schedule_service.MarkAttendanceRequest) (*schedule_service.LessonAttendance, error) {
+	resp := &schedule_service.LessonAttendance{}
+
+	var (
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+
+	err := s.db.QueryRow(ctx, `
+        INSERT INTO "lesson_attendance" (
+            id,
+            scheduleId,
+            studentId,
+            isPresent
+        ) VALUES (
+            $1, $2, $3, $4
+        )
+        ON CONFLICT (scheduleId, studentId) DO UPDATE SET
+            isPresent = EXCLUDED.isPresent,
+            updated_at = NOW()
+        RETURNING id, scheduleId, studentId, isPresent, created_at, updated_at
+    `, uuid.NewString(), req.ScheduleId, req.StudentId, req.IsPresent).Scan(&resp.Id, &resp.ScheduleId, &resp.StudentId, &resp.IsPresent, &created_at, &updated_at)
+
+	if err != nil {
+		log.Println("error while marking attendance", err)
+		return nil, err
+	}
+
+	resp.CreatedAt = created_at.String
+	resp.UpdatedAt = updated_at.String
+
+	return resp, nil
+}
+
+// AssignSubstitute sets the teacher who covers a single lesson instead of
+// the group's teacher. An empty teacherId removes the substitute. The
+// substitute must be available on that weekday and time and must not
+// already teach another lesson at the same time.
+func (s *scheduleRepo) AssignSubstitute(ctx context.Context, req *schedule_service.AssignSubstituteRequest) (*schedule_service.GetSchedule, error) {
+	if req.TeacherId == "" {
+		_, err := s.db.Exec(ctx, `
+            UPDATE "schedule" SET
+                substituteTeacherId = NULL,
+                updated_at = NOW()
+            WHERE id = $1
+        `, req.ScheduleId)
+		if err != nil {
+			log.Println("error while removing substitute teacher", err)
+			return nil, err
+		}
+
+		return s.GetByID(ctx, &schedule_service.SchedulePrimaryKey{Id: req.ScheduleId})
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Locking the substitute serialises assignments to the same teacher, so
+	// two lessons at the same time cannot both pass the check below.
+	var teacherId string
+	err = tx.QueryRow(ctx, `
+        SELECT id FROM "teacher" WHERE id = $1 AND deleted_at = 0 FOR UPDATE
+    `, req.TeacherId).Scan(&teacherId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("substitute teacher not found")
+		}
+		log.Println("error while locking substitute teacher", err)
+		return nil, err
+	}
+
+	var groupTeacherId sql.NullString
+	err = tx.QueryRow(ctx, `
+        SELECT g.teacherId
+        FROM "schedule" s
+        JOIN "journal" j ON j.id = s.journalId
+        JOIN "group" g ON g.id = j.groupId
+        WHERE s.id = $1 AND s.deleted_at = 0
+        FOR UPDATE OF s
+    `, req.ScheduleId).Scan(&groupTeacherId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("schedule not found")
+		}
+		log.Println("error while getting schedule teacher", err)
+		return nil, err
+	}
+
+	if groupTeacherId.String == req.TeacherId {
+		return nil, errors.New("substitute is already the group's teacher")
+	}
+
+	var available bool
+	err = tx.QueryRow(ctx, `
+        SELECT EXISTS (
+            SELECT 1
+            FROM "schedule" cur
+            JOIN "teacher_availability" a ON a.weekday = EXTRACT(ISODOW FROM cur.date)
+                AND a.startTime <= cur.startTime
+                AND a.endTime >= cur.endTime
+                AND a.deleted_at = 0
+            WHERE cur.id = $1
+              AND a.teacherId = $2
+        )`, req.ScheduleId, req.TeacherId).Scan(&available)
+	if err != nil {
+		log.Println("error while checking substitute availability", err)
+		return nil, err
+	}
+
+	if !available {
+		return nil, errors.New("substitute teacher is not available at this time")
+	}
+
+	rows, err := tx.Query(ctx, `
+        SELECT o.id
+        FROM "schedule" cur
+        JOIN "schedule" o ON o.date = cur.date
+            AND o.startTime < cur.endTime
+            AND o.endTime > cur.startTime
+            AND o.id <> cur.id
+            AND o.deleted_at = 0
+        JOIN "journal" j ON j.id = o.journalId
+        JOIN "group" g ON g.id = j.groupId
+        WHERE cur.id = $1
+          AND (COALESCE(o.substituteTeacherId, g.teacherId) = $2 OR g.supportTeacherId = $2)
+        FOR UPDATE OF o
+    `, req.ScheduleId, req.TeacherId)
+	if err != nil {
+		log.Println("error while checking substitute lessons", err)
+		return nil, err
+	}
+	busy := rows.Next()
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		log.Println("error while checking substitute lessons", err)
+		return nil, err
+	}
+
+	if busy {
+		return nil, errors.New("substitute teacher has another lesson at this time")
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE "schedule" SET
+            substituteTeacherId = $1,
+            updated_at = NOW()
+        WHERE id = $2
+    `, req.TeacherId, req.ScheduleId)
+	if err != nil {
+		log.Println("error while assigning substitute teacher", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing substitute teacher", err)
+		return nil, err
+	}
+
+	return s.GetByID(ctx, &schedule_service.SchedulePrimaryKey{Id: req.ScheduleId})
+}
+
+// GetTeacherLessonCount counts the lessons a teacher actually delivered in a
+// period. Lessons covered by a substitute are counted for the substitute
+// and not for the teacher the lesson was planned with.
+func (s *scheduleRepo) GetTeacherLessonCount(ctx context.Context, req *schedule_service.TeacherLessonCountRequest) (*schedule_service.TeacherLessonCount, error) {
+	resp := &schedule_service.TeacherLessonCount{TeacherId: req.TeacherId}
+
+	err := s.db.QueryRow(ctx, `
+        SELECT
+            COUNT(*),
+            COUNT(*) FILTER (WHERE sc.substituteTeacherId = $1)
+        FROM "schedule" sc
+        JOIN "journal" j ON j.id = sc.journalId
+        JOIN "group" g ON g.id = j.groupId
+        WHERE sc.deleted_at = 0
+          AND sc.date BETWEEN $2 AND $3
+          AND COALESCE(sc.substituteTeacherId, sc.teacherId) = $1
+          AND `+lessonDelivered+`
+    `, req.TeacherId, req.FromDate, req.ToDate).Scan(&resp.Lessons, &resp.SubstitutedLessons)
+	if err != nil {
+		log.Println("error while counting teacher lessons", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -3,15 +3,37 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"schedule_service/genproto/schedule_service"
+	"schedule_service/pkg/money"
 	"schedule_service/storage"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
+// studentPaymentColumns selects a ledger entry aliased as sp. Payments are
+// positive, reversals and refunds are negative and point at the payment they
+// correct through reversalOf. Entries recorded by an administrator belong to
+// their open cash shift.
+const studentPaymentColumns = `
+            sp.id,
+            sp.studentId::text,
+            sp.groupId::text,
+            sp.paidSum::text,
+            sp.administrationId::text,
+            sp.created_at::text,
+            sp.updated_at::text,
+            sp.entryType,
+            sp.reversalOf::text,
+            sp.reason,
+            sp.approvedBy::text,
+            sp.paymentMethod,
+            sp.shiftId::text`
+
 type studentPaymentRepo struct {
 	db *pgxpool.Pool
 }
@@ -22,22 +44,25 @@ func NewStudentPaymentRepo(db *pgxpool.Pool) storage.StudentPaymentRepoI {
 	}
 }
 
+// Create implements storage.StudentPaymentRepoI. Each payment is issued the
+// next receipt number of its branch.
 func (s *studentPaymentRepo) Create(ctx context.Context, req *schedule_service.CreateStudentPayment) (*schedule_service.GetStudentPayment, error) {
-	id := uuid.NewString()
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting student payment transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
 
-	_, err := s.db.Exec(ctx, `
-        INSERT INTO "student_payment" (
-            id,
-            student_id,
-            group_id,
-            paidsum,
-            administration_id
-        ) VALUES (
-            $1, $2, $3, $4, $5
-        )`, id, req.StudentId, req.GroupId, req.Paidsum, req.AdministrationId)
+	id := uuid.NewString()
 
+	err = insertStudentPayment(ctx, tx, id, req.StudentId, req.GroupId, req.PaidSum, req.AdministrationId, req.PaymentMethod)
 	if err != nil {
-		log.Println("error while creating student payment in storage", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing student payment", err)
 		return nil, err
 	}
 
@@ -49,62 +74,71 @@ func (s *studentPaymentRepo) Create(ctx context.Context, req *schedule_service.C
 	return studentPayment, nil
 }
 
+// GetByID implements storage.StudentPaymentRepoI. Refundable is what is left
+// of a payment after its refunds, zero for reversed payments and for
+// reversal and refund entries.
 func (s *studentPaymentRepo) GetByID(ctx context.Context, req *schedule_service.StudentPaymentPrimaryKey) (*schedule_service.GetStudentPayment, error) {
-	resp := &schedule_service.GetStudentPayment{}
-
-	var (
-		created_at sql.NullString
-		updated_at sql.NullString
-	)
-
-	err := s.db.QueryRow(ctx, `
-            SELECT id,
-            student_id,
-            group_id,
-            paidsum,
-            administration_id,
-            created_at,
-            updated_at
-            FROM "student_payment"
-        WHERE id=$1`, req.Id).Scan(&resp.Id, &resp.StudentId, &resp.GroupId, &resp.Paidsum, &resp.AdministrationId, &created_at, &updated_at)
-
+	var refundable sql.NullString
+
+	payment, err := scanStudentPayment(s.db.QueryRow(ctx, `
+        SELECT `+studentPaymentColumns+`,
+            CASE WHEN sp.entryType = 'payment'
+                THEN sp.paidSum + COALESCE((SELECT SUM(r.paidSum) FROM "student_payment" r WHERE r.reversalOf = sp.id), 0)
+                ELSE 0
+            END::numeric(10, 2)::text
+        FROM "student_payment" sp
+        WHERE sp.id = $1`, req.Id), &refundable)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("student payment not found")
+		}
 		log.Println("error while getting student payment by id", err)
 		return nil, err
 	}
 
-	resp.CreatedAt = created_at.String
-	resp.UpdatedAt = updated_at.String
-
-	return resp, nil
+	return &schedule_service.GetStudentPayment{
+		Id:               payment.Id,
+		StudentId:        payment.StudentId,
+		GroupId:          payment.GroupId,
+		AdministrationId: payment.AdministrationId,
+		CreatedAt:        payment.CreatedAt,
+		UpdatedAt:        payment.UpdatedAt,
+		PaidSum:          payment.PaidSum,
+		EntryType:        payment.EntryType,
+		ReversalOf:       payment.ReversalOf,
+		Reason:           payment.Reason,
+		ApprovedBy:       payment.ApprovedBy,
+		Refundable:       refundable.String,
+		PaymentMethod:    payment.PaymentMethod,
+		ShiftId:          payment.ShiftId,
+	}, nil
 }
 
+// GetList implements storage.StudentPaymentRepoI. Entries come in the order
+// they were recorded, each with the running balance of its student, which is
+// computed before the group, type and search filters are applied.
 func (s *studentPaymentRepo) GetList(ctx context.Context, req *schedule_service.GetListStudentPaymentRequest) (*schedule_service.GetListStudentPaymentResponse, error) {
 	resp := &schedule_service.GetListStudentPaymentResponse{}
-	var (
-		filter     string
-		created_at sql.NullString
-		updated_at sql.NullString
-	)
 	offset := (req.Page - 1) * req.Limit
 
-	if req.Search != "" {
-		filter = fmt.Sprintf(` AND (student_id ILIKE '%%%v%%' OR group_id ILIKE '%%%v%%' OR paidsum ILIKE '%%%v%%' OR administration_id ILIKE '%%%v%%')`, req.Search, req.Search, req.Search, req.Search)
-	}
-
-	filter += fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit)
-
 	rows, err := s.db.Query(ctx, `
-        SELECT
-            id,
-            student_id,
-            group_id,
-            paidsum,
-            administration_id,
-            created_at,
-            updated_at
-        FROM "student_payment" WHERE deleted_at=0
-    `+filter)
+        SELECT `+studentPaymentColumns+`,
+            sp.balance::text
+        FROM (
+            SELECT ledger.*,
+                SUM(ledger.paidSum) OVER (PARTITION BY ledger.studentId ORDER BY ledger.created_at, ledger.id) AS balance
+            FROM "student_payment" ledger
+            WHERE ($1 = '' OR ledger.studentId::text = $1)
+        ) sp
+        WHERE ($2 = '' OR sp.groupId::text = $2)
+          AND ($3 = '' OR sp.entryType = $3)
+          AND ($5 = '' OR sp.shiftId::text = $5)
+          AND ($4 = '' OR sp.studentId::text ILIKE '%' || $4 || '%'
+            OR sp.groupId::text ILIKE '%' || $4 || '%'
+            OR sp.administrationId::text ILIKE '%' || $4 || '%'
+            OR sp.reason ILIKE '%' || $4 || '%')
+        ORDER BY sp.created_at, sp.id
+    `+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.StudentId, req.GroupId, req.EntryType, req.Search, req.ShiftId)
 
 	if err != nil {
 		log.Println("error while getting all student payments:", err)
@@ -116,18 +150,17 @@ func (s *studentPaymentRepo) GetList(ctx context.Context, req *schedule_service.
 	var count int64
 
 	for rows.Next() {
-		var studentPayment schedule_service.StudentPayment
+		var balance sql.NullString
 		count++
-		err = rows.Scan(&studentPayment.Id, &studentPayment.StudentId, &studentPayment.GroupId, &studentPayment.Paidsum, &studentPayment.AdministrationId, &created_at, &updated_at)
 
+		studentPayment, err := scanStudentPayment(rows, &balance)
 		if err != nil {
 			log.Println("error while scanning student payments:", err)
 			return nil, err
 		}
-		studentPayment.CreatedAt = created_at.String
-		studentPayment.UpdatedAt = updated_at.String
+		studentPayment.Balance = balance.String
 
-		resp.StudentPayments = append(resp.StudentPayments, &studentPayment)
+		resp.StudentPayments = append(resp.StudentPayments, studentPayment)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -140,42 +173,237 @@ func (s *studentPaymentRepo) GetList(ctx context.Context, req *schedule_service.
 	return resp, nil
 }
 
-func (s *studentPaymentRepo) Update(ctx context.Context, req *schedule_service.UpdateStudentPayment) (*schedule_service.GetStudentPayment, error) {
+// Reverse implements storage.StudentPaymentRepoI. It cancels what is left of
+// a payment after its refunds with a single negative entry.
+func (s *studentPaymentRepo) Reverse(ctx context.Context, req *schedule_service.ReverseStudentPayment) (*schedule_service.GetStudentPayment, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting student payment reversal transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	remaining, err := lockStudentPayment(ctx, tx, req.Id)
+	if err != nil {
+		return nil, err
+	}
 
-	_, err := s.db.Exec(ctx, `
-        UPDATE "student_payment" SET
-            student_id=$1,
-            group_id=$2,
-            paidsum=$3,
-            administration_id=$4,
-            updated_at = NOW()
-        WHERE id = $5`, req.StudentId, req.GroupId, req.Paidsum, req.AdministrationId, req.Id)
+	id := uuid.NewString()
 
+	err = insertStudentPaymentCorrection(ctx, tx, id, req.Id, "reversal", money.Format(-remaining), req.Reason, "", req.AdministrationId)
 	if err != nil {
-		log.Println("error while updating student payment in storage", err)
+		log.Println("error while reversing student payment in storage", err)
 		return nil, err
 	}
 
-	studentPayment, err := s.GetByID(ctx, &schedule_service.StudentPaymentPrimaryKey{Id: req.Id})
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing student payment reversal", err)
+		return nil, err
+	}
+
+	return s.GetByID(ctx, &schedule_service.StudentPaymentPrimaryKey{Id: id})
+}
+
+// Refund implements storage.StudentPaymentRepoI. A payment can be refunded in
+// parts as long as the refunds do not exceed it.
+func (s *studentPaymentRepo) Refund(ctx context.Context, req *schedule_service.RefundStudentPayment) (*schedule_service.GetStudentPayment, error) {
+	amount, err := money.Amount.ParsePositive(req.PaidSum)
 	if err != nil {
-		log.Println("error while getting updated student payment by id", err)
 		return nil, err
 	}
 
-	return studentPayment, nil
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting student payment refund transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	remaining, err := lockStudentPayment(ctx, tx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount > remaining {
+		return nil, fmt.Errorf("refund exceeds the refundable amount of %s", money.Format(remaining))
+	}
+
+	id := uuid.NewString()
+
+	err = insertStudentPaymentCorrection(ctx, tx, id, req.Id, "refund", money.Format(-amount), req.Reason, req.ApprovedBy, req.AdministrationId)
+	if err != nil {
+		log.Println("error while refunding student payment in storage", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing student payment refund", err)
+		return nil, err
+	}
+
+	return s.GetByID(ctx, &schedule_service.StudentPaymentPrimaryKey{Id: id})
 }
 
-func (s *studentPaymentRepo) Delete(ctx context.Context, req *schedule_service.StudentPaymentPrimaryKey) error {
-	_, err := s.db.Exec(ctx, `
-        UPDATE "student_payment" SET 
-            deleted_at = 1
+// lockStudentPayment locks a payment for correction and returns what is left
+// of it in minor units. Reversed and fully refunded payments are rejected.
+func lockStudentPayment(ctx context.Context, tx pgx.Tx, id string) (int64, error) {
+	var (
+		entryType string
+		paidSum   string
+	)
+
+	err := tx.QueryRow(ctx, `
+        SELECT entryType, paidSum::text
+        FROM "student_payment"
         WHERE id = $1
-    `, req.Id)
+        FOR UPDATE`, id).Scan(&entryType, &paidSum)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, errors.New("student payment not found")
+		}
+		log.Println("error while locking student payment", err)
+		return 0, err
+	}
+
+	if entryType != "payment" {
+		return 0, fmt.Errorf("a %s cannot be corrected, only payments can", entryType)
+	}
+
+	var (
+		corrected string
+		reversed  bool
+	)
+
+	err = tx.QueryRow(ctx, `
+        SELECT COALESCE(SUM(paidSum), 0)::text, COALESCE(BOOL_OR(entryType = 'reversal'), false)
+        FROM "student_payment"
+        WHERE reversalOf = $1`, id).Scan(&corrected, &reversed)
+	if err != nil {
+		log.Println("error while getting student payment corrections", err)
+		return 0, err
+	}
+
+	if reversed {
+		return 0, errors.New("student payment is already reversed")
+	}
+
+	paid, err := money.Amount.Parse(paidSum)
+	if err != nil {
+		return 0, err
+	}
+
+	correction, err := money.Amount.Parse(corrected)
+	if err != nil {
+		return 0, err
+	}
+
+	remaining := paid + correction
+	if remaining <= 0 {
+		return 0, errors.New("student payment is already fully refunded")
+	}
 
+	return remaining, nil
+}
+
+// insertStudentPayment records a payment, crediting its commission to the
+// group's current teachers, and issues its receipt.
+func insertStudentPayment(ctx context.Context, tx pgx.Tx, id, studentId, groupId, paidSum, administrationId, paymentMethod string) error {
+	shiftId, err := openCashShiftOf(ctx, tx, administrationId)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+        INSERT INTO "student_payment" (
+            id,
+            studentId,
+            groupId,
+            paidSum,
+            administrationId,
+            entryType,
+            paymentMethod,
+            shiftId,
+            teacherId,
+            supportTeacherId
+        ) VALUES (
+            $1, $2, $3, $4, NULLIF($5, '')::uuid, 'payment', $6, NULLIF($7, '')::uuid,
+            (SELECT teacherId FROM "group" WHERE id = $3),
+            (SELECT supportTeacherId FROM "group" WHERE id = $3)
+        )`, id, studentId, groupId, paidSum, administrationId, paymentMethod, shiftId)
+
+	if err != nil {
+		log.Println("error while creating student payment in storage", err)
+		return err
+	}
+
+	return issueReceipt(ctx, tx, id)
+}
+
+// insertStudentPaymentCorrection records a reversal or refund with the
+// payment method and the staff of the payment it corrects.
+func insertStudentPaymentCorrection(ctx context.Context, tx pgx.Tx, id, paymentId, entryType, paidSum, reason, approvedBy, administrationId string) error {
+	shiftId, err := openCashShiftOf(ctx, tx, administrationId)
 	if err != nil {
-		log.Println("error while deleting student payment")
 		return err
 	}
 
-	return nil
+	_, err = tx.Exec(ctx, `
+        INSERT INTO "student_payment" (
+            id,
+            studentId,
+            groupId,
+            paidSum,
+            administrationId,
+            entryType,
+            reversalOf,
+            reason,
+            approvedBy,
+            paymentMethod,
+            shiftId,
+            teacherId,
+            supportTeacherId
+        )
+        SELECT $1, studentId, groupId, $2, NULLIF($3, '')::uuid, $4, id, $5, NULLIF($6, '')::uuid, paymentMethod, NULLIF($8, '')::uuid, teacherId, supportTeacherId
+        FROM "student_payment"
+        WHERE id = $7`, id, paidSum, administrationId, entryType, reason, approvedBy, paymentId, shiftId)
+
+	return err
+}
+
+func scanStudentPayment(row pgx.Row, extra ...interface{}) (*schedule_service.StudentPayment, error) {
+	var (
+		payment          schedule_service.StudentPayment
+		studentId        sql.NullString
+		groupId          sql.NullString
+		paidSum          sql.NullString
+		administrationId sql.NullString
+		created_at       sql.NullString
+		updated_at       sql.NullString
+		reversalOf       sql.NullString
+		reason           sql.NullString
+		approvedBy       sql.NullString
+		shiftId          sql.NullString
+	)
+
+	dest := append([]interface{}{
+		&payment.Id, &studentId, &groupId, &paidSum, &administrationId, &created_at, &updated_at,
+		&payment.EntryType, &reversalOf, &reason, &approvedBy, &payment.PaymentMethod, &shiftId,
+	}, extra...)
+
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	payment.StudentId = studentId.String
+	payment.GroupId = groupId.String
+	payment.PaidSum = paidSum.String
+	payment.AdministrationId = administrationId.String
+	payment.CreatedAt = created_at.String
+	payment.UpdatedAt = updated_at.String
+	payment.ReversalOf = reversalOf.String
+	payment.Reason = reason.String
+	payment.ShiftId = shiftId.String
+	payment.ApprovedBy = approvedBy.String
+
+	return &payment, nil
 }
@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"schedule_service/genproto/schedule_service"
+	"testing"
+)
+
+func TestStudentPaymentLedgerIsAppendOnly(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	payments := NewStudentPaymentRepo(db)
+
+	branchId := newTestBranch(t, db)
+	groupId := newTestGroup(t, db, branchId, "", 0)
+	studentId := newTestStudent(t, db, branchId)
+
+	payment, err := payments.Create(ctx, &schedule_service.CreateStudentPayment{
+		StudentId:     studentId,
+		GroupId:       groupId,
+		PaidSum:       "100.00",
+		PaymentMethod: "cash",
+	})
+	if err != nil {
+		t.Fatalf("create payment: %v", err)
+	}
+
+	if _, err := db.Exec(ctx, `UPDATE "student_payment" SET paidSum = 1 WHERE id = $1`, payment.Id); err == nil {
+		t.Error("updated a ledger entry")
+	}
+	if _, err := db.Exec(ctx, `DELETE FROM "student_payment" WHERE id = $1`, payment.Id); err == nil {
+		t.Error("deleted a ledger entry")
+	}
+
+	refund, err := payments.Refund(ctx, &schedule_service.RefundStudentPayment{Id: payment.Id, PaidSum: "30", Reason: "test"})
+	if err != nil {
+		t.Fatalf("refund: %v", err)
+	}
+	if refund.EntryType != "refund" || refund.ReversalOf != payment.Id {
+		t.Errorf("refund = %s of %s, want a refund of %s", refund.EntryType, refund.ReversalOf, payment.Id)
+	}
+
+	partial, err := payments.GetByID(ctx, &schedule_service.StudentPaymentPrimaryKey{Id: payment.Id})
+	if err != nil {
+		t.Fatalf("get payment: %v", err)
+	}
+	if partial.Refundable != "70.00" {
+		t.Errorf("refundable = %s, want 70.00", partial.Refundable)
+	}
+
+	if _, err := payments.Refund(ctx, &schedule_service.RefundStudentPayment{Id: payment.Id, PaidSum: "70.01", Reason: "test"}); err == nil {
+		t.Error("refunded more than is left of the payment")
+	}
+	if _, err := payments.Reverse(ctx, &schedule_service.ReverseStudentPayment{Id: refund.Id, Reason: "test"}); err == nil {
+		t.Error("reversed a refund")
+	}
+
+	reversal, err := payments.Reverse(ctx, &schedule_service.ReverseStudentPayment{Id: payment.Id, Reason: "test"})
+	if err != nil {
+		t.Fatalf("reverse: %v", err)
+	}
+	if reversal.PaidSum != "-70.00" {
+		t.Errorf("reversal = %s, want -70.00", reversal.PaidSum)
+	}
+
+	if _, err := payments.Reverse(ctx, &schedule_service.ReverseStudentPayment{Id: payment.Id, Reason: "test"}); err == nil {
+		t.Error("reversed a payment twice")
+	}
+
+	original, err := payments.GetByID(ctx, &schedule_service.StudentPaymentPrimaryKey{Id: payment.Id})
+	if err != nil {
+		t.Fatalf("get payment: %v", err)
+	}
+	if original.PaidSum != "100.00" {
+		t.Errorf("payment changed to %s after corrections", original.PaidSum)
+	}
+}
@@ -26,6 +26,10 @@ func NewStudentTaskRepo(db *pgxpool.Pool) storage.StudentTaskRepoI {
 func (s *studentTaskRepo) Create(ctx context.Context, req *schedule_service.CreateStudentTask) (*schedule_service.GetStudentTask, error) {
 	id := uuid.NewString()
 
+	if err := checkStudentHold(ctx, s.db, req.StudentId); err != nil {
+		return nil, err
+	}
+
 	_, err := s.db.Exec(ctx, `
         INSERT INTO "student_task" (
             id,
@@ -98,10 +102,12 @@ func (s *studentTaskRepo) GetList(ctx context.Context, req *schedule_service.Get
             id,
             taskId,
             studentId,
+            COALESCE(score, 0),
             created_at,
             updated_at
         FROM "student_task" WHERE deleted_at=0
-    `+filter)
+          AND ($1 = '' OR studentId::text = $1)
+    `+filter, req.StudentId)
 
 	if err != nil {
 		log.Println("error while getting all student tasks:", err)
@@ -115,7 +121,7 @@ func (s *studentTaskRepo) GetList(ctx context.Context, req *schedule_service.Get
 	for rows.Next() {
 		var studentTask schedule_service.StudentTask
 		count++
-		err = rows.Scan(&studentTask.Id, &studentTask.TaskId, &studentTask.StudentId, &created_at, &updated_at)
+		err = rows.Scan(&studentTask.Id, &studentTask.TaskId, &studentTask.StudentId, &studentTask.Score, &created_at, &updated_at)
 
 		if err != nil {
 			log.Println("error while scanning student tasks:", err)
@@ -0,0 +1,807 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type timetableRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewTimetableRepo(db *pgxpool.Pool) storage.TimetableRepoI {
+	return &timetableRepo{
+		db: db,
+	}
+}
+
+// CreateAvailability implements storage.TimetableRepoI.
+func (t *timetableRepo) CreateAvailability(ctx context.Context, req *schedule_service.CreateTeacherAvailability) (*schedule_service.TeacherAvailability, error) {
+	if (req.TeacherId == "") == (req.SupportTeacherId == "") {
+		return nil, errors.New("exactly one of teacherId and supportTeacherId is required")
+	}
+
+	resp := &schedule_service.TeacherAvailability{}
+
+	var (
+		teacherId        sql.NullString
+		supportTeacherId sql.NullString
+		created_at       sql.NullString
+		updated_at       sql.NullString
+	)
+
+	err := t.db.QueryRow(ctx, `
+        INSERT INTO "teacher_availability" (
+            id,
+            teacherId,
+            supportTeacherId,
+            weekday,
+            startTime,
+            endTime
+        ) VALUES (
+            $1, NULLIF($2, '')::uuid, NULLIF($3, '')::uuid, $4, $5, $6
+        )
+        RETURNING id, teacherId, supportTeacherId, weekday, startTime::text, endTime::text, created_at, updated_at
+    `, uuid.NewString(), req.TeacherId, req.SupportTeacherId, req.Weekday, req.StartTime, req.EndTime).Scan(
+		&resp.Id, &teacherId, &supportTeacherId, &resp.Weekday, &resp.StartTime, &resp.EndTime, &created_at, &updated_at,
+	)
+	if err != nil {
+		log.Println("error while creating teacher availability", err)
+		return nil, err
+	}
+
+	resp.TeacherId = teacherId.String
+	resp.SupportTeacherId = supportTeacherId.String
+	resp.CreatedAt = created_at.String
+	resp.UpdatedAt = updated_at.String
+
+	return resp, nil
+}
+
+// GetListAvailability implements storage.TimetableRepoI.
+func (t *timetableRepo) GetListAvailability(ctx context.Context, req *schedule_service.GetListAvailabilityRequest) (*schedule_service.GetListAvailabilityResponse, error) {
+	resp := &schedule_service.GetListAvailabilityResponse{}
+
+	rows, err := t.db.Query(ctx, `
+        SELECT
+            a.id,
+            a.teacherId,
+            a.supportTeacherId,
+            a.weekday,
+            a.startTime::text,
+            a.endTime::text,
+            a.created_at,
+            a.updated_at
+        FROM "teacher_availability" a
+        LEFT JOIN "teacher" t ON t.id = a.teacherId
+        LEFT JOIN "support_teacher" st ON st.id = a.supportTeacherId
+        WHERE a.deleted_at = 0
+          AND ($1 = '' OR a.teacherId::text = $1)
+          AND ($2 = '' OR a.supportTeacherId::text = $2)
+          AND ($3 = '' OR COALESCE(t.branchId, st.branchId)::text = $3)
+        ORDER BY a.weekday, a.startTime
+    `, req.TeacherId, req.SupportTeacherId, req.BranchId)
+	if err != nil {
+		log.Println("error while getting teacher availability:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+	for rows.Next() {
+		var (
+			availability     schedule_service.TeacherAvailability
+			teacherId        sql.NullString
+			supportTeacherId sql.NullString
+			created_at       sql.NullString
+			updated_at       sql.NullString
+		)
+		count++
+		err = rows.Scan(&availability.Id, &teacherId, &supportTeacherId, &availability.Weekday, &availability.StartTime, &availability.EndTime, &created_at, &updated_at)
+		if err != nil {
+			log.Println("error while scanning teacher availability:", err)
+			return nil, err
+		}
+
+		availability.TeacherId = teacherId.String
+		availability.SupportTeacherId = supportTeacherId.String
+		availability.CreatedAt = created_at.String
+		availability.UpdatedAt = updated_at.String
+
+		resp.Availabilities = append(resp.Availabilities, &availability)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// DeleteAvailability implements storage.TimetableRepoI.
+func (t *timetableRepo) DeleteAvailability(ctx context.Context, req *schedule_service.TeacherAvailabilityPrimaryKey) error {
+	_, err := t.db.Exec(ctx, `
+        UPDATE "teacher_availability" SET
+            deleted_at = 1,
+            updated_at = NOW()
+        WHERE id = $1
+    `, req.Id)
+	if err != nil {
+		log.Println("error while deleting teacher availability")
+		return err
+	}
+
+	return nil
+}
+
+// SetLessonRequirement implements storage.TimetableRepoI.
+func (t *timetableRepo) SetLessonRequirement(ctx context.Context, req *schedule_service.LessonRequirement) (*schedule_service.LessonRequirement, error) {
+	_, err := t.db.Exec(ctx, `
+        INSERT INTO "group_lesson_requirement" (
+            groupId,
+            lessonsPerWeek,
+            durationMinutes
+        ) VALUES (
+            $1, $2, COALESCE(NULLIF($3, 0), (
+                SELECT bs.lessonDurationMinutes
+                FROM "group" g
+                JOIN "branch_setting" bs ON bs.branchId = g.branchId
+                WHERE g.id = $1
+            ), 90)
+        )
+        ON CONFLICT (groupId) DO UPDATE SET
+            lessonsPerWeek = EXCLUDED.lessonsPerWeek,
+            durationMinutes = EXCLUDED.durationMinutes,
+            updated_at = NOW()
+    `, req.GroupId, req.LessonsPerWeek, req.DurationMinutes)
+	if err != nil {
+		log.Println("error while setting lesson requirement", err)
+		return nil, err
+	}
+
+	resp := &schedule_service.LessonRequirement{}
+
+	var (
+		teacherId        sql.NullString
+		supportTeacherId sql.NullString
+		updated_at       sql.NullString
+	)
+
+	err = t.db.QueryRow(ctx, `
+        SELECT r.groupId, r.lessonsPerWeek, r.durationMinutes, g.teacherId, g.supportTeacherId, r.updated_at
+        FROM "group_lesson_requirement" r
+        JOIN "group" g ON g.id = r.groupId
+        WHERE r.groupId = $1
+    `, req.GroupId).Scan(&resp.GroupId, &resp.LessonsPerWeek, &resp.DurationMinutes, &teacherId, &supportTeacherId, &updated_at)
+	if err != nil {
+		log.Println("error while getting lesson requirement", err)
+		return nil, err
+	}
+
+	resp.TeacherId = teacherId.String
+	resp.SupportTeacherId = supportTeacherId.String
+	resp.UpdatedAt = updated_at.String
+
+	return resp, nil
+}
+
+// GetListLessonRequirement returns the requirements of the active groups of
+// a branch.
+func (t *timetableRepo) GetListLessonRequirement(ctx context.Context, req *schedule_service.GetListLessonRequirementRequest) (*schedule_service.GetListLessonRequirementResponse, error) {
+	resp := &schedule_service.GetListLessonRequirementResponse{}
+
+	rows, err := t.db.Query(ctx, `
+        SELECT r.groupId, r.lessonsPerWeek, r.durationMinutes, g.teacherId, g.supportTeacherId, r.updated_at
+        FROM "group_lesson_requirement" r
+        JOIN "group" g ON g.id = r.groupId
+        WHERE g.branchId = $1 AND g.deleted_at = 0 AND g.status = 'active'
+        ORDER BY g.created_at
+    `, req.BranchId)
+	if err != nil {
+		log.Println("error while getting lesson requirements:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+	for rows.Next() {
+		var (
+			requirement      schedule_service.LessonRequirement
+			teacherId        sql.NullString
+			supportTeacherId sql.NullString
+			updated_at       sql.NullString
+		)
+		count++
+		err = rows.Scan(&requirement.GroupId, &requirement.LessonsPerWeek, &requirement.DurationMinutes, &teacherId, &supportTeacherId, &updated_at)
+		if err != nil {
+			log.Println("error while scanning lesson requirements:", err)
+			return nil, err
+		}
+
+		requirement.TeacherId = teacherId.String
+		requirement.SupportTeacherId = supportTeacherId.String
+		requirement.UpdatedAt = updated_at.String
+
+		resp.Requirements = append(resp.Requirements, &requirement)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// GetBusyLessons returns the recurring lessons that a new timetable for the
+// branch has to work around: lessons of branch groups without a requirement
+// (they keep their slot and room) and lessons in other branches (they only
+// block their teachers). Groups that were never planned by a timetable are
+// busy at the weekday and time of their upcoming lessons.
+func (t *timetableRepo) GetBusyLessons(ctx context.Context, branchId string) ([]*schedule_service.ProposedLesson, []*schedule_service.ProposedLesson, error) {
+	rows, err := t.db.Query(ctx, `
+        WITH slots AS (
+            SELECT rl.groupId, rl.weekday, rl.startTime, rl.endTime
+            FROM "recurring_lesson" rl
+            WHERE rl.deleted_at = 0
+            UNION
+            SELECT j.groupId, EXTRACT(ISODOW FROM s.date)::int, s.startTime, s.endTime
+            FROM "schedule" s
+            JOIN "journal" j ON j.id = s.journalId
+            WHERE s.deleted_at = 0
+              AND s.date >= CURRENT_DATE
+              AND s.startTime IS NOT NULL
+              AND s.endTime IS NOT NULL
+              AND j.deleted_at = 0
+              AND NOT EXISTS (
+                  SELECT 1 FROM "recurring_lesson" rl
+                  WHERE rl.groupId = j.groupId AND rl.deleted_at = 0
+              )
+        )
+        SELECT
+            rl.groupId,
+            g.teacherId,
+            g.supportTeacherId,
+            rl.weekday,
+            rl.startTime::text,
+            rl.endTime::text,
+            g.branchId::text = $1 AS same_branch
+        FROM slots rl
+        JOIN "group" g ON g.id = rl.groupId
+        WHERE g.deleted_at = 0
+          AND g.status = 'active'
+          AND NOT (
+              g.branchId::text = $1
+              AND EXISTS (SELECT 1 FROM "group_lesson_requirement" r WHERE r.groupId = g.id)
+          )
+    `, branchId)
+	if err != nil {
+		log.Println("error while getting busy lessons:", err)
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var sameBranch, otherBranches []*schedule_service.ProposedLesson
+	for rows.Next() {
+		var (
+			lesson           schedule_service.ProposedLesson
+			teacherId        sql.NullString
+			supportTeacherId sql.NullString
+			same             sql.NullBool
+		)
+		err = rows.Scan(&lesson.GroupId, &teacherId, &supportTeacherId, &lesson.Weekday, &lesson.StartTime, &lesson.EndTime, &same)
+		if err != nil {
+			log.Println("error while scanning busy lessons:", err)
+			return nil, nil, err
+		}
+
+		lesson.TeacherId = teacherId.String
+		lesson.SupportTeacherId = supportTeacherId.String
+
+		if same.Bool {
+			sameBranch = append(sameBranch, &lesson)
+		} else {
+			otherBranches = append(otherBranches, &lesson)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, nil, err
+	}
+
+	return sameBranch, otherBranches, nil
+}
+
+// CountRooms returns the number of rooms of the branch, which bounds how
+// many of its lessons can run at the same time.
+func (t *timetableRepo) CountRooms(ctx context.Context, branchId string) (int32, error) {
+	var count int32
+	err := t.db.QueryRow(ctx, `
+        SELECT COUNT(*) FROM "room" WHERE branchId::text = $1 AND deleted_at = 0
+    `, branchId).Scan(&count)
+	if err != nil {
+		log.Println("error while counting rooms", err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// SaveProposal stores a solver result as a draft proposal.
+func (t *timetableRepo) SaveProposal(ctx context.Context, req *schedule_service.TimetableProposal) (*schedule_service.TimetableProposal, error) {
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting proposal transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	id := uuid.NewString()
+	_, err = tx.Exec(ctx, `
+        INSERT INTO "timetable_proposal" (
+            id,
+            branchId,
+            roomCount
+        ) VALUES (
+            $1, $2, $3
+        )`, id, req.BranchId, req.RoomCount)
+	if err != nil {
+		log.Println("error while creating timetable proposal", err)
+		return nil, err
+	}
+
+	for _, lesson := range req.Lessons {
+		_, err = tx.Exec(ctx, `
+            INSERT INTO "timetable_proposal_lesson" (
+                id,
+                proposalId,
+                groupId,
+                weekday,
+                startTime,
+                endTime
+            ) VALUES (
+                $1, $2, $3, $4, $5, $6
+            )`, uuid.NewString(), id, lesson.GroupId, lesson.Weekday, lesson.StartTime, lesson.EndTime)
+		if err != nil {
+			log.Println("error while creating proposed lesson", err)
+			return nil, err
+		}
+	}
+
+	for _, group := range req.Unscheduled {
+		_, err = tx.Exec(ctx, `
+            INSERT INTO "timetable_proposal_unscheduled" (
+                id,
+                proposalId,
+                groupId,
+                reason
+            ) VALUES (
+                $1, $2, $3, $4
+            )`, uuid.NewString(), id, group.GroupId, group.Reason)
+		if err != nil {
+			log.Println("error while creating unscheduled group", err)
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing timetable proposal", err)
+		return nil, err
+	}
+
+	return t.GetProposal(ctx, &schedule_service.TimetableProposalPrimaryKey{Id: id})
+}
+
+// GetProposal implements storage.TimetableRepoI.
+func (t *timetableRepo) GetProposal(ctx context.Context, req *schedule_service.TimetableProposalPrimaryKey) (*schedule_service.TimetableProposal, error) {
+	resp := &schedule_service.TimetableProposal{}
+
+	var (
+		created_at   sql.NullString
+		committed_at sql.NullString
+	)
+
+	err := t.db.QueryRow(ctx, `
+        SELECT id, branchId, roomCount, status, created_at, committed_at
+        FROM "timetable_proposal"
+        WHERE id = $1
+    `, req.Id).Scan(&resp.Id, &resp.BranchId, &resp.RoomCount, &resp.Status, &created_at, &committed_at)
+	if err != nil {
+		log.Println("error while getting timetable proposal by id", err)
+		return nil, err
+	}
+
+	resp.CreatedAt = created_at.String
+	resp.CommittedAt = committed_at.String
+
+	rows, err := t.db.Query(ctx, `
+        SELECT pl.groupId, g.teacherId, g.supportTeacherId, pl.weekday, pl.startTime::text, pl.endTime::text
+        FROM "timetable_proposal_lesson" pl
+        JOIN "group" g ON g.id = pl.groupId
+        WHERE pl.proposalId = $1
+        ORDER BY pl.weekday, pl.startTime
+    `, req.Id)
+	if err != nil {
+		log.Println("error while getting proposed lessons", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			lesson           schedule_service.ProposedLesson
+			teacherId        sql.NullString
+			supportTeacherId sql.NullString
+		)
+		err = rows.Scan(&lesson.GroupId, &teacherId, &supportTeacherId, &lesson.Weekday, &lesson.StartTime, &lesson.EndTime)
+		if err != nil {
+			log.Println("error while scanning proposed lessons", err)
+			return nil, err
+		}
+
+		lesson.TeacherId = teacherId.String
+		lesson.SupportTeacherId = supportTeacherId.String
+
+		resp.Lessons = append(resp.Lessons, &lesson)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	unscheduled, err := t.db.Query(ctx, `
+        SELECT groupId, reason
+        FROM "timetable_proposal_unscheduled"
+        WHERE proposalId = $1
+    `, req.Id)
+	if err != nil {
+		log.Println("error while getting unscheduled groups", err)
+		return nil, err
+	}
+	defer unscheduled.Close()
+
+	for unscheduled.Next() {
+		var (
+			group  schedule_service.UnscheduledGroup
+			reason sql.NullString
+		)
+		if err = unscheduled.Scan(&group.GroupId, &reason); err != nil {
+			log.Println("error while scanning unscheduled groups", err)
+			return nil, err
+		}
+		group.Reason = reason.String
+
+		resp.Unscheduled = append(resp.Unscheduled, &group)
+	}
+
+	if err = unscheduled.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// CommitProposal replaces the recurring lessons of every group in the
+// proposal and moves the future lessons of their open journals onto the new
+// slots. Lessons are moved in date order so that their topic, substitute,
+// tasks, attendance and trial bookings stay with them; missing lessons are
+// added in the room the group already uses. The commit fails when a lesson
+// left over still has data, or when a lesson clashes with a room booking or
+// a teacher's lesson made after the proposal was generated.
+func (t *timetableRepo) CommitProposal(ctx context.Context, req *schedule_service.TimetableProposalPrimaryKey) (*schedule_service.CommitTimetableResponse, error) {
+	resp := &schedule_service.CommitTimetableResponse{ProposalId: req.Id}
+
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting commit transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var status string
+	err = tx.QueryRow(ctx, `
+        SELECT status FROM "timetable_proposal" WHERE id = $1 FOR UPDATE
+    `, req.Id).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("timetable proposal not found")
+		}
+		log.Println("error while locking timetable proposal", err)
+		return nil, err
+	}
+
+	if status != "draft" {
+		return nil, errors.New("timetable proposal is already committed")
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE "recurring_lesson" SET
+            deleted_at = 1
+        WHERE deleted_at = 0
+          AND groupId IN (SELECT groupId FROM "timetable_proposal_lesson" WHERE proposalId = $1)
+    `, req.Id)
+	if err != nil {
+		log.Println("error while removing old recurring lessons", err)
+		return nil, err
+	}
+
+	tag, err := tx.Exec(ctx, `
+        INSERT INTO "recurring_lesson" (id, groupId, proposalId, weekday, startTime, endTime)
+        SELECT uuid_generate_v4(), groupId, proposalId, weekday, startTime, endTime
+        FROM "timetable_proposal_lesson"
+        WHERE proposalId = $1
+    `, req.Id)
+	if err != nil {
+		log.Println("error while creating recurring lessons", err)
+		return nil, err
+	}
+	resp.RecurringLessons = int32(tag.RowsAffected())
+
+	// Each group keeps the room of its next lesson, or of its last one when
+	// none is left, before the future lessons are replaced.
+	var groupIds, roomIds []string
+	rooms, err := tx.Query(ctx, `
+        SELECT DISTINCT ON (j.groupId) j.groupId::text, s.roomId::text
+        FROM "schedule" s
+        JOIN "journal" j ON j.id = s.journalId
+        WHERE s.deleted_at = 0
+          AND s.roomId IS NOT NULL
+          AND j.deleted_at = 0
+          AND j.groupId IN (SELECT groupId FROM "timetable_proposal_lesson" WHERE proposalId = $1)
+        ORDER BY j.groupId, s.date <= CURRENT_DATE, ABS(s.date - CURRENT_DATE)
+    `, req.Id)
+	if err != nil {
+		log.Println("error while getting group rooms", err)
+		return nil, err
+	}
+
+	for rooms.Next() {
+		var groupId, roomId string
+		if err = rooms.Scan(&groupId, &roomId); err != nil {
+			rooms.Close()
+			log.Println("error while scanning group rooms", err)
+			return nil, err
+		}
+		groupIds = append(groupIds, groupId)
+		roomIds = append(roomIds, roomId)
+	}
+	rooms.Close()
+
+	if err = rooms.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	// Lock the lessons that are about to move before pairing them with the
+	// new slots.
+	_, err = tx.Exec(ctx, `
+        SELECT s.id
+        FROM "schedule" s
+        JOIN "journal" j ON j.id = s.journalId
+        WHERE s.deleted_at = 0
+          AND s.date > CURRENT_DATE
+          AND j.deleted_at = 0
+          AND j.closed_at IS NULL
+          AND j.groupId IN (SELECT groupId FROM "timetable_proposal_lesson" WHERE proposalId = $1)
+        FOR UPDATE OF s
+    `, req.Id)
+	if err != nil {
+		log.Println("error while locking future lessons", err)
+		return nil, err
+	}
+
+	slots, err := tx.Query(ctx, `
+        WITH old AS (
+            SELECT s.id, s.journalId,
+                   ROW_NUMBER() OVER (PARTITION BY s.journalId ORDER BY s.date, s.startTime) AS n
+            FROM "schedule" s
+            JOIN "journal" j ON j.id = s.journalId
+            WHERE s.deleted_at = 0
+              AND s.date > CURRENT_DATE
+              AND j.deleted_at = 0
+              AND j.closed_at IS NULL
+              AND j.groupId IN (SELECT groupId FROM "timetable_proposal_lesson" WHERE proposalId = $1)
+        ), new AS (
+            SELECT j.id AS journalId, d::date AS date, rl.startTime, rl.endTime,
+                   ROW_NUMBER() OVER (PARTITION BY j.id ORDER BY d, rl.startTime) AS n
+            FROM "journal" j
+            JOIN "recurring_lesson" rl ON rl.groupId = j.groupId AND rl.proposalId = $1
+            CROSS JOIN LATERAL generate_series(GREATEST(j.fromDate, CURRENT_DATE + 1), j.toDate, INTERVAL '1 day') d
+            WHERE j.deleted_at = 0
+              AND j.closed_at IS NULL
+              AND EXTRACT(ISODOW FROM d) = rl.weekday
+        )
+        SELECT
+            COALESCE(old.id::text, ''),
+            COALESCE(new.journalId, old.journalId)::text,
+            COALESCE(new.date::text, ''),
+            COALESCE(new.startTime::text, ''),
+            COALESCE(new.endTime::text, '')
+        FROM old
+        FULL JOIN new ON new.journalId = old.journalId AND new.n = old.n
+    `, req.Id)
+	if err != nil {
+		log.Println("error while pairing lessons with the timetable", err)
+		return nil, err
+	}
+
+	var (
+		moveIds, moveDates, moveStarts, moveEnds  []string
+		addJournals, addDates, addStarts, addEnds []string
+		dropIds                                   []string
+	)
+	for slots.Next() {
+		var id, journalId, date, start, end string
+		if err = slots.Scan(&id, &journalId, &date, &start, &end); err != nil {
+			slots.Close()
+			log.Println("error while scanning lesson slots", err)
+			return nil, err
+		}
+
+		switch {
+		case id == "":
+			addJournals = append(addJournals, journalId)
+			addDates = append(addDates, date)
+			addStarts = append(addStarts, start)
+			addEnds = append(addEnds, end)
+		case date == "":
+			dropIds = append(dropIds, id)
+		default:
+			moveIds = append(moveIds, id)
+			moveDates = append(moveDates, date)
+			moveStarts = append(moveStarts, start)
+			moveEnds = append(moveEnds, end)
+		}
+	}
+	slots.Close()
+
+	if err = slots.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	if len(dropIds) > 0 {
+		var used int
+		err = tx.QueryRow(ctx, `
+            SELECT COUNT(*)
+            FROM "schedule" s
+            WHERE s.id::text = ANY($1::text[])
+              AND (
+                  COALESCE(s.lesson, '') <> ''
+                  OR s.substituteTeacherId IS NOT NULL
+                  OR EXISTS (SELECT 1 FROM "task" t WHERE t.scheduleId = s.id AND t.deleted_at = 0)
+                  OR EXISTS (SELECT 1 FROM "lesson_attendance" a WHERE a.scheduleId = s.id)
+                  OR EXISTS (SELECT 1 FROM "lead" l WHERE l.trialScheduleId = s.id AND l.deleted_at = 0)
+              )
+        `, dropIds).Scan(&used)
+		if err != nil {
+			log.Println("error while checking removed lessons", err)
+			return nil, err
+		}
+
+		if used > 0 {
+			return nil, fmt.Errorf("the new timetable has fewer lessons and %d of the lessons it would remove have a topic, substitute, tasks, attendance or a trial booking", used)
+		}
+
+		_, err = tx.Exec(ctx, `
+            UPDATE "schedule" SET
+                deleted_at = 1,
+                updated_at = NOW()
+            WHERE id::text = ANY($1::text[])
+        `, dropIds)
+		if err != nil {
+			log.Println("error while removing future lessons", err)
+			return nil, err
+		}
+	}
+
+	moved, err := tx.Query(ctx, `
+        UPDATE "schedule" s SET
+            date = m.date::date,
+            startTime = m.startTime::time,
+            endTime = m.endTime::time,
+            updated_at = NOW()
+        FROM unnest($1::text[], $2::text[], $3::text[], $4::text[]) m (id, date, startTime, endTime)
+        WHERE s.id::text = m.id
+        RETURNING s.id::text, s.date::text, s.roomId IS NOT NULL
+    `, moveIds, moveDates, moveStarts, moveEnds)
+	if err != nil {
+		log.Println("error while moving lessons to the timetable", err)
+		return nil, err
+	}
+
+	movedCount, err := checkLessonRooms(ctx, tx, moved)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := tx.Query(ctx, `
+        INSERT INTO "schedule" (id, journalId, date, startTime, endTime, lesson, roomId, teacherId, supportTeacherId)
+        SELECT uuid_generate_v4(), j.id, n.date::date, n.startTime::time, n.endTime::time, '', r.roomId::uuid, g.teacherId, g.supportTeacherId
+        FROM unnest($1::text[], $2::text[], $3::text[], $4::text[]) n (journalId, date, startTime, endTime)
+        JOIN "journal" j ON j.id::text = n.journalId
+        JOIN "group" g ON g.id = j.groupId
+        LEFT JOIN unnest($5::text[], $6::text[]) r (groupId, roomId) ON r.groupId = j.groupId::text
+        RETURNING id::text, date::text, roomId IS NOT NULL
+    `, addJournals, addDates, addStarts, addEnds, groupIds, roomIds)
+	if err != nil {
+		log.Println("error while creating lessons from timetable", err)
+		return nil, err
+	}
+
+	addedCount, err := checkLessonRooms(ctx, tx, added)
+	if err != nil {
+		return nil, err
+	}
+	resp.ScheduledLessons = int32(movedCount + addedCount)
+
+	// The proposal only knew the lessons that existed when it was generated,
+	// so the teachers are checked again against everything booked since.
+	var clashDate, clashStart, clashGroup string
+	err = tx.QueryRow(ctx, `
+        SELECT s.date::text, s.startTime::text, og.name
+        FROM "schedule" s
+        JOIN "journal" j ON j.id = s.journalId
+        JOIN "group" g ON g.id = j.groupId
+        JOIN "schedule" o ON o.date = s.date
+            AND o.startTime < s.endTime
+            AND o.endTime > s.startTime
+            AND o.id <> s.id
+            AND o.deleted_at = 0
+        JOIN "journal" oj ON oj.id = o.journalId
+        JOIN "group" og ON og.id = oj.groupId
+        WHERE s.deleted_at = 0
+          AND s.date > CURRENT_DATE
+          AND j.deleted_at = 0
+          AND j.closed_at IS NULL
+          AND g.id IN (SELECT groupId FROM "timetable_proposal_lesson" WHERE proposalId = $1)
+          AND (
+              COALESCE(s.substituteTeacherId, g.teacherId) IN (COALESCE(o.substituteTeacherId, og.teacherId), og.supportTeacherId)
+              OR g.supportTeacherId IN (COALESCE(o.substituteTeacherId, og.teacherId), og.supportTeacherId)
+          )
+        ORDER BY s.date, s.startTime
+        LIMIT 1
+    `, req.Id).Scan(&clashDate, &clashStart, &clashGroup)
+	if err == nil {
+		return nil, fmt.Errorf("lesson on %s at %s clashes with a lesson of group %s taught by the same teacher", clashDate, clashStart[:5], clashGroup)
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		log.Println("error while checking teacher clashes", err)
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE "timetable_proposal" SET
+            status = 'committed',
+            committed_at = NOW()
+        WHERE id = $1
+    `, req.Id)
+	if err != nil {
+		log.Println("error while committing timetable proposal", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing timetable", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,442 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"schedule_service/genproto/schedule_service"
+	"schedule_service/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const tuitionPlanColumns = `
+            id,
+            branchId,
+            groupType,
+            monthlyFee::text,
+            validFrom::text,
+            created_at::text,
+            updated_at::text`
+
+const invoiceColumns = `
+            i.id,
+            i.studentId,
+            i.groupId,
+            i.tuitionPlanId::text,
+            i.period::text,
+            i.amount::text,
+            i.dueDate::text,
+            i.created_at::text,
+            i.baseAmount::text,
+            i.discountAmount::text`
+
+type tuitionRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewTuitionRepo(db *pgxpool.Pool) storage.TuitionRepoI {
+	return &tuitionRepo{
+		db: db,
+	}
+}
+
+// CreatePlan implements storage.TuitionRepoI.
+func (t *tuitionRepo) CreatePlan(ctx context.Context, req *schedule_service.CreateTuitionPlan) (*schedule_service.TuitionPlan, error) {
+	id := uuid.NewString()
+
+	_, err := t.db.Exec(ctx, `
+        INSERT INTO "tuition_plan" (
+            id,
+            branchId,
+            groupType,
+            monthlyFee,
+            validFrom
+        ) VALUES (
+            $1, $2, $3, $4, COALESCE(date_trunc('month', NULLIF($5, '')::date)::date, date_trunc('month', NOW())::date)
+        )`, id, req.BranchId, req.GroupType, req.MonthlyFee, req.ValidFrom)
+
+	if err != nil {
+		log.Println("error while creating tuition plan in storage", err)
+		return nil, err
+	}
+
+	return t.getPlan(ctx, id)
+}
+
+func (t *tuitionRepo) getPlan(ctx context.Context, id string) (*schedule_service.TuitionPlan, error) {
+	plan, err := scanTuitionPlan(t.db.QueryRow(ctx, `
+        SELECT `+tuitionPlanColumns+`
+        FROM "tuition_plan"
+        WHERE id = $1 AND deleted_at = 0`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("tuition plan not found")
+		}
+		log.Println("error while getting tuition plan by id", err)
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// GetListPlan implements storage.TuitionRepoI.
+func (t *tuitionRepo) GetListPlan(ctx context.Context, req *schedule_service.GetListTuitionPlanRequest) (*schedule_service.GetListTuitionPlanResponse, error) {
+	resp := &schedule_service.GetListTuitionPlanResponse{}
+
+	rows, err := t.db.Query(ctx, `
+        SELECT `+tuitionPlanColumns+`
+        FROM "tuition_plan"
+        WHERE deleted_at = 0
+          AND ($1 = '' OR branchId::text = $1)
+          AND ($2 = '' OR groupType = $2)
+        ORDER BY branchId, groupType, validFrom DESC`, req.BranchId, req.GroupType)
+	if err != nil {
+		log.Println("error while getting all tuition plans:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		plan, err := scanTuitionPlan(rows)
+		if err != nil {
+			log.Println("error while scanning tuition plans:", err)
+			return nil, err
+		}
+
+		resp.Plans = append(resp.Plans, plan)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// UpdatePlan implements storage.TuitionRepoI. Invoices already issued keep
+// their amounts.
+func (t *tuitionRepo) UpdatePlan(ctx context.Context, req *schedule_service.UpdateTuitionPlan) (*schedule_service.TuitionPlan, error) {
+	_, err := t.db.Exec(ctx, `
+        UPDATE "tuition_plan" SET
+            monthlyFee = $1,
+            validFrom = COALESCE(date_trunc('month', NULLIF($2, '')::date)::date, validFrom),
+            updated_at = NOW()
+        WHERE id = $3 AND deleted_at = 0`, req.MonthlyFee, req.ValidFrom, req.Id)
+
+	if err != nil {
+		log.Println("error while updating tuition plan in storage", err)
+		return nil, err
+	}
+
+	return t.getPlan(ctx, req.Id)
+}
+
+// DeletePlan implements storage.TuitionRepoI.
+func (t *tuitionRepo) DeletePlan(ctx context.Context, req *schedule_service.TuitionPlanPrimaryKey) error {
+	_, err := t.db.Exec(ctx, `
+        UPDATE "tuition_plan" SET
+            deleted_at = 1
+        WHERE id = $1
+    `, req.Id)
+
+	if err != nil {
+		log.Println("error while deleting tuition plan")
+		return err
+	}
+
+	return nil
+}
+
+// GenerateInvoices implements storage.TuitionRepoI. Every student of an
+// active group is invoiced once per period with the plan of the group's
+// branch and type in force at the start of the period. Running it again for
+// the same period only adds invoices for students who joined since. New
+// invoices are reduced by the students' discounts.
+func (t *tuitionRepo) GenerateInvoices(ctx context.Context, req *schedule_service.GenerateInvoicesRequest) (*schedule_service.GenerateInvoicesResponse, error) {
+	resp := &schedule_service.GenerateInvoicesResponse{Period: req.Period}
+
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting invoice generation transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	issued, err := tx.Query(ctx, `
+        INSERT INTO "invoice" (
+            studentId,
+            groupId,
+            tuitionPlanId,
+            period,
+            baseAmount,
+            amount,
+            dueDate
+        )
+        SELECT gs.studentId,
+            g.id,
+            p.id,
+            $1::date,
+            p.monthlyFee,
+            p.monthlyFee,
+            $1::date + (COALESCE(bs.paymentDueDay, 5) - 1)
+        FROM "group" g
+        JOIN "group_student" gs ON gs.groupId = g.id AND gs.deleted_at = 0
+        JOIN LATERAL (
+            SELECT tp.id, tp.monthlyFee
+            FROM "tuition_plan" tp
+            WHERE tp.branchId = g.branchId
+              AND tp.groupType = g.type
+              AND tp.deleted_at = 0
+              AND tp.validFrom <= $1::date
+            ORDER BY tp.validFrom DESC
+            LIMIT 1
+        ) p ON TRUE
+        LEFT JOIN "branch_setting" bs ON bs.branchId = g.branchId
+        WHERE g.deleted_at = 0
+          AND g.status = 'active'
+          AND ($2 = '' OR g.branchId::text = $2)
+        ON CONFLICT (studentId, groupId, period) DO NOTHING
+        RETURNING id, studentId::text, groupId::text, baseAmount::text`, req.Period, req.BranchId)
+	if err != nil {
+		log.Println("error while generating invoices", err)
+		return nil, err
+	}
+	defer issued.Close()
+
+	var invoices []issuedInvoice
+	for issued.Next() {
+		var invoice issuedInvoice
+		if err = issued.Scan(&invoice.id, &invoice.studentId, &invoice.groupId, &invoice.baseAmount); err != nil {
+			log.Println("error while scanning generated invoices", err)
+			return nil, err
+		}
+
+		invoices = append(invoices, invoice)
+	}
+
+	if err = issued.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+	issued.Close()
+	resp.Created = int32(len(invoices))
+
+	if err = applyInvoiceDiscounts(ctx, tx, req.Period, invoices); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, `
+        SELECT g.id, g.branchId::text, g.type
+        FROM "group" g
+        WHERE g.deleted_at = 0
+          AND g.status = 'active'
+          AND ($2 = '' OR g.branchId::text = $2)
+          AND EXISTS (SELECT 1 FROM "group_student" gs WHERE gs.groupId = g.id AND gs.deleted_at = 0)
+          AND NOT EXISTS (
+              SELECT 1 FROM "tuition_plan" tp
+              WHERE tp.branchId = g.branchId
+                AND tp.groupType = g.type
+                AND tp.deleted_at = 0
+                AND tp.validFrom <= $1::date
+          )`, req.Period, req.BranchId)
+	if err != nil {
+		log.Println("error while getting groups without tuition plan", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			group     schedule_service.GroupWithoutPlan
+			branchId  sql.NullString
+			groupType sql.NullString
+		)
+
+		if err = rows.Scan(&group.GroupId, &branchId, &groupType); err != nil {
+			log.Println("error while scanning group without tuition plan", err)
+			return nil, err
+		}
+		group.BranchId = branchId.String
+		group.GroupType = groupType.String
+
+		resp.GroupsWithoutPlan = append(resp.GroupsWithoutPlan, &group)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing invoice generation", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetListInvoice implements storage.TuitionRepoI.
+func (t *tuitionRepo) GetListInvoice(ctx context.Context, req *schedule_service.GetListInvoiceRequest) (*schedule_service.GetListInvoiceResponse, error) {
+	resp := &schedule_service.GetListInvoiceResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := t.db.Query(ctx, `
+        SELECT `+invoiceColumns+`
+        FROM "invoice" i
+        WHERE ($1 = '' OR i.studentId::text = $1)
+          AND ($2 = '' OR i.groupId::text = $2)
+          AND ($3 = '' OR i.period = $3::date)
+        ORDER BY i.period DESC, i.created_at DESC
+    `+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.StudentId, req.GroupId, req.Period)
+	if err != nil {
+		log.Println("error while getting all invoices:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		invoice, err := scanInvoice(rows)
+		if err != nil {
+			log.Println("error while scanning invoices:", err)
+			return nil, err
+		}
+
+		resp.Invoices = append(resp.Invoices, invoice)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// GetStudentBalance implements storage.TuitionRepoI. A positive outstanding
+// amount is debt, a negative one is prepaid credit.
+func (t *tuitionRepo) GetStudentBalance(ctx context.Context, req *schedule_service.StudentBalanceRequest) (*schedule_service.StudentBalance, error) {
+	resp := &schedule_service.StudentBalance{StudentId: req.StudentId}
+
+	err := t.db.QueryRow(ctx, `
+        SELECT invoiced::text, paid::text, (invoiced - paid)::text
+        FROM (
+            SELECT
+                COALESCE((SELECT SUM(amount) FROM "invoice" WHERE studentId = $1), 0) AS invoiced,
+                COALESCE((SELECT SUM(paidSum) FROM "student_payment" WHERE studentId = $1), 0) AS paid
+        ) totals`, req.StudentId).Scan(&resp.TotalInvoiced, &resp.TotalPaid, &resp.Outstanding)
+	if err != nil {
+		log.Println("error while getting student balance", err)
+		return nil, err
+	}
+
+	rows, err := t.db.Query(ctx, `
+        SELECT `+invoiceColumns+`
+        FROM "invoice" i
+        WHERE i.studentId = $1
+        ORDER BY i.period, i.created_at`, req.StudentId)
+	if err != nil {
+		log.Println("error while getting student invoices", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		invoice, err := scanInvoice(rows)
+		if err != nil {
+			log.Println("error while scanning student invoices", err)
+			return nil, err
+		}
+
+		resp.Invoices = append(resp.Invoices, invoice)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	payments, err := t.db.Query(ctx, `
+        SELECT `+studentPaymentColumns+`,
+            SUM(sp.paidSum) OVER (ORDER BY sp.created_at, sp.id)::text
+        FROM "student_payment" sp
+        WHERE sp.studentId = $1
+        ORDER BY sp.created_at, sp.id`, req.StudentId)
+	if err != nil {
+		log.Println("error while getting student payments", err)
+		return nil, err
+	}
+	defer payments.Close()
+
+	for payments.Next() {
+		var balance sql.NullString
+
+		payment, err := scanStudentPayment(payments, &balance)
+		if err != nil {
+			log.Println("error while scanning student payments", err)
+			return nil, err
+		}
+		payment.Balance = balance.String
+
+		resp.Payments = append(resp.Payments, payment)
+	}
+
+	if err = payments.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func scanTuitionPlan(row pgx.Row) (*schedule_service.TuitionPlan, error) {
+	var (
+		plan       schedule_service.TuitionPlan
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+
+	err := row.Scan(&plan.Id, &plan.BranchId, &plan.GroupType, &plan.MonthlyFee, &plan.ValidFrom, &created_at, &updated_at)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.CreatedAt = created_at.String
+	plan.UpdatedAt = updated_at.String
+
+	return &plan, nil
+}
+
+func scanInvoice(row pgx.Row) (*schedule_service.Invoice, error) {
+	var (
+		invoice       schedule_service.Invoice
+		tuitionPlanId sql.NullString
+		created_at    sql.NullString
+	)
+
+	err := row.Scan(&invoice.Id, &invoice.StudentId, &invoice.GroupId, &tuitionPlanId, &invoice.Period, &invoice.Amount, &invoice.DueDate, &created_at,
+		&invoice.BaseAmount, &invoice.DiscountAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice.TuitionPlanId = tuitionPlanId.String
+	invoice.CreatedAt = created_at.String
+
+	return &invoice, nil
+}
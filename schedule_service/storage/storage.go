@@ -3,6 +3,8 @@ package storage
 import (
 	"context"
 	us "schedule_service/genproto/schedule_service"
+	"schedule_service/pkg/payment"
+	"time"
 )
 
 type StorageI interface {
@@ -10,19 +12,36 @@ type StorageI interface {
 	EventStudent() EventStudentRepoI
 	Event() EventRepoI
 	Group() GroupRepoI
+	GroupStudent() GroupStudentRepoI
 	Journal() JournalRepoI
 	Schedule() ScheduleRepoI
 	StudentTask() StudentTaskRepoI
 	Task() TaskRepoI
 	StudentPayment() StudentPaymentRepoI
+	Timetable() TimetableRepoI
+	EventFeedback() EventFeedbackRepoI
+	Tuition() TuitionRepoI
+	Discount() DiscountRepoI
+	Overdue() OverdueRepoI
+	Document() DocumentRepoI
+	OnlinePayment() OnlinePaymentRepoI
+	CashShift() CashShiftRepoI
+	FinanceReport() FinanceReportRepoI
+	Payroll() PayrollRepoI
+	Expense() ExpenseRepoI
+	Commission() CommissionRepoI
+	MockTest() MockTestRepoI
+	Room() RoomRepoI
 }
 
 type EventStudentRepoI interface {
 	Create(ctx context.Context, req *us.CreateEventStudent) (*us.GetEventStudent, error)
 	GetByID(ctx context.Context, req *us.EventStudentPrimaryKey) (*us.GetEventStudent, error)
 	GetList(ctx context.Context, req *us.GetListEventStudentRequest) (*us.GetListEventStudentResponse, error)
-	Update(ctx context.Context, req *us.UpdateEventStudent) (*us.GetEventStudent, error)
 	Delete(ctx context.Context, req *us.EventStudentPrimaryKey) error
+	Cancel(ctx context.Context, req *us.CancelEventStudent) (*us.GetEventStudent, error)
+	CheckIn(ctx context.Context, eventStudentId string, req *us.CheckInEventStudent) (*us.GetEventStudent, error)
+	GetAttendance(ctx context.Context, req *us.EventAttendanceRequest) (*us.EventAttendance, error)
     GetStudentWithEventsByID(ctx context.Context, req *us.EventStudentPrimaryKey) (*us.GetStudentWithEventsResponse, error)
 
 }
@@ -42,6 +61,19 @@ type GroupRepoI interface {
 	Update(ctx context.Context, req *us.UpdateGroup) (*us.GetGroup, error)
 	Delete(ctx context.Context, req *us.GroupPrimaryKey) error
 	GetByIDTeacher(ctx context.Context, req *us.TeacherID) (*us.GetGroup, error)
+	PromoteGroup(ctx context.Context, req *us.PromoteGroupRequest) (*us.PromoteGroupResponse, error)
+	GetPromotion(ctx context.Context, req *us.PromotionPrimaryKey) (*us.PromoteGroupResponse, error)
+}
+
+type GroupStudentRepoI interface {
+	Create(ctx context.Context, req *us.CreateGroupStudent) (*us.GetGroupStudent, error)
+	GetByID(ctx context.Context, req *us.GroupStudentPrimaryKey) (*us.GetGroupStudent, error)
+	GetList(ctx context.Context, req *us.GetListGroupStudentRequest) (*us.GetListGroupStudentResponse, error)
+	Delete(ctx context.Context, req *us.GroupStudentPrimaryKey) error
+	JoinWaitlist(ctx context.Context, req *us.CreateGroupStudent) (*us.WaitlistEntry, error)
+	GetWaitlist(ctx context.Context, req *us.GetWaitlistRequest) (*us.GetWaitlistResponse, error)
+	LeaveWaitlist(ctx context.Context, req *us.WaitlistPrimaryKey) error
+	PromoteFromWaitlist(ctx context.Context, groupId string) ([]*us.WaitlistEntry, error)
 }
 
 type JournalRepoI interface {
@@ -51,6 +83,10 @@ type JournalRepoI interface {
 	Update(ctx context.Context, req *us.UpdateJournal) (*us.GetJournal, error)
 	Delete(ctx context.Context, req *us.JournalPrimaryKey) error
 	GetByGroupID(ctx context.Context, req *us.JournalPrimaryKey) (*us.GetJournal, error)
+	GetDueForRollover(ctx context.Context) ([]*us.Journal, error)
+	Rollover(ctx context.Context, req *us.JournalPrimaryKey) (*us.GetJournal, error)
+	SaveRolloverFailure(ctx context.Context, req *us.RolloverFailure) error
+	GetRolloverReport(ctx context.Context, req *us.GetRolloverReportRequest) (*us.GetRolloverReportResponse, error)
 }
 
 type ScheduleRepoI interface {
@@ -61,6 +97,9 @@ type ScheduleRepoI interface {
 	Delete(ctx context.Context, req *us.SchedulePrimaryKey) error
 	GetScheduleForWeek(ctx context.Context, teacherId string, weekStartDate, weekEndDate string) (*us.GetListScheduleResponse, error)
 	GetScheduleForMonth(ctx context.Context, teacherId string, monthStartDate, monthEndDate string) (*us.GetListScheduleResponse, error)
+	MarkAttendance(ctx context.Context, req *us.MarkAttendanceRequest) (*us.LessonAttendance, error)
+	AssignSubstitute(ctx context.Context, req *us.AssignSubstituteRequest) (*us.GetSchedule, error)
+	GetTeacherLessonCount(ctx context.Context, req *us.TeacherLessonCountRequest) (*us.TeacherLessonCount, error)
 }
 
 type StudentTaskRepoI interface {
@@ -85,6 +124,152 @@ type StudentPaymentRepoI interface {
 	Create(ctx context.Context, req *us.CreateStudentPayment) (*us.GetStudentPayment, error)
 	GetByID(ctx context.Context, req *us.StudentPaymentPrimaryKey) (*us.GetStudentPayment, error)
 	GetList(ctx context.Context, req *us.GetListStudentPaymentRequest) (*us.GetListStudentPaymentResponse, error)
-	Update(ctx context.Context, req *us.UpdateStudentPayment) (*us.GetStudentPayment, error)
-	Delete(ctx context.Context, req *us.StudentPaymentPrimaryKey) error
+	Reverse(ctx context.Context, req *us.ReverseStudentPayment) (*us.GetStudentPayment, error)
+	Refund(ctx context.Context, req *us.RefundStudentPayment) (*us.GetStudentPayment, error)
+}
+
+type TimetableRepoI interface {
+	CreateAvailability(ctx context.Context, req *us.CreateTeacherAvailability) (*us.TeacherAvailability, error)
+	GetListAvailability(ctx context.Context, req *us.GetListAvailabilityRequest) (*us.GetListAvailabilityResponse, error)
+	DeleteAvailability(ctx context.Context, req *us.TeacherAvailabilityPrimaryKey) error
+	SetLessonRequirement(ctx context.Context, req *us.LessonRequirement) (*us.LessonRequirement, error)
+	GetListLessonRequirement(ctx context.Context, req *us.GetListLessonRequirementRequest) (*us.GetListLessonRequirementResponse, error)
+	CountRooms(ctx context.Context, branchId string) (int32, error)
+	GetBusyLessons(ctx context.Context, branchId string) (sameBranch, otherBranches []*us.ProposedLesson, err error)
+	SaveProposal(ctx context.Context, req *us.TimetableProposal) (*us.TimetableProposal, error)
+	GetProposal(ctx context.Context, req *us.TimetableProposalPrimaryKey) (*us.TimetableProposal, error)
+	CommitProposal(ctx context.Context, req *us.TimetableProposalPrimaryKey) (*us.CommitTimetableResponse, error)
+}
+
+type EventFeedbackRepoI interface {
+	Create(ctx context.Context, req *us.CreateEventFeedback) (*us.EventFeedback, error)
+	GetList(ctx context.Context, req *us.GetListEventFeedbackRequest) (*us.GetListEventFeedbackResponse, error)
+	GetEventRating(ctx context.Context, req *us.EventRatingRequest) (*us.EventRating, error)
+	GetTopicRatings(ctx context.Context, req *us.TopicRatingRequest) (*us.TopicRatingResponse, error)
+	GetLowRatedEvents(ctx context.Context, req *us.LowRatedEventsRequest) (*us.LowRatedEventsResponse, error)
+}
+
+type TuitionRepoI interface {
+	CreatePlan(ctx context.Context, req *us.CreateTuitionPlan) (*us.TuitionPlan, error)
+	GetListPlan(ctx context.Context, req *us.GetListTuitionPlanRequest) (*us.GetListTuitionPlanResponse, error)
+	UpdatePlan(ctx context.Context, req *us.UpdateTuitionPlan) (*us.TuitionPlan, error)
+	DeletePlan(ctx context.Context, req *us.TuitionPlanPrimaryKey) error
+	GenerateInvoices(ctx context.Context, req *us.GenerateInvoicesRequest) (*us.GenerateInvoicesResponse, error)
+	GetListInvoice(ctx context.Context, req *us.GetListInvoiceRequest) (*us.GetListInvoiceResponse, error)
+	GetStudentBalance(ctx context.Context, req *us.StudentBalanceRequest) (*us.StudentBalance, error)
+}
+
+type DiscountRepoI interface {
+	Create(ctx context.Context, req *us.CreateDiscount) (*us.Discount, error)
+	GetList(ctx context.Context, req *us.GetListDiscountRequest) (*us.GetListDiscountResponse, error)
+	Delete(ctx context.Context, req *us.DiscountPrimaryKey) error
+	CreatePromo(ctx context.Context, req *us.CreatePromoCode) (*us.PromoCode, error)
+	GetListPromo(ctx context.Context, req *us.GetListPromoCodeRequest) (*us.GetListPromoCodeResponse, error)
+	DeletePromo(ctx context.Context, req *us.PromoCodePrimaryKey) error
+	RedeemPromo(ctx context.Context, req *us.RedeemPromoCodeRequest) (*us.Discount, error)
+	GetReport(ctx context.Context, req *us.DiscountReportRequest) (*us.DiscountReport, error)
+}
+
+type OverdueRepoI interface {
+	Refresh(ctx context.Context) (int64, int64, error)
+	GetList(ctx context.Context, req *us.GetListOverdueRequest) (*us.GetListOverdueResponse, error)
+	GetDueForReminder(ctx context.Context, remindEvery time.Duration) ([]*us.Overdue, error)
+	MarkReminded(ctx context.Context, id string) error
+	HoldOverdue(ctx context.Context, afterDays int32) ([]*us.StudentHold, error)
+	CreateHold(ctx context.Context, req *us.CreateStudentHold) (*us.StudentHold, error)
+	ClearHold(ctx context.Context, req *us.ClearStudentHold) (*us.StudentHold, error)
+	GetListHold(ctx context.Context, req *us.GetListStudentHoldRequest) (*us.GetListStudentHoldResponse, error)
+	GetHold(ctx context.Context, req *us.StudentHoldRequest) (*us.StudentHoldStatus, error)
+}
+
+type DocumentRepoI interface {
+	GetReceipt(ctx context.Context, req *us.PaymentReceiptRequest) (*us.PaymentReceipt, error)
+	CreateTemplate(ctx context.Context, req *us.CreateContractTemplate) (*us.ContractTemplate, error)
+	GetTemplate(ctx context.Context, req *us.ContractTemplatePrimaryKey) (*us.ContractTemplate, error)
+	GetListTemplate(ctx context.Context, req *us.GetListContractTemplateRequest) (*us.GetListContractTemplateResponse, error)
+	UpdateTemplate(ctx context.Context, req *us.UpdateContractTemplate) (*us.ContractTemplate, error)
+	DeleteTemplate(ctx context.Context, req *us.ContractTemplatePrimaryKey) error
+	GetContractData(ctx context.Context, req *us.RenderContractRequest) (*us.ContractTemplate, map[string]string, error)
+}
+
+type OnlinePaymentRepoI interface {
+	Create(ctx context.Context, req *us.CreateOnlinePayment) (*us.OnlinePayment, error)
+	GetByID(ctx context.Context, req *us.OnlinePaymentPrimaryKey) (*us.OnlinePayment, error)
+	GetList(ctx context.Context, req *us.GetListOnlinePaymentRequest) (*us.GetListOnlinePaymentResponse, error)
+	Capture(ctx context.Context, provider string, callback *payment.Callback) (*us.OnlinePayment, bool, error)
+	LogCallback(ctx context.Context, provider string, payload map[string]string, callback *payment.Callback, callbackErr error) error
+	Reconcile(ctx context.Context, req *us.ReconcileOnlinePaymentRequest) (*us.OnlinePaymentReconciliation, error)
+}
+
+type CashShiftRepoI interface {
+	Open(ctx context.Context, req *us.OpenCashShift) (*us.CashShift, error)
+	Close(ctx context.Context, req *us.CloseCashShift) (*us.CashShift, error)
+	Approve(ctx context.Context, req *us.ApproveCashShift) (*us.CashShift, error)
+	GetByID(ctx context.Context, req *us.CashShiftPrimaryKey) (*us.CashShift, error)
+	GetCurrent(ctx context.Context, req *us.CurrentCashShiftRequest) (*us.CashShift, error)
+	GetList(ctx context.Context, req *us.GetListCashShiftRequest) (*us.GetListCashShiftResponse, error)
+}
+
+type FinanceReportRepoI interface {
+	GetRevenue(ctx context.Context, req *us.FinanceReportRequest) (*us.RevenueReport, error)
+	GetCollections(ctx context.Context, req *us.FinanceReportRequest) (*us.CollectionsReport, error)
+	GetDebtAging(ctx context.Context, req *us.DebtAgingRequest) (*us.DebtAgingReport, error)
+	GetMonthComparison(ctx context.Context, req *us.FinanceReportRequest) (*us.MonthComparisonReport, error)
+	GetProfitAndLoss(ctx context.Context, req *us.FinanceReportRequest) (*us.ProfitAndLossReport, error)
+}
+
+type PayrollRepoI interface {
+	CreateRule(ctx context.Context, req *us.CreatePayRule) (*us.PayRule, error)
+	GetListRule(ctx context.Context, req *us.GetListPayRuleRequest) (*us.GetListPayRuleResponse, error)
+	DeleteRule(ctx context.Context, req *us.PayRulePrimaryKey) error
+	CreateAdjustment(ctx context.Context, req *us.CreatePayrollAdjustment) (*us.PayrollAdjustment, error)
+	GetListAdjustment(ctx context.Context, req *us.GetListPayrollAdjustmentRequest) (*us.GetListPayrollAdjustmentResponse, error)
+	DeleteAdjustment(ctx context.Context, req *us.PayrollAdjustmentPrimaryKey) error
+	CreateRun(ctx context.Context, req *us.CreatePayrollRun) (*us.PayrollRun, error)
+	RecalculateRun(ctx context.Context, req *us.PayrollRunPrimaryKey) (*us.PayrollRun, error)
+	LockRun(ctx context.Context, req *us.LockPayrollRun) (*us.PayrollRun, error)
+	GetRun(ctx context.Context, req *us.GetPayrollRunRequest) (*us.PayrollRun, error)
+	GetListRun(ctx context.Context, req *us.GetListPayrollRunRequest) (*us.GetListPayrollRunResponse, error)
+}
+
+type ExpenseRepoI interface {
+	CreateCategory(ctx context.Context, req *us.CreateExpenseCategory) (*us.ExpenseCategory, error)
+	GetListCategory(ctx context.Context, req *us.GetListExpenseCategoryRequest) (*us.GetListExpenseCategoryResponse, error)
+	DeleteCategory(ctx context.Context, req *us.ExpenseCategoryPrimaryKey) error
+	Create(ctx context.Context, req *us.CreateExpense) (*us.Expense, error)
+	GetByID(ctx context.Context, req *us.ExpensePrimaryKey) (*us.Expense, error)
+	GetList(ctx context.Context, req *us.GetListExpenseRequest) (*us.GetListExpenseResponse, error)
+	Update(ctx context.Context, req *us.UpdateExpense) (*us.Expense, error)
+	Delete(ctx context.Context, req *us.ExpensePrimaryKey) error
+	Review(ctx context.Context, req *us.ReviewExpense) (*us.Expense, error)
+	AddAttachment(ctx context.Context, req *us.CreateExpenseAttachment) (*us.ExpenseAttachment, error)
+	GetAttachment(ctx context.Context, req *us.ExpenseAttachmentPrimaryKey) (*us.ExpenseAttachmentFile, error)
+	DeleteAttachment(ctx context.Context, req *us.ExpenseAttachmentPrimaryKey) error
+}
+
+type CommissionRepoI interface {
+	CreateRule(ctx context.Context, req *us.CreateCommissionRule) (*us.CommissionRule, error)
+	GetListRule(ctx context.Context, req *us.GetListCommissionRuleRequest) (*us.GetListCommissionRuleResponse, error)
+	DeleteRule(ctx context.Context, req *us.CommissionRulePrimaryKey) error
+	GetReport(ctx context.Context, req *us.CommissionReportRequest) (*us.CommissionReport, error)
+}
+
+type MockTestRepoI interface {
+	Create(ctx context.Context, req *us.CreateMockTest) (*us.MockTest, error)
+	GetByID(ctx context.Context, req *us.MockTestPrimaryKey) (*us.MockTest, error)
+	GetList(ctx context.Context, req *us.GetListMockTestRequest) (*us.GetListMockTestResponse, error)
+	Delete(ctx context.Context, req *us.MockTestPrimaryKey) error
+	SaveResult(ctx context.Context, req *us.MockTestResult) (*us.MockTestResult, error)
+	DeleteResult(ctx context.Context, req *us.MockTestResultPrimaryKey) error
+	GetStudentProgress(ctx context.Context, req *us.StudentMockProgressRequest) (*us.StudentMockProgress, error)
+	GetGroupAverages(ctx context.Context, req *us.GroupMockAveragesRequest) (*us.GroupMockAverages, error)
+}
+
+type RoomRepoI interface {
+	Create(ctx context.Context, req *us.CreateRoom) (*us.Room, error)
+	GetByID(ctx context.Context, req *us.RoomPrimaryKey) (*us.Room, error)
+	GetList(ctx context.Context, req *us.GetListRoomRequest) (*us.GetListRoomResponse, error)
+	Update(ctx context.Context, req *us.UpdateRoom) (*us.Room, error)
+	Delete(ctx context.Context, req *us.RoomPrimaryKey) error
+	GetUtilization(ctx context.Context, req *us.RoomUtilizationRequest) (*us.RoomUtilizationReport, error)
 }
@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: branch_setting.proto
+
+package user_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	BranchSettingService_Create_FullMethodName  = "/user_service.BranchSettingService/Create"
+	BranchSettingService_GetByID_FullMethodName = "/user_service.BranchSettingService/GetByID"
+	BranchSettingService_GetList_FullMethodName = "/user_service.BranchSettingService/GetList"
+	BranchSettingService_Update_FullMethodName  = "/user_service.BranchSettingService/Update"
+	BranchSettingService_Delete_FullMethodName  = "/user_service.BranchSettingService/Delete"
+)
+
+// BranchSettingServiceClient is the client API for BranchSettingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BranchSettingServiceClient interface {
+	Create(ctx context.Context, in *CreateBranchSetting, opts ...grpc.CallOption) (*BranchSetting, error)
+	GetByID(ctx context.Context, in *BranchSettingPrimaryKey, opts ...grpc.CallOption) (*BranchSetting, error)
+	GetList(ctx context.Context, in *GetListBranchSettingRequest, opts ...grpc.CallOption) (*GetListBranchSettingResponse, error)
+	Update(ctx context.Context, in *UpdateBranchSetting, opts ...grpc.CallOption) (*BranchSetting, error)
+	Delete(ctx context.Context, in *BranchSettingPrimaryKey, opts ...grpc.CallOption) (*EmptyBranchSetting, error)
+}
+
+type branchSettingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBranchSettingServiceClient(cc grpc.ClientConnInterface) BranchSettingServiceClient {
+	return &branchSettingServiceClient{cc}
+}
+
+func (c *branchSettingServiceClient) Create(ctx context.Context, in *CreateBranchSetting, opts ...grpc.CallOption) (*BranchSetting, error) {
+	out := new(BranchSetting)
+	err := c.cc.Invoke(ctx, BranchSettingService_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchSettingServiceClient) GetByID(ctx context.Context, in *BranchSettingPrimaryKey, opts ...grpc.CallOption) (*BranchSetting, error) {
+	out := new(BranchSetting)
+	err := c.cc.Invoke(ctx, BranchSettingService_GetByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchSettingServiceClient) GetList(ctx context.Context, in *GetListBranchSettingRequest, opts ...grpc.CallOption) (*GetListBranchSettingResponse, error) {
+	out := new(GetListBranchSettingResponse)
+	err := c.cc.Invoke(ctx, BranchSettingService_GetList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchSettingServiceClient) Update(ctx context.Context, in *UpdateBranchSetting, opts ...grpc.CallOption) (*BranchSetting, error) {
+	out := new(BranchSetting)
+	err := c.cc.Invoke(ctx, BranchSettingService_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchSettingServiceClient) Delete(ctx context.Context, in *BranchSettingPrimaryKey, opts ...grpc.CallOption) (*EmptyBranchSetting, error) {
+	out := new(EmptyBranchSetting)
+	err := c.cc.Invoke(ctx, BranchSettingService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BranchSettingServiceServer is the server API for BranchSettingService service.
+// All implementations should embed UnimplementedBranchSettingServiceServer
+// for forward compatibility
+type BranchSettingServiceServer interface {
+	Create(context.Context, *CreateBranchSetting) (*BranchSetting, error)
+	GetByID(context.Context, *BranchSettingPrimaryKey) (*BranchSetting, error)
+	GetList(context.Context, *GetListBranchSettingRequest) (*GetListBranchSettingResponse, error)
+	Update(context.Context, *UpdateBranchSetting) (*BranchSetting, error)
+	Delete(context.Context, *BranchSettingPrimaryKey) (*EmptyBranchSetting, error)
+}
+
+// UnimplementedBranchSettingServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedBranchSettingServiceServer struct {
+}
+
+func (UnimplementedBranchSettingServiceServer) Create(context.Context, *CreateBranchSetting) (*BranchSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedBranchSettingServiceServer) GetByID(context.Context, *BranchSettingPrimaryKey) (*BranchSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedBranchSettingServiceServer) GetList(context.Context, *GetListBranchSettingRequest) (*GetListBranchSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedBranchSettingServiceServer) Update(context.Context, *UpdateBranchSetting) (*BranchSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedBranchSettingServiceServer) Delete(context.Context, *BranchSettingPrimaryKey) (*EmptyBranchSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+
+// UnsafeBranchSettingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BranchSettingServiceServer will
+// result in compilation errors.
+type UnsafeBranchSettingServiceServer interface {
+	mustEmbedUnimplementedBranchSettingServiceServer()
+}
+
+func RegisterBranchSettingServiceServer(s grpc.ServiceRegistrar, srv BranchSettingServiceServer) {
+	s.RegisterService(&BranchSettingService_ServiceDesc, srv)
+}
+
+func _BranchSettingService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBranchSetting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchSettingServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BranchSettingService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchSettingServiceServer).Create(ctx, req.(*CreateBranchSetting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BranchSettingService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BranchSettingPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchSettingServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BranchSettingService_GetByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchSettingServiceServer).GetByID(ctx, req.(*BranchSettingPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BranchSettingService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListBranchSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchSettingServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BranchSettingService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchSettingServiceServer).GetList(ctx, req.(*GetListBranchSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BranchSettingService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBranchSetting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchSettingServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BranchSettingService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchSettingServiceServer).Update(ctx, req.(*UpdateBranchSetting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BranchSettingService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BranchSettingPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchSettingServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BranchSettingService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchSettingServiceServer).Delete(ctx, req.(*BranchSettingPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BranchSettingService_ServiceDesc is the grpc.ServiceDesc for BranchSettingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BranchSettingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user_service.BranchSettingService",
+	HandlerType: (*BranchSettingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _BranchSettingService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _BranchSettingService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _BranchSettingService_GetList_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _BranchSettingService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _BranchSettingService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "branch_setting.proto",
+}
@@ -0,0 +1,292 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: ielts_result.proto
+
+package user_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	IeltsResultService_Create_FullMethodName          = "/user_service.IeltsResultService/Create"
+	IeltsResultService_GetByID_FullMethodName         = "/user_service.IeltsResultService/GetByID"
+	IeltsResultService_GetList_FullMethodName         = "/user_service.IeltsResultService/GetList"
+	IeltsResultService_Update_FullMethodName          = "/user_service.IeltsResultService/Update"
+	IeltsResultService_Delete_FullMethodName          = "/user_service.IeltsResultService/Delete"
+	IeltsResultService_GetListExpiring_FullMethodName = "/user_service.IeltsResultService/GetListExpiring"
+)
+
+// IeltsResultServiceClient is the client API for IeltsResultService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IeltsResultServiceClient interface {
+	Create(ctx context.Context, in *CreateIeltsResult, opts ...grpc.CallOption) (*IeltsResult, error)
+	GetByID(ctx context.Context, in *IeltsResultPrimaryKey, opts ...grpc.CallOption) (*IeltsResult, error)
+	GetList(ctx context.Context, in *GetListIeltsResultRequest, opts ...grpc.CallOption) (*GetListIeltsResultResponse, error)
+	Update(ctx context.Context, in *UpdateIeltsResult, opts ...grpc.CallOption) (*IeltsResult, error)
+	Delete(ctx context.Context, in *IeltsResultPrimaryKey, opts ...grpc.CallOption) (*EmptyIeltsResult, error)
+	GetListExpiring(ctx context.Context, in *GetListExpiringIeltsRequest, opts ...grpc.CallOption) (*GetListExpiringIeltsResponse, error)
+}
+
+type ieltsResultServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIeltsResultServiceClient(cc grpc.ClientConnInterface) IeltsResultServiceClient {
+	return &ieltsResultServiceClient{cc}
+}
+
+func (c *ieltsResultServiceClient) Create(ctx context.Context, in *CreateIeltsResult, opts ...grpc.CallOption) (*IeltsResult, error) {
+	out := new(IeltsResult)
+	err := c.cc.Invoke(ctx, IeltsResultService_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ieltsResultServiceClient) GetByID(ctx context.Context, in *IeltsResultPrimaryKey, opts ...grpc.CallOption) (*IeltsResult, error) {
+	out := new(IeltsResult)
+	err := c.cc.Invoke(ctx, IeltsResultService_GetByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ieltsResultServiceClient) GetList(ctx context.Context, in *GetListIeltsResultRequest, opts ...grpc.CallOption) (*GetListIeltsResultResponse, error) {
+	out := new(GetListIeltsResultResponse)
+	err := c.cc.Invoke(ctx, IeltsResultService_GetList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ieltsResultServiceClient) Update(ctx context.Context, in *UpdateIeltsResult, opts ...grpc.CallOption) (*IeltsResult, error) {
+	out := new(IeltsResult)
+	err := c.cc.Invoke(ctx, IeltsResultService_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ieltsResultServiceClient) Delete(ctx context.Context, in *IeltsResultPrimaryKey, opts ...grpc.CallOption) (*EmptyIeltsResult, error) {
+	out := new(EmptyIeltsResult)
+	err := c.cc.Invoke(ctx, IeltsResultService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ieltsResultServiceClient) GetListExpiring(ctx context.Context, in *GetListExpiringIeltsRequest, opts ...grpc.CallOption) (*GetListExpiringIeltsResponse, error) {
+	out := new(GetListExpiringIeltsResponse)
+	err := c.cc.Invoke(ctx, IeltsResultService_GetListExpiring_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IeltsResultServiceServer is the server API for IeltsResultService service.
+// All implementations should embed UnimplementedIeltsResultServiceServer
+// for forward compatibility
+type IeltsResultServiceServer interface {
+	Create(context.Context, *CreateIeltsResult) (*IeltsResult, error)
+	GetByID(context.Context, *IeltsResultPrimaryKey) (*IeltsResult, error)
+	GetList(context.Context, *GetListIeltsResultRequest) (*GetListIeltsResultResponse, error)
+	Update(context.Context, *UpdateIeltsResult) (*IeltsResult, error)
+	Delete(context.Context, *IeltsResultPrimaryKey) (*EmptyIeltsResult, error)
+	GetListExpiring(context.Context, *GetListExpiringIeltsRequest) (*GetListExpiringIeltsResponse, error)
+}
+
+// UnimplementedIeltsResultServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedIeltsResultServiceServer struct {
+}
+
+func (UnimplementedIeltsResultServiceServer) Create(context.Context, *CreateIeltsResult) (*IeltsResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedIeltsResultServiceServer) GetByID(context.Context, *IeltsResultPrimaryKey) (*IeltsResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedIeltsResultServiceServer) GetList(context.Context, *GetListIeltsResultRequest) (*GetListIeltsResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedIeltsResultServiceServer) Update(context.Context, *UpdateIeltsResult) (*IeltsResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedIeltsResultServiceServer) Delete(context.Context, *IeltsResultPrimaryKey) (*EmptyIeltsResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedIeltsResultServiceServer) GetListExpiring(context.Context, *GetListExpiringIeltsRequest) (*GetListExpiringIeltsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListExpiring not implemented")
+}
+
+// UnsafeIeltsResultServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IeltsResultServiceServer will
+// result in compilation errors.
+type UnsafeIeltsResultServiceServer interface {
+	mustEmbedUnimplementedIeltsResultServiceServer()
+}
+
+func RegisterIeltsResultServiceServer(s grpc.ServiceRegistrar, srv IeltsResultServiceServer) {
+	s.RegisterService(&IeltsResultService_ServiceDesc, srv)
+}
+
+func _IeltsResultService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIeltsResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IeltsResultServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IeltsResultService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IeltsResultServiceServer).Create(ctx, req.(*CreateIeltsResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IeltsResultService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IeltsResultPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IeltsResultServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IeltsResultService_GetByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IeltsResultServiceServer).GetByID(ctx, req.(*IeltsResultPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IeltsResultService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListIeltsResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IeltsResultServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IeltsResultService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IeltsResultServiceServer).GetList(ctx, req.(*GetListIeltsResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IeltsResultService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIeltsResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IeltsResultServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IeltsResultService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IeltsResultServiceServer).Update(ctx, req.(*UpdateIeltsResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IeltsResultService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IeltsResultPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IeltsResultServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IeltsResultService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IeltsResultServiceServer).Delete(ctx, req.(*IeltsResultPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IeltsResultService_GetListExpiring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListExpiringIeltsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IeltsResultServiceServer).GetListExpiring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IeltsResultService_GetListExpiring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IeltsResultServiceServer).GetListExpiring(ctx, req.(*GetListExpiringIeltsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IeltsResultService_ServiceDesc is the grpc.ServiceDesc for IeltsResultService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IeltsResultService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user_service.IeltsResultService",
+	HandlerType: (*IeltsResultServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _IeltsResultService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _IeltsResultService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _IeltsResultService_GetList_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _IeltsResultService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _IeltsResultService_Delete_Handler,
+		},
+		{
+			MethodName: "GetListExpiring",
+			Handler:    _IeltsResultService_GetListExpiring_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ielts_result.proto",
+}
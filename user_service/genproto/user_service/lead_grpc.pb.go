@@ -0,0 +1,403 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: lead.proto
+
+package user_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	LeadService_Create_FullMethodName              = "/user_service.LeadService/Create"
+	LeadService_GetByID_FullMethodName             = "/user_service.LeadService/GetByID"
+	LeadService_GetList_FullMethodName             = "/user_service.LeadService/GetList"
+	LeadService_Update_FullMethodName              = "/user_service.LeadService/Update"
+	LeadService_Delete_FullMethodName              = "/user_service.LeadService/Delete"
+	LeadService_UpdateStatus_FullMethodName        = "/user_service.LeadService/UpdateStatus"
+	LeadService_BookTrial_FullMethodName           = "/user_service.LeadService/BookTrial"
+	LeadService_Convert_FullMethodName             = "/user_service.LeadService/Convert"
+	LeadService_GetConversionReport_FullMethodName = "/user_service.LeadService/GetConversionReport"
+)
+
+// LeadServiceClient is the client API for LeadService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LeadServiceClient interface {
+	Create(ctx context.Context, in *CreateLead, opts ...grpc.CallOption) (*Lead, error)
+	GetByID(ctx context.Context, in *LeadPrimaryKey, opts ...grpc.CallOption) (*Lead, error)
+	GetList(ctx context.Context, in *GetListLeadRequest, opts ...grpc.CallOption) (*GetListLeadResponse, error)
+	Update(ctx context.Context, in *UpdateLead, opts ...grpc.CallOption) (*Lead, error)
+	Delete(ctx context.Context, in *LeadPrimaryKey, opts ...grpc.CallOption) (*EmptyLead, error)
+	UpdateStatus(ctx context.Context, in *UpdateLeadStatus, opts ...grpc.CallOption) (*Lead, error)
+	BookTrial(ctx context.Context, in *BookLeadTrial, opts ...grpc.CallOption) (*Lead, error)
+	Convert(ctx context.Context, in *ConvertLead, opts ...grpc.CallOption) (*Lead, error)
+	GetConversionReport(ctx context.Context, in *LeadConversionReportRequest, opts ...grpc.CallOption) (*LeadConversionReport, error)
+}
+
+type leadServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLeadServiceClient(cc grpc.ClientConnInterface) LeadServiceClient {
+	return &leadServiceClient{cc}
+}
+
+func (c *leadServiceClient) Create(ctx context.Context, in *CreateLead, opts ...grpc.CallOption) (*Lead, error) {
+	out := new(Lead)
+	err := c.cc.Invoke(ctx, LeadService_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadServiceClient) GetByID(ctx context.Context, in *LeadPrimaryKey, opts ...grpc.CallOption) (*Lead, error) {
+	out := new(Lead)
+	err := c.cc.Invoke(ctx, LeadService_GetByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadServiceClient) GetList(ctx context.Context, in *GetListLeadRequest, opts ...grpc.CallOption) (*GetListLeadResponse, error) {
+	out := new(GetListLeadResponse)
+	err := c.cc.Invoke(ctx, LeadService_GetList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadServiceClient) Update(ctx context.Context, in *UpdateLead, opts ...grpc.CallOption) (*Lead, error) {
+	out := new(Lead)
+	err := c.cc.Invoke(ctx, LeadService_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadServiceClient) Delete(ctx context.Context, in *LeadPrimaryKey, opts ...grpc.CallOption) (*EmptyLead, error) {
+	out := new(EmptyLead)
+	err := c.cc.Invoke(ctx, LeadService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadServiceClient) UpdateStatus(ctx context.Context, in *UpdateLeadStatus, opts ...grpc.CallOption) (*Lead, error) {
+	out := new(Lead)
+	err := c.cc.Invoke(ctx, LeadService_UpdateStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadServiceClient) BookTrial(ctx context.Context, in *BookLeadTrial, opts ...grpc.CallOption) (*Lead, error) {
+	out := new(Lead)
+	err := c.cc.Invoke(ctx, LeadService_BookTrial_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadServiceClient) Convert(ctx context.Context, in *ConvertLead, opts ...grpc.CallOption) (*Lead, error) {
+	out := new(Lead)
+	err := c.cc.Invoke(ctx, LeadService_Convert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadServiceClient) GetConversionReport(ctx context.Context, in *LeadConversionReportRequest, opts ...grpc.CallOption) (*LeadConversionReport, error) {
+	out := new(LeadConversionReport)
+	err := c.cc.Invoke(ctx, LeadService_GetConversionReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LeadServiceServer is the server API for LeadService service.
+// All implementations should embed UnimplementedLeadServiceServer
+// for forward compatibility
+type LeadServiceServer interface {
+	Create(context.Context, *CreateLead) (*Lead, error)
+	GetByID(context.Context, *LeadPrimaryKey) (*Lead, error)
+	GetList(context.Context, *GetListLeadRequest) (*GetListLeadResponse, error)
+	Update(context.Context, *UpdateLead) (*Lead, error)
+	Delete(context.Context, *LeadPrimaryKey) (*EmptyLead, error)
+	UpdateStatus(context.Context, *UpdateLeadStatus) (*Lead, error)
+	BookTrial(context.Context, *BookLeadTrial) (*Lead, error)
+	Convert(context.Context, *ConvertLead) (*Lead, error)
+	GetConversionReport(context.Context, *LeadConversionReportRequest) (*LeadConversionReport, error)
+}
+
+// UnimplementedLeadServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedLeadServiceServer struct {
+}
+
+func (UnimplementedLeadServiceServer) Create(context.Context, *CreateLead) (*Lead, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedLeadServiceServer) GetByID(context.Context, *LeadPrimaryKey) (*Lead, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
+func (UnimplementedLeadServiceServer) GetList(context.Context, *GetListLeadRequest) (*GetListLeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedLeadServiceServer) Update(context.Context, *UpdateLead) (*Lead, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedLeadServiceServer) Delete(context.Context, *LeadPrimaryKey) (*EmptyLead, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedLeadServiceServer) UpdateStatus(context.Context, *UpdateLeadStatus) (*Lead, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStatus not implemented")
+}
+func (UnimplementedLeadServiceServer) BookTrial(context.Context, *BookLeadTrial) (*Lead, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookTrial not implemented")
+}
+func (UnimplementedLeadServiceServer) Convert(context.Context, *ConvertLead) (*Lead, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Convert not implemented")
+}
+func (UnimplementedLeadServiceServer) GetConversionReport(context.Context, *LeadConversionReportRequest) (*LeadConversionReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversionReport not implemented")
+}
+
+// UnsafeLeadServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LeadServiceServer will
+// result in compilation errors.
+type UnsafeLeadServiceServer interface {
+	mustEmbedUnimplementedLeadServiceServer()
+}
+
+func RegisterLeadServiceServer(s grpc.ServiceRegistrar, srv LeadServiceServer) {
+	s.RegisterService(&LeadService_ServiceDesc, srv)
+}
+
+func _LeadService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).Create(ctx, req.(*CreateLead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeadPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_GetByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).GetByID(ctx, req.(*LeadPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListLeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).GetList(ctx, req.(*GetListLeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).Update(ctx, req.(*UpdateLead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeadPrimaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).Delete(ctx, req.(*LeadPrimaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadService_UpdateStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLeadStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).UpdateStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_UpdateStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).UpdateStatus(ctx, req.(*UpdateLeadStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadService_BookTrial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookLeadTrial)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).BookTrial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_BookTrial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).BookTrial(ctx, req.(*BookLeadTrial))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadService_Convert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertLead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).Convert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_Convert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).Convert(ctx, req.(*ConvertLead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadService_GetConversionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeadConversionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).GetConversionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_GetConversionReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).GetConversionReport(ctx, req.(*LeadConversionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LeadService_ServiceDesc is the grpc.ServiceDesc for LeadService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LeadService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user_service.LeadService",
+	HandlerType: (*LeadServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _LeadService_Create_Handler,
+		},
+		{
+			MethodName: "GetByID",
+			Handler:    _LeadService_GetByID_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _LeadService_GetList_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _LeadService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _LeadService_Delete_Handler,
+		},
+		{
+			MethodName: "UpdateStatus",
+			Handler:    _LeadService_UpdateStatus_Handler,
+		},
+		{
+			MethodName: "BookTrial",
+			Handler:    _LeadService_BookTrial_Handler,
+		},
+		{
+			MethodName: "Convert",
+			Handler:    _LeadService_Convert_Handler,
+		},
+		{
+			MethodName: "GetConversionReport",
+			Handler:    _LeadService_GetConversionReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lead.proto",
+}
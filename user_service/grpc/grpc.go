@@ -18,11 +18,15 @@ func SetUpServer(cfg config.Config, log logger.LoggerI, strg storage.StorageI, s
 
 	user_service.RegisterAdministrationServiceServer(grpcServer, service.NewAdministrationService(cfg, log, strg, srvc))
 	user_service.RegisterBranchServiceServer(grpcServer, service.NewBranchService(cfg, log, strg, srvc))
+	user_service.RegisterBranchSettingServiceServer(grpcServer, service.NewBranchSettingService(cfg, log, strg, srvc))
 	user_service.RegisterManagerServiceServer(grpcServer, service.NewManagerService(cfg, log, strg, srvc))
 	user_service.RegisterStudentServiceServer(grpcServer, service.NewStudentService(cfg, log, strg, srvc))
 	user_service.RegisterSupportTeacherServiceServer(grpcServer, service.NewSupportTeacherService(cfg, log, strg, srvc))
 	user_service.RegisterTeacherServiceServer(grpcServer, service.NewTeacherService(cfg, log, strg, srvc))
 	user_service.RegisterLoginServiceServer(grpcServer, service.NewLoginService(cfg, log, strg, srvc))
+	user_service.RegisterIeltsResultServiceServer(grpcServer, service.NewIeltsResultService(cfg, log, strg, srvc))
+	user_service.RegisterGuardianServiceServer(grpcServer, service.NewGuardianService(cfg, log, strg, srvc))
+	user_service.RegisterLeadServiceServer(grpcServer, service.NewLeadService(cfg, log, strg, srvc))
 	reflection.Register(grpcServer)
 	return
 }
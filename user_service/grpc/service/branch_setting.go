@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+	"user_service/config"
+	"user_service/genproto/user_service"
+	"user_service/grpc/client"
+	"user_service/storage"
+	"user_service/storage/postgres"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+type BranchSettingService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+}
+
+func NewBranchSettingService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *BranchSettingService {
+	return &BranchSettingService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (b *BranchSettingService) Create(ctx context.Context, req *user_service.CreateBranchSetting) (*user_service.BranchSetting, error) {
+	b.log.Info("---CreateBranchSetting--->>>", logger.Any("req", req))
+
+	setting := &user_service.UpdateBranchSetting{
+		BranchId:                req.BranchId,
+		EventWeekdays:           req.EventWeekdays,
+		RegistrationCutoffHours: req.RegistrationCutoffHours,
+		PaymentDueDay:           req.PaymentDueDay,
+		LessonDurationMinutes:   req.LessonDurationMinutes,
+		Timezone:                req.Timezone,
+		PhonePattern:            req.PhonePattern,
+	}
+	if err := normalizeBranchSetting(setting); err != nil {
+		b.log.Error("---CreateBranchSetting--->>>", logger.Error(err))
+		return &user_service.BranchSetting{}, err
+	}
+
+	req = &user_service.CreateBranchSetting{
+		BranchId:                setting.BranchId,
+		EventWeekdays:           setting.EventWeekdays,
+		RegistrationCutoffHours: setting.RegistrationCutoffHours,
+		PaymentDueDay:           setting.PaymentDueDay,
+		LessonDurationMinutes:   setting.LessonDurationMinutes,
+		Timezone:                setting.Timezone,
+		PhonePattern:            setting.PhonePattern,
+	}
+
+	resp, err := b.strg.BranchSetting().Create(ctx, req)
+	if err != nil {
+		b.log.Error("---CreateBranchSetting--->>>", logger.Error(err))
+		return &user_service.BranchSetting{}, err
+	}
+
+	return resp, nil
+}
+
+func (b *BranchSettingService) GetByID(ctx context.Context, req *user_service.BranchSettingPrimaryKey) (*user_service.BranchSetting, error) {
+	b.log.Info("---GetSingleBranchSetting--->>>", logger.Any("req", req))
+
+	resp, err := b.strg.BranchSetting().GetByID(ctx, req)
+	if err != nil {
+		b.log.Error("---GetSingleBranchSetting--->>>", logger.Error(err))
+		return &user_service.BranchSetting{}, err
+	}
+
+	return resp, nil
+}
+
+func (b *BranchSettingService) GetList(ctx context.Context, req *user_service.GetListBranchSettingRequest) (*user_service.GetListBranchSettingResponse, error) {
+	b.log.Info("---GetAllBranchSetting--->>>", logger.Any("req", req))
+
+	resp, err := b.strg.BranchSetting().GetList(ctx, req)
+	if err != nil {
+		b.log.Error("---GetAllBranchSetting--->>>", logger.Error(err))
+		return &user_service.GetListBranchSettingResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (b *BranchSettingService) Update(ctx context.Context, req *user_service.UpdateBranchSetting) (*user_service.BranchSetting, error) {
+	b.log.Info("---UpdateBranchSetting--->>>", logger.Any("req", req))
+
+	if err := normalizeBranchSetting(req); err != nil {
+		b.log.Error("---UpdateBranchSetting--->>>", logger.Error(err))
+		return &user_service.BranchSetting{}, err
+	}
+
+	resp, err := b.strg.BranchSetting().Update(ctx, req)
+	if err != nil {
+		b.log.Error("---UpdateBranchSetting--->>>", logger.Error(err))
+		return &user_service.BranchSetting{}, err
+	}
+
+	return resp, nil
+}
+
+func (b *BranchSettingService) Delete(ctx context.Context, req *user_service.BranchSettingPrimaryKey) (*user_service.EmptyBranchSetting, error) {
+	b.log.Info("---DeleteBranchSetting--->>>", logger.Any("req", req))
+
+	err := b.strg.BranchSetting().Delete(ctx, req)
+	if err != nil {
+		b.log.Error("---DeleteBranchSetting--->>>", logger.Error(err))
+		return &user_service.EmptyBranchSetting{}, err
+	}
+
+	return &user_service.EmptyBranchSetting{}, nil
+}
+
+// normalizeBranchSetting fills unset fields with the defaults and rejects
+// values the other services cannot work with.
+func normalizeBranchSetting(req *user_service.UpdateBranchSetting) error {
+	defaults := postgres.DefaultBranchSetting(req.BranchId)
+
+	if req.BranchId == "" {
+		return fmt.Errorf("branchId is required")
+	}
+	if len(req.EventWeekdays) == 0 {
+		req.EventWeekdays = defaults.EventWeekdays
+	}
+	if req.PaymentDueDay == 0 {
+		req.PaymentDueDay = defaults.PaymentDueDay
+	}
+	if req.LessonDurationMinutes == 0 {
+		req.LessonDurationMinutes = defaults.LessonDurationMinutes
+	}
+	if req.Timezone == "" {
+		req.Timezone = defaults.Timezone
+	}
+	if req.PhonePattern == "" {
+		req.PhonePattern = defaults.PhonePattern
+	}
+
+	for _, day := range req.EventWeekdays {
+		if day < 1 || day > 7 {
+			return fmt.Errorf("event weekday %d is out of range 1 (Monday) to 7 (Sunday)", day)
+		}
+	}
+	if req.RegistrationCutoffHours < 0 {
+		return fmt.Errorf("registration cutoff can not be negative")
+	}
+	if req.PaymentDueDay < 1 || req.PaymentDueDay > 28 {
+		return fmt.Errorf("payment due day must be between 1 and 28")
+	}
+	if req.LessonDurationMinutes < 0 {
+		return fmt.Errorf("lesson duration can not be negative")
+	}
+	if _, err := time.LoadLocation(req.Timezone); err != nil {
+		return fmt.Errorf("unknown timezone: %s", req.Timezone)
+	}
+	if _, err := regexp.Compile(req.PhonePattern); err != nil {
+		return fmt.Errorf("invalid phone pattern: %v", err)
+	}
+
+	return nil
+}
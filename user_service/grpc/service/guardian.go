@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"user_service/config"
+	"user_service/genproto/user_service"
+	"user_service/grpc/client"
+	"user_service/storage"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+// guardianRelations are the ways a guardian can be related to a student.
+var guardianRelations = map[string]bool{
+	"mother":   true,
+	"father":   true,
+	"guardian": true,
+	"other":    true,
+}
+
+type GuardianService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+}
+
+func NewGuardianService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *GuardianService {
+	return &GuardianService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (g *GuardianService) Create(ctx context.Context, req *user_service.CreateGuardian) (*user_service.Guardian, error) {
+	g.log.Info("---CreateGuardian--->>>", logger.Any("req", req))
+
+	if req.Phone == "" || req.Password == "" {
+		err := errors.New("phone and password are required")
+		g.log.Error("---CreateGuardian--->>>", logger.Error(err))
+		return &user_service.Guardian{}, err
+	}
+
+	resp, err := g.strg.Guardian().Create(ctx, req)
+	if err != nil {
+		g.log.Error("---CreateGuardian--->>>", logger.Error(err))
+		return &user_service.Guardian{}, err
+	}
+
+	return resp, nil
+}
+
+func (g *GuardianService) GetByID(ctx context.Context, req *user_service.GuardianPrimaryKey) (*user_service.Guardian, error) {
+	g.log.Info("---GetGuardianByID--->>>", logger.Any("req", req))
+
+	resp, err := g.strg.Guardian().GetByID(ctx, req)
+	if err != nil {
+		g.log.Error("---GetGuardianByID--->>>", logger.Error(err))
+		return &user_service.Guardian{}, err
+	}
+
+	return resp, nil
+}
+
+func (g *GuardianService) GetList(ctx context.Context, req *user_service.GetListGuardianRequest) (*user_service.GetListGuardianResponse, error) {
+	g.log.Info("---GetListGuardian--->>>", logger.Any("req", req))
+
+	resp, err := g.strg.Guardian().GetList(ctx, req)
+	if err != nil {
+		g.log.Error("---GetListGuardian--->>>", logger.Error(err))
+		return &user_service.GetListGuardianResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (g *GuardianService) Update(ctx context.Context, req *user_service.UpdateGuardian) (*user_service.Guardian, error) {
+	g.log.Info("---UpdateGuardian--->>>", logger.Any("req", req))
+
+	if req.Phone == "" {
+		err := errors.New("phone is required")
+		g.log.Error("---UpdateGuardian--->>>", logger.Error(err))
+		return &user_service.Guardian{}, err
+	}
+
+	resp, err := g.strg.Guardian().Update(ctx, req)
+	if err != nil {
+		g.log.Error("---UpdateGuardian--->>>", logger.Error(err))
+		return &user_service.Guardian{}, err
+	}
+
+	return resp, nil
+}
+
+func (g *GuardianService) Delete(ctx context.Context, req *user_service.GuardianPrimaryKey) (*user_service.EmptyGuardian, error) {
+	g.log.Info("---DeleteGuardian--->>>", logger.Any("req", req))
+
+	err := g.strg.Guardian().Delete(ctx, req)
+	if err != nil {
+		g.log.Error("---DeleteGuardian--->>>", logger.Error(err))
+		return &user_service.EmptyGuardian{}, err
+	}
+
+	return &user_service.EmptyGuardian{}, nil
+}
+
+// LinkStudent links a student to a guardian and returns the guardian with all
+// their children.
+func (g *GuardianService) LinkStudent(ctx context.Context, req *user_service.GuardianStudent) (*user_service.Guardian, error) {
+	g.log.Info("---LinkGuardianStudent--->>>", logger.Any("req", req))
+
+	if req.Relation == "" {
+		req.Relation = "guardian"
+	}
+	if !guardianRelations[req.Relation] {
+		err := errors.New("relation must be mother, father, guardian or other")
+		g.log.Error("---LinkGuardianStudent--->>>", logger.Error(err))
+		return &user_service.Guardian{}, err
+	}
+
+	if err := g.strg.Guardian().LinkStudent(ctx, req); err != nil {
+		g.log.Error("---LinkGuardianStudent--->>>", logger.Error(err))
+		return &user_service.Guardian{}, err
+	}
+
+	resp, err := g.strg.Guardian().GetByID(ctx, &user_service.GuardianPrimaryKey{Id: req.GuardianId})
+	if err != nil {
+		g.log.Error("---LinkGuardianStudent--->>>", logger.Error(err))
+		return &user_service.Guardian{}, err
+	}
+
+	return resp, nil
+}
+
+func (g *GuardianService) UnlinkStudent(ctx context.Context, req *user_service.GuardianStudentPrimaryKey) (*user_service.EmptyGuardian, error) {
+	g.log.Info("---UnlinkGuardianStudent--->>>", logger.Any("req", req))
+
+	err := g.strg.Guardian().UnlinkStudent(ctx, req)
+	if err != nil {
+		g.log.Error("---UnlinkGuardianStudent--->>>", logger.Error(err))
+		return &user_service.EmptyGuardian{}, err
+	}
+
+	return &user_service.EmptyGuardian{}, nil
+}
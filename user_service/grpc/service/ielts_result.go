@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+	"user_service/config"
+	"user_service/genproto/user_service"
+	"user_service/grpc/client"
+	"user_service/storage"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+// defaultExpiryAlertDays is how far ahead certificates are reported as
+// expiring when no window is given.
+const defaultExpiryAlertDays = 60
+
+type IeltsResultService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+}
+
+func NewIeltsResultService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *IeltsResultService {
+	return &IeltsResultService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+// Create records a test result. The staff member's current score and attempt
+// count follow from their results.
+func (i *IeltsResultService) Create(ctx context.Context, req *user_service.CreateIeltsResult) (*user_service.IeltsResult, error) {
+	i.log.Info("---CreateIeltsResult--->>>", logger.Any("req", req))
+
+	var err error
+	if req.StaffType != "teacher" && req.StaffType != "support_teacher" && req.StaffType != "administration" {
+		err = errors.New("staffType must be teacher, support_teacher or administration")
+	}
+	if err == nil {
+		req.Overall, err = ieltsBands(req.TestDate, req.Overall, req.Listening, req.Reading, req.Writing, req.Speaking)
+	}
+	if err != nil {
+		i.log.Error("---CreateIeltsResult--->>>", logger.Error(err))
+		return &user_service.IeltsResult{}, err
+	}
+
+	resp, err := i.strg.IeltsResult().Create(ctx, req)
+	if err != nil {
+		i.log.Error("---CreateIeltsResult--->>>", logger.Error(err))
+		return &user_service.IeltsResult{}, err
+	}
+
+	return resp, nil
+}
+
+func (i *IeltsResultService) GetByID(ctx context.Context, req *user_service.IeltsResultPrimaryKey) (*user_service.IeltsResult, error) {
+	i.log.Info("---GetIeltsResultByID--->>>", logger.Any("req", req))
+
+	resp, err := i.strg.IeltsResult().GetByID(ctx, req)
+	if err != nil {
+		i.log.Error("---GetIeltsResultByID--->>>", logger.Error(err))
+		return &user_service.IeltsResult{}, err
+	}
+
+	return resp, nil
+}
+
+func (i *IeltsResultService) GetList(ctx context.Context, req *user_service.GetListIeltsResultRequest) (*user_service.GetListIeltsResultResponse, error) {
+	i.log.Info("---GetListIeltsResult--->>>", logger.Any("req", req))
+
+	resp, err := i.strg.IeltsResult().GetList(ctx, req)
+	if err != nil {
+		i.log.Error("---GetListIeltsResult--->>>", logger.Error(err))
+		return &user_service.GetListIeltsResultResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (i *IeltsResultService) Update(ctx context.Context, req *user_service.UpdateIeltsResult) (*user_service.IeltsResult, error) {
+	i.log.Info("---UpdateIeltsResult--->>>", logger.Any("req", req))
+
+	var err error
+	req.Overall, err = ieltsBands(req.TestDate, req.Overall, req.Listening, req.Reading, req.Writing, req.Speaking)
+	if err != nil {
+		i.log.Error("---UpdateIeltsResult--->>>", logger.Error(err))
+		return &user_service.IeltsResult{}, err
+	}
+
+	resp, err := i.strg.IeltsResult().Update(ctx, req)
+	if err != nil {
+		i.log.Error("---UpdateIeltsResult--->>>", logger.Error(err))
+		return &user_service.IeltsResult{}, err
+	}
+
+	return resp, nil
+}
+
+func (i *IeltsResultService) Delete(ctx context.Context, req *user_service.IeltsResultPrimaryKey) (*user_service.EmptyIeltsResult, error) {
+	i.log.Info("---DeleteIeltsResult--->>>", logger.Any("req", req))
+
+	err := i.strg.IeltsResult().Delete(ctx, req)
+	if err != nil {
+		i.log.Error("---DeleteIeltsResult--->>>", logger.Error(err))
+		return &user_service.EmptyIeltsResult{}, err
+	}
+
+	return &user_service.EmptyIeltsResult{}, nil
+}
+
+// GetListExpiring returns the staff whose latest certificate runs out within
+// the given number of days, or has already run out.
+func (i *IeltsResultService) GetListExpiring(ctx context.Context, req *user_service.GetListExpiringIeltsRequest) (*user_service.GetListExpiringIeltsResponse, error) {
+	i.log.Info("---GetListExpiringIelts--->>>", logger.Any("req", req))
+
+	if req.Days < 0 {
+		err := errors.New("days must not be negative")
+		i.log.Error("---GetListExpiringIelts--->>>", logger.Error(err))
+		return &user_service.GetListExpiringIeltsResponse{}, err
+	}
+	if req.Days == 0 {
+		req.Days = defaultExpiryAlertDays
+	}
+
+	resp, err := i.strg.IeltsResult().GetListExpiring(ctx, req)
+	if err != nil {
+		i.log.Error("---GetListExpiringIelts--->>>", logger.Error(err))
+		return &user_service.GetListExpiringIeltsResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// ieltsBands checks a test date and its band scores, which go from 0 to 9 in
+// half bands, and returns the overall band. When it is not given, it is
+// worked out from the four sub-scores the way IELTS does: their mean, with
+// .25 rounded up to the half band and .75 up to the whole band.
+func ieltsBands(testDate string, overall float32, subScores ...float32) (float32, error) {
+	date, err := time.Parse("2006-01-02", testDate)
+	if err != nil {
+		return 0, fmt.Errorf("invalid testDate: %s", testDate)
+	}
+	if date.After(time.Now()) {
+		return 0, errors.New("testDate must not be in the future")
+	}
+
+	var sum float64
+	given := 0
+	for _, band := range append(subScores, overall) {
+		if band < 0 || band > 9 || band*2 != float32(math.Trunc(float64(band*2))) {
+			return 0, fmt.Errorf("invalid band score: %v", band)
+		}
+	}
+	for _, band := range subScores {
+		if band > 0 {
+			sum += float64(band)
+			given++
+		}
+	}
+
+	if overall > 0 {
+		return overall, nil
+	}
+	if given < len(subScores) {
+		return 0, errors.New("overall is required unless all four sub-scores are given")
+	}
+
+	quarter := math.Floor(sum/float64(given)*4) / 4
+	return float32(math.Ceil(quarter*2) / 2), nil
+}
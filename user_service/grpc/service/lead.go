@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user_service/config"
+	"user_service/genproto/user_service"
+	"user_service/grpc/client"
+	"user_service/storage"
+
+	"github.com/saidamir98/udevs_pkg/logger"
+)
+
+// leadSources are the channels a lead can come from.
+var leadSources = map[string]bool{
+	"walk_in":   true,
+	"call":      true,
+	"referral":  true,
+	"instagram": true,
+	"telegram":  true,
+	"facebook":  true,
+	"website":   true,
+	"other":     true,
+}
+
+// leadLevels are the group types a lead can ask for.
+var leadLevels = map[string]bool{
+	"":             true,
+	"beginner":     true,
+	"elementary":   true,
+	"intermediate": true,
+	"ielts":        true,
+}
+
+type LeadService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+}
+
+func NewLeadService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *LeadService {
+	return &LeadService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (l *LeadService) Create(ctx context.Context, req *user_service.CreateLead) (*user_service.Lead, error) {
+	l.log.Info("---CreateLead--->>>", logger.Any("req", req))
+
+	if err := validateLead(req.Fullname, req.Phone, req.Source, req.DesiredLevel, req.BranchId); err != nil {
+		l.log.Error("---CreateLead--->>>", logger.Error(err))
+		return &user_service.Lead{}, err
+	}
+
+	resp, err := l.strg.Lead().Create(ctx, req)
+	if err != nil {
+		l.log.Error("---CreateLead--->>>", logger.Error(err))
+		return &user_service.Lead{}, err
+	}
+
+	return resp, nil
+}
+
+func (l *LeadService) GetByID(ctx context.Context, req *user_service.LeadPrimaryKey) (*user_service.Lead, error) {
+	l.log.Info("---GetLeadByID--->>>", logger.Any("req", req))
+
+	resp, err := l.strg.Lead().GetByID(ctx, req)
+	if err != nil {
+		l.log.Error("---GetLeadByID--->>>", logger.Error(err))
+		return &user_service.Lead{}, err
+	}
+
+	return resp, nil
+}
+
+func (l *LeadService) GetList(ctx context.Context, req *user_service.GetListLeadRequest) (*user_service.GetListLeadResponse, error) {
+	l.log.Info("---GetListLead--->>>", logger.Any("req", req))
+
+	resp, err := l.strg.Lead().GetList(ctx, req)
+	if err != nil {
+		l.log.Error("---GetListLead--->>>", logger.Error(err))
+		return &user_service.GetListLeadResponse{}, err
+	}
+
+	return resp, nil
+}
+
+func (l *LeadService) Update(ctx context.Context, req *user_service.UpdateLead) (*user_service.Lead, error) {
+	l.log.Info("---UpdateLead--->>>", logger.Any("req", req))
+
+	if err := validateLead(req.Fullname, req.Phone, req.Source, req.DesiredLevel, req.BranchId); err != nil {
+		l.log.Error("---UpdateLead--->>>", logger.Error(err))
+		return &user_service.Lead{}, err
+	}
+
+	resp, err := l.strg.Lead().Update(ctx, req)
+	if err != nil {
+		l.log.Error("---UpdateLead--->>>", logger.Error(err))
+		return &user_service.Lead{}, err
+	}
+
+	return resp, nil
+}
+
+func (l *LeadService) Delete(ctx context.Context, req *user_service.LeadPrimaryKey) (*user_service.EmptyLead, error) {
+	l.log.Info("---DeleteLead--->>>", logger.Any("req", req))
+
+	err := l.strg.Lead().Delete(ctx, req)
+	if err != nil {
+		l.log.Error("---DeleteLead--->>>", logger.Error(err))
+		return &user_service.EmptyLead{}, err
+	}
+
+	return &user_service.EmptyLead{}, nil
+}
+
+func (l *LeadService) UpdateStatus(ctx context.Context, req *user_service.UpdateLeadStatus) (*user_service.Lead, error) {
+	l.log.Info("---UpdateLeadStatus--->>>", logger.Any("req", req))
+
+	resp, err := l.strg.Lead().UpdateStatus(ctx, req)
+	if err != nil {
+		l.log.Error("---UpdateLeadStatus--->>>", logger.Error(err))
+		return &user_service.Lead{}, err
+	}
+
+	return resp, nil
+}
+
+func (l *LeadService) BookTrial(ctx context.Context, req *user_service.BookLeadTrial) (*user_service.Lead, error) {
+	l.log.Info("---BookLeadTrial--->>>", logger.Any("req", req))
+
+	if req.ScheduleId == "" {
+		err := errors.New("scheduleId is required")
+		l.log.Error("---BookLeadTrial--->>>", logger.Error(err))
+		return &user_service.Lead{}, err
+	}
+
+	resp, err := l.strg.Lead().BookTrial(ctx, req)
+	if err != nil {
+		l.log.Error("---BookLeadTrial--->>>", logger.Error(err))
+		return &user_service.Lead{}, err
+	}
+
+	return resp, nil
+}
+
+// Convert turns a lead into a student; the password comes already hashed.
+func (l *LeadService) Convert(ctx context.Context, req *user_service.ConvertLead) (*user_service.Lead, error) {
+	l.log.Info("---ConvertLead--->>>", logger.Any("req", req.Id))
+
+	if req.Password == "" {
+		err := errors.New("password is required")
+		l.log.Error("---ConvertLead--->>>", logger.Error(err))
+		return &user_service.Lead{}, err
+	}
+
+	resp, err := l.strg.Lead().Convert(ctx, req)
+	if err != nil {
+		l.log.Error("---ConvertLead--->>>", logger.Error(err))
+		return &user_service.Lead{}, err
+	}
+
+	return resp, nil
+}
+
+func (l *LeadService) GetConversionReport(ctx context.Context, req *user_service.LeadConversionReportRequest) (*user_service.LeadConversionReport, error) {
+	l.log.Info("---GetLeadConversionReport--->>>", logger.Any("req", req))
+
+	from, err := time.Parse("2006-01-02", req.FromDate)
+	if err != nil {
+		err = fmt.Errorf("invalid fromDate: %s", req.FromDate)
+	} else if to, parseErr := time.Parse("2006-01-02", req.ToDate); parseErr != nil {
+		err = fmt.Errorf("invalid toDate: %s", req.ToDate)
+	} else if to.Before(from) {
+		err = errors.New("toDate must not be before fromDate")
+	}
+	if err != nil {
+		l.log.Error("---GetLeadConversionReport--->>>", logger.Error(err))
+		return &user_service.LeadConversionReport{}, err
+	}
+
+	resp, err := l.strg.Lead().GetConversionReport(ctx, req)
+	if err != nil {
+		l.log.Error("---GetLeadConversionReport--->>>", logger.Error(err))
+		return &user_service.LeadConversionReport{}, err
+	}
+
+	return resp, nil
+}
+
+func validateLead(fullname, phone, source, desiredLevel, branchId string) error {
+	if fullname == "" || phone == "" || branchId == "" {
+		return errors.New("fullname, phone and branchId are required")
+	}
+	if !leadSources[source] {
+		return fmt.Errorf("invalid source: %s", source)
+	}
+	if !leadLevels[desiredLevel] {
+		return fmt.Errorf("invalid desiredLevel: %s", desiredLevel)
+	}
+
+	return nil
+}
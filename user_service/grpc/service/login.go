@@ -218,3 +218,35 @@ func (s *LoginService) SuperAdminLogin(ctx context.Context, req *user_service.Lo
 
 	return &token, nil
 }
+
+func (s *LoginService) ParentLogin(ctx context.Context, req *user_service.LoginPasswors) (*user_service.Token, error) {
+	s.log.Info("---LoginParent--->>>", logger.Any("req", req))
+
+	resp, hash, err := s.strg.Guardian().GetByLogin(ctx, req.Login)
+	if err != nil {
+		s.log.Error("---LoginParent--->>>", logger.Error(err))
+		return &user_service.Token{}, err
+	}
+
+	if err = password.CompareHashAndPassword(hash, req.Password); err != nil {
+		s.log.Error("error while comparing password", logger.Error(err))
+		return &user_service.Token{}, err
+	}
+
+	m := make(map[interface{}]interface{})
+
+	m["user_id"] = resp.Id
+	m["user_role"] = "Parent"
+
+	accessToken, refreshToken, err := jwt.GenJWT(m)
+	if err != nil {
+		s.log.Error("error while generating tokens for Parent login", logger.Error(err))
+		return &user_service.Token{}, err
+	}
+
+	token := user_service.Token{}
+	token.AccessToken = accessToken
+	token.RefreshToken = refreshToken
+
+	return &token, nil
+}
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS "branch_setting" (
+    branchId UUID PRIMARY KEY REFERENCES branch(id),
+    eventWeekdays INTEGER[] NOT NULL DEFAULT '{7}',
+    registrationCutoffHours INTEGER NOT NULL DEFAULT 3 CHECK (registrationCutoffHours >= 0),
+    paymentDueDay INTEGER NOT NULL DEFAULT 5 CHECK (paymentDueDay BETWEEN 1 AND 28),
+    lessonDurationMinutes INTEGER NOT NULL DEFAULT 90 CHECK (lessonDurationMinutes > 0),
+    timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Tashkent',
+    phonePattern VARCHAR(255) NOT NULL DEFAULT '^[+][9][9][8]\d{9}$',
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
@@ -0,0 +1,3 @@
+ALTER TABLE "support_teacher" DROP COLUMN IF EXISTS ieltsAttemptOffset;
+ALTER TABLE "teacher" DROP COLUMN IF EXISTS ieltsAttemptOffset;
+DROP TABLE IF EXISTS "ielts_result";
@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS "ielts_result" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    staffType VARCHAR(20) NOT NULL CHECK (staffType IN ('teacher', 'support_teacher', 'administration')),
+    staffId UUID NOT NULL,
+    testDate DATE NOT NULL,
+    overall NUMERIC(2, 1) NOT NULL CHECK (overall BETWEEN 0 AND 9 AND overall * 2 = TRUNC(overall * 2)),
+    listening NUMERIC(2, 1) CHECK (listening BETWEEN 0 AND 9 AND listening * 2 = TRUNC(listening * 2)),
+    reading NUMERIC(2, 1) CHECK (reading BETWEEN 0 AND 9 AND reading * 2 = TRUNC(reading * 2)),
+    writing NUMERIC(2, 1) CHECK (writing BETWEEN 0 AND 9 AND writing * 2 = TRUNC(writing * 2)),
+    speaking NUMERIC(2, 1) CHECK (speaking BETWEEN 0 AND 9 AND speaking * 2 = TRUNC(speaking * 2)),
+    certificateNumber VARCHAR(50),
+    expiresAt DATE GENERATED ALWAYS AS ((testDate + INTERVAL '2 years')::date) STORED,
+    backfilled BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS ielts_result_staff_idx ON "ielts_result" (staffType, staffId, testDate DESC) WHERE deleted_at = 0;
+
+CREATE UNIQUE INDEX IF NOT EXISTS ielts_result_certificate_idx ON "ielts_result" (certificateNumber) WHERE deleted_at = 0;
+
+-- Attempts recorded before results were kept, less the backfilled result
+-- that stands for the latest of them.
+ALTER TABLE "teacher" ADD COLUMN IF NOT EXISTS ieltsAttemptOffset INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE "support_teacher" ADD COLUMN IF NOT EXISTS ieltsAttemptOffset INTEGER NOT NULL DEFAULT 0;
+
+UPDATE "teacher" SET ieltsAttemptOffset =
+    GREATEST(COALESCE(ieltsAttemptCount, 0) - CASE WHEN ieltsScore BETWEEN 0 AND 9 THEN 1 ELSE 0 END, 0);
+UPDATE "support_teacher" SET ieltsAttemptOffset =
+    GREATEST(COALESCE(ieltsAttemptCount, 0) - CASE WHEN ieltsScore BETWEEN 0 AND 9 THEN 1 ELSE 0 END, 0);
+
+-- The test date of a legacy score is unknown, so its result is flagged:
+-- it keeps the score until a recorded result replaces it, but raises no
+-- expiry alert. Correcting it with the real test date clears the flag.
+INSERT INTO "ielts_result" (staffType, staffId, testDate, overall, backfilled)
+SELECT 'teacher', id, COALESCE(updated_at, created_at, NOW())::date, ROUND(ieltsScore::numeric * 2) / 2, TRUE
+FROM "teacher" WHERE ieltsScore IS NOT NULL AND ieltsScore BETWEEN 0 AND 9
+UNION ALL
+SELECT 'support_teacher', id, COALESCE(updated_at, created_at, NOW())::date, ROUND(ieltsScore::numeric * 2) / 2, TRUE
+FROM "support_teacher" WHERE ieltsScore IS NOT NULL AND ieltsScore BETWEEN 0 AND 9
+UNION ALL
+SELECT 'administration', id, COALESCE(updated_at, created_at, NOW())::date, ROUND(ieltsScore::numeric * 2) / 2, TRUE
+FROM "administration" WHERE ieltsScore IS NOT NULL AND ieltsScore BETWEEN 0 AND 9;
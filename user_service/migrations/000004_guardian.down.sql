@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "guardian_student";
+DROP TABLE IF EXISTS "guardian";
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS "guardian" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    login VARCHAR(255) UNIQUE NOT NULL,
+    fullname VARCHAR(255),
+    phone VARCHAR(20) UNIQUE NOT NULL,
+    password VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS "guardian_student" (
+    guardianId UUID NOT NULL REFERENCES "guardian"(id),
+    studentId UUID NOT NULL REFERENCES "student"(id),
+    relation VARCHAR(20) NOT NULL CHECK (relation IN ('mother', 'father', 'guardian', 'other')),
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (guardianId, studentId)
+);
+
+CREATE INDEX IF NOT EXISTS guardian_student_student_idx ON "guardian_student" (studentId);
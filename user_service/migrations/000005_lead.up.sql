@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS "lead" (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    fullname VARCHAR(255) NOT NULL,
+    phone VARCHAR(20) NOT NULL,
+    source VARCHAR(20) NOT NULL CHECK (source IN ('walk_in', 'call', 'referral', 'instagram', 'telegram', 'facebook', 'website', 'other')),
+    desiredLevel VARCHAR(50) CHECK (desiredLevel IN ('beginner', 'elementary', 'intermediate', 'ielts')),
+    branchId UUID NOT NULL REFERENCES branch(id),
+    status VARCHAR(20) NOT NULL DEFAULT 'new' CHECK (status IN ('new', 'contacted', 'trial_booked', 'trial_attended', 'converted', 'lost')),
+    note TEXT,
+    lostReason TEXT,
+    trialScheduleId UUID REFERENCES schedule(id),
+    trialAttendedAt TIMESTAMP,
+    studentId UUID REFERENCES student(id),
+    convertedAt TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    deleted_at INTEGER DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS lead_status_idx ON "lead" (branchId, status) WHERE deleted_at = 0;
+
+CREATE INDEX IF NOT EXISTS lead_phone_idx ON "lead" (phone) WHERE deleted_at = 0;
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"time"
 	us "user_service/genproto/user_service"
 	"user_service/storage"
 
@@ -44,11 +43,10 @@ func (a *administrationRepo) Create(ctx context.Context, req *us.CreateAdministr
 			fullname,
 			password,
 			salary,
-			ieltsScore,
 			branchId
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8
-		) `, id, login, req.Phone, req.Fullname, req.Password, req.Salary, req.IeltsScore, req.BranchId)
+			$1, $2, $3, $4, $5, $6, $7
+		) `, id, login, req.Phone, req.Fullname, req.Password, req.Salary, req.BranchId)
 
 	if err != nil {
 		log.Println("error while creating administration in storage", err)
@@ -171,16 +169,15 @@ func (a *administrationRepo) GetList(ctx context.Context, req *us.GetListAdminis
 func (a *administrationRepo) Update(ctx context.Context, req *us.UpdateAdministration) (*us.Administration, error) {
 
 	_, err := a.db.Exec(ctx, `
-        UPDATE "admininstration" SET
+        UPDATE "administration" SET
 			fullname=$1,
 			phone=$2,
 			password=$3,
 			salary=$4,
-			ieltsScore=$5,
-			branchId=$6,
+			branchId=$5,
             updated_at = NOW()
-        WHERE id = $1`, req.Id, req.Fullname, req.Phone, req.Password,
-		req.Salary, req.IeltsScore, req.BranchId)
+        WHERE id = $6`, req.Fullname, req.Phone, req.Password,
+		req.Salary, req.BranchId, req.Id)
 
 	if err != nil {
 		log.Println("error while updating admininstration in storage", err)
@@ -280,7 +277,11 @@ func (r *administrationRepo) GetReportList(ctx context.Context, req *us.GetRepor
 	}
 
 	query := fmt.Sprintf(`
-        SELECT id, login, fullname, phone, password, salary, ieltsScore, branchId, created_at, updated_at
+        SELECT id, login, fullname, phone, password, salary, ieltsScore, branchId, created_at, updated_at,
+            (SELECT COALESCE(SUM(pl.total), 0)
+                FROM payroll_line pl
+                JOIN payroll_run pr ON pr.id = pl.runId
+                WHERE pr.status = 'locked' AND pl.staffType = 'administration' AND pl.staffId = administration.id)::text
         FROM "administration"
         %s
         LIMIT $1 OFFSET $2
@@ -295,7 +296,7 @@ func (r *administrationRepo) GetReportList(ctx context.Context, req *us.GetRepor
 
 	for rows.Next() {
 		var administration us.GetReportAdministrationResponse
-		err := rows.Scan(&administration.Id, &administration.Login, &administration.Fullname, &administration.Phone, &administration.Password, &administration.Salary, &administration.IeltsScore, &branchId, &created_at, &updated_at)
+		err := rows.Scan(&administration.Id, &administration.Login, &administration.Fullname, &administration.Phone, &administration.Password, &administration.Salary, &administration.IeltsScore, &branchId, &created_at, &updated_at, &administration.Totalsum)
 		if err != nil {
 			log.Println("error while scanning administration row:", err)
 			return nil, err
@@ -305,21 +306,6 @@ func (r *administrationRepo) GetReportList(ctx context.Context, req *us.GetRepor
 		administration.BranchId = branchId.String
 		administration.CreatedAt = created_at.String
 
-		// Parse created date to calculate days worked
-		startDateStr := administration.CreatedAt[:10]
-		startDate, err := time.Parse("2006-01-02", startDateStr)
-		if err != nil {
-			log.Println("Error parsing start date:", err)
-			return nil, err
-		}
-
-		daysWorked := time.Since(startDate).Hours() / 24
-
-		monthlyRate := float64(administration.Salary / 30)
-		totalSum := int64(daysWorked * monthlyRate)
-
-		administration.Totalsum = fmt.Sprintf("%d", totalSum)
-
 		resp.Getadministrations = append(resp.Getadministrations, &administration)
 	}
 	if err := rows.Err(); err != nil {
@@ -0,0 +1,214 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	us "user_service/genproto/user_service"
+	"user_service/storage"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// DefaultBranchSetting holds the rules used by branches that have no
+// settings of their own. They match the column defaults of branch_setting.
+func DefaultBranchSetting(branchId string) *us.BranchSetting {
+	return &us.BranchSetting{
+		BranchId:                branchId,
+		EventWeekdays:           []int32{7},
+		RegistrationCutoffHours: 3,
+		PaymentDueDay:           5,
+		LessonDurationMinutes:   90,
+		Timezone:                "Asia/Tashkent",
+		PhonePattern:            `^[+][9][9][8]\d{9}$`,
+		IsDefault:               true,
+	}
+}
+
+type branchSettingRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewBranchSettingRepo(db *pgxpool.Pool) storage.BranchSettingRepoI {
+	return &branchSettingRepo{
+		db: db,
+	}
+}
+
+// Create implements storage.BranchSettingRepoI.
+func (b *branchSettingRepo) Create(ctx context.Context, req *us.CreateBranchSetting) (*us.BranchSetting, error) {
+	_, err := b.db.Exec(ctx, `
+		INSERT INTO "branch_setting" (
+			branchId,
+			eventWeekdays,
+			registrationCutoffHours,
+			paymentDueDay,
+			lessonDurationMinutes,
+			timezone,
+			phonePattern
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)`, req.BranchId, req.EventWeekdays, req.RegistrationCutoffHours, req.PaymentDueDay, req.LessonDurationMinutes, req.Timezone, req.PhonePattern)
+
+	if err != nil {
+		log.Println("error while creating branch setting in storage", err)
+		return nil, err
+	}
+
+	setting, err := b.GetByID(ctx, &us.BranchSettingPrimaryKey{BranchId: req.BranchId})
+	if err != nil {
+		log.Println("error while getting branch setting by id after creating", err)
+		return nil, err
+	}
+	return setting, nil
+}
+
+// GetByID implements storage.BranchSettingRepoI. Branches without settings
+// get the defaults.
+func (b *branchSettingRepo) GetByID(ctx context.Context, req *us.BranchSettingPrimaryKey) (*us.BranchSetting, error) {
+	if req.BranchId == "" {
+		return DefaultBranchSetting(""), nil
+	}
+
+	resp := &us.BranchSetting{}
+
+	var (
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+
+	err := b.db.QueryRow(ctx, `
+		SELECT branchId,
+			eventWeekdays,
+			registrationCutoffHours,
+			paymentDueDay,
+			lessonDurationMinutes,
+			timezone,
+			phonePattern,
+			created_at,
+			updated_at
+		FROM "branch_setting"
+		WHERE branchId=$1`, req.BranchId).Scan(&resp.BranchId, &resp.EventWeekdays, &resp.RegistrationCutoffHours, &resp.PaymentDueDay, &resp.LessonDurationMinutes, &resp.Timezone, &resp.PhonePattern, &created_at, &updated_at)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return DefaultBranchSetting(req.BranchId), nil
+	}
+	if err != nil {
+		log.Println("error while getting branch setting by id", err)
+		return nil, err
+	}
+
+	resp.CreatedAt = created_at.String
+	resp.UpdatedAt = updated_at.String
+
+	return resp, nil
+}
+
+// GetList implements storage.BranchSettingRepoI. Only branches with their own
+// settings are listed.
+func (b *branchSettingRepo) GetList(ctx context.Context, req *us.GetListBranchSettingRequest) (*us.GetListBranchSettingResponse, error) {
+	resp := &us.GetListBranchSettingResponse{}
+	var (
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+	offset := (req.Page - 1) * req.Limit
+
+	filter := fmt.Sprintf(" ORDER BY created_at OFFSET %v LIMIT %v", offset, req.Limit)
+
+	rows, err := b.db.Query(ctx, `
+		SELECT
+			branchId,
+			eventWeekdays,
+			registrationCutoffHours,
+			paymentDueDay,
+			lessonDurationMinutes,
+			timezone,
+			phonePattern,
+			created_at,
+			updated_at
+		FROM "branch_setting"
+	`+filter)
+
+	if err != nil {
+		log.Println("error while getting all branch settings:", err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		var setting us.BranchSetting
+		count++
+		err = rows.Scan(&setting.BranchId, &setting.EventWeekdays, &setting.RegistrationCutoffHours, &setting.PaymentDueDay, &setting.LessonDurationMinutes, &setting.Timezone, &setting.PhonePattern, &created_at, &updated_at)
+
+		if err != nil {
+			log.Println("error while scanning branch settings:", err)
+			return nil, err
+		}
+		setting.CreatedAt = created_at.String
+		setting.UpdatedAt = updated_at.String
+
+		resp.BranchSettings = append(resp.BranchSettings, &setting)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// Update implements storage.BranchSettingRepoI.
+func (b *branchSettingRepo) Update(ctx context.Context, req *us.UpdateBranchSetting) (*us.BranchSetting, error) {
+	result, err := b.db.Exec(ctx, `
+		UPDATE "branch_setting" SET
+			eventWeekdays=$1,
+			registrationCutoffHours=$2,
+			paymentDueDay=$3,
+			lessonDurationMinutes=$4,
+			timezone=$5,
+			phonePattern=$6,
+			updated_at = NOW()
+		WHERE branchId = $7`, req.EventWeekdays, req.RegistrationCutoffHours, req.PaymentDueDay, req.LessonDurationMinutes, req.Timezone, req.PhonePattern, req.BranchId)
+
+	if err != nil {
+		log.Println("error while updating branch setting in storage", err)
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("branch has no settings, create them first")
+	}
+
+	setting, err := b.GetByID(ctx, &us.BranchSettingPrimaryKey{BranchId: req.BranchId})
+	if err != nil {
+		log.Println("error while getting updated branch setting by id", err)
+		return nil, err
+	}
+
+	return setting, nil
+}
+
+// Delete implements storage.BranchSettingRepoI. The branch falls back to the
+// defaults afterwards.
+func (b *branchSettingRepo) Delete(ctx context.Context, req *us.BranchSettingPrimaryKey) error {
+	_, err := b.db.Exec(ctx, `
+		DELETE FROM "branch_setting"
+		WHERE branchId = $1
+	`, req.BranchId)
+
+	if err != nil {
+		log.Println("error while deleting branch setting")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,282 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	us "user_service/genproto/user_service"
+	"user_service/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const guardianColumns = `
+			g.id,
+			g.login,
+			COALESCE(g.fullname, ''),
+			g.phone,
+			COALESCE(g.created_at::text, ''),
+			COALESCE(g.updated_at::text, '')`
+
+type guardianRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewGuardianRepo(db *pgxpool.Pool) storage.GuardianRepoI {
+	return &guardianRepo{
+		db: db,
+	}
+}
+
+// Create implements storage.GuardianRepoI. Logins are handed out in order,
+// like student logins, with a P prefix.
+func (g *guardianRepo) Create(ctx context.Context, req *us.CreateGuardian) (*us.Guardian, error) {
+	id := uuid.NewString()
+
+	var last string
+	err := g.db.QueryRow(ctx, `
+		SELECT COALESCE(MAX(login), 'P00000') FROM "guardian"
+	`).Scan(&last)
+	if err != nil {
+		log.Println("error while getting last guardian login", err)
+		return nil, err
+	}
+
+	num, _ := strconv.Atoi(last[1:])
+	login := fmt.Sprintf("P%05d", num+1)
+
+	_, err = g.db.Exec(ctx, `
+		INSERT INTO "guardian" (
+			id,
+			login,
+			fullname,
+			phone,
+			password
+		) VALUES (
+			$1, $2, $3, $4, $5
+		)`, id, login, req.Fullname, req.Phone, req.Password)
+
+	if err != nil {
+		log.Println("error while creating guardian in storage", err)
+		return nil, err
+	}
+
+	return g.GetByID(ctx, &us.GuardianPrimaryKey{Id: id})
+}
+
+// GetByID implements storage.GuardianRepoI. The guardian comes with the
+// students linked to them.
+func (g *guardianRepo) GetByID(ctx context.Context, req *us.GuardianPrimaryKey) (*us.Guardian, error) {
+	resp, err := scanGuardian(g.db.QueryRow(ctx, `
+		SELECT `+guardianColumns+`
+		FROM "guardian" g
+		WHERE g.id::text = $1 AND g.deleted_at = 0`, req.Id))
+
+	if err != nil {
+		log.Println("error while getting guardian by id", err)
+		return nil, err
+	}
+
+	rows, err := g.db.Query(ctx, `
+		SELECT
+			s.id,
+			s.login,
+			COALESCE(s.fullname, ''),
+			s.phone,
+			COALESCE(s.groupName, ''),
+			COALESCE(s.branchId::text, ''),
+			gs.relation
+		FROM "guardian_student" gs
+		JOIN "student" s ON s.id = gs.studentId
+		WHERE gs.guardianId::text = $1 AND s.deleted_at = 0
+		ORDER BY s.fullname`, req.Id)
+
+	if err != nil {
+		log.Println("error while getting guardian children", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var child us.GuardianChild
+		err = rows.Scan(&child.StudentId, &child.Login, &child.Fullname, &child.Phone, &child.GroupName, &child.BranchId, &child.Relation)
+		if err != nil {
+			log.Println("error while scanning guardian children", err)
+			return nil, err
+		}
+
+		resp.Children = append(resp.Children, &child)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetList implements storage.GuardianRepoI. Children are only filled in by
+// GetByID.
+func (g *guardianRepo) GetList(ctx context.Context, req *us.GetListGuardianRequest) (*us.GetListGuardianResponse, error) {
+	resp := &us.GetListGuardianResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := g.db.Query(ctx, `
+		SELECT `+guardianColumns+`
+		FROM "guardian" g
+		WHERE g.deleted_at = 0
+		  AND ($1 = '' OR g.login ILIKE '%' || $1 || '%'
+			OR g.fullname ILIKE '%' || $1 || '%'
+			OR g.phone ILIKE '%' || $1 || '%')
+		  AND ($2 = '' OR EXISTS (
+			SELECT 1 FROM "guardian_student" gs
+			WHERE gs.guardianId = g.id AND gs.studentId::text = $2
+		  ))
+		ORDER BY g.created_at`+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.Search, req.StudentId)
+
+	if err != nil {
+		log.Println("error while getting all guardians:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		guardian, err := scanGuardian(rows)
+		if err != nil {
+			log.Println("error while scanning guardians:", err)
+			return nil, err
+		}
+
+		resp.Guardians = append(resp.Guardians, guardian)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// Update implements storage.GuardianRepoI. An empty password keeps the
+// current one.
+func (g *guardianRepo) Update(ctx context.Context, req *us.UpdateGuardian) (*us.Guardian, error) {
+	result, err := g.db.Exec(ctx, `
+		UPDATE "guardian" SET
+			fullname = $1,
+			phone = $2,
+			password = COALESCE(NULLIF($3, ''), password),
+			updated_at = NOW()
+		WHERE id::text = $4 AND deleted_at = 0`, req.Fullname, req.Phone, req.Password, req.Id)
+
+	if err != nil {
+		log.Println("error while updating guardian in storage", err)
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("guardian not found")
+	}
+
+	return g.GetByID(ctx, &us.GuardianPrimaryKey{Id: req.Id})
+}
+
+// Delete implements storage.GuardianRepoI.
+func (g *guardianRepo) Delete(ctx context.Context, req *us.GuardianPrimaryKey) error {
+	_, err := g.db.Exec(ctx, `
+		UPDATE "guardian" SET
+			deleted_at = 1,
+			updated_at = NOW()
+		WHERE id::text = $1`, req.Id)
+
+	if err != nil {
+		log.Println("error while deleting guardian", err)
+		return err
+	}
+
+	return nil
+}
+
+// GetByLogin implements storage.GuardianRepoI. It also returns the password
+// hash, which the Guardian message never carries.
+func (g *guardianRepo) GetByLogin(ctx context.Context, login string) (*us.Guardian, string, error) {
+	var hash string
+
+	resp, err := scanGuardian(g.db.QueryRow(ctx, `
+		SELECT `+guardianColumns+`, g.password
+		FROM "guardian" g
+		WHERE g.login = $1 AND g.deleted_at = 0`, login), &hash)
+
+	if err != nil {
+		log.Println("error while getting guardian by login:", err)
+		return nil, "", err
+	}
+
+	return resp, hash, nil
+}
+
+// LinkStudent implements storage.GuardianRepoI. Linking an already linked
+// student changes the relation.
+func (g *guardianRepo) LinkStudent(ctx context.Context, req *us.GuardianStudent) error {
+	result, err := g.db.Exec(ctx, `
+		INSERT INTO "guardian_student" (
+			guardianId,
+			studentId,
+			relation
+		)
+		SELECT g.id, s.id, $3
+		FROM "guardian" g, "student" s
+		WHERE g.id::text = $1 AND g.deleted_at = 0
+		  AND s.id::text = $2 AND s.deleted_at = 0
+		ON CONFLICT (guardianId, studentId) DO UPDATE SET relation = EXCLUDED.relation`, req.GuardianId, req.StudentId, req.Relation)
+
+	if err != nil {
+		log.Println("error while linking guardian to student", err)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return errors.New("guardian or student not found")
+	}
+
+	return nil
+}
+
+// UnlinkStudent implements storage.GuardianRepoI.
+func (g *guardianRepo) UnlinkStudent(ctx context.Context, req *us.GuardianStudentPrimaryKey) error {
+	result, err := g.db.Exec(ctx, `
+		DELETE FROM "guardian_student"
+		WHERE guardianId::text = $1 AND studentId::text = $2`, req.GuardianId, req.StudentId)
+
+	if err != nil {
+		log.Println("error while unlinking guardian from student", err)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return errors.New("student is not linked to this guardian")
+	}
+
+	return nil
+}
+
+func scanGuardian(row pgx.Row, extra ...interface{}) (*us.Guardian, error) {
+	var guardian us.Guardian
+
+	dest := append([]interface{}{&guardian.Id, &guardian.Login, &guardian.Fullname, &guardian.Phone, &guardian.CreatedAt, &guardian.UpdatedAt}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	return &guardian, nil
+}
@@ -0,0 +1,347 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	us "user_service/genproto/user_service"
+	"user_service/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// ieltsStaff lists the staff members that can have IELTS results.
+const ieltsStaff = `
+		SELECT 'teacher' AS staffType, id, fullname, branchId, deleted_at FROM "teacher"
+		UNION ALL
+		SELECT 'support_teacher', id, fullname, branchId, deleted_at FROM "support_teacher"
+		UNION ALL
+		SELECT 'administration', id, fullname, branchId, deleted_at FROM "administration"`
+
+const ieltsResultColumns = `
+			ir.id,
+			ir.staffType,
+			ir.staffId::text,
+			COALESCE(st.fullname, ''),
+			ir.testDate::text,
+			ir.overall::real,
+			COALESCE(ir.listening, 0)::real,
+			COALESCE(ir.reading, 0)::real,
+			COALESCE(ir.writing, 0)::real,
+			COALESCE(ir.speaking, 0)::real,
+			COALESCE(ir.certificateNumber, ''),
+			ir.expiresAt::text,
+			NOT ir.backfilled AND ir.expiresAt < CURRENT_DATE,
+			COALESCE(ir.created_at::text, ''),
+			COALESCE(ir.updated_at::text, '')`
+
+type ieltsResultRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewIeltsResultRepo(db *pgxpool.Pool) storage.IeltsResultRepoI {
+	return &ieltsResultRepo{
+		db: db,
+	}
+}
+
+// Create implements storage.IeltsResultRepoI.
+func (i *ieltsResultRepo) Create(ctx context.Context, req *us.CreateIeltsResult) (*us.IeltsResult, error) {
+	id := uuid.NewString()
+
+	tx, err := i.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		INSERT INTO "ielts_result" (
+			id,
+			staffType,
+			staffId,
+			testDate,
+			overall,
+			listening,
+			reading,
+			writing,
+			speaking,
+			certificateNumber
+		)
+		SELECT $1, st.staffType, st.id, $4, $5, NULLIF($6::numeric, 0), NULLIF($7::numeric, 0), NULLIF($8::numeric, 0), NULLIF($9::numeric, 0), NULLIF($10, '')
+		FROM (`+ieltsStaff+`
+		) st
+		WHERE st.staffType = $2 AND st.id::text = $3 AND st.deleted_at = 0
+		`, id, req.StaffType, req.StaffId, req.TestDate, req.Overall, req.Listening, req.Reading, req.Writing, req.Speaking, req.CertificateNumber)
+
+	if err != nil {
+		log.Println("error while creating ielts result in storage", err)
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("staff member not found")
+	}
+
+	if err = syncIeltsScore(ctx, tx, req.StaffType, req.StaffId); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing ielts result", err)
+		return nil, err
+	}
+
+	return i.GetByID(ctx, &us.IeltsResultPrimaryKey{Id: id})
+}
+
+// GetByID implements storage.IeltsResultRepoI.
+func (i *ieltsResultRepo) GetByID(ctx context.Context, req *us.IeltsResultPrimaryKey) (*us.IeltsResult, error) {
+	resp, err := scanIeltsResult(i.db.QueryRow(ctx, `
+		SELECT `+ieltsResultColumns+`
+		FROM "ielts_result" ir
+		LEFT JOIN (`+ieltsStaff+`
+		) st ON st.staffType = ir.staffType AND st.id = ir.staffId
+		WHERE ir.id::text = $1 AND ir.deleted_at = 0`, req.Id))
+
+	if err != nil {
+		log.Println("error while getting ielts result by id", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetList implements storage.IeltsResultRepoI. Results are listed newest
+// test first.
+func (i *ieltsResultRepo) GetList(ctx context.Context, req *us.GetListIeltsResultRequest) (*us.GetListIeltsResultResponse, error) {
+	resp := &us.GetListIeltsResultResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := i.db.Query(ctx, `
+		SELECT `+ieltsResultColumns+`
+		FROM "ielts_result" ir
+		LEFT JOIN (`+ieltsStaff+`
+		) st ON st.staffType = ir.staffType AND st.id = ir.staffId
+		WHERE ir.deleted_at = 0
+		  AND ($1 = '' OR ir.staffType = $1)
+		  AND ($2 = '' OR ir.staffId::text = $2)
+		ORDER BY ir.testDate DESC, ir.created_at DESC`+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.StaffType, req.StaffId)
+
+	if err != nil {
+		log.Println("error while getting all ielts results:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		result, err := scanIeltsResult(rows)
+		if err != nil {
+			log.Println("error while scanning ielts results:", err)
+			return nil, err
+		}
+
+		resp.IeltsResults = append(resp.IeltsResults, result)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// Update implements storage.IeltsResultRepoI.
+func (i *ieltsResultRepo) Update(ctx context.Context, req *us.UpdateIeltsResult) (*us.IeltsResult, error) {
+	var staffType, staffId string
+
+	tx, err := i.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, `
+		UPDATE "ielts_result" SET
+			testDate = $1,
+			overall = $2,
+			listening = NULLIF($3::numeric, 0),
+			reading = NULLIF($4::numeric, 0),
+			writing = NULLIF($5::numeric, 0),
+			speaking = NULLIF($6::numeric, 0),
+			certificateNumber = NULLIF($7, ''),
+			backfilled = FALSE,
+			updated_at = NOW()
+		WHERE id::text = $8 AND deleted_at = 0
+		RETURNING staffType, staffId::text`, req.TestDate, req.Overall, req.Listening, req.Reading, req.Writing, req.Speaking, req.CertificateNumber, req.Id).Scan(&staffType, &staffId)
+
+	if err != nil {
+		log.Println("error while updating ielts result in storage", err)
+		return nil, err
+	}
+
+	if err = syncIeltsScore(ctx, tx, staffType, staffId); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing ielts result", err)
+		return nil, err
+	}
+
+	return i.GetByID(ctx, &us.IeltsResultPrimaryKey{Id: req.Id})
+}
+
+// Delete implements storage.IeltsResultRepoI.
+func (i *ieltsResultRepo) Delete(ctx context.Context, req *us.IeltsResultPrimaryKey) error {
+	var staffType, staffId string
+
+	tx, err := i.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting transaction", err)
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, `
+		UPDATE "ielts_result" SET
+			deleted_at = 1,
+			updated_at = NOW()
+		WHERE id::text = $1 AND deleted_at = 0
+		RETURNING staffType, staffId::text`, req.Id).Scan(&staffType, &staffId)
+
+	if err != nil {
+		log.Println("error while deleting ielts result", err)
+		return err
+	}
+
+	if err = syncIeltsScore(ctx, tx, staffType, staffId); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetListExpiring implements storage.IeltsResultRepoI. Only the latest
+// result of each active staff member counts: an older certificate running
+// out does not matter once a newer test is on record. A backfilled result
+// has no known test date and raises no alert.
+func (i *ieltsResultRepo) GetListExpiring(ctx context.Context, req *us.GetListExpiringIeltsRequest) (*us.GetListExpiringIeltsResponse, error) {
+	resp := &us.GetListExpiringIeltsResponse{}
+
+	rows, err := i.db.Query(ctx, `
+		SELECT
+			ir.staffType,
+			ir.staffId::text,
+			COALESCE(st.fullname, ''),
+			COALESCE(st.branchId::text, ''),
+			ir.id,
+			ir.overall::real,
+			COALESCE(ir.certificateNumber, ''),
+			ir.expiresAt::text,
+			ir.expiresAt - CURRENT_DATE
+		FROM (`+ieltsStaff+`
+		) st
+		JOIN LATERAL (
+			SELECT *
+			FROM "ielts_result"
+			WHERE staffType = st.staffType AND staffId = st.id AND deleted_at = 0
+			ORDER BY backfilled, testDate DESC, created_at DESC
+			LIMIT 1
+		) ir ON true
+		WHERE st.deleted_at = 0
+		  AND NOT ir.backfilled
+		  AND ir.expiresAt <= CURRENT_DATE + $1::int
+		  AND ($2 = '' OR st.staffType = $2)
+		  AND ($3 = '' OR st.branchId::text = $3)
+		ORDER BY ir.expiresAt, st.fullname`, req.Days, req.StaffType, req.BranchId)
+
+	if err != nil {
+		log.Println("error while getting expiring ielts results:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		var alert us.IeltsExpiryAlert
+		count++
+		err = rows.Scan(&alert.StaffType, &alert.StaffId, &alert.StaffName, &alert.BranchId, &alert.ResultId, &alert.Overall, &alert.CertificateNumber, &alert.ExpiresAt, &alert.DaysLeft)
+		if err != nil {
+			log.Println("error while scanning expiring ielts results:", err)
+			return nil, err
+		}
+
+		resp.Alerts = append(resp.Alerts, &alert)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// syncIeltsScore derives a staff member's current score, the overall band of
+// their latest test, and their attempt count from their results. A backfilled
+// result only holds the score until a recorded one replaces it, and the
+// attempts made before results were kept are carried in ieltsAttemptOffset.
+// The staff type is also the table name; administration has no attempt count.
+func syncIeltsScore(ctx context.Context, tx pgx.Tx, staffType, staffId string) error {
+	attempts := ""
+	switch staffType {
+	case "administration":
+	case "teacher", "support_teacher":
+		attempts = `,
+			ieltsAttemptCount = ieltsAttemptOffset + (
+				SELECT COUNT(*) FROM "ielts_result"
+				WHERE staffType = $1 AND staffId::text = $2 AND deleted_at = 0
+			)`
+	default:
+		return fmt.Errorf("invalid staff type: %s", staffType)
+	}
+
+	_, err := tx.Exec(ctx, fmt.Sprintf(`
+		UPDATE "%s" SET
+			ieltsScore = (
+				SELECT overall FROM "ielts_result"
+				WHERE staffType = $1 AND staffId::text = $2 AND deleted_at = 0
+				ORDER BY backfilled, testDate DESC, created_at DESC
+				LIMIT 1
+			)%s
+		WHERE id::text = $2`, staffType, attempts), staffType, staffId)
+
+	if err != nil {
+		log.Println("error while syncing ielts score", err)
+		return err
+	}
+
+	return nil
+}
+
+func scanIeltsResult(row pgx.Row) (*us.IeltsResult, error) {
+	var result us.IeltsResult
+
+	err := row.Scan(&result.Id, &result.StaffType, &result.StaffId, &result.StaffName, &result.TestDate, &result.Overall, &result.Listening, &result.Reading,
+		&result.Writing, &result.Speaking, &result.CertificateNumber, &result.ExpiresAt, &result.IsExpired, &result.CreatedAt, &result.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
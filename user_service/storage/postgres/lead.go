@@ -0,0 +1,492 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	us "user_service/genproto/user_service"
+	"user_service/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const leadColumns = `
+			l.id,
+			l.fullname,
+			l.phone,
+			l.source,
+			COALESCE(l.desiredLevel, ''),
+			l.branchId::text,
+			l.status,
+			COALESCE(l.note, ''),
+			COALESCE(l.lostReason, ''),
+			COALESCE(l.trialScheduleId::text, ''),
+			COALESCE(s.date::text, ''),
+			COALESCE(s.startTime::text, ''),
+			COALESCE(g.id::text, ''),
+			COALESCE(g.name, ''),
+			COALESCE(l.trialAttendedAt::text, ''),
+			COALESCE(l.studentId::text, ''),
+			COALESCE(st.login, ''),
+			COALESCE(l.convertedAt::text, ''),
+			COALESCE(l.created_at::text, ''),
+			COALESCE(l.updated_at::text, '')`
+
+// leadJoins brings in the trial lesson with its group and the student the
+// lead became.
+const leadJoins = `
+		LEFT JOIN "schedule" s ON s.id = l.trialScheduleId
+		LEFT JOIN "journal" j ON j.id = s.journalId
+		LEFT JOIN "group" g ON g.id = j.groupId
+		LEFT JOIN "student" st ON st.id = l.studentId`
+
+// leadMovesFrom lists, for each status a lead can be moved to by hand, the
+// statuses it can come from. A booked trial that did not happen goes back to
+// contacted, and a lost lead can be picked up again.
+var leadMovesFrom = map[string][]string{
+	"contacted":      {"new", "trial_booked", "lost"},
+	"trial_attended": {"trial_booked"},
+	"lost":           {"new", "contacted", "trial_booked", "trial_attended"},
+}
+
+var (
+	leadTrialFrom   = []string{"new", "contacted", "trial_booked"}
+	leadConvertFrom = []string{"new", "contacted", "trial_booked", "trial_attended"}
+)
+
+type leadRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewLeadRepo(db *pgxpool.Pool) storage.LeadRepoI {
+	return &leadRepo{
+		db: db,
+	}
+}
+
+// Create implements storage.LeadRepoI.
+func (l *leadRepo) Create(ctx context.Context, req *us.CreateLead) (*us.Lead, error) {
+	id := uuid.NewString()
+
+	_, err := l.db.Exec(ctx, `
+		INSERT INTO "lead" (
+			id,
+			fullname,
+			phone,
+			source,
+			desiredLevel,
+			branchId,
+			note
+		) VALUES (
+			$1, $2, $3, $4, NULLIF($5, ''), $6, NULLIF($7, '')
+		)`, id, req.Fullname, req.Phone, req.Source, req.DesiredLevel, req.BranchId, req.Note)
+
+	if err != nil {
+		log.Println("error while creating lead in storage", err)
+		return nil, err
+	}
+
+	return l.GetByID(ctx, &us.LeadPrimaryKey{Id: id})
+}
+
+// GetByID implements storage.LeadRepoI.
+func (l *leadRepo) GetByID(ctx context.Context, req *us.LeadPrimaryKey) (*us.Lead, error) {
+	resp, err := scanLead(l.db.QueryRow(ctx, `
+		SELECT `+leadColumns+`
+		FROM "lead" l`+leadJoins+`
+		WHERE l.id::text = $1 AND l.deleted_at = 0`, req.Id))
+
+	if err != nil {
+		log.Println("error while getting lead by id", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetList implements storage.LeadRepoI. Newest leads come first.
+func (l *leadRepo) GetList(ctx context.Context, req *us.GetListLeadRequest) (*us.GetListLeadResponse, error) {
+	resp := &us.GetListLeadResponse{}
+	offset := (req.Page - 1) * req.Limit
+
+	rows, err := l.db.Query(ctx, `
+		SELECT `+leadColumns+`
+		FROM "lead" l`+leadJoins+`
+		WHERE l.deleted_at = 0
+		  AND ($1 = '' OR l.fullname ILIKE '%' || $1 || '%' OR l.phone ILIKE '%' || $1 || '%')
+		  AND ($2 = '' OR l.status = $2)
+		  AND ($3 = '' OR l.source = $3)
+		  AND ($4 = '' OR l.branchId::text = $4)
+		ORDER BY l.created_at DESC`+fmt.Sprintf(" OFFSET %v LIMIT %v", offset, req.Limit), req.Search, req.Status, req.Source, req.BranchId)
+
+	if err != nil {
+		log.Println("error while getting all leads:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var count int64
+
+	for rows.Next() {
+		count++
+		lead, err := scanLead(rows)
+		if err != nil {
+			log.Println("error while scanning leads:", err)
+			return nil, err
+		}
+
+		resp.Leads = append(resp.Leads, lead)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Count = count
+
+	return resp, nil
+}
+
+// Update implements storage.LeadRepoI. A converted lead is kept as it was.
+func (l *leadRepo) Update(ctx context.Context, req *us.UpdateLead) (*us.Lead, error) {
+	result, err := l.db.Exec(ctx, `
+		UPDATE "lead" SET
+			fullname = $1,
+			phone = $2,
+			source = $3,
+			desiredLevel = NULLIF($4, ''),
+			branchId = $5,
+			note = NULLIF($6, ''),
+			updated_at = NOW()
+		WHERE id::text = $7 AND deleted_at = 0 AND status <> 'converted'`, req.Fullname, req.Phone, req.Source, req.DesiredLevel, req.BranchId, req.Note, req.Id)
+
+	if err != nil {
+		log.Println("error while updating lead in storage", err)
+		return nil, err
+	}
+
+	if result.RowsAffected() == 0 {
+		return nil, errors.New("lead not found or already converted")
+	}
+
+	return l.GetByID(ctx, &us.LeadPrimaryKey{Id: req.Id})
+}
+
+// Delete implements storage.LeadRepoI.
+func (l *leadRepo) Delete(ctx context.Context, req *us.LeadPrimaryKey) error {
+	_, err := l.db.Exec(ctx, `
+		UPDATE "lead" SET
+			deleted_at = 1,
+			updated_at = NOW()
+		WHERE id::text = $1`, req.Id)
+
+	if err != nil {
+		log.Println("error while deleting lead", err)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateStatus implements storage.LeadRepoI.
+func (l *leadRepo) UpdateStatus(ctx context.Context, req *us.UpdateLeadStatus) (*us.Lead, error) {
+	from, ok := leadMovesFrom[req.Status]
+	if !ok {
+		return nil, fmt.Errorf("lead cannot be moved to %s by hand", req.Status)
+	}
+
+	tx, err := l.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err = lockLead(ctx, tx, req.Id, req.Status, from); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE "lead" SET
+			status = $1,
+			lostReason = CASE WHEN $1 = 'lost' THEN NULLIF($2, '') END,
+			trialAttendedAt = CASE WHEN $1 = 'trial_attended' THEN NOW() ELSE trialAttendedAt END,
+			updated_at = NOW()
+		WHERE id::text = $3`, req.Status, req.LostReason, req.Id)
+
+	if err != nil {
+		log.Println("error while updating lead status", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing lead status", err)
+		return nil, err
+	}
+
+	return l.GetByID(ctx, &us.LeadPrimaryKey{Id: req.Id})
+}
+
+// BookTrial implements storage.LeadRepoI. The trial is an upcoming lesson of
+// a group in the lead's branch; booking again moves the trial.
+func (l *leadRepo) BookTrial(ctx context.Context, req *us.BookLeadTrial) (*us.Lead, error) {
+	var trialBranchId string
+
+	tx, err := l.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	branchId, err := lockLead(ctx, tx, req.Id, "trial_booked", leadTrialFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(ctx, `
+		SELECT COALESCE(g.branchId::text, '')
+		FROM "schedule" s
+		JOIN "journal" j ON j.id = s.journalId
+		JOIN "group" g ON g.id = j.groupId
+		WHERE s.id::text = $1 AND s.deleted_at = 0 AND g.deleted_at = 0 AND s.date >= CURRENT_DATE`, req.ScheduleId).Scan(&trialBranchId)
+
+	if err == pgx.ErrNoRows {
+		return nil, errors.New("lesson not found or already past")
+	}
+	if err != nil {
+		log.Println("error while getting trial lesson", err)
+		return nil, err
+	}
+
+	if trialBranchId != branchId {
+		return nil, errors.New("trial lesson must be in the lead's branch")
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE "lead" SET
+			status = 'trial_booked',
+			trialScheduleId = $1,
+			lostReason = NULL,
+			updated_at = NOW()
+		WHERE id::text = $2`, req.ScheduleId, req.Id)
+
+	if err != nil {
+		log.Println("error while booking trial lesson", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing trial lesson", err)
+		return nil, err
+	}
+
+	return l.GetByID(ctx, &us.LeadPrimaryKey{Id: req.Id})
+}
+
+// Convert implements storage.LeadRepoI. The student is created with the
+// lead's name, phone and branch and the next student login, in the same
+// transaction that closes the lead and enrols the student in groupName, so a
+// full or unknown group leaves the lead open.
+func (l *leadRepo) Convert(ctx context.Context, req *us.ConvertLead) (*us.Lead, error) {
+	var (
+		studentId = uuid.NewString()
+		fullname  string
+		phone     string
+		taken     bool
+	)
+
+	tx, err := l.db.Begin(ctx)
+	if err != nil {
+		log.Println("error while starting transaction", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	branchId, err := lockLead(ctx, tx, req.Id, "converted", leadConvertFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(ctx, `
+		SELECT
+			l.fullname,
+			l.phone,
+			EXISTS (SELECT 1 FROM "student" WHERE phone = l.phone AND deleted_at = 0)
+		FROM "lead" l
+		WHERE l.id::text = $1`, req.Id).Scan(&fullname, &phone, &taken)
+
+	if err != nil {
+		log.Println("error while getting lead to convert", err)
+		return nil, err
+	}
+
+	if taken {
+		return nil, errors.New("a student with this phone already exists")
+	}
+
+	login, err := nextStudentLogin(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO "student" (
+			id,
+			login,
+			fullname,
+			phone,
+			password,
+			groupName,
+			branchId
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)`, studentId, login, fullname, phone, req.Password, req.GroupName, branchId)
+
+	if err != nil {
+		log.Println("error while creating student from lead", err)
+		return nil, err
+	}
+
+	if err = enrollStudent(ctx, tx, studentId, branchId, req.GroupName); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE "lead" SET
+			status = 'converted',
+			studentId = $1,
+			convertedAt = NOW(),
+			lostReason = NULL,
+			updated_at = NOW()
+		WHERE id::text = $2`, studentId, req.Id)
+
+	if err != nil {
+		log.Println("error while converting lead", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing lead conversion", err)
+		return nil, err
+	}
+
+	return l.GetByID(ctx, &us.LeadPrimaryKey{Id: req.Id})
+}
+
+// GetConversionReport implements storage.LeadRepoI. Leads count towards the
+// period they came in, whatever happened to them later; trials count once
+// booked or attended, even if the lead went on to be lost.
+func (l *leadRepo) GetConversionReport(ctx context.Context, req *us.LeadConversionReportRequest) (*us.LeadConversionReport, error) {
+	resp := &us.LeadConversionReport{
+		FromDate: req.FromDate,
+		ToDate:   req.ToDate,
+		Total:    &us.LeadConversionRow{},
+	}
+
+	rows, err := l.db.Query(ctx, `
+		SELECT
+			l.source,
+			l.branchId::text,
+			COALESCE(b.name, ''),
+			COUNT(*)::int,
+			COUNT(l.trialScheduleId)::int,
+			COUNT(l.trialAttendedAt)::int,
+			COUNT(*) FILTER (WHERE l.status = 'converted')::int,
+			COUNT(*) FILTER (WHERE l.status = 'lost')::int
+		FROM "lead" l
+		LEFT JOIN "branch" b ON b.id = l.branchId
+		WHERE l.deleted_at = 0
+		  AND l.created_at::date BETWEEN $1::date AND $2::date
+		  AND ($3 = '' OR l.branchId::text = $3)
+		GROUP BY l.source, l.branchId, b.name
+		ORDER BY l.source, b.name`, req.FromDate, req.ToDate, req.BranchId)
+
+	if err != nil {
+		log.Println("error while getting lead conversion report:", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row us.LeadConversionRow
+		err = rows.Scan(&row.Source, &row.BranchId, &row.BranchName, &row.Leads, &row.TrialsBooked, &row.TrialsAttended, &row.Converted, &row.Lost)
+		if err != nil {
+			log.Println("error while scanning lead conversion report:", err)
+			return nil, err
+		}
+
+		row.ConversionRate = conversionRate(row.Converted, row.Leads)
+		resp.Rows = append(resp.Rows, &row)
+
+		resp.Total.Leads += row.Leads
+		resp.Total.TrialsBooked += row.TrialsBooked
+		resp.Total.TrialsAttended += row.TrialsAttended
+		resp.Total.Converted += row.Converted
+		resp.Total.Lost += row.Lost
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return nil, err
+	}
+
+	resp.Total.ConversionRate = conversionRate(resp.Total.Converted, resp.Total.Leads)
+
+	return resp, nil
+}
+
+// lockLead locks a lead for a status change and returns its branch. It fails
+// unless the lead is in one of the given statuses.
+func lockLead(ctx context.Context, tx pgx.Tx, id, to string, from []string) (string, error) {
+	var branchId, status string
+
+	err := tx.QueryRow(ctx, `
+		SELECT branchId::text, status
+		FROM "lead"
+		WHERE id::text = $1 AND deleted_at = 0
+		FOR UPDATE`, id).Scan(&branchId, &status)
+
+	if err == pgx.ErrNoRows {
+		return "", errors.New("lead not found")
+	}
+	if err != nil {
+		log.Println("error while locking lead", err)
+		return "", err
+	}
+
+	for _, s := range from {
+		if s == status {
+			return branchId, nil
+		}
+	}
+
+	return "", fmt.Errorf("lead cannot go from %s to %s", status, to)
+}
+
+// conversionRate is the share of leads converted, in percent to two decimals.
+func conversionRate(converted, leads int32) float32 {
+	if leads == 0 {
+		return 0
+	}
+
+	return float32(math.Round(float64(converted)*10000/float64(leads)) / 100)
+}
+
+func scanLead(row pgx.Row) (*us.Lead, error) {
+	var lead us.Lead
+
+	err := row.Scan(&lead.Id, &lead.Fullname, &lead.Phone, &lead.Source, &lead.DesiredLevel, &lead.BranchId, &lead.Status, &lead.Note, &lead.LostReason,
+		&lead.TrialScheduleId, &lead.TrialDate, &lead.TrialStartTime, &lead.TrialGroupId, &lead.TrialGroupName, &lead.TrialAttendedAt,
+		&lead.StudentId, &lead.StudentLogin, &lead.ConvertedAt, &lead.CreatedAt, &lead.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lead, nil
+}
@@ -15,10 +15,14 @@ type Store struct {
 	db             *pgxpool.Pool
 	administration storage.AdministrationRepoI
 	branch         storage.BranchRepoI
+	branchSetting  storage.BranchSettingRepoI
 	manager        storage.ManagerRepoI
 	student        storage.StudentRepoI
 	supportTeacher storage.SupportTeacherRepoI
 	teacher        storage.TeacherRepoI
+	ieltsResult    storage.IeltsResultRepoI
+	guardian       storage.GuardianRepoI
+	lead           storage.LeadRepoI
 }
 
 func NewPostgres(ctx context.Context, cfg config.Config) (storage.StorageI, error) {
@@ -76,6 +80,15 @@ func (s *Store) Branch() storage.BranchRepoI {
 	return s.branch
 }
 
+// BranchSetting implements storage.StorageI.
+func (s *Store) BranchSetting() storage.BranchSettingRepoI {
+	if s.branchSetting == nil {
+		s.branchSetting = NewBranchSettingRepo(s.db)
+	}
+
+	return s.branchSetting
+}
+
 // Manager implements storage.StorageI.
 func (s *Store) Manager() storage.ManagerRepoI {
 	if s.manager == nil {
@@ -111,3 +124,30 @@ func (s *Store) Teacher() storage.TeacherRepoI {
 
 	return s.teacher
 }
+
+// IeltsResult implements storage.StorageI.
+func (s *Store) IeltsResult() storage.IeltsResultRepoI {
+	if s.ieltsResult == nil {
+		s.ieltsResult = NewIeltsResultRepo(s.db)
+	}
+
+	return s.ieltsResult
+}
+
+// Guardian implements storage.StorageI.
+func (s *Store) Guardian() storage.GuardianRepoI {
+	if s.guardian == nil {
+		s.guardian = NewGuardianRepo(s.db)
+	}
+
+	return s.guardian
+}
+
+// Lead implements storage.StorageI.
+func (s *Store) Lead() storage.LeadRepoI {
+	if s.lead == nil {
+		s.lead = NewLeadRepo(s.db)
+	}
+
+	return s.lead
+}
@@ -11,6 +11,7 @@ import (
 	"user_service/storage"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -24,19 +25,24 @@ func NewStudentRepo(db *pgxpool.Pool) storage.StudentRepoI {
 	}
 }
 
-// Create implements storage.StudentRepoI.
+// Create implements storage.StudentRepoI. A student given a groupName is
+// enrolled in that group in the same transaction.
 func (s *studentRepo) Create(ctx context.Context, req *us.CreateStudent) (*us.Student, error) {
 	id := uuid.NewString()
 
-	loginlast, err := s.GetLastLogin(ctx)
+	tx, err := s.db.Begin(ctx)
 	if err != nil {
-		log.Println("error while creating student:", err)
+		log.Println("error while starting transaction", err)
 		return nil, err
 	}
+	defer tx.Rollback(ctx)
 
-	login:=GenerateNewLoginStudent(loginlast)
+	login, err := nextStudentLogin(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
 
-	_, err = s.db.Exec(ctx, `
+	_, err = tx.Exec(ctx, `
 		INSERT INTO "student" (
 			id,
 			login,
@@ -54,6 +60,15 @@ func (s *studentRepo) Create(ctx context.Context, req *us.CreateStudent) (*us.St
 		return nil, err
 	}
 
+	if err = enrollStudent(ctx, tx, id, req.BranchId, req.GroupName); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		log.Println("error while committing student", err)
+		return nil, err
+	}
+
 	student, err := s.GetByID(ctx, &us.StudentPrimaryKey{Id: id})
 	if err != nil {
 		log.Println("error while getting student by id after creating", err)
@@ -62,6 +77,81 @@ func (s *studentRepo) Create(ctx context.Context, req *us.CreateStudent) (*us.St
 	return student, nil
 }
 
+// enrollStudent adds a new student to the running group of the branch named
+// groupName. Like enrolments in schedule_service, it locks the group row so
+// two students cannot take its last seat, and turns students away from a full
+// group: they have to join its waitlist instead.
+func enrollStudent(ctx context.Context, tx pgx.Tx, studentId, branchId, groupName string) error {
+	if groupName == "" {
+		return nil
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT id::text, capacity
+		FROM "group"
+		WHERE name = $1
+		  AND ($2 = '' OR branchId::text = $2)
+		  AND deleted_at = 0
+		  AND status IS DISTINCT FROM 'finished'
+		ORDER BY id
+		FOR UPDATE`, groupName, branchId)
+	if err != nil {
+		log.Println("error while locking group", err)
+		return err
+	}
+
+	var (
+		groupIds []string
+		capacity sql.NullInt32
+	)
+	for rows.Next() {
+		var groupId string
+		if err = rows.Scan(&groupId, &capacity); err != nil {
+			rows.Close()
+			log.Println("error while scanning group", err)
+			return err
+		}
+		groupIds = append(groupIds, groupId)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		log.Println("rows iteration error:", err)
+		return err
+	}
+
+	if len(groupIds) != 1 {
+		return fmt.Errorf("no single running group named %s in this branch", groupName)
+	}
+
+	var members int32
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*)::int FROM "group_student" WHERE groupId::text = $1 AND deleted_at = 0`, groupIds[0]).Scan(&members)
+	if err != nil {
+		log.Println("error while counting group students", err)
+		return err
+	}
+
+	if capacity.Valid && members >= capacity.Int32 {
+		return errors.New("group is full, add the student to the waitlist")
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO "group_student" (
+			id,
+			groupId,
+			studentId
+		) VALUES (
+			$1, $2, $3
+		)`, uuid.NewString(), groupIds[0], studentId)
+	if err != nil {
+		log.Println("error while adding student to group", err)
+		return err
+	}
+
+	return nil
+}
+
 // GetByID implements storage.StudentRepoI.
 func (s *studentRepo) GetByID(ctx context.Context, req *us.StudentPrimaryKey) (*us.Student, error) {
 	resp := &us.Student{}
@@ -159,7 +249,8 @@ func (s *studentRepo) GetList(ctx context.Context, req *us.GetListStudentRequest
 	return resp, nil
 }
 
-// Update implements storage.StudentRepoI.
+// Update implements storage.StudentRepoI. groupName is left alone: it
+// follows the student's group membership in schedule_service.
 func (s *studentRepo) Update(ctx context.Context, req *us.UpdateStudent) (*us.Student, error) {
 
 	_, err := s.db.Exec(ctx, `
@@ -168,10 +259,9 @@ func (s *studentRepo) Update(ctx context.Context, req *us.UpdateStudent) (*us.St
 			fullname=$2,
 			phone=$3,
 			password=$4,
-			groupName=$5,
-			branchId=$6,
+			branchId=$5,
             updated_at = NOW()
-        WHERE id = $7`, req.Login, req.Fullname, req.Phone, req.Password, req.GroupName, req.BranchId, req.Id)
+        WHERE id = $6`, req.Login, req.Fullname, req.Phone, req.Password, req.BranchId, req.Id)
 
 	if err != nil {
 		log.Println("error while updating student in storage", err)
@@ -224,6 +314,28 @@ func (r *studentRepo) GetLastLogin(ctx context.Context) (string, error) {
 	return login, nil
 }
 
+// nextStudentLogin picks the login for a new student. The transaction-level
+// advisory lock makes concurrent creates and lead conversions wait for each
+// other, so two students cannot be given the same login.
+func nextStudentLogin(ctx context.Context, tx pgx.Tx) (string, error) {
+	var lastLogin string
+
+	_, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('student_login'))`)
+	if err != nil {
+		log.Println("error while locking student logins", err)
+		return "", err
+	}
+
+	err = tx.QueryRow(ctx, `
+		SELECT COALESCE(MAX(login), 'S00000') FROM "student"`).Scan(&lastLogin)
+	if err != nil {
+		log.Println("error while getting last student login", err)
+		return "", err
+	}
+
+	return GenerateNewLoginStudent(lastLogin), nil
+}
+
 func GenerateNewLoginStudent(log string) string {
 	prefix := "S"
 	numbStr := log[1:]
@@ -260,23 +372,8 @@ func (r *studentRepo) GetReportList(ctx context.Context, req *us.GetReportListSt
 	// Calculate OFFSET based on page and limit
 	offset := (req.Page - 1) * req.Limit
 
-	// Initialize filter string
-	filter := ""
-
-	// Add search condition if req.Search is not empty
-	if req.Search != "" {
-		filter = fmt.Sprintf(`
-            WHERE (s.login ILIKE '%%%v%%'
-            OR s.fullname ILIKE '%%%v%%'
-            OR s.phone ILIKE '%%%v%%'
-            OR s.groupName ILIKE '%%%v%%') AND s.deleted_at=0
-        `, req.Search, req.Search, req.Search, req.Search)
-	} else {
-		filter = " WHERE s.deleted_at IS NULL "
-	}
-
-	// Construct the final query with filter, limit, and offset
-	query := fmt.Sprintf(`
+	// Construct the final query with search, limit, and offset
+	query := `
         SELECT 
             s.id,
             s.login,
@@ -287,27 +384,31 @@ func (r *studentRepo) GetReportList(ctx context.Context, req *us.GetReportListSt
             s.branchId,
             s.created_at,
             s.updated_at,
-            COALESCE(SUM(sp.paidSum), 0) AS total_paid_sum
+            paid.total::text AS total_paid_sum,
+            GREATEST(invoiced.total - paid.total, 0)::text AS debt
         FROM 
             student s
-        LEFT JOIN 
-            student_payment sp ON s.id = sp.studentId
-        %s
-        GROUP BY 
-            s.id,
-            s.login,
-            s.fullname,
-            s.phone,
-            s.password,
-            s.groupName,
-            s.branchId,
-            s.created_at,
-            s.updated_at
+        CROSS JOIN LATERAL (
+            SELECT COALESCE(SUM(sp.paidSum), 0) AS total
+            FROM student_payment sp
+            WHERE sp.studentId = s.id
+        ) paid
+        CROSS JOIN LATERAL (
+            SELECT COALESCE(SUM(i.amount), 0) AS total
+            FROM invoice i
+            WHERE i.studentId = s.id
+        ) invoiced
+        WHERE s.deleted_at = 0
+          AND ($3 = '' OR s.login ILIKE '%' || $3 || '%'
+            OR s.fullname ILIKE '%' || $3 || '%'
+            OR s.phone ILIKE '%' || $3 || '%'
+            OR s.groupName ILIKE '%' || $3 || '%')
+        ORDER BY s.created_at
         LIMIT $1 OFFSET $2
-    `, filter)
+    `
 
 	// Execute the query
-	rows, err := r.db.Query(ctx, query, req.Limit, offset)
+	rows, err := r.db.Query(ctx, query, req.Limit, offset, req.Search)
 	if err != nil {
 		log.Println("error while getting student list:", err)
 		return nil, err
@@ -318,7 +419,7 @@ func (r *studentRepo) GetReportList(ctx context.Context, req *us.GetReportListSt
 	for rows.Next() {
 		var student us.GetReportStudentResponse
 		err := rows.Scan(&student.Id, &student.Login, &student.Fullname, &student.Phone, &student.Password, &student.GroupName,
-			&student.BranchId, &created_at, &updated_at, &student.Paidsum)
+			&student.BranchId, &created_at, &updated_at, &student.Paidsum, &student.Debt)
 		if err != nil {
 			log.Println("error while scanning student row:", err)
 			return nil, err
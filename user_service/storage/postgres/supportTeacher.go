@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"time"
 	us "user_service/genproto/user_service"
 	"user_service/storage"
 
@@ -44,12 +43,10 @@ func (s *supportTeacherRepo) Create(ctx context.Context, req *us.CreateSupportTe
 			phone,
 			password,
 			salary,
-			ieltsScore,
-			ieltsAttemptCount,
 			branchId
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9
-		)`, id, login, req.Fullname, req.Phone, req.Password, req.Salary, req.IeltsScore, req.IeltsAttemptCount, req.BranchId)
+			$1, $2, $3, $4, $5, $6, $7
+		)`, id, login, req.Fullname, req.Phone, req.Password, req.Salary, req.BranchId)
 
 	if err != nil {
 		log.Println("error while creating support teacher in storage", err)
@@ -175,11 +172,9 @@ func (s *supportTeacherRepo) Update(ctx context.Context, req *us.UpdateSupportTe
 			phone=$3,
 			password=$4,
 			salary=$5,
-			ieltsScore=$6,
-			ieltsAttemptCount=$7,
-			branchId=$8,
+			branchId=$6,
             updated_at = NOW()
-        WHERE id = $9`, req.Login, req.Fullname, req.Phone, req.Password, req.Salary, req.IeltsScore, req.IeltsAttemptCount, req.BranchId, req.Id)
+        WHERE id = $7`, req.Login, req.Fullname, req.Phone, req.Password, req.Salary, req.BranchId, req.Id)
 
 	if err != nil {
 		log.Println("error while updating support teacher in storage", err)
@@ -279,7 +274,11 @@ func (r *supportTeacherRepo) GetReportList(ctx context.Context, req *us.GetRepor
 	}
 
 	query := fmt.Sprintf(`
-		SELECT id, login, fullname, phone, password, salary, ieltsScore, ieltsAttemptCount, branchId, created_at, updated_at
+		SELECT id, login, fullname, phone, password, salary, ieltsScore, ieltsAttemptCount, branchId, created_at, updated_at,
+			(SELECT COALESCE(SUM(pl.total), 0)
+			    FROM payroll_line pl
+			    JOIN payroll_run pr ON pr.id = pl.runId
+			    WHERE pr.status = 'locked' AND pl.staffType = 'support_teacher' AND pl.staffId = support_teacher.id)::text
 		FROM support_teacher
 		%s
 		LIMIT $1 OFFSET $2
@@ -294,7 +293,7 @@ func (r *supportTeacherRepo) GetReportList(ctx context.Context, req *us.GetRepor
 
 	for rows.Next() {
 		var teacher us.GetReportSupportTeacherResponse
-		err := rows.Scan(&teacher.Id, &teacher.Login, &teacher.Fullname, &teacher.Phone, &teacher.Password, &teacher.Salary, &teacher.IeltsScore, &teacher.IeltsAttemptCount, &branchId, &created_at, &updatedAt)
+		err := rows.Scan(&teacher.Id, &teacher.Login, &teacher.Fullname, &teacher.Phone, &teacher.Password, &teacher.Salary, &teacher.IeltsScore, &teacher.IeltsAttemptCount, &branchId, &created_at, &updatedAt, &teacher.Totalsum)
 		if err != nil {
 			log.Println("error while scanning teacher row:", err)
 			return nil, err
@@ -303,22 +302,6 @@ func (r *supportTeacherRepo) GetReportList(ctx context.Context, req *us.GetRepor
 		teacher.CreatedAt = created_at.String
 		teacher.UpdatedAt = updatedAt.String
 
-		startDateStr := teacher.CreatedAt[:10]
-		startDate, err := time.Parse("2006-01-02", startDateStr)
-		if err != nil {
-			log.Println("Error parsing start date:", err)
-			return nil, err
-		}
-
-		daysWorked := time.Since(startDate).Hours() / 24
-
-		monthlyRate := float64(teacher.Salary) 
-
-		totalSum := int64(daysWorked * monthlyRate)
-
-		teacher.Totalsum = fmt.Sprintf("%d", totalSum)
-
-
 		resp.GetSupportTeachers = append(resp.GetSupportTeachers, &teacher)
 	}
 	if err := rows.Err(); err != nil {
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"time"
 	us "user_service/genproto/user_service"
 	"user_service/storage"
 
@@ -44,13 +43,11 @@ func (t *teacherRepo) Create(ctx context.Context, req *us.CreateTeacher) (*us.Te
 			phone,
 			password,
 			salary,
-			ieltsScore,
-			ieltsAttemptCount,
 			supportTeacherId,
 			branchId
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
-		)`, id, login, req.Fullname, req.Phone, req.Password, req.Salary, req.IeltsScore, req.IeltsAttemptCount, req.SupportTeacherId, req.BranchId)
+			$1, $2, $3, $4, $5, $6, $7, $8
+		)`, id, login, req.Fullname, req.Phone, req.Password, req.Salary, req.SupportTeacherId, req.BranchId)
 
 	if err != nil {
 		log.Println("error while creating teacher in storage", err)
@@ -178,12 +175,10 @@ func (t *teacherRepo) Update(ctx context.Context, req *us.UpdateTeacher) (*us.Te
 			phone=$3,
 			password=$4,
 			salary=$5,
-			ieltsScore=$6,
-			ieltsAttemptCount=$7,
-			supportTeacherId=$8,
-			branchId=$9,
+			supportTeacherId=$6,
+			branchId=$7,
             updated_at = NOW()
-        WHERE id = $10`, req.Login, req.Fullname, req.Phone, req.Password, req.Salary, req.IeltsScore, req.IeltsAttemptCount, req.SupportTeacherId, req.BranchId, req.Id)
+        WHERE id = $8`, req.Login, req.Fullname, req.Phone, req.Password, req.Salary, req.SupportTeacherId, req.BranchId, req.Id)
 
 	if err != nil {
 		log.Println("error while updating teacher in storage", err)
@@ -288,7 +283,11 @@ func (r *teacherRepo) GetReportList(ctx context.Context, req *us.GetReportListTe
 
 	query := fmt.Sprintf(`
         SELECT id, login, fullname, phone, salary,
-         ieltsScore, ieltsAttemptCount, supportTeacherId, branchId, created_at, updated_at
+         ieltsScore, ieltsAttemptCount, supportTeacherId, branchId, created_at, updated_at,
+         (SELECT COALESCE(SUM(pl.total), 0)
+            FROM payroll_line pl
+            JOIN payroll_run pr ON pr.id = pl.runId
+            WHERE pr.status = 'locked' AND pl.staffType = 'teacher' AND pl.staffId = teacher.id)::text
         FROM "teacher"
         %s
         LIMIT $1 OFFSET $2
@@ -303,7 +302,7 @@ func (r *teacherRepo) GetReportList(ctx context.Context, req *us.GetReportListTe
 
 	for rows.Next() {
 		var teacher us.GetReportTeacherResponse
-		err := rows.Scan(&teacher.Id, &login, &teacher.Fullname, &phone, &teacher.Salary, &ieltsScore, &ieltsAttemptCount, &teacher.SupportTeacherId, &branchId, &created_at, &updated_at)
+		err := rows.Scan(&teacher.Id, &login, &teacher.Fullname, &phone, &teacher.Salary, &ieltsScore, &ieltsAttemptCount, &teacher.SupportTeacherId, &branchId, &created_at, &updated_at, &teacher.Totalsum)
 		if err != nil {
 			log.Println("error while scanning teacher row:", err)
 			return nil, err
@@ -318,21 +317,6 @@ func (r *teacherRepo) GetReportList(ctx context.Context, req *us.GetReportListTe
 		teacher.BranchId = branchId.String
 		teacher.CreatedAt = created_at.String
 
-		startDateStr := teacher.CreatedAt[:10]
-		startDate, err := time.Parse("2006-01-02", startDateStr)
-		if err != nil {
-			log.Println("Error parsing start date:", err)
-			return nil, err
-		}
-
-		daysWorked := time.Since(startDate).Hours() / 24
-
-		monthlyRate := float64(teacher.Salary)
-
-		totalSum := int64(daysWorked * monthlyRate)
-
-		teacher.Totalsum = fmt.Sprintf("%d", totalSum)
-
 		resp.GetTeacherResponse = append(resp.GetTeacherResponse, &teacher)
 	}
 
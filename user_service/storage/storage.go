@@ -9,10 +9,14 @@ type StorageI interface {
 	CloseDB()
 	Administration() AdministrationRepoI
 	Branch() BranchRepoI
+	BranchSetting() BranchSettingRepoI
 	Manager() ManagerRepoI
 	Student() StudentRepoI
 	SupportTeacher() SupportTeacherRepoI
 	Teacher() TeacherRepoI
+	IeltsResult() IeltsResultRepoI
+	Guardian() GuardianRepoI
+	Lead() LeadRepoI
 }
 
 type AdministrationRepoI interface {
@@ -33,6 +37,14 @@ type BranchRepoI interface {
 	Delete(ctx context.Context, req *us.BranchPrimaryKey) error
 }
 
+type BranchSettingRepoI interface {
+	Create(ctx context.Context, req *us.CreateBranchSetting) (*us.BranchSetting, error)
+	GetByID(ctx context.Context, req *us.BranchSettingPrimaryKey) (*us.BranchSetting, error)
+	GetList(ctx context.Context, req *us.GetListBranchSettingRequest) (*us.GetListBranchSettingResponse, error)
+	Update(ctx context.Context, req *us.UpdateBranchSetting) (*us.BranchSetting, error)
+	Delete(ctx context.Context, req *us.BranchSettingPrimaryKey) error
+}
+
 type ManagerRepoI interface {
 	Create(ctx context.Context, req *us.CreateManager) (*us.Manager, error)
 	GetByID(ctx context.Context, req *us.ManagerPrimaryKey) (*us.Manager, error)
@@ -71,3 +83,35 @@ type TeacherRepoI interface {
 	GetByLogin(ctx context.Context, login string) (*us.Teacher, error)
 	GetReportList(ctx context.Context, req *us.GetReportListTeacherRequest) (*us.GetReportListTeacherResponse, error)
 }
+
+type IeltsResultRepoI interface {
+	Create(ctx context.Context, req *us.CreateIeltsResult) (*us.IeltsResult, error)
+	GetByID(ctx context.Context, req *us.IeltsResultPrimaryKey) (*us.IeltsResult, error)
+	GetList(ctx context.Context, req *us.GetListIeltsResultRequest) (*us.GetListIeltsResultResponse, error)
+	Update(ctx context.Context, req *us.UpdateIeltsResult) (*us.IeltsResult, error)
+	Delete(ctx context.Context, req *us.IeltsResultPrimaryKey) error
+	GetListExpiring(ctx context.Context, req *us.GetListExpiringIeltsRequest) (*us.GetListExpiringIeltsResponse, error)
+}
+
+type GuardianRepoI interface {
+	Create(ctx context.Context, req *us.CreateGuardian) (*us.Guardian, error)
+	GetByID(ctx context.Context, req *us.GuardianPrimaryKey) (*us.Guardian, error)
+	GetList(ctx context.Context, req *us.GetListGuardianRequest) (*us.GetListGuardianResponse, error)
+	Update(ctx context.Context, req *us.UpdateGuardian) (*us.Guardian, error)
+	Delete(ctx context.Context, req *us.GuardianPrimaryKey) error
+	GetByLogin(ctx context.Context, login string) (*us.Guardian, string, error)
+	LinkStudent(ctx context.Context, req *us.GuardianStudent) error
+	UnlinkStudent(ctx context.Context, req *us.GuardianStudentPrimaryKey) error
+}
+
+type LeadRepoI interface {
+	Create(ctx context.Context, req *us.CreateLead) (*us.Lead, error)
+	GetByID(ctx context.Context, req *us.LeadPrimaryKey) (*us.Lead, error)
+	GetList(ctx context.Context, req *us.GetListLeadRequest) (*us.GetListLeadResponse, error)
+	Update(ctx context.Context, req *us.UpdateLead) (*us.Lead, error)
+	Delete(ctx context.Context, req *us.LeadPrimaryKey) error
+	UpdateStatus(ctx context.Context, req *us.UpdateLeadStatus) (*us.Lead, error)
+	BookTrial(ctx context.Context, req *us.BookLeadTrial) (*us.Lead, error)
+	Convert(ctx context.Context, req *us.ConvertLead) (*us.Lead, error)
+	GetConversionReport(ctx context.Context, req *us.LeadConversionReportRequest) (*us.LeadConversionReport, error)
+}